@@ -0,0 +1,134 @@
+// batch.go
+/**
+ * Nexuflex Client - Batch Mode
+ *
+ * This file contains the execution of a single command without the user
+ * interface, for scripts and Unix pipelines. The client connects to the
+ * configured server, logs in with an API key, the credentials stored in
+ * the keyring or a provider that needs none, runs the command given on the
+ * command line, prints its output to stdout and logs out again. The
+ * "upload" command streams a file or stdin into an upload command.
+ * Servers of an environment whose policy demands it (prod) are only used
+ * with the --prod acknowledgment.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+)
+
+// Exit codes of the batch mode
+const (
+	exitOK              = 0
+	exitFailed          = 1
+	exitNoSession       = 2
+	exitNotAcknowledged = 3
+)
+
+// batchOptions are the command line options of the batch mode
+type batchOptions struct {
+	prodAck bool     // Acknowledges that the server may be a production server
+	apiKey  string   // Logs in as service account instead of with the stored credentials
+	scopes  []string // Limits the API key session to these scopes
+}
+
+// runBatch runs a command without the user interface and returns the exit code
+func runBatch(c *client.Client, cfg *config.Config, command string, options batchOptions) int {
+	c.SetCallbacks(nil, nil, func(output string) {
+		fmt.Println(output)
+	})
+
+	if cfg.Server.Address == "" || cfg.Server.Port == 0 {
+		fmt.Fprintln(os.Stderr, "Error: no server configured (use -server and -port)")
+		return exitNoSession
+	}
+	if err := c.Connect(cfg.Server.Address, cfg.Server.Port, cfg.Server.UseTLS); err != nil {
+		fmt.Fprintf(os.Stderr, "Connection error: %v\n", err)
+		return exitNoSession
+	}
+	defer c.Close()
+
+	// Production servers are only used with the --prod acknowledgment
+	if err := c.CheckBatchAcknowledgment(options.prodAck); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitNotAcknowledged
+	}
+
+	// Service accounts log in with their API key
+	if options.apiKey != "" {
+		if err := c.LoginWithAPIKey(options.apiKey, options.scopes); err != nil {
+			fmt.Fprintf(os.Stderr, "Login with API key failed: %v\n", err)
+			return exitNoSession
+		}
+	} else if err := c.LoginStored(); err != nil {
+		fmt.Fprintf(os.Stderr, "Login failed: %v (log in once interactively and store the credentials)\n", err)
+		return exitNoSession
+	}
+	defer c.Logout()
+
+	name, args, _ := strings.Cut(command, " ")
+	if strings.EqualFold(name, "upload") {
+		return runBatchUpload(c, args)
+	}
+
+	// Local files referenced with @file: are uploaded first
+	if client.HasFileParameters(command) {
+		staged, err := c.StageFileParameters(context.Background(), command, nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitFailed
+		}
+		command = staged
+	}
+
+	err := c.ExecuteCommand(command)
+	var pending *client.ApprovalPendingError
+	if errors.As(err, &pending) {
+		// The server runs the command once a second user has approved it
+		fmt.Printf("Command requires approval by a second user (approval ID %s)\n", pending.ApprovalID)
+		return exitOK
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailed
+	}
+	return exitOK
+}
+
+// runBatchUpload streams a file or stdin into an upload command
+func runBatchUpload(c *client.Client, args string) int {
+	source, command, err := client.ParseUploadArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailed
+	}
+
+	data, err := client.OpenUploadSource(source, os.Stdin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailed
+	}
+	defer data.Close()
+
+	output, err := c.UploadData(context.Background(), command, data, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailed
+	}
+	if output != "" {
+		fmt.Println(output)
+	}
+	return exitOK
+}
@@ -1 +1,7 @@
-go build -o nexuflex-client.exe main.go
+@echo off
+rem Build the client with embedded version information
+set VERSION=1.0.0
+set COMMIT=unknown
+for /f %%i in ('git rev-parse --short HEAD 2^>nul') do set COMMIT=%%i
+set PKG=github.com/msto63/nexuflex/nexuflex-client/client
+go build -ldflags "-X %PKG%.Version=%VERSION% -X %PKG%.Commit=%COMMIT% -X %PKG%.BuildDate=%DATE%" -o nexuflex-client.exe .
\ No newline at end of file
@@ -0,0 +1,268 @@
+// aliases.go
+/**
+ * Nexuflex Client - Local Alias Management
+ *
+ * This file contains functions for managing local command aliases
+ * that are not stored on the server. An alias may expand to another
+ * alias; the expansion follows such chains up to a depth limit and stops
+ * at cycles instead of looping. An alias whose expansion starts with its
+ * own name ("status=status --verbose") is expanded once, as in shells.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2025-03-12
+ */
+
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+)
+
+// DefaultAliasDepth is the number of aliases expanded one after another by default
+const DefaultAliasDepth = 8
+
+// AliasManager manages local command aliases; it can be used from several goroutines
+type AliasManager struct {
+	mu       sync.RWMutex
+	aliases  map[string]string
+	maxCount int
+	maxDepth int
+}
+
+// AliasExpansionError reports an alias chain that cannot be expanded completely
+type AliasExpansionError struct {
+	Chain []string // Aliases in the order of expansion, ending with the one not expanded
+	Cycle bool     // The last alias was already expanded before
+	Limit int      // The depth limit, if it was reached
+}
+
+// Error describes the chain of aliases and why its expansion stopped
+func (e *AliasExpansionError) Error() string {
+	chain := strings.Join(e.Chain, " → ")
+	if e.Cycle {
+		return fmt.Sprintf("alias cycle: %s", chain)
+	}
+	return fmt.Sprintf("more than %d aliases in a row: %s", e.Limit, chain)
+}
+
+// NewAliasManager creates a new AliasManager
+func NewAliasManager(maxCount int) *AliasManager {
+	return &AliasManager{
+		aliases:  make(map[string]string),
+		maxCount: maxCount,
+		maxDepth: DefaultAliasDepth,
+	}
+}
+
+// SetMaxDepth sets the number of aliases expanded one after another
+func (am *AliasManager) SetMaxDepth(depth int) {
+	if depth < 1 {
+		depth = DefaultAliasDepth
+	}
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.maxDepth = depth
+}
+
+// AddAlias adds a local alias
+func (am *AliasManager) AddAlias(alias, command string) error {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	// Check if there are already too many aliases
+	if len(am.aliases) >= am.maxCount {
+		return fmt.Errorf("maximum number of aliases (%d) reached", am.maxCount)
+	}
+
+	// Check if the alias is valid
+	if strings.Contains(alias, " ") || strings.Contains(alias, ".") {
+		return fmt.Errorf("alias cannot contain spaces or periods")
+	}
+
+	// Check if the alias already exists
+	if _, exists := am.aliases[alias]; exists {
+		return fmt.Errorf("an alias with the name '%s' already exists", alias)
+	}
+
+	// Add alias
+	am.aliases[alias] = command
+	return nil
+}
+
+// RemoveAlias removes a local alias
+func (am *AliasManager) RemoveAlias(alias string) error {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	// Check if the alias exists
+	if _, exists := am.aliases[alias]; !exists {
+		return fmt.Errorf("no alias with the name '%s' found", alias)
+	}
+
+	// Remove alias
+	delete(am.aliases, alias)
+	return nil
+}
+
+// GetAlias returns an alias if it exists
+func (am *AliasManager) GetAlias(alias string) (string, bool) {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+	command, exists := am.aliases[alias]
+	return command, exists
+}
+
+// GetAllAliases returns all local aliases
+func (am *AliasManager) GetAllAliases() map[string]string {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	// Create a copy to avoid modifying the internal map
+	result := make(map[string]string, len(am.aliases))
+	for alias, command := range am.aliases {
+		result[alias] = command
+	}
+	return result
+}
+
+// SaveAliases saves all aliases to a file
+func (am *AliasManager) SaveAliases() error {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return err
+	}
+
+	// Create directory if it doesn't exist
+	configDir := filepath.Join(userConfigDir, "nexuflex")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return err
+	}
+
+	// Replace the file atomically
+	am.mu.RLock()
+	lines := formatAliasLines(am.aliases)
+	am.mu.RUnlock()
+	return state.WriteLinesAtomic(filepath.Join(configDir, "local_aliases.txt"), lines)
+}
+
+// LoadAliases loads aliases from a file
+func (am *AliasManager) LoadAliases() error {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return err
+	}
+
+	aliasPath := filepath.Join(userConfigDir, "nexuflex", "local_aliases.txt")
+
+	// Clear aliases
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.aliases = make(map[string]string)
+
+	// Read file line by line; lines without "=" are quarantined
+	return state.ReadLines(aliasPath, func(line string) bool {
+		alias, command, ok := parseAliasLine(line)
+		if ok && len(am.aliases) < am.maxCount {
+			// Add alias, but only if the maximum count hasn't been reached
+			am.aliases[alias] = command
+		}
+		return ok
+	})
+}
+
+// parseAliasLine parses an "alias=command" line
+func parseAliasLine(line string) (string, string, bool) {
+	parts := strings.SplitN(line, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// formatAliasLines formats aliases as "alias=command" lines in sorted order
+func formatAliasLines(aliases map[string]string) []string {
+	lines := make([]string, 0, len(aliases))
+	for alias, command := range aliases {
+		lines = append(lines, alias+"="+command)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// ExpandCommand replaces aliases with the full command; a chain that
+// cannot be expanded completely is returned as far as it was expanded
+func (am *AliasManager) ExpandCommand(command string) string {
+	expanded, _, _ := am.ExpandAliases(command)
+	return expanded
+}
+
+// ExpandAliases replaces the alias at the start of a command with its
+// expansion for as long as that starts with an alias again; it returns the
+// expanded command and the aliases expanded on the way. Cycles and chains
+// longer than the depth limit return an *AliasExpansionError together with
+// the command as far as it was expanded.
+func (am *AliasManager) ExpandAliases(command string) (string, []string, error) {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+	command = strings.TrimSpace(command)
+
+	var chain []string
+	expanded := make(map[string]bool)
+	for {
+		// Split command into the first word and the rest
+		parts := strings.SplitN(command, " ", 2)
+		firstWord := parts[0]
+
+		expansion, ok := am.aliases[firstWord]
+		if !ok {
+			return command, chain, nil
+		}
+		if expanded[firstWord] {
+			// An alias starting with its own name refers to the command of that name
+			if firstWord == chain[len(chain)-1] {
+				return command, chain, nil
+			}
+			return command, chain, &AliasExpansionError{Chain: append(chain, firstWord), Cycle: true}
+		}
+		if len(chain) >= am.maxDepth {
+			return command, chain, &AliasExpansionError{Chain: append(chain, firstWord), Limit: am.maxDepth}
+		}
+
+		expanded[firstWord] = true
+		chain = append(chain, firstWord)
+		command = strings.TrimSpace(expansion)
+		if len(parts) > 1 {
+			command += " " + parts[1]
+		}
+	}
+}
+
+// IsReservedKeyword checks if a word is a reserved keyword
+func IsReservedKeyword(word string) bool {
+	// List of reserved keywords
+	reservedKeywords := map[string]bool{
+		"help":       true,
+		"login":      true,
+		"logout":     true,
+		"alias":      true,
+		"unalias":    true,
+		"exit":       true,
+		"quit":       true,
+		"clear":      true,
+		"history":    true,
+		"use":        true,
+		"connect":    true,
+		"disconnect": true,
+		"status":     true,
+	}
+
+	return reservedKeywords[strings.ToLower(word)]
+}
@@ -0,0 +1,134 @@
+// annotations.go
+/**
+ * Nexuflex Client - Annotation Rules
+ *
+ * This file contains the annotation rules, the configured counterpart of
+ * the annotators of extensions. Rules are declared in the [annotate]
+ * section of the configuration as "<regex> => <severity> [#tag...] [link]";
+ * the output of a finished command matching the pattern is annotated with
+ * the severity (info, warning or error), the tags and the link, a URL or
+ * a command in which "{0}", "{1}", ... are replaced by the match and its
+ * capture groups. The text of the annotation is the rule name followed by
+ * the matched text.
+ *
+ * Example:
+ *   [annotate]
+ *   deadlock = ORA-00060 => error #db #locking https://wiki.example.com/runbooks/deadlock
+ *   slow     = took ([0-9]{4,}) ms => warning #performance
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+)
+
+// maxAnnotationMatch limits the matched text quoted in an annotation
+const maxAnnotationMatch = 60
+
+// AnnotationRule annotates output matching a pattern
+type AnnotationRule struct {
+	Name     string
+	Pattern  *regexp.Regexp
+	Severity string
+	Tags     []string
+	Link     string
+}
+
+// ParseAnnotationRules compiles the annotation definitions of the configuration
+func ParseAnnotationRules(definitions map[string]string) ([]*AnnotationRule, error) {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := make([]*AnnotationRule, 0, len(names))
+	var invalid []string
+	for _, name := range names {
+		rule, err := parseAnnotationRule(name, definitions[name])
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(invalid) > 0 {
+		return rules, fmt.Errorf("invalid annotation rules: %s", strings.Join(invalid, "; "))
+	}
+	return rules, nil
+}
+
+// parseAnnotationRule parses a single "<regex> => <severity> [#tag...] [link]" definition
+func parseAnnotationRule(name, definition string) (*AnnotationRule, error) {
+	sep := strings.LastIndex(definition, referenceSeparator)
+	if sep < 0 {
+		return nil, fmt.Errorf("expected \"<pattern> %s <severity> [#tag...] [link]\"", referenceSeparator)
+	}
+
+	pattern := strings.TrimSpace(definition[:sep])
+	fields := strings.Fields(definition[sep+len(referenceSeparator):])
+	if pattern == "" || len(fields) == 0 {
+		return nil, fmt.Errorf("pattern and severity must not be empty")
+	}
+	severity := strings.ToLower(fields[0])
+	if plugin.NormalizeSeverity(severity) != severity {
+		return nil, fmt.Errorf("unknown severity %q (info, warning or error)", fields[0])
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &AnnotationRule{Name: name, Pattern: re, Severity: severity}
+	fields = fields[1:]
+	for len(fields) > 0 && strings.HasPrefix(fields[0], "#") && len(fields[0]) > 1 {
+		rule.Tags = append(rule.Tags, strings.ToLower(fields[0][1:]))
+		fields = fields[1:]
+	}
+	rule.Link = strings.Join(fields, " ")
+	return rule, nil
+}
+
+// MatchAnnotations returns the annotations of the rules matching an output;
+// every rule annotates the first of its matches
+func MatchAnnotations(rules []*AnnotationRule, output string) []plugin.Annotation {
+	var annotations []plugin.Annotation
+	for _, rule := range rules {
+		loc := rule.Pattern.FindStringSubmatchIndex(output)
+		if loc == nil || loc[0] == loc[1] {
+			continue
+		}
+
+		match := strings.Join(strings.Fields(output[loc[0]:loc[1]]), " ")
+		if runes := []rune(match); len(runes) > maxAnnotationMatch {
+			match = string(runes[:maxAnnotationMatch-1]) + "…"
+		}
+		annotations = append(annotations, plugin.Annotation{
+			Severity: rule.Severity,
+			Text:     fmt.Sprintf("%s: %s", rule.Name, match),
+			Tags:     rule.Tags,
+			Link:     expandTarget(rule.Link, output, loc),
+			Source:   "annotate",
+		})
+	}
+	return annotations
+}
+
+// RecordAnnotation publishes an annotation of a command in the timeline,
+// tagged with its severity and tags
+func (c *Client) RecordAnnotation(command string, annotation plugin.Annotation) {
+	tags := append([]string{annotation.Severity}, annotation.Tags...)
+	c.publishTaggedEvent(EventAnnotation, fmt.Sprintf("%s: %s", command, annotation.Text), tags)
+}
@@ -0,0 +1,48 @@
+// annotations_test.go
+/**
+ * Nexuflex Client - Annotation Rule Tests
+ *
+ * This file contains a test of the annotation rule of the README, read
+ * from a configuration file and parsed with its tags and link.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+)
+
+func TestAnnotationRuleFromConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.ini")
+	content := "[annotate]\ndeadlock = ORA-00060 => error #db #locking https://wiki.example.com/runbooks/deadlock\n"
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule, err := parseAnnotationRule("deadlock", cfg.Annotations["deadlock"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Pattern.String() != "ORA-00060" || rule.Severity != "error" {
+		t.Errorf("pattern %q, severity %q", rule.Pattern, rule.Severity)
+	}
+	if want := []string{"db", "locking"}; !reflect.DeepEqual(rule.Tags, want) {
+		t.Errorf("tags = %q, want %q", rule.Tags, want)
+	}
+	if want := "https://wiki.example.com/runbooks/deadlock"; rule.Link != want {
+		t.Errorf("link = %q, want %q", rule.Link, want)
+	}
+}
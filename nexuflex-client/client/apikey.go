@@ -0,0 +1,79 @@
+// apikey.go
+/**
+ * Nexuflex Client - API Key Login
+ *
+ * This file contains the login of service accounts with an API key, so
+ * that scripts and schedulers do not use the password of a person. The
+ * key is taken from the --api-key option or the NEXUFLEX_API_KEY
+ * environment variable. The session is limited to the scopes of the key
+ * and is non-interactive: there is no keep-alive, no metadata is loaded,
+ * no startup commands run and the server is not added to the recent list.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyVariable names the environment variable holding the API key of batch mode
+const APIKeyVariable = "NEXUFLEX_API_KEY"
+
+// APIKeyFromEnvironment returns the API key given as option, or else the one
+// in the NEXUFLEX_API_KEY environment variable
+func APIKeyFromEnvironment(option string) string {
+	if key := strings.TrimSpace(option); key != "" {
+		return key
+	}
+	return strings.TrimSpace(os.Getenv(APIKeyVariable))
+}
+
+// LoginWithAPIKey starts a non-interactive session with an API key; scopes
+// limits the session to a subset of the scopes of the key
+func (c *Client) LoginWithAPIKey(apiKey string, scopes []string) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+	if apiKey == "" {
+		return fmt.Errorf("no API key given")
+	}
+
+	c.logger("Login with API key (scopes: %v)...", scopes)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	hostname, _ := os.Hostname()
+	resp, err := c.client.LoginWithApiKey(ctx, &proto.ApiKeyLoginRequest{
+		ApiKey:     apiKey,
+		Scopes:     scopes,
+		ClientName: fmt.Sprintf("nexuflex-client %s on %s", Version, hostname),
+	})
+	if err != nil {
+		c.logger("API key login request failed: %v", err)
+		if status.Code(err) == codes.Unimplemented {
+			return fmt.Errorf("the server does not support API key login")
+		}
+		return fmt.Errorf("login request failed: %v", err)
+	}
+	if !resp.Success {
+		c.logger("API key login failed: %s", resp.ErrorMessage)
+		return fmt.Errorf("login failed: %s", resp.ErrorMessage)
+	}
+
+	c.acceptLogin(resp, "api-key")
+	c.markActivity()
+	return nil
+}
@@ -0,0 +1,134 @@
+// apiversion.go
+/**
+ * Nexuflex Client - Protocol Version Negotiation
+ *
+ * This file contains the compatibility shim between the protocol versions.
+ * The client is generated from nexuflex.v1; interceptors on the connection
+ * map each call to the service of the protocol version the server speaks.
+ * With `api_version = auto` the versions are tried in order of preference
+ * on the first call of a connection (Connect) until the server knows the
+ * service; servers from before the versioned packages serve the unversioned
+ * "nexuflex" service. During a migration window a newer version is added
+ * in front of the list with the methods it renamed, so one client works
+ * with servers of both versions.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIVersion describes a version of the service API the client can speak
+type APIVersion struct {
+	Name    string            // Name in the configuration, e.g. "v1"
+	Service string            // Full name of the gRPC service
+	Methods map[string]string // Method names of v1 that are renamed in this version
+}
+
+// apiVersions lists the known API versions in order of preference
+var apiVersions = []APIVersion{
+	{Name: "v1", Service: "nexuflex.v1.NexuflexService"},
+	{Name: "legacy", Service: "nexuflex.NexuflexService"},
+}
+
+// apiNegotiation holds the API version negotiated for the current connection
+type apiNegotiation struct {
+	mu      sync.Mutex
+	version *APIVersion
+}
+
+// method maps the full method name of a v1 stub to this version
+func (v *APIVersion) method(fullMethod string) string {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if renamed, ok := v.Methods[name]; ok {
+		name = renamed
+	}
+	return "/" + v.Service + "/" + name
+}
+
+// GetAPIVersion returns the name of the API version spoken with the server, "" before negotiation
+func (c *Client) GetAPIVersion() string {
+	c.api.mu.Lock()
+	defer c.api.mu.Unlock()
+	if c.api.version == nil {
+		return ""
+	}
+	return c.api.version.Name
+}
+
+// resetAPIVersion starts a new negotiation, e.g. for a new connection; a
+// version set in the configuration is used without negotiation
+func (c *Client) resetAPIVersion() {
+	c.api.mu.Lock()
+	defer c.api.mu.Unlock()
+
+	c.api.version = nil
+	for i := range apiVersions {
+		if apiVersions[i].Name == c.config.Server.APIVersion {
+			c.api.version = &apiVersions[i]
+		}
+	}
+}
+
+// negotiatedAPIVersion returns the API version of the connection, nil before negotiation
+func (c *Client) negotiatedAPIVersion() *APIVersion {
+	c.api.mu.Lock()
+	defer c.api.mu.Unlock()
+	return c.api.version
+}
+
+// apiDialOptions returns the interceptors mapping the calls to the negotiated API version
+func (c *Client) apiDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(c.apiUnaryInterceptor),
+		grpc.WithStreamInterceptor(c.apiStreamInterceptor),
+	}
+}
+
+// apiUnaryInterceptor sends a call with the negotiated API version; before
+// the negotiation it tries the versions until the server implements one
+func (c *Client) apiUnaryInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if version := c.negotiatedAPIVersion(); version != nil {
+		return invoker(ctx, version.method(method), req, reply, cc, opts...)
+	}
+
+	var err error
+	for i := range apiVersions {
+		version := &apiVersions[i]
+		err = invoker(ctx, version.method(method), req, reply, cc, opts...)
+		if status.Code(err) == codes.Unimplemented {
+			continue
+		}
+		if err == nil {
+			c.api.mu.Lock()
+			c.api.version = version
+			c.api.mu.Unlock()
+			c.logger("Server speaks API version %s", version.Name)
+		}
+		return err
+	}
+	return err
+}
+
+// apiStreamInterceptor opens a stream with the negotiated API version, the
+// preferred one before the negotiation
+func (c *Client) apiStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	version := c.negotiatedAPIVersion()
+	if version == nil {
+		version = &apiVersions[0]
+	}
+	return streamer(ctx, desc, cc, version.method(method), opts...)
+}
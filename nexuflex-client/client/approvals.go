@@ -0,0 +1,222 @@
+// approvals.go
+/**
+ * Nexuflex Client - Command Approval Workflow
+ *
+ * This file contains the client side of the four-eyes principle: commands
+ * the server answers with PENDING_APPROVAL are tracked and polled until a
+ * second user has approved or rejected them, and approvers can list and
+ * decide on the requests waiting for them.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// PendingApproval is an own command waiting for approval
+type PendingApproval struct {
+	ApprovalID  string
+	Command     string
+	RequestedAt time.Time
+}
+
+// ApprovalPendingError reports a command that the server executes only once
+// a second user has approved it; the decision is reported to the approval
+// callback later
+type ApprovalPendingError struct {
+	ApprovalID string
+	Command    string // Command line with the secrets masked
+}
+
+// Error implements the error interface
+func (e *ApprovalPendingError) Error() string {
+	return fmt.Sprintf("'%s' waits for approval %s", e.Command, e.ApprovalID)
+}
+
+// trackApproval remembers a pending command and polls for the decision
+func (c *Client) trackApproval(approvalID, command string) {
+	c.approvalMu.Lock()
+	if c.pendingApprovals == nil {
+		c.pendingApprovals = make(map[string]*PendingApproval)
+	}
+	c.pendingApprovals[approvalID] = &PendingApproval{
+		ApprovalID:  approvalID,
+		Command:     command,
+		RequestedAt: time.Now(),
+	}
+	c.approvalMu.Unlock()
+
+	go c.pollApproval(approvalID)
+}
+
+// pollApproval queries the approval status until a decision has been made
+func (c *Client) pollApproval(approvalID string) {
+	interval := time.Duration(c.config.Commands.ApprovalPollSeconds) * time.Second
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Stop polling when the request was forgotten (logout, reconnect)
+		c.approvalMu.Lock()
+		pending, ok := c.pendingApprovals[approvalID]
+		c.approvalMu.Unlock()
+		if !ok {
+			return
+		}
+
+		approval, err := c.GetApprovalStatus(approvalID)
+		if err != nil {
+			c.logger("Approval status of %s not available: %v", approvalID, err)
+			continue
+		}
+		if approval.Decision == proto.ApprovalInfo_PENDING {
+			continue
+		}
+
+		c.approvalMu.Lock()
+		delete(c.pendingApprovals, approvalID)
+		c.approvalMu.Unlock()
+
+		c.recordApprovalDecision(pending, approval)
+		if c.onApprovalDecided != nil {
+			c.onApprovalDecided(pending, approval)
+		}
+		return
+	}
+}
+
+// recordApprovalDecision adds the decision and the command output to the transcript
+func (c *Client) recordApprovalDecision(pending *PendingApproval, approval *proto.ApprovalInfo) {
+	c.recordTranscript(EntryEvent, fmt.Sprintf("approval %s for '%s': %s by %s",
+		approval.ApprovalId, pending.Command, approval.Decision, approval.DecidedBy))
+	if approval.Decision == proto.ApprovalInfo_APPROVED && approval.Output != "" {
+		c.recordTranscript(EntryOutput, approval.Output)
+	}
+}
+
+// GetPendingApprovals returns the own commands waiting for approval, oldest first
+func (c *Client) GetPendingApprovals() []*PendingApproval {
+	c.approvalMu.Lock()
+	defer c.approvalMu.Unlock()
+
+	result := make([]*PendingApproval, 0, len(c.pendingApprovals))
+	for _, pending := range c.pendingApprovals {
+		result = append(result, pending)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].RequestedAt.Before(result[j].RequestedAt)
+	})
+	return result
+}
+
+// clearPendingApprovals stops tracking all pending approvals
+func (c *Client) clearPendingApprovals() {
+	c.approvalMu.Lock()
+	c.pendingApprovals = nil
+	c.approvalMu.Unlock()
+}
+
+// SetApprovalCallback sets the function called when a pending command was decided
+func (c *Client) SetApprovalCallback(onDecided func(pending *PendingApproval, approval *proto.ApprovalInfo)) {
+	c.onApprovalDecided = onDecided
+}
+
+// ListApprovals retrieves approval requests; own=false returns those awaiting my approval
+func (c *Client) ListApprovals(own bool) ([]*proto.ApprovalInfo, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListApprovals(ctx, &proto.ListApprovalsRequest{
+		SessionToken: c.sessionToken,
+		OwnRequests:  own,
+	})
+	if err != nil {
+		c.logger("Error retrieving approvals: %v", err)
+		return nil, fmt.Errorf("error retrieving approvals: %v", err)
+	}
+
+	return resp.Approvals, nil
+}
+
+// GetApprovalStatus retrieves the current state of an approval request
+func (c *Client) GetApprovalStatus(approvalID string) (*proto.ApprovalInfo, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetApprovalStatus(ctx, &proto.ApprovalStatusRequest{
+		SessionToken: c.sessionToken,
+		ApprovalId:   approvalID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving approval status: %v", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("error retrieving approval status: %s", resp.ErrorMessage)
+	}
+
+	return resp.Approval, nil
+}
+
+// Approve approves or rejects a command of another user
+func (c *Client) Approve(approvalID string, approve bool, comment string) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.Approve(ctx, &proto.ApproveRequest{
+		SessionToken: c.sessionToken,
+		ApprovalId:   approvalID,
+		Approve:      approve,
+		Comment:      comment,
+	})
+	if err != nil {
+		c.logger("Error deciding approval %s: %v", approvalID, err)
+		return fmt.Errorf("error deciding approval: %v", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("decision not accepted: %s", resp.ErrorMessage)
+	}
+
+	c.logger("Approval %s decided (approve: %v)", approvalID, approve)
+	c.recordTranscript(EntryEvent, fmt.Sprintf("decided approval %s: approve=%v %s", approvalID, approve, comment))
+	return nil
+}
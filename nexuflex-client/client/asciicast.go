@@ -0,0 +1,152 @@
+// asciicast.go
+/**
+ * Nexuflex Client - Session Recording
+ *
+ * This file contains the writer of session recordings in the asciicast v2
+ * format of asciinema, so that trainings and incident reviews can replay
+ * exactly what the operator saw with `asciinema play` or the asciinema
+ * web player. A recording is a JSON header line followed by one JSON
+ * array per event: the seconds since the start, the event type ("o" for
+ * terminal output, "r" for a resize) and the data.
+ *
+ * Example:
+ *   {"version": 2, "width": 120, "height": 40, "timestamp": 1792150000, "title": "nexuflex"}
+ *   [0.0, "o", "\u001b[2J\u001b[1;1H..."]
+ *   [1.25, "o", "\u001b[40;1H..."]
+ *
+ * The terminal output is produced by the user interface; SecretRanges
+ * locates the credentials in it before it is written, so they can be masked.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// asciicastHeader is the first line of an asciicast v2 file
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// SessionRecording writes the terminal output of a session to an asciicast v2 file
+type SessionRecording struct {
+	mu      sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	path    string
+	started time.Time
+}
+
+// StartSessionRecording creates an asciicast v2 file for a terminal of the given size
+func StartSessionRecording(path string, width, height int, title string) (*SessionRecording, error) {
+	file, err := os.OpenFile(expandHome(path), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &SessionRecording{
+		file:    file,
+		writer:  bufio.NewWriter(file),
+		path:    path,
+		started: time.Now(),
+	}
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.started.Unix(),
+		Title:     title,
+		Env: map[string]string{
+			"TERM":  os.Getenv("TERM"),
+			"SHELL": os.Getenv("SHELL"),
+		},
+	})
+	if err == nil {
+		_, err = fmt.Fprintf(r.writer, "%s\n", header)
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Output records terminal output, i.e. text with ANSI escape sequences
+func (r *SessionRecording) Output(data string) error {
+	return r.event("o", data)
+}
+
+// Resize records a change of the terminal size
+func (r *SessionRecording) Resize(width, height int) error {
+	return r.event("r", fmt.Sprintf("%dx%d", width, height))
+}
+
+// event appends an event and writes it through, so that a crash keeps the recording so far
+func (r *SessionRecording) event(kind, data string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return os.ErrClosed
+	}
+
+	line, err := json.Marshal([]interface{}{time.Since(r.started).Seconds(), kind, data})
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(r.writer, "%s\n", line); err != nil {
+		return err
+	}
+	return r.writer.Flush()
+}
+
+// Path returns the file the session is recorded to
+func (r *SessionRecording) Path() string {
+	return r.path
+}
+
+// Duration returns the time since the recording started
+func (r *SessionRecording) Duration() time.Duration {
+	return time.Since(r.started)
+}
+
+// Close ends the recording
+func (r *SessionRecording) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.writer.Flush()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+	r.file = nil
+	return err
+}
+
+// SecretRanges returns the byte ranges of credentials in a text, e.g. the
+// value of "password=..." or "token: ...", that must not be recorded
+func SecretRanges(text string) [][2]int {
+	var ranges [][2]int
+	for _, loc := range logSecret.FindAllStringSubmatchIndex(text, -1) {
+		// The third group is the value after the key and the separator
+		ranges = append(ranges, [2]int{loc[6], loc[7]})
+	}
+	return ranges
+}
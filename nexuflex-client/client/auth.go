@@ -0,0 +1,347 @@
+// auth.go
+/**
+ * Nexuflex Client - Authentication Providers
+ *
+ * This file contains the pluggable authentication providers. A provider
+ * declares the fields the user has to enter and builds the login request
+ * from them; providers that authenticate on the transport layer (mTLS)
+ * additionally supply the TLS credentials for the connection. The provider
+ * is selected per profile with the "provider" option in the [auth] section.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc/credentials"
+)
+
+// AuthField describes an input field of the login dialog
+type AuthField struct {
+	Key     string // Key in the values passed to BuildLoginRequest
+	Label   string // i18n message key of the label
+	Secret  bool   // Input is masked and not kept in the dialog
+	Default string // Initial value
+}
+
+// AuthProvider authenticates the user with a specific scheme
+type AuthProvider interface {
+	// Name returns the name used in the configuration
+	Name() string
+
+	// Fields returns the fields the user has to fill in (may be empty)
+	Fields() []AuthField
+
+	// BuildLoginRequest creates the login request from the entered values
+	BuildLoginRequest(values map[string]string) (*proto.LoginRequest, error)
+}
+
+// TransportAuthProvider is implemented by providers that authenticate on the transport layer
+type TransportAuthProvider interface {
+	AuthProvider
+
+	// TransportCredentials returns the credentials used for the connection
+	TransportCredentials() (credentials.TransportCredentials, error)
+}
+
+// AuthProviderFactory creates a provider from the authentication settings of a profile
+type AuthProviderFactory func(cfg config.AuthConfig) AuthProvider
+
+var (
+	authProvidersMu sync.RWMutex
+	authProviders   = map[string]AuthProviderFactory{
+		"password": func(cfg config.AuthConfig) AuthProvider { return &PasswordAuthProvider{cfg: cfg} },
+		"token":    func(cfg config.AuthConfig) AuthProvider { return &TokenAuthProvider{cfg: cfg} },
+		"oidc":     func(cfg config.AuthConfig) AuthProvider { return &OIDCAuthProvider{cfg: cfg} },
+		"kerberos": func(cfg config.AuthConfig) AuthProvider { return &KerberosAuthProvider{cfg: cfg} },
+		"mtls":     func(cfg config.AuthConfig) AuthProvider { return &MTLSAuthProvider{cfg: cfg} },
+	}
+)
+
+// RegisterAuthProvider makes an additional authentication provider available
+func RegisterAuthProvider(name string, factory AuthProviderFactory) {
+	authProvidersMu.Lock()
+	defer authProvidersMu.Unlock()
+	authProviders[strings.ToLower(name)] = factory
+}
+
+// AuthProviderNames returns the names of all registered providers
+func AuthProviderNames() []string {
+	authProvidersMu.RLock()
+	defer authProvidersMu.RUnlock()
+
+	names := make([]string, 0, len(authProviders))
+	for name := range authProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewAuthProvider creates the provider configured in the authentication settings
+func NewAuthProvider(cfg config.AuthConfig) (AuthProvider, error) {
+	name := strings.ToLower(strings.TrimSpace(cfg.Provider))
+	if name == "" {
+		name = "password"
+	}
+
+	authProvidersMu.RLock()
+	factory, ok := authProviders[name]
+	authProvidersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown authentication provider %q (available: %s)",
+			name, strings.Join(AuthProviderNames(), ", "))
+	}
+
+	return factory(cfg), nil
+}
+
+// GetAuthProvider returns the authentication provider of the current profile
+func (c *Client) GetAuthProvider() AuthProvider {
+	return c.authProvider
+}
+
+// LoginWith authenticates with the values entered for the fields of the current provider
+func (c *Client) LoginWith(values map[string]string) error {
+	req, err := c.authProvider.BuildLoginRequest(values)
+	if err != nil {
+		return err
+	}
+	return c.login(req, true)
+}
+
+// PasswordAuthProvider authenticates with username and password
+type PasswordAuthProvider struct {
+	cfg config.AuthConfig
+}
+
+// Name returns the name of the provider
+func (p *PasswordAuthProvider) Name() string {
+	return "password"
+}
+
+// Fields returns the username and password fields; the password field is
+// left out if the password is obtained by a command
+func (p *PasswordAuthProvider) Fields() []AuthField {
+	fields := []AuthField{{Key: "username", Label: "ui.username", Default: p.cfg.Username}}
+	if p.cfg.PasswordCommand == "" {
+		fields = append(fields, AuthField{Key: "password", Label: "ui.password", Secret: true})
+	}
+	return fields
+}
+
+// BuildLoginRequest creates a password login request
+func (p *PasswordAuthProvider) BuildLoginRequest(values map[string]string) (*proto.LoginRequest, error) {
+	if values["username"] == "" {
+		return nil, fmt.Errorf("username is required")
+	}
+
+	password := values["password"]
+	if p.cfg.PasswordCommand != "" {
+		var err error
+		if password, err = runPasswordCommand(p.cfg.PasswordCommand, values["username"]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &proto.LoginRequest{
+		Username:   values["username"],
+		Password:   password,
+		AuthMethod: "password",
+	}, nil
+}
+
+// TokenAuthProvider authenticates with an API or access token
+type TokenAuthProvider struct {
+	cfg config.AuthConfig
+}
+
+// Name returns the name of the provider
+func (p *TokenAuthProvider) Name() string {
+	return "token"
+}
+
+// Fields returns the token field unless the token is obtained by a command
+func (p *TokenAuthProvider) Fields() []AuthField {
+	if p.cfg.TokenCommand != "" {
+		return nil
+	}
+	return []AuthField{{Key: "token", Label: "ui.token", Secret: true}}
+}
+
+// BuildLoginRequest creates a token login request
+func (p *TokenAuthProvider) BuildLoginRequest(values map[string]string) (*proto.LoginRequest, error) {
+	token, err := obtainToken(values["token"], p.cfg.TokenCommand)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LoginRequest{
+		Username:    p.cfg.Username,
+		AuthMethod:  "token",
+		Credentials: map[string]string{"token": token},
+	}, nil
+}
+
+// OIDCAuthProvider authenticates with an OpenID Connect ID token
+type OIDCAuthProvider struct {
+	cfg config.AuthConfig
+}
+
+// Name returns the name of the provider
+func (p *OIDCAuthProvider) Name() string {
+	return "oidc"
+}
+
+// Fields returns the ID token field unless the token is obtained by a command
+func (p *OIDCAuthProvider) Fields() []AuthField {
+	if p.cfg.TokenCommand != "" {
+		return nil
+	}
+	return []AuthField{{Key: "id_token", Label: "ui.id_token", Secret: true}}
+}
+
+// BuildLoginRequest creates an OIDC login request
+func (p *OIDCAuthProvider) BuildLoginRequest(values map[string]string) (*proto.LoginRequest, error) {
+	token, err := obtainToken(values["id_token"], p.cfg.TokenCommand)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LoginRequest{
+		Username:   p.cfg.Username,
+		AuthMethod: "oidc",
+		Credentials: map[string]string{
+			"id_token": token,
+			"issuer":   p.cfg.OIDCIssuer,
+		},
+	}, nil
+}
+
+// KerberosAuthProvider authenticates with a SPNEGO token from the Kerberos ticket cache
+type KerberosAuthProvider struct {
+	cfg config.AuthConfig
+}
+
+// Name returns the name of the provider
+func (p *KerberosAuthProvider) Name() string {
+	return "kerberos"
+}
+
+// Fields returns the principal field
+func (p *KerberosAuthProvider) Fields() []AuthField {
+	return []AuthField{{Key: "principal", Label: "ui.principal", Default: p.cfg.Username}}
+}
+
+// BuildLoginRequest creates a Kerberos login request; the SPNEGO token is
+// produced by the configured token command (e.g. a kinit/gss helper)
+func (p *KerberosAuthProvider) BuildLoginRequest(values map[string]string) (*proto.LoginRequest, error) {
+	if p.cfg.TokenCommand == "" {
+		return nil, fmt.Errorf("kerberos authentication requires token_command in the [auth] section")
+	}
+
+	token, err := runTokenCommand(p.cfg.TokenCommand)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LoginRequest{
+		Username:   values["principal"],
+		AuthMethod: "kerberos",
+		Credentials: map[string]string{
+			"spnego_token": token,
+			"spn":          p.cfg.KerberosSPN,
+		},
+	}, nil
+}
+
+// MTLSAuthProvider authenticates with the client certificate of the TLS connection only
+type MTLSAuthProvider struct {
+	cfg config.AuthConfig
+}
+
+// Name returns the name of the provider
+func (p *MTLSAuthProvider) Name() string {
+	return "mtls"
+}
+
+// Fields returns no fields, the identity is taken from the certificate
+func (p *MTLSAuthProvider) Fields() []AuthField {
+	return nil
+}
+
+// BuildLoginRequest creates a login request without credentials
+func (p *MTLSAuthProvider) BuildLoginRequest(values map[string]string) (*proto.LoginRequest, error) {
+	return &proto.LoginRequest{
+		Username:   p.cfg.Username,
+		AuthMethod: "mtls",
+	}, nil
+}
+
+// TransportCredentials loads the client certificate and the optional CA certificate
+func (p *MTLSAuthProvider) TransportCredentials() (credentials.TransportCredentials, error) {
+	if p.cfg.ClientCert == "" || p.cfg.ClientKey == "" {
+		return nil, fmt.Errorf("mtls authentication requires client_cert and client_key in the [auth] section")
+	}
+
+	cert, err := tls.LoadX509KeyPair(p.cfg.ClientCert, p.cfg.ClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("error loading client certificate: %v", err)
+	}
+
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if p.cfg.CACert != "" {
+		pem, err := os.ReadFile(p.cfg.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA certificate: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates in %s", p.cfg.CACert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// obtainToken returns the entered token or, if configured, the output of the token command
+func obtainToken(entered, command string) (string, error) {
+	if command != "" {
+		return runTokenCommand(command)
+	}
+	if strings.TrimSpace(entered) == "" {
+		return "", fmt.Errorf("token is required")
+	}
+	return strings.TrimSpace(entered), nil
+}
+
+// runTokenCommand runs an external command and returns its trimmed output as token
+func runTokenCommand(command string) (string, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return "", fmt.Errorf("empty token command")
+	}
+
+	out, err := exec.Command(args[0], args[1:]...).Output()
+	if err != nil {
+		return "", fmt.Errorf("token command failed: %v", err)
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", fmt.Errorf("token command returned no token")
+	}
+	return token, nil
+}
@@ -0,0 +1,136 @@
+// background.go
+/**
+ * Nexuflex Client - Background Work of a Session
+ *
+ * This file contains the goroutines working for a session in the
+ * background: the notification subscription, the metadata refresh and
+ * the reconciliation of critical commands. They run with a
+ * context of the session, which is cancelled when the session ends
+ * (logout, expiry, detach, a new connection or Close); ending the session
+ * waits for them before the connection and session fields are cleared.
+ * While they run they do not read these fields directly but take a
+ * snapshot of the connection and the session token under connMu, which
+ * guards every change of them.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc"
+)
+
+// sessionTasks is the background work of one session
+type sessionTasks struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// backgroundState holds the background work of the current session
+type backgroundState struct {
+	mu    sync.Mutex
+	tasks *sessionTasks // nil while nothing runs
+}
+
+// goSession runs a task in the background of the current session; its
+// context is cancelled when the session ends
+func (c *Client) goSession(task func(ctx context.Context)) {
+	c.background.mu.Lock()
+	if c.background.tasks == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		c.background.tasks = &sessionTasks{ctx: ctx, cancel: cancel}
+	}
+	tasks := c.background.tasks
+	tasks.wg.Add(1)
+	c.background.mu.Unlock()
+
+	go func() {
+		defer tasks.wg.Done()
+		task(tasks.ctx)
+	}()
+}
+
+// endSessionTasks cancels the background work of the session and waits
+// until it has finished; it must not be called from such a task
+func (c *Client) endSessionTasks() {
+	c.background.mu.Lock()
+	tasks := c.background.tasks
+	c.background.tasks = nil
+	c.background.mu.Unlock()
+
+	if tasks != nil {
+		tasks.cancel()
+		tasks.wg.Wait()
+	}
+}
+
+// sessionClient returns the client for metadata calls and the session
+// token, read together under connMu
+func (c *Client) sessionClient() (proto.NexuflexServiceClient, string, error) {
+	return c.snapshotSession(true)
+}
+
+// commandSession returns the client of the command connection and the
+// session token, read together under connMu
+func (c *Client) commandSession() (proto.NexuflexServiceClient, string, error) {
+	return c.snapshotSession(false)
+}
+
+// snapshotSession reads the client and the session token under connMu
+func (c *Client) snapshotSession(metadata bool) (proto.NexuflexServiceClient, string, error) {
+	c.connMu.RLock()
+	defer c.connMu.RUnlock()
+	if c.client == nil {
+		return nil, "", fmt.Errorf("not connected to server")
+	}
+	if c.sessionToken == "" {
+		return nil, "", fmt.Errorf("not logged in")
+	}
+	if metadata {
+		return c.metadataClient(), c.sessionToken, nil
+	}
+	return c.client, c.sessionToken, nil
+}
+
+// setSessionToken changes the session token
+func (c *Client) setSessionToken(token string) {
+	c.connMu.Lock()
+	c.sessionToken = token
+	c.connMu.Unlock()
+}
+
+// setConnection takes over a new connection to the server
+func (c *Client) setConnection(conn *grpc.ClientConn) {
+	c.connMu.Lock()
+	c.conn = conn
+	c.client = proto.NewNexuflexServiceClient(conn)
+	c.connMu.Unlock()
+}
+
+// dropConnection ends the background work of the session, closes the
+// connections to the server and forgets the session
+func (c *Client) dropConnection() error {
+	c.endSessionTasks()
+
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	var err error
+	if c.conn != nil {
+		err = c.conn.Close()
+	}
+	c.conn = nil
+	c.client = nil
+	c.closeMetadataChannel()
+	c.sessionToken = ""
+	c.serverInfo = nil
+	return err
+}
@@ -0,0 +1,1435 @@
+// client.go
+/**
+* Nexuflex Client - Client Implementation
+*
+* This file contains the main implementation of the nexuflex client,
+* which handles communication with the Application Server.
+*
+* @author msto63
+* @version 1.0.0
+* @date 2025-03-12
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/discovery"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// minKeepAliveInterval is the shortest interval between keep-alives, even for short session TTLs
+const minKeepAliveInterval = 5 * time.Second
+
+// LogFunc defines the type for the logging function
+type LogFunc func(format string, v ...interface{})
+
+// Client represents the nexuflex client
+type Client struct {
+	// Configuration
+	config *config.Config
+
+	// Logger
+	logger LogFunc
+
+	// gRPC connection and client; connMu guards changes of the connection,
+	// the metadata channel, the session token and the server information
+	connMu sync.RWMutex
+	conn   *grpc.ClientConn
+	client proto.NexuflexServiceClient
+
+	// Separate connection for metadata calls, nil if not used
+	metaConn *grpc.ClientConn
+	meta     proto.NexuflexServiceClient
+
+	// Session and status
+	sessionToken    string
+	username        string
+	userInfo        *proto.UserInfo
+	serverInfo      *proto.ServerInfo
+	serverFeatures  []string
+	lastServiceUsed string
+
+	// Aliases defined on the server
+	aliasMu       sync.RWMutex
+	serverAliases map[string]*proto.AliasInfo
+
+	// Cached server metadata and idle detection
+	metadata         metadataCache
+	activityMu       sync.Mutex
+	lastActivity     time.Time
+	keepAliveMu      sync.Mutex
+	keepAliveRunning bool
+	sessionTTL       time.Duration // Reported by the server, 0 if unknown
+	activeStreams    atomic.Int32  // Running streaming commands
+
+	// Subscription to the notifications of the server
+	notifications notificationState
+
+	// Background work of the session
+	background backgroundState
+
+	// Own commands waiting for approval
+	approvalMu       sync.Mutex
+	pendingApprovals map[string]*PendingApproval
+
+	// Authentication provider of the profile
+	authProvider AuthProvider
+
+	// Opt-in telemetry
+	telemetry *Telemetry
+
+	// Session transcript
+	transcript *Transcript
+
+	// Recently used servers
+	recentServers *RecentServers
+
+	// Last used parameter values
+	paramValues *ParameterValues
+
+	// Write-ahead log of critical commands
+	commandWAL *CommandWAL
+
+	// Compensating actions of the session for "undo"
+	undo            undoStack
+	onUndoAvailable func(entry UndoEntry)
+
+	// Entity versions of edit workflows, sent to detect concurrent changes
+	entities entityVersions
+
+	// Maintenance window announced by the server
+	maintenance          maintenanceState
+	onMaintenanceChanged func(window *MaintenanceWindow)
+
+	// Tracing of the RPCs in verbose mode; the last traces are kept for support bundles
+	verbose    atomic.Bool
+	onRPCTrace func(trace RPCTrace)
+	traces     traceHistory
+
+	// Client-enforced read-only mode
+	readOnly atomic.Bool
+
+	// Low-bandwidth mode, the estimated traffic and the next page of the last result
+	lowBandwidth       atomic.Bool
+	compressionRefused atomic.Bool // The server does not accept compressed calls
+	traffic            trafficCounter
+	nextPage           pageState
+
+	// Flow being recorded
+	flowRecorder flowRecorder
+
+	// Background jobs (streaming commands)
+	jobs jobManager
+
+	// API version negotiated with the server
+	api apiNegotiation
+
+	// Quick actions of the connected server
+	quickActions quickActionCache
+
+	// Cached command help of the connected server
+	help helpCache
+
+	// Message of error codes of the shared catalog in the client language
+	errorLocalizer func(code string, params map[string]string, fallback string) string
+
+	// Callbacks
+	onStatusChanged   func(statusInfo *proto.StatusInfo)
+	onServerList      func(servers []*proto.ServerInfo) (int, error)
+	onServerSelection func(servers []*proto.ServerInfo, choose func(server *proto.ServerInfo))
+	onOutputReceived  func(output string)
+
+	onMetadataRefreshed func()
+	onApprovalDecided   func(pending *PendingApproval, approval *proto.ApprovalInfo)
+	onCommandReconciled func(pending PendingCommand, status *proto.CommandStatusResponse)
+	onJobsChanged       func()
+	onSessionStarted    func(startupCommands []string)
+	onTableReceived     func(command string, table *proto.TableResult)
+	onContentReceived   func(command, contentType, content string)
+
+	// Refreshing displays of streams
+	frameStreams   atomic.Int64
+	onFrameChanged func(frame Frame)
+
+	// Commands in flight and queued
+	limiter    commandLimiter
+	onActivity func()
+
+	// Events of the session, e.g. for the timeline
+	events *EventBus
+
+	// Quality of the connection measured by the keep-alives
+	quality          connectionQuality
+	onQualityChanged func()
+
+	// Automatic reconnect after the connection was lost
+	reconnecting     atomic.Bool
+	onConnectionLost func(server string, err error)
+	onReconnected    func(server string, loggedIn bool, err error)
+
+	// Other nodes of the cluster to fail over to
+	cluster    clusterState
+	onFailover func(from, to string, loggedIn, sessionKept bool)
+
+	// Sync of the personal settings after login
+	onSettingsSync func()
+}
+
+// NewClient creates a new Client instance
+func NewClient(cfg *config.Config, logger LogFunc) *Client {
+	// Fall back to password authentication if the configured provider is unknown
+	authProvider, err := NewAuthProvider(cfg.Auth)
+	if err != nil {
+		logger("%v, using password authentication", err)
+		authProvider = &PasswordAuthProvider{cfg: cfg.Auth}
+	}
+
+	c := &Client{
+		config:          cfg,
+		logger:          logger,
+		sessionToken:    "",
+		lastServiceUsed: "",
+		authProvider:    authProvider,
+		telemetry:       NewTelemetry(cfg.Telemetry.Enabled),
+		transcript:      NewTranscript(cfg.Commands.SaveTranscripts),
+		recentServers:   NewRecentServers(),
+		paramValues:     NewParameterValues(),
+		events:          NewEventBus(),
+		commandWAL:      NewCommandWAL(),
+	}
+	c.readOnly.Store(cfg.Commands.ReadOnly)
+	c.lowBandwidth.Store(cfg.Server.LowBandwidth)
+	return c
+}
+
+// SetCallbacks sets the callback functions for UI updates
+func (c *Client) SetCallbacks(
+	onStatusChanged func(statusInfo *proto.StatusInfo),
+	onServerList func(servers []*proto.ServerInfo) (int, error),
+	onOutputReceived func(output string),
+) {
+	c.onStatusChanged = onStatusChanged
+	c.onServerList = onServerList
+	c.onOutputReceived = onOutputReceived
+}
+
+// SetSessionStartedCallback sets the function called with the startup commands of the profile after a login
+func (c *Client) SetSessionStartedCallback(onStarted func(startupCommands []string)) {
+	c.onSessionStarted = onStarted
+}
+
+// SetTableCallback sets the function called with the structured result of a command
+func (c *Client) SetTableCallback(onTable func(command string, table *proto.TableResult)) {
+	c.onTableReceived = onTable
+}
+
+// SetContentCallback sets the function called with command results that
+// declare a content type other than text/plain
+func (c *Client) SetContentCallback(onContent func(command, contentType, content string)) {
+	c.onContentReceived = onContent
+}
+
+// DiscoverServer performs server discovery and connects to the server the
+// user selects; with a selection callback it blocks until the choice, so
+// user interfaces call it in a goroutine
+func (c *Client) DiscoverServer(timeout time.Duration) error {
+	c.logger("Starting server discovery...")
+
+	// If already connected, close connection
+	if c.conn != nil {
+		c.Close()
+	}
+
+	// Ask the servers in the local network to describe themselves
+	if timeout <= 0 {
+		timeout = discovery.DiscoveryTimeout
+	}
+	multicastAddr := c.config.Server.DiscoveryAddress
+	if multicastAddr == "" {
+		multicastAddr = discovery.DefaultMulticastAddress
+	}
+	packets, err := discovery.Discover(multicastAddr, c.config.Server.DiscoveryToken, timeout)
+	if err != nil {
+		if len(packets) == 0 {
+			return fmt.Errorf("server discovery failed: %v", err)
+		}
+		// Offer the servers that answered before the error
+		c.logger("Server discovery ended early: %v", err)
+	}
+
+	knownServers := make([]*proto.ServerInfo, len(packets))
+	for i, packet := range packets {
+		knownServers[i] = discoveredServerInfo(packet)
+	}
+	c.logger("Servers found: %d", len(knownServers))
+	if len(knownServers) == 0 {
+		return fmt.Errorf("no servers found")
+	}
+
+	c.rememberDiscoveredServers(knownServers)
+
+	// Let the user choose while the caller of the callback goes on
+	if c.onServerSelection != nil {
+		server, err := c.awaitServerSelection(knownServers)
+		if err != nil {
+			return err
+		}
+		if err := c.CheckServerAvailability(server); err != nil {
+			return err
+		}
+		return c.Connect(server.Address, int(server.Port), server.TlsEnabled)
+	}
+
+	// Show server list to user, if callback is set
+	if c.onServerList != nil {
+		selectedIndex, err := c.onServerList(knownServers)
+		if err != nil {
+			return err
+		}
+
+		// Connect to selected server
+		if selectedIndex >= 0 && selectedIndex < len(knownServers) {
+			selectedServer := knownServers[selectedIndex]
+			if err := c.CheckServerAvailability(selectedServer); err != nil {
+				return err
+			}
+			return c.Connect(selectedServer.Address, int(selectedServer.Port), selectedServer.TlsEnabled)
+		}
+
+		return ErrNoServerSelected
+	}
+
+	// If no callback is set, connect to the most suitable server
+	if server := preferredServer(knownServers); server != nil {
+		if err := c.CheckServerAvailability(server); err != nil {
+			return err
+		}
+		return c.Connect(server.Address, int(server.Port), server.TlsEnabled)
+	}
+
+	return fmt.Errorf("no servers found")
+}
+
+// discoveredServerInfo converts the discovery response of a server
+func discoveredServerInfo(packet discovery.DiscoveryPacket) *proto.ServerInfo {
+	server := &proto.ServerInfo{
+		Hostname:           packet.Hostname,
+		Address:            packet.Address,
+		Port:               int32(packet.Port),
+		ShortName:          packet.Name,
+		Description:        packet.Description,
+		TlsEnabled:         packet.TLS,
+		Version:            packet.Version,
+		Health:             proto.ServerInfo_Health(proto.ServerInfo_Health_value[strings.ToUpper(packet.Health)]),
+		LoadPercent:        int32(min(max(packet.LoadPercent, 0), 100)),
+		Maintenance:        packet.Maintenance,
+		MaintenanceMessage: packet.MaintenanceMessage,
+		Cluster:            packet.Cluster,
+	}
+	if server.ShortName == "" {
+		server.ShortName = net.JoinHostPort(packet.Address, strconv.Itoa(packet.Port))
+	}
+	if server.Hostname == "" {
+		server.Hostname = packet.Address
+	}
+	return server
+}
+
+// Connect establishes a connection to the server
+func (c *Client) Connect(address string, port int, useTLS bool) error {
+	c.reconnecting.Store(false)
+	return c.connect(address, port, useTLS, false)
+}
+
+// connect establishes the connection; with keepState the metadata, pending
+// approvals and jobs of the previous connection are kept (reconnect to the same server)
+func (c *Client) connect(address string, port int, useTLS bool, keepState bool) error {
+	c.logger("Connecting to %s:%d (TLS: %v)...", address, port, useTLS)
+
+	// Close existing connection, if any
+	if c.conn != nil {
+		c.dropConnection()
+		c.serverFeatures = nil
+		c.clearCluster()
+		if !keepState {
+			c.clearMetadata()
+			c.clearPendingApprovals()
+			c.cancelAllJobs()
+			c.clearEntityVersions()
+		}
+	}
+
+	// Configure connection options
+	var opts []grpc.DialOption
+	if transportAuth, ok := c.authProvider.(TransportAuthProvider); ok {
+		// The authentication provider supplies the (mutual) TLS credentials
+		creds, err := transportAuth.TransportCredentials()
+		if err != nil {
+			c.logger("Error loading transport credentials: %v", err)
+			return fmt.Errorf("error loading transport credentials: %v", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else if useTLS {
+		// Verification, client certificate and pins of the [tls] section
+		creds, err := c.tlsCredentials()
+		if err != nil {
+			c.logger("Error loading TLS configuration: %v", err)
+			return fmt.Errorf("error loading TLS configuration: %v", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	// A connection the user made is measured from the start
+	if !c.reconnecting.Load() {
+		c.resetConnectionQuality()
+		c.traffic.reset()
+	}
+	c.compressionRefused.Store(false)
+
+	// Map the calls to the API version of the server
+	c.resetAPIVersion()
+	opts = append(opts, c.apiDialOptions()...)
+	opts = append(opts, c.traceDialOptions()...)
+	opts = append(opts, c.compressionDialOptions()...)
+
+	// Establish connection
+	serverAddr := fmt.Sprintf("%s:%d", address, port)
+	conn, err := grpc.Dial(serverAddr, opts...)
+	if err != nil {
+		c.logger("Connection error: %v", err)
+
+		// Update status information
+		if c.onStatusChanged != nil {
+			c.onStatusChanged(&proto.StatusInfo{
+				ConnectionStatus: proto.StatusInfo_CONNECTION_ERROR,
+				SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			})
+		}
+
+		return fmt.Errorf("failed to connect to server: %v", err)
+	}
+
+	c.setConnection(conn)
+	c.openMetadataChannel(serverAddr, opts)
+
+	// Send Connect request
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.Connect(ctx, &proto.ConnectRequest{
+		Address: address,
+		Port:    int32(port),
+		UseTls:  useTLS,
+	})
+	if err != nil {
+		c.dropConnection()
+
+		c.logger("Connect request failed: %v", err)
+
+		// Update status information
+		if c.onStatusChanged != nil {
+			c.onStatusChanged(&proto.StatusInfo{
+				ConnectionStatus: proto.StatusInfo_CONNECTION_ERROR,
+				SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			})
+		}
+
+		return fmt.Errorf("connect request failed: %v", err)
+	}
+
+	if !resp.Success {
+		c.dropConnection()
+
+		c.logger("Connect failed: %s", resp.ErrorMessage)
+
+		// Update status information
+		if c.onStatusChanged != nil {
+			c.onStatusChanged(&proto.StatusInfo{
+				ConnectionStatus: proto.StatusInfo_CONNECTION_ERROR,
+				SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			})
+		}
+
+		return fmt.Errorf("connect failed: %s", resp.ErrorMessage)
+	}
+
+	// Store server information
+	c.serverInfo = &proto.ServerInfo{
+		Address:    address,
+		Port:       int32(port),
+		ShortName:  resp.ServerName,
+		Version:    resp.Version,
+		TlsEnabled: useTLS,
+		Cluster:    resp.Cluster,
+	}
+
+	c.serverFeatures = resp.SupportedFeatures
+	c.setMaintenance(resp.UpcomingMaintenance)
+	c.setCluster(resp.Cluster, resp.ClusterNodes)
+
+	c.logger("Connected to server %s (Version %s)", resp.ServerName, resp.Version)
+	c.rememberServer()
+	c.publishEvent(EventConnect, fmt.Sprintf("%s (%s:%d)", resp.ServerName, address, port))
+
+	// Inform the compiled-in extensions
+	plugin.NotifyConnect(plugin.ConnectInfo{
+		Address:    address,
+		Port:       port,
+		TLS:        useTLS,
+		ServerName: resp.ServerName,
+		Version:    resp.Version,
+	})
+
+	// Warn about servers that are known not to work with this client
+	if report := c.CheckCompatibility(); report.Verdict == Incompatible {
+		c.logger("Incompatible server: %s", report.Reason)
+		if c.onOutputReceived != nil {
+			c.onOutputReceived(fmt.Sprintf("Warning: server %s is not compatible with client %s: %s",
+				resp.ServerName, Version, report.Reason))
+		}
+	}
+
+	// Report status
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			ServerName:       resp.ServerName,
+		})
+	}
+
+	return nil
+}
+
+// Login performs user authentication
+func (c *Client) Login(username, password string) error {
+	return c.login(&proto.LoginRequest{
+		Username:   username,
+		Password:   password,
+		AuthMethod: "password",
+	}, true)
+}
+
+// login authenticates the user and optionally outputs a welcome message
+func (c *Client) login(req *proto.LoginRequest, welcome bool) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	c.logger("Login for user %s (%s)...", req.Username, req.AuthMethod)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.Login(ctx, req)
+	if err != nil {
+		c.logger("Login request failed: %v", err)
+		return fmt.Errorf("login request failed: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Login failed: %s", resp.ErrorMessage)
+		return fmt.Errorf("login failed: %s", resp.ErrorMessage)
+	}
+
+	username := c.acceptLogin(resp, req.Username)
+
+	// A new session starts in the default context of the profile
+	if welcome && c.config.Session.DefaultContext != "" {
+		c.changeContext(c.config.Session.DefaultContext)
+	}
+
+	// Report status
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_AUTHENTICATED,
+			ServerName:       c.serverInfo.ShortName,
+			Username:         username,
+			CurrentService:   c.lastServiceUsed,
+		})
+	}
+
+	// Output welcome message
+	if welcome && c.onOutputReceived != nil {
+		c.onOutputReceived(fmt.Sprintf("Welcome, %s! You are now logged in.", resp.UserInfo.DisplayName))
+	}
+
+	c.startSession("login")
+
+	// Run the startup commands of the profile, but not after a re-login
+	if welcome && c.onSessionStarted != nil {
+		c.onSessionStarted(c.config.Session.OnConnect)
+	}
+	return nil
+}
+
+// acceptLogin stores the session token and user information of a successful
+// login and returns the name of the user
+func (c *Client) acceptLogin(resp *proto.LoginResponse, username string) string {
+	c.setSessionToken(resp.SessionToken)
+	c.keepAliveMu.Lock()
+	c.sessionTTL = 0 // The TTL of a previous session no longer applies
+	c.keepAliveMu.Unlock()
+	c.setSessionTTL(resp.SessionTtlSeconds)
+	if name := resp.UserInfo.GetUsername(); name != "" {
+		username = name
+	}
+	c.username = username
+	c.userInfo = resp.UserInfo
+	c.logger("Login successful for %s", resp.UserInfo.DisplayName)
+	c.publishEvent(EventLogin, username)
+	return username
+}
+
+// startSession prepares a new session after a login or after attaching a session
+func (c *Client) startSession(reason string) {
+	// The background work of a previous session ends first
+	c.endSessionTasks()
+
+	// Remember the user for the server in the recent list
+	c.rememberServer()
+
+	// Load the server metadata for completion and keep the session alive
+	c.markActivity()
+	if c.prefetchMetadata() {
+		c.refreshMetadataInBackground(reason)
+	}
+	c.StartKeepAlive(time.Duration(c.config.Server.KeepAliveSeconds) * time.Second)
+	c.startNotifications()
+
+	// Find out what happened to critical commands sent before a network drop
+	server, username := c.serverKey(), c.username
+	c.goSession(func(ctx context.Context) {
+		c.reconcileCommands(ctx, server, username)
+	})
+
+	// Bring the personal settings up to date with other workstations
+	if c.onSettingsSync != nil && c.CanSyncSettings() {
+		go c.onSettingsSync()
+	}
+
+	// Send opt-in client report in the background
+	if c.telemetry.IsEnabled() {
+		go c.ReportClientInfo()
+	}
+}
+
+// Logout logs out the user
+func (c *Client) Logout() error {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return fmt.Errorf("not logged in")
+	}
+
+	c.logger("Logout...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.Logout(ctx, &proto.LogoutRequest{
+		SessionToken: c.sessionToken,
+	})
+	if err != nil {
+		c.logger("Logout request failed: %v", err)
+		return fmt.Errorf("logout request failed: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Logout failed: %s", resp.ErrorMessage)
+		return fmt.Errorf("logout failed: %s", resp.ErrorMessage)
+	}
+
+	c.publishEvent(EventLogout, c.username)
+
+	// Reset session token
+	c.endSessionTasks()
+	c.setSessionToken("")
+	c.username = ""
+	c.clearMetadata()
+	c.clearPendingApprovals()
+	c.cancelAllJobs()
+	c.logger("Logout successful")
+
+	// Report status
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			ServerName:       c.serverInfo.ShortName,
+		})
+	}
+
+	return nil
+}
+
+// ExecuteCommand executes a command on the server
+func (c *Client) ExecuteCommand(command string) error {
+	return c.executeCommand(command, c.commandIDFor(command))
+}
+
+// commandIDFor returns a new command ID for a critical command, which is used
+// for the write-ahead log and the receipt, and "" for any other command
+func (c *Client) commandIDFor(command string) string {
+	if c.IsCriticalCommand(command) {
+		return newCommandID()
+	}
+	return ""
+}
+
+// executeCommand sends a command; with a command ID it is written ahead and
+// removed from the log once the server has answered
+func (c *Client) executeCommand(command, commandID string) error {
+	_, err := c.sendCommand(command, commandID)
+	return err
+}
+
+// ExecuteCommandWithTimeout executes a command on the server, allowing it
+// to take longer than usual (e.g. when it timed out before)
+func (c *Client) ExecuteCommandWithTimeout(command string, timeout time.Duration) error {
+	_, err := c.sendCommandWithTimeout(command, c.commandIDFor(command), timeout)
+	return err
+}
+
+// sendCommand sends a command, delivers its output and returns the response
+// of the server
+func (c *Client) sendCommand(command, commandID string) (*proto.CommandResponse, error) {
+	return c.sendCommandWithTimeout(command, commandID, defaultCommandTimeout)
+}
+
+// sendCommandWithTimeout sends a command with the given timeout for the call
+func (c *Client) sendCommandWithTimeout(command, commandID string, timeout time.Duration) (*proto.CommandResponse, error) {
+	c.takeSlot()
+	defer c.releaseSlot()
+	return c.sendCommandInSlot(command, commandID, timeout)
+}
+
+// sendCommandInSlot sends a command for which the caller holds a slot
+func (c *Client) sendCommandInSlot(command, commandID string, timeout time.Duration) (*proto.CommandResponse, error) {
+	return c.sendRequestInSlot(command, commandID, "", timeout)
+}
+
+// sendRequestInSlot sends a command, or fetches a further page of its result
+// with the page token, for which the caller holds a slot
+func (c *Client) sendRequestInSlot(command, commandID, pageToken string, timeout time.Duration) (*proto.CommandResponse, error) {
+	return c.sendRequestPosted(command, commandID, pageToken, timeout, nil)
+}
+
+// sendRequestPosted sends a command like sendRequestInSlot; with post set,
+// the answer is processed by a function passed to post, which runs it where
+// the caller processes output, and the output of a streamed result is
+// passed the same way while it arrives. The call waits until the answer
+// has been processed.
+func (c *Client) sendRequestPosted(command, commandID, pageToken string, timeout time.Duration, post func(func())) (*proto.CommandResponse, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+	if err := c.checkReadOnly(command); err != nil {
+		return nil, err
+	}
+
+	masked := c.maskCommand(command)
+	c.logger("Executing command: %s", masked.line)
+	c.recordCommand(masked)
+	c.markActivity()
+
+	if commandID != "" {
+		if err := c.writeAhead(commandID, command); err != nil {
+			c.recordTranscript(EntryError, err.Error())
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	lastContext := c.lastServiceUsed
+	preferStreaming, streamThreshold := c.streamingPreference()
+	resp, err := c.client.ExecuteCommand(ctx, &proto.CommandRequest{
+		SessionToken:         c.sessionToken,
+		CommandLine:          command,
+		LastContext:          lastContext,
+		CommandId:            commandID,
+		PreferStreaming:      preferStreaming,
+		StreamThresholdBytes: streamThreshold,
+		IfMatch:              c.ifMatch(),
+		PageSize:             c.pageSize(),
+		PageToken:            pageToken,
+	})
+	if err != nil {
+		c.logger("Command execution failed: %v", err)
+		c.recordTranscript(EntryError, err.Error())
+		err = c.rpcError("command execution", command, timeout, err)
+		var rpcErr *RPCError
+		if commandID != "" && errors.As(err, &rpcErr) {
+			// The command may or may not have reached the server
+			rpcErr.CommandID = commandID
+		}
+		return nil, err
+	}
+
+	// Any answer of the server is the receipt for the command
+	if commandID != "" {
+		c.confirmReceipt(commandID)
+	}
+
+	// Renew an expired session and let the caller replay the command
+	if !resp.Success && resp.StatusInfo != nil &&
+		resp.StatusInfo.SessionStatus == proto.StatusInfo_SESSION_EXPIRED && c.canAutoRelogin() {
+		c.logger("Command interrupted by expired session: %s", masked.line)
+		if err := c.Relogin(); err == nil {
+			return nil, &InterruptedCommandError{Command: command}
+		}
+	}
+
+	// A large output held back by the server is fetched as a stream and
+	// delivered while it arrives
+	streamed := resp.Success && resp.ExecutionState != proto.CommandResponse_PENDING_APPROVAL &&
+		resp.Conflict == nil && resp.StreamId != ""
+	var streamErr error
+	if streamed {
+		resp.Output, streamErr = c.receiveStreamedResult(command, resp, post)
+	}
+
+	if post == nil {
+		return c.processResponse(command, lastContext, masked, resp, streamed, streamErr)
+	}
+	processed := make(chan struct{})
+	post(func() {
+		defer close(processed)
+		resp, err = c.processResponse(command, lastContext, masked, resp, streamed, streamErr)
+	})
+	<-processed
+	return resp, err
+}
+
+// processResponse processes the answer to a command: the output callbacks,
+// the service context and the other state depending on it
+func (c *Client) processResponse(command, lastContext string, masked maskedCommand, resp *proto.CommandResponse,
+	streamed bool, streamErr error) (*proto.CommandResponse, error) {
+	// A change based on an outdated version of an entity was rejected
+	if resp.Conflict != nil {
+		conflict := c.newConflictError(command, resp.Conflict)
+		c.logger("Command rejected by concurrent modification of %s: %s", resp.Conflict.Entity, masked.line)
+		c.recordTranscript(EntryError, conflict.Error())
+		return nil, conflict
+	}
+
+	// Process output
+	if !resp.Success {
+		c.logger("Command failed: %s", resp.ErrorMessage)
+		c.recordTranscript(EntryError, resp.ErrorMessage)
+		if c.onOutputReceived != nil {
+			c.onOutputReceived(fmt.Sprintf("Error: %s", c.commandErrorMessage(resp)))
+		}
+	} else if resp.ExecutionState == proto.CommandResponse_PENDING_APPROVAL {
+		// The command is executed by the server once a second user approved it
+		c.logger("Command pending approval %s: %s", resp.ApprovalId, masked.line)
+		c.recordTranscript(EntryEvent, fmt.Sprintf("pending approval %s", resp.ApprovalId))
+		c.trackApproval(resp.ApprovalId, command)
+		if c.onStatusChanged != nil {
+			c.onStatusChanged(resp.StatusInfo)
+		}
+		return nil, &ApprovalPendingError{ApprovalID: resp.ApprovalId, Command: masked.line}
+	} else {
+		if streamErr != nil {
+			c.logger("Streamed result failed: %v", streamErr)
+			c.recordTranscript(EntryError, streamErr.Error())
+			return nil, streamErr
+		}
+
+		c.recordTranscript(EntryOutput, resp.Output)
+		c.recordFlowStep(command, lastContext, resp.Output)
+		c.rememberParameters(command, lastContext)
+		if contentType := plugin.NormalizeContentType(resp.ContentType); contentType != plugin.ContentTypePlain && c.onContentReceived != nil {
+			c.onContentReceived(command, contentType, resp.Output)
+		} else if c.onOutputReceived != nil && !streamed {
+			c.onOutputReceived(resp.Output)
+		}
+		if resp.Table != nil && len(resp.Table.Columns) > 0 && c.onTableReceived != nil {
+			c.onTableReceived(command, resp.Table)
+		}
+		c.recordUndo(command, resp.Undo)
+		c.recordEntityVersions(command, resp.EntityVersions)
+		c.setNextPage(command, resp.NextPageToken)
+
+		// Remember last used service
+		if resp.NewContext != "" {
+			c.changeContext(resp.NewContext)
+			c.logger("New service context: %s", c.lastServiceUsed)
+			c.rememberServer()
+		}
+	}
+
+	// Display status message
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(resp.StatusInfo)
+	}
+
+	return resp, nil
+}
+
+// ExecuteStreamingCommand executes a command that produces continuous output
+func (c *Client) ExecuteStreamingCommand(command string) error {
+	return c.ExecuteStreamingCommandWithOutput(command, c.onOutputReceived)
+}
+
+// ExecuteStreamingCommandWithOutput executes a streaming command and delivers
+// its output to the given handler instead of the default output callback
+func (c *Client) ExecuteStreamingCommandWithOutput(command string, onOutput func(output string)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	return c.executeStream(ctx, command, onOutput, nil, nil)
+}
+
+// executeStream runs a streaming command until it ends, the context is
+// cancelled or the server stays silent for too long; status updates with
+// their progress go to onStatus, and onAlive is called for every message
+// with the activity a heartbeat reports
+func (c *Client) executeStream(ctx context.Context, command string, onOutput func(output string),
+	onStatus func(status string, percent int32), onAlive func(activity string)) (err error) {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+	if err := c.checkReadOnly(command); err != nil {
+		return err
+	}
+
+	// Streams count as commands in flight until they end
+	if err := c.acquireSlot(ctx); err != nil {
+		return err
+	}
+	defer c.releaseSlot()
+
+	masked := c.maskCommand(command)
+	c.logger("Executing streaming command: %s", masked.line)
+	c.recordCommand(masked)
+	c.markActivity()
+
+	ctx, watchdog := c.watchStream(ctx, command)
+	defer func() { err = watchdog.end(ctx, err) }()
+
+	stream, err := c.client.ExecuteStreamingCommand(ctx, &proto.CommandRequest{
+		SessionToken:     c.sessionToken,
+		CommandLine:      command,
+		LastContext:      c.lastServiceUsed,
+		HeartbeatSeconds: c.heartbeatSeconds(),
+		ProgressMinDelta: c.progressMinDelta(),
+	})
+	if err != nil {
+		c.logger("Streaming command execution failed: %v", err)
+		c.recordTranscript(EntryError, err.Error())
+		return c.rpcError("streaming command execution", command, 0, err)
+	}
+
+	// Keep-alives pause while a stream is running
+	c.activeStreams.Add(1)
+	defer c.activeStreams.Add(-1)
+
+	// Progress updates of servers not filtering them are filtered here
+	progress := progressFilter{minDelta: c.progressMinDelta()}
+
+	// Frames of refreshing displays are final once the stream ends
+	frames := c.newFrameSet(command)
+	defer func() {
+		for _, frame := range frames.end() {
+			c.notifyFrame(frame)
+		}
+	}()
+
+	// Process stream
+	for {
+		output, err := stream.Recv()
+		if err == io.EOF {
+			// Stream ended
+			c.logger("Streaming command completed")
+			break
+		}
+		if err != nil {
+			c.logger("Error receiving streaming data: %v", err)
+			c.recordTranscript(EntryError, err.Error())
+			return c.rpcError("receiving streaming data", command, 0, err)
+		}
+
+		watchdog.signal()
+		if onAlive != nil {
+			activity := ""
+			if output.Type == proto.CommandOutput_HEARTBEAT {
+				activity = output.Content
+			}
+			onAlive(activity)
+		}
+
+		// Process output by type
+		switch output.Type {
+		case proto.CommandOutput_TEXT:
+			if onOutput != nil {
+				onOutput(output.Content)
+			}
+		case proto.CommandOutput_STATUS_UPDATE:
+			// Process status update (e.g., progress indicator)
+			if !progress.pass(output.ProgressPercent) {
+				continue
+			}
+			c.logger("Status update: %s (%d%%)", output.Content, output.ProgressPercent)
+			if onStatus != nil {
+				onStatus(output.Content, output.ProgressPercent)
+			}
+		case proto.CommandOutput_ERROR:
+			c.logger("Streaming error: %s", output.Content)
+			c.recordTranscript(EntryError, output.Content)
+			if onOutput != nil {
+				onOutput(fmt.Sprintf("Error: %s", output.Content))
+			}
+		case proto.CommandOutput_PATCH:
+			c.notifyFrame(frames.apply(output))
+		case proto.CommandOutput_COMPLETION:
+			c.logger("Streaming command complete: %s", output.Content)
+			c.recordTranscript(EntryOutput, output.Content)
+			if onOutput != nil {
+				onOutput(fmt.Sprintf("Completed: %s", output.Content))
+			}
+		}
+	}
+
+	return nil
+}
+
+// AutoComplete provides command completion suggestions
+func (c *Client) AutoComplete(partialInput string, cursorPos int) ([]string, string, error) {
+	if c.client == nil {
+		return nil, "", fmt.Errorf("not connected to server")
+	}
+
+	c.markActivity()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	resp, err := c.metadataClient().AutoComplete(ctx, &proto.AutoCompleteRequest{
+		SessionToken:   c.sessionToken,
+		PartialInput:   partialInput,
+		CurrentContext: c.lastServiceUsed,
+		CursorPosition: int32(cursorPos),
+	})
+	if err != nil {
+		c.logger("Auto-completion failed: %v", err)
+		return nil, "", fmt.Errorf("auto-completion failed: %v", err)
+	}
+
+	return resp.Suggestions, resp.CommonPrefix, nil
+}
+
+// CompletionScope describes what the suggestions of AutoComplete and
+// CompleteInContext depend on: the server, the session, the service context,
+// the service prefix and the metadata version. Cached suggestions are stale
+// as soon as it changes.
+func (c *Client) CompletionScope() string {
+	server := ""
+	if c.serverInfo != nil {
+		server = fmt.Sprintf("%s:%d", c.serverInfo.Address, c.serverInfo.Port)
+	}
+	c.metadata.mu.RLock()
+	version := c.metadata.version
+	c.metadata.mu.RUnlock()
+	return fmt.Sprintf("%s|%s|%s|%t|%d", server, c.sessionToken, c.lastServiceUsed, c.ServicePrefixEnabled(), version)
+}
+
+// GetAliases retrieves the available command aliases
+func (c *Client) GetAliases() ([]*proto.AliasInfo, error) {
+	return c.getAliases(context.Background())
+}
+
+// getAliases retrieves the server aliases until the context ends
+func (c *Client) getAliases(ctx context.Context) ([]*proto.AliasInfo, error) {
+	client, token, err := c.sessionClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetAliases(ctx, &proto.GetAliasesRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		c.logger("Error retrieving aliases: %v", err)
+		return nil, fmt.Errorf("error retrieving aliases: %v", err)
+	}
+
+	return resp.Aliases, nil
+}
+
+// CreateAlias creates a new command alias
+func (c *Client) CreateAlias(alias, expandedCommand string) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreateAlias(ctx, &proto.CreateAliasRequest{
+		SessionToken:    c.sessionToken,
+		Alias:           alias,
+		ExpandedCommand: expandedCommand,
+	})
+	if err != nil {
+		c.logger("Error creating alias: %v", err)
+		return fmt.Errorf("error creating alias: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Alias creation failed: %s", resp.ErrorMessage)
+		return fmt.Errorf("alias creation failed: %s", resp.ErrorMessage)
+	}
+
+	c.logger("Alias '%s' created for '%s'", alias, expandedCommand)
+	return nil
+}
+
+// DeleteAlias deletes a command alias
+func (c *Client) DeleteAlias(alias string) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.DeleteAlias(ctx, &proto.DeleteAliasRequest{
+		SessionToken: c.sessionToken,
+		Alias:        alias,
+	})
+	if err != nil {
+		c.logger("Error deleting alias: %v", err)
+		return fmt.Errorf("error deleting alias: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Alias deletion failed: %s", resp.ErrorMessage)
+		return fmt.Errorf("alias deletion failed: %s", resp.ErrorMessage)
+	}
+
+	c.logger("Alias '%s' deleted", alias)
+	return nil
+}
+
+// GetAvailableServices retrieves the available services
+func (c *Client) GetAvailableServices() ([]*proto.ServiceInfo, error) {
+	return c.availableServices(context.Background())
+}
+
+// availableServices retrieves the available services until the context ends
+func (c *Client) availableServices(ctx context.Context) ([]*proto.ServiceInfo, error) {
+	client, token, err := c.sessionClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetAvailableServices(ctx, &proto.ServicesRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		c.logger("Error retrieving services: %v", err)
+		return nil, fmt.Errorf("error retrieving services: %v", err)
+	}
+
+	return resp.Services, nil
+}
+
+// GetServiceCommands retrieves the available commands for a service
+func (c *Client) GetServiceCommands(serviceName string) ([]*proto.CommandInfo, error) {
+	return c.serviceCommands(context.Background(), serviceName)
+}
+
+// serviceCommands retrieves the commands of a service until the context ends
+func (c *Client) serviceCommands(ctx context.Context, serviceName string) ([]*proto.CommandInfo, error) {
+	client, token, err := c.sessionClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetServiceCommands(ctx, &proto.ServiceCommandsRequest{
+		SessionToken: token,
+		ServiceName:  serviceName,
+	})
+	if err != nil {
+		c.logger("Error retrieving commands: %v", err)
+		return nil, fmt.Errorf("error retrieving commands: %v", err)
+	}
+
+	return resp.Commands, nil
+}
+
+// GetCommandHelp retrieves help for a specific command
+func (c *Client) GetCommandHelp(service, action, subaction string) (string, *proto.CommandInfo, error) {
+	helpText, info, err := c.requestCommandHelp(service, action, subaction)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Keep the help for use without connection
+	c.cacheCommandHelp(service, info, helpText)
+
+	return helpText, info, nil
+}
+
+// requestCommandHelp retrieves the help for a command from the server
+func (c *Client) requestCommandHelp(service, action, subaction string) (string, *proto.CommandInfo, error) {
+	if c.client == nil {
+		return "", nil, fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return "", nil, fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.metadataClient().GetCommandHelp(ctx, &proto.CommandHelpRequest{
+		SessionToken: c.sessionToken,
+		Service:      service,
+		Action:       action,
+		Subaction:    subaction,
+	})
+	if err != nil {
+		c.logger("Error retrieving help: %v", err)
+		return "", nil, fmt.Errorf("error retrieving help: %v", err)
+	}
+
+	return resp.HelpText, resp.CommandInfo, nil
+}
+
+// IsConnected returns whether the client is connected to a server
+func (c *Client) IsConnected() bool {
+	return c.conn != nil && c.client != nil
+}
+
+// IsLoggedIn returns whether the client is logged in
+func (c *Client) IsLoggedIn() bool {
+	return c.sessionToken != ""
+}
+
+// GetUsername returns the name of the logged-in user
+func (c *Client) GetUsername() string {
+	return c.username
+}
+
+// GetConfig returns the client configuration
+func (c *Client) GetConfig() *config.Config {
+	return c.config
+}
+
+// GetServerInfo returns information about the connected server
+func (c *Client) GetServerInfo() *proto.ServerInfo {
+	return c.serverInfo
+}
+
+// GetLastServiceUsed returns the last used service
+func (c *Client) GetLastServiceUsed() string {
+	return c.lastServiceUsed
+}
+
+// SetLastServiceUsed sets the last used service
+func (c *Client) SetLastServiceUsed(service string) {
+	c.changeContext(service)
+	c.rememberServer()
+}
+
+// StartKeepAlive starts a background process for session keep-alive; the
+// interval is used as long as the server reports no session TTL
+func (c *Client) StartKeepAlive(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	// Only one KeepAlive loop runs at a time
+	c.keepAliveMu.Lock()
+	if c.keepAliveRunning {
+		c.keepAliveMu.Unlock()
+		return
+	}
+	c.keepAliveRunning = true
+	c.keepAliveMu.Unlock()
+
+	go func() {
+		timer := time.NewTimer(c.keepAliveInterval(interval))
+		defer timer.Stop()
+		defer func() {
+			c.keepAliveMu.Lock()
+			c.keepAliveRunning = false
+			c.keepAliveMu.Unlock()
+		}()
+
+		for range timer.C {
+			if _, _, err := c.sessionClient(); err != nil {
+				// End KeepAlive if not connected or not logged in
+				return
+			}
+
+			// A running streaming command already proves that the session is alive
+			if c.activeStreams.Load() == 0 && !c.keepAlive() {
+				return
+			}
+			timer.Reset(c.keepAliveInterval(interval))
+		}
+	}()
+}
+
+// keepAlive sends a keep-alive request; returns false if the session has expired
+// and could not be renewed
+func (c *Client) keepAlive() bool {
+	client, token, err := c.sessionClient()
+	if err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	started := time.Now()
+	resp, err := client.KeepAlive(ctx, &proto.KeepAliveRequest{
+		SessionToken: token,
+	})
+	cancel()
+
+	// Any answer of the server counts, even an error
+	code := status.Code(err)
+	c.recordHeartbeat(time.Since(started), code != codes.Unavailable && code != codes.DeadlineExceeded)
+
+	if err != nil {
+		c.logger("KeepAlive error: %v", err)
+		if status.Code(err) == codes.Unavailable && c.config.Server.AutoReconnect {
+			c.connectionLost(err)
+			return false
+		}
+		return true
+	}
+
+	if !resp.SessionValid {
+		c.logger("Session expired")
+
+		// Renew the session transparently if configured
+		if c.canAutoRelogin() {
+			if err := c.Relogin(); err == nil {
+				return true
+			}
+		}
+
+		c.endSessionTasks()
+		c.setSessionToken("")
+
+		// Report status
+		if c.onStatusChanged != nil {
+			c.onStatusChanged(&proto.StatusInfo{
+				ConnectionStatus: proto.StatusInfo_CONNECTED,
+				SessionStatus:    proto.StatusInfo_SESSION_EXPIRED,
+				ServerName:       c.serverInfo.ShortName,
+			})
+		}
+
+		// End KeepAlive since session has expired
+		return false
+	}
+
+	c.setSessionTTL(resp.SessionTtlSeconds)
+	c.setMaintenance(resp.UpcomingMaintenance)
+
+	// Refresh cached metadata after server changes or while idle
+	c.checkMetadataVersion(resp.MetadataVersion)
+	c.checkIdleRefresh()
+	return true
+}
+
+// setSessionTTL remembers the session TTL reported by the server; 0 keeps the last value
+func (c *Client) setSessionTTL(seconds int32) {
+	if seconds <= 0 {
+		return
+	}
+
+	c.keepAliveMu.Lock()
+	defer c.keepAliveMu.Unlock()
+	if ttl := time.Duration(seconds) * time.Second; ttl != c.sessionTTL {
+		c.sessionTTL = ttl
+		c.logger("Session TTL is %v", ttl)
+	}
+}
+
+// keepAliveInterval returns the time until the next keep-alive: the configured share
+// of the session TTL, or the fallback interval if the server reported no TTL
+func (c *Client) keepAliveInterval(fallback time.Duration) time.Duration {
+	c.keepAliveMu.Lock()
+	ttl := c.sessionTTL
+	c.keepAliveMu.Unlock()
+	if ttl <= 0 {
+		return fallback
+	}
+
+	percent := c.config.Server.KeepAliveTTLPercent
+	if percent <= 0 || percent >= 100 {
+		percent = 50
+	}
+	interval := ttl * time.Duration(percent) / 100
+	if interval < minKeepAliveInterval {
+		interval = minKeepAliveInterval
+	}
+	return interval
+}
+
+// Close closes the connection to the server
+func (c *Client) Close() error {
+	c.reconnecting.Store(false)
+	if c.conn != nil {
+		if c.serverInfo != nil {
+			c.publishEvent(EventDisconnect, c.serverInfo.ShortName)
+		}
+		// The background work ends before the connection is cleared
+		err := c.dropConnection()
+		c.username = ""
+		c.clearMetadata()
+		c.clearPendingApprovals()
+		c.cancelAllJobs()
+		c.setMaintenance(nil)
+
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+// commandwal.go
+/**
+ * Nexuflex Client - Write-Ahead Log for Critical Commands
+ *
+ * This file contains the write-ahead log for commands flagged critical.
+ * Such commands get a command ID and are persisted before they are sent;
+ * the entry is removed once the server has answered with a receipt. After
+ * a network drop the remaining entries are reconciled with the server via
+ * QueryCommandStatus on the next login, so the operator always learns
+ * whether a command was executed.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// PendingCommand is a critical command whose outcome is not yet known
+type PendingCommand struct {
+	CommandID string    `json:"command_id"`
+	Command   string    `json:"command"`
+	Server    string    `json:"server"`
+	User      string    `json:"user"`
+	Context   string    `json:"context,omitempty"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// CommandWAL is the write-ahead log of critical commands
+type CommandWAL struct {
+	mu      sync.Mutex
+	path    string
+	entries []*PendingCommand
+}
+
+// NewCommandWAL creates the log and loads the entries left from earlier sessions
+func NewCommandWAL() *CommandWAL {
+	w := &CommandWAL{}
+	if userConfigDir, err := os.UserConfigDir(); err == nil {
+		w.path = filepath.Join(userConfigDir, "nexuflex", "command_wal")
+		state.ReadLines(w.path, func(line string) bool {
+			var entry PendingCommand
+			if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.CommandID == "" {
+				return false
+			}
+			w.entries = append(w.entries, &entry)
+			return true
+		})
+	}
+	return w
+}
+
+// save writes all entries; must be called with the lock held
+func (w *CommandWAL) save() error {
+	if w.path == "" {
+		return fmt.Errorf("no configuration directory for the write-ahead log")
+	}
+
+	lines := make([]string, 0, len(w.entries))
+	for _, entry := range w.entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, string(data))
+	}
+	return state.WriteLinesAtomic(w.path, lines)
+}
+
+// add persists a command before it is sent; an entry with the same ID is replaced
+func (w *CommandWAL) add(entry PendingCommand) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for i, existing := range w.entries {
+		if existing.CommandID == entry.CommandID {
+			w.entries[i] = &entry
+			return w.save()
+		}
+	}
+	w.entries = append(w.entries, &entry)
+	return w.save()
+}
+
+// remove deletes a command whose outcome is known
+func (w *CommandWAL) remove(commandID string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for i, entry := range w.entries {
+		if entry.CommandID == commandID {
+			w.entries = append(w.entries[:i], w.entries[i+1:]...)
+			return w.save()
+		}
+	}
+	return nil
+}
+
+// pending returns the entries sent to a server by a user
+func (w *CommandWAL) pending(server, user string) []PendingCommand {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var result []PendingCommand
+	for _, entry := range w.entries {
+		if entry.Server == server && entry.User == user {
+			result = append(result, *entry)
+		}
+	}
+	return result
+}
+
+// newCommandID creates a random command ID
+func newCommandID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// Fall back to the time; uniqueness per client is sufficient
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// serverKey identifies the connected server, e.g. in the write-ahead log
+func (c *Client) serverKey() string {
+	if c.serverInfo == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.serverInfo.Address, c.serverInfo.Port)
+}
+
+// IsCriticalCommand checks whether a command is flagged critical by the
+// configuration or by the command metadata of the server
+func (c *Client) IsCriticalCommand(command string) bool {
+	name := strings.SplitN(strings.TrimSpace(command), " ", 2)[0]
+	if name == "" {
+		return false
+	}
+
+	return matchesCommandPattern(name, c.config.Commands.CriticalCommands) ||
+		c.hasCommandFlag(name, func(info *proto.CommandInfo) bool { return info.Critical })
+}
+
+// matchesCommandPattern checks a command name against configured patterns;
+// a trailing "*" matches a prefix
+func matchesCommandPattern(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(strings.TrimSuffix(pattern, "*"))) {
+				return true
+			}
+		} else if strings.EqualFold(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCommandFlag checks whether the cached command metadata of a command
+// "Service.Action[.Subaction]" has a flag set
+func (c *Client) hasCommandFlag(name string, flag func(info *proto.CommandInfo) bool) bool {
+	parts := strings.Split(name, ".")
+	if len(parts) < 2 {
+		return false
+	}
+	for _, info := range c.GetCachedCommands(parts[0]) {
+		if !flag(info) || !strings.EqualFold(info.Action, parts[1]) {
+			continue
+		}
+		if info.Subaction == "" || (len(parts) > 2 && strings.EqualFold(info.Subaction, parts[2])) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeAhead persists a critical command before it is sent
+func (c *Client) writeAhead(commandID, command string) error {
+	err := c.commandWAL.add(PendingCommand{
+		CommandID: commandID,
+		Command:   command,
+		Server:    c.serverKey(),
+		User:      c.username,
+		Context:   c.lastServiceUsed,
+		SentAt:    time.Now(),
+	})
+	if err != nil {
+		c.logger("Error writing command %s to the write-ahead log: %v", commandID, err)
+		return fmt.Errorf("critical command not sent, write-ahead log not writable: %v", err)
+	}
+	return nil
+}
+
+// confirmReceipt removes a command from the write-ahead log once the server has answered
+func (c *Client) confirmReceipt(commandID string) {
+	if err := c.commandWAL.remove(commandID); err != nil {
+		c.logger("Error updating the write-ahead log: %v", err)
+	}
+}
+
+// QueryCommandStatus asks the server for the outcome of a command
+func (c *Client) QueryCommandStatus(commandID string) (*proto.CommandStatusResponse, error) {
+	return c.queryCommandStatus(context.Background(), commandID)
+}
+
+// queryCommandStatus asks the server for the outcome of a command until the context ends
+func (c *Client) queryCommandStatus(ctx context.Context, commandID string) (*proto.CommandStatusResponse, error) {
+	client, token, err := c.commandSession()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := client.QueryCommandStatus(ctx, &proto.CommandStatusRequest{
+		SessionToken: token,
+		CommandId:    commandID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying command status: %v", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("error querying command status: %s", resp.ErrorMessage)
+	}
+
+	return resp, nil
+}
+
+// reconcileCommands queries the outcome of the critical commands that the
+// user sent to the server without a receipt and reports them to the
+// callback; it runs in the background of the session and ends with it
+func (c *Client) reconcileCommands(ctx context.Context, server, username string) {
+	for _, pending := range c.commandWAL.pending(server, username) {
+		if ctx.Err() != nil {
+			// The next login reconciles the remaining commands
+			return
+		}
+		status, err := c.queryCommandStatus(ctx, pending.CommandID)
+		if err != nil {
+			// Keep the entry and try again on the next login
+			c.logger("Outcome of command %s not available: %v", pending.CommandID, err)
+			continue
+		}
+
+		switch status.Status {
+		case proto.CommandStatusResponse_RUNNING:
+			// Still unknown, keep the entry
+		case proto.CommandStatusResponse_PENDING_APPROVAL:
+			c.confirmReceipt(pending.CommandID)
+			c.trackApproval(status.ApprovalId, pending.Command)
+		case proto.CommandStatusResponse_UNKNOWN:
+			// Not received: stays in the log until it is resent or discarded
+		default:
+			c.confirmReceipt(pending.CommandID)
+		}
+
+		c.recordTranscript(EntryEvent, fmt.Sprintf("outcome of '%s' (%s): %s",
+			c.MaskCommand(pending.Command), pending.CommandID, status.Status))
+		if c.onCommandReconciled != nil {
+			c.onCommandReconciled(pending, status)
+		}
+	}
+}
+
+// SetCommandReconciledCallback sets the function called with the outcome of a critical command after a reconnect
+func (c *Client) SetCommandReconciledCallback(onReconciled func(pending PendingCommand, status *proto.CommandStatusResponse)) {
+	c.onCommandReconciled = onReconciled
+}
+
+// ResendCommand sends a critical command again with its original command ID,
+// so the server executes it at most once
+func (c *Client) ResendCommand(pending PendingCommand) error {
+	return c.executeCommand(pending.Command, pending.CommandID)
+}
+
+// DiscardCommand removes a critical command that the server never received from the log
+func (c *Client) DiscardCommand(pending PendingCommand) {
+	c.confirmReceipt(pending.CommandID)
+	c.recordTranscript(EntryEvent, fmt.Sprintf("discarded '%s' (%s)", c.MaskCommand(pending.Command), pending.CommandID))
+}
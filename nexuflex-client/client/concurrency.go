@@ -0,0 +1,188 @@
+// concurrency.go
+/**
+ * Nexuflex Client - Command Concurrency
+ *
+ * This file contains the limit of the commands in flight on the
+ * connection, set with `max_in_flight`. Streams and commands entered by
+ * the user beyond the limit wait in a queue in the order they were issued
+ * and are sent as soon as a slot becomes free. Commands sent directly by
+ * the client (e.g. the steps of a flow) are counted but never wait, since
+ * they are sent from the user interface, which must not block. Commands
+ * of the user are sent with SendCommandAsync from a goroutine of their
+ * own, so the user interface keeps drawing while they are outstanding.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// commandLimiter counts the commands in flight and queues the ones beyond the limit
+type commandLimiter struct {
+	mu       sync.Mutex
+	inFlight int
+	waiting  []chan struct{} // In the order the commands were issued
+}
+
+// SetActivityCallback sets the function called when a command starts, ends or is queued
+func (c *Client) SetActivityCallback(onActivity func()) {
+	c.onActivity = onActivity
+}
+
+// notifyActivity reports a change of the commands in flight or queued
+func (c *Client) notifyActivity() {
+	if c.onActivity != nil {
+		c.onActivity()
+	}
+}
+
+// maxInFlight returns the configured limit, 0 for no limit
+func (c *Client) maxInFlight() int {
+	if c.config.Server.MaxInFlight < 0 {
+		return 0
+	}
+	return c.config.Server.MaxInFlight
+}
+
+// CommandsInFlight returns the number of commands and streams waiting for the server
+func (c *Client) CommandsInFlight() int {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	return c.limiter.inFlight
+}
+
+// QueuedCommands returns the number of commands waiting for a free slot
+func (c *Client) QueuedCommands() int {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	return len(c.limiter.waiting)
+}
+
+// tryAcquireSlot takes a slot if one is free and no command is queued;
+// otherwise the returned channel is closed once the caller holds a slot
+// and ahead is the number of commands queued before it
+func (c *Client) tryAcquireSlot() (ready chan struct{}, ahead int) {
+	c.limiter.mu.Lock()
+	limit := c.maxInFlight()
+	if len(c.limiter.waiting) == 0 && (limit == 0 || c.limiter.inFlight < limit) {
+		c.limiter.inFlight++
+		c.limiter.mu.Unlock()
+		c.notifyActivity()
+		return nil, 0
+	}
+
+	ready = make(chan struct{})
+	ahead = len(c.limiter.waiting)
+	c.limiter.waiting = append(c.limiter.waiting, ready)
+	c.limiter.mu.Unlock()
+	c.notifyActivity()
+	return ready, ahead
+}
+
+// takeSlot counts a command that is sent without waiting for a free slot
+func (c *Client) takeSlot() {
+	c.limiter.mu.Lock()
+	c.limiter.inFlight++
+	c.limiter.mu.Unlock()
+	c.notifyActivity()
+}
+
+// acquireSlot waits until a slot is free or the context ends
+func (c *Client) acquireSlot(ctx context.Context) error {
+	ready, _ := c.tryAcquireSlot()
+	if ready == nil {
+		return nil
+	}
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	c.limiter.mu.Lock()
+	for i, waiting := range c.limiter.waiting {
+		if waiting == ready {
+			c.limiter.waiting = append(c.limiter.waiting[:i], c.limiter.waiting[i+1:]...)
+			c.limiter.mu.Unlock()
+			c.notifyActivity()
+			return ctx.Err()
+		}
+	}
+	c.limiter.mu.Unlock()
+
+	// The slot was handed over while the context ended
+	c.releaseSlot()
+	return ctx.Err()
+}
+
+// releaseSlot frees a slot, handing it over to the first queued command
+func (c *Client) releaseSlot() {
+	c.limiter.mu.Lock()
+	limit := c.maxInFlight()
+	c.limiter.inFlight--
+	for len(c.limiter.waiting) > 0 && (limit == 0 || c.limiter.inFlight < limit) {
+		c.limiter.inFlight++
+		close(c.limiter.waiting[0])
+		c.limiter.waiting = c.limiter.waiting[1:]
+	}
+	c.limiter.mu.Unlock()
+	c.notifyActivity()
+}
+
+// QueueCommand sends a command at once if a slot is free and returns its
+// error. Otherwise the command is queued and 0 or more commands ahead of it
+// are returned with queued set; once it has a slot, dispatch is called
+// from another goroutine with the function sending it, which the caller
+// runs where it processes the output.
+func (c *Client) QueueCommand(command string, dispatch func(send func() error)) (ahead int, queued bool, err error) {
+	send := func() error {
+		defer c.releaseSlot()
+		_, err := c.sendCommandInSlot(command, c.commandIDFor(command), defaultCommandTimeout)
+		return err
+	}
+
+	ready, ahead := c.tryAcquireSlot()
+	if ready == nil {
+		return 0, false, send()
+	}
+
+	c.logger("Command queued (%d ahead): %s", ahead, c.MaskCommand(command))
+	go func() {
+		<-ready
+		dispatch(send)
+	}()
+	return ahead, true, nil
+}
+
+// SendCommandAsync sends a command from a goroutine of its own, at once if
+// a slot is free and otherwise once the commands ahead of it made room. The
+// answer is processed by a function passed to post, which runs it where the
+// caller processes output, e.g. on the UI goroutine; done is passed to post
+// the same way afterwards, with the time the command was sent and its
+// error. It returns the number of commands ahead of it and whether it was
+// queued.
+func (c *Client) SendCommandAsync(command string, post func(func()), done func(sent time.Time, err error)) (ahead int, queued bool) {
+	ready, ahead := c.tryAcquireSlot()
+	if ready != nil {
+		c.logger("Command queued (%d ahead): %s", ahead, c.MaskCommand(command))
+	}
+
+	go func() {
+		if ready != nil {
+			<-ready
+		}
+		sent := time.Now()
+		_, err := c.sendRequestPosted(command, c.commandIDFor(command), "", defaultCommandTimeout, post)
+		c.releaseSlot()
+		post(func() { done(sent, err) })
+	}()
+	return ahead, ready != nil
+}
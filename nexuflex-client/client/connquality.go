@@ -0,0 +1,179 @@
+// connquality.go
+/**
+ * Nexuflex Client - Connection Quality
+ *
+ * This file contains the connection quality derived from the keep-alive
+ * heartbeats: the round-trip time of the recent heartbeats and its jitter,
+ * the share of heartbeats that got no answer and the number of automatic
+ * reconnects. They are combined into a score from 0 to 100, which the
+ * user interface shows as a signal strength indicator with up to four
+ * bars. The measurements start over when the user connects to a server.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// Limits of the connection quality
+const (
+	qualitySamples     = 20                     // Heartbeats evaluated
+	qualityGoodRTT     = 100 * time.Millisecond // Round-trip time without penalty
+	qualityMaxBars     = 4                      // Bars of the indicator
+	qualityNoHeartbeat = -1                     // Bars while nothing was measured
+)
+
+// ConnectionQuality describes the quality of the connection to the server
+type ConnectionQuality struct {
+	Heartbeats int           // Heartbeats sent on this connection
+	Missed     int           // Heartbeats without an answer
+	Reconnects int           // Automatic reconnects since the user connected
+	LastRTT    time.Duration // Round-trip time of the last answered heartbeat
+	AverageRTT time.Duration // Of the recent heartbeats
+	Jitter     time.Duration // Mean difference between consecutive round-trip times
+	Loss       float64       // Share of the recent heartbeats without an answer, 0 to 1
+	Score      int           // 0 (unusable) to 100 (perfect)
+	Bars       int           // 0 to 4, -1 before the first heartbeat
+	Since      time.Time     // Start of the measurement
+}
+
+// connectionQuality collects the heartbeats of the connection
+type connectionQuality struct {
+	mu         sync.Mutex
+	rtts       []time.Duration // Answered heartbeats among the recent ones
+	recent     []bool          // Recent heartbeats, true if answered
+	heartbeats int
+	missed     int
+	reconnects int
+	since      time.Time
+}
+
+// SetConnectionQualityCallback sets the function called when the connection quality changes
+func (c *Client) SetConnectionQualityCallback(onQualityChanged func()) {
+	c.onQualityChanged = onQualityChanged
+}
+
+// resetConnectionQuality starts the measurement for a connection the user made
+func (c *Client) resetConnectionQuality() {
+	c.quality.mu.Lock()
+	c.quality.rtts = nil
+	c.quality.recent = nil
+	c.quality.heartbeats = 0
+	c.quality.missed = 0
+	c.quality.reconnects = 0
+	c.quality.since = time.Now()
+	c.quality.mu.Unlock()
+	c.notifyQuality()
+}
+
+// recordHeartbeat adds a keep-alive to the measurement; answered is false
+// if the server did not answer in time
+func (c *Client) recordHeartbeat(rtt time.Duration, answered bool) {
+	c.quality.mu.Lock()
+	q := &c.quality
+	q.heartbeats++
+	q.recent = append(q.recent, answered)
+	if len(q.recent) > qualitySamples {
+		q.recent = q.recent[1:]
+	}
+	if answered {
+		q.rtts = append(q.rtts, rtt)
+		if len(q.rtts) > qualitySamples {
+			q.rtts = q.rtts[1:]
+		}
+	} else {
+		q.missed++
+	}
+	c.quality.mu.Unlock()
+	c.notifyQuality()
+}
+
+// recordReconnect counts an automatic reconnect
+func (c *Client) recordReconnect() {
+	c.quality.mu.Lock()
+	c.quality.reconnects++
+	c.quality.mu.Unlock()
+	c.notifyQuality()
+}
+
+// notifyQuality tells the user interface that the connection quality changed
+func (c *Client) notifyQuality() {
+	if c.onQualityChanged != nil {
+		c.onQualityChanged()
+	}
+}
+
+// GetConnectionQuality evaluates the heartbeats of the connection
+func (c *Client) GetConnectionQuality() ConnectionQuality {
+	c.quality.mu.Lock()
+	defer c.quality.mu.Unlock()
+	q := &c.quality
+
+	quality := ConnectionQuality{
+		Heartbeats: q.heartbeats,
+		Missed:     q.missed,
+		Reconnects: q.reconnects,
+		Since:      q.since,
+		Bars:       qualityNoHeartbeat,
+	}
+	if len(q.recent) == 0 {
+		return quality
+	}
+
+	var total, jitter time.Duration
+	for i, rtt := range q.rtts {
+		total += rtt
+		if i > 0 {
+			jitter += absDuration(rtt - q.rtts[i-1])
+		}
+	}
+	if len(q.rtts) > 0 {
+		quality.LastRTT = q.rtts[len(q.rtts)-1]
+		quality.AverageRTT = total / time.Duration(len(q.rtts))
+	}
+	if len(q.rtts) > 1 {
+		quality.Jitter = jitter / time.Duration(len(q.rtts)-1)
+	}
+	lost := 0
+	for _, answered := range q.recent {
+		if !answered {
+			lost++
+		}
+	}
+	quality.Loss = float64(lost) / float64(len(q.recent))
+
+	quality.Score = qualityScore(quality)
+	quality.Bars = (quality.Score + 24) / 25
+	if quality.Bars > qualityMaxBars {
+		quality.Bars = qualityMaxBars
+	}
+	return quality
+}
+
+// qualityScore combines the measurements into a score from 0 to 100: slow
+// round trips cost up to 40 points, jitter up to 30, lost heartbeats up to
+// 60 and reconnects up to 30
+func qualityScore(quality ConnectionQuality) int {
+	score := 100
+	if quality.AverageRTT > qualityGoodRTT {
+		score -= min(40, int((quality.AverageRTT-qualityGoodRTT)/(10*time.Millisecond)))
+	}
+	score -= min(30, int(quality.Jitter/(5*time.Millisecond)))
+	score -= min(60, int(quality.Loss*200))
+	score -= min(30, 10*quality.Reconnects)
+	return max(0, score)
+}
+
+// absDuration returns the absolute value of a duration
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
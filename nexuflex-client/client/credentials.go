@@ -0,0 +1,163 @@
+// credentials.go
+/**
+ * Nexuflex Client - Stored Credentials and Automatic Re-Login
+ *
+ * This file contains the storage of login credentials in the operating
+ * system keyring and the transparent re-authentication after the server
+ * has reported an expired session. The stored values are the login fields
+ * of the authentication provider (e.g. username and password).
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService is the service name under which credentials are stored in the keyring
+const keyringService = "nexuflex"
+
+// InterruptedCommandError reports that a command was not executed because the
+// session had expired and was renewed automatically in the meantime
+type InterruptedCommandError struct {
+	Command string
+}
+
+// Error implements the error interface
+func (e *InterruptedCommandError) Error() string {
+	return fmt.Sprintf("session expired, command was not executed: %s", e.Command)
+}
+
+// credentialKey returns the keyring account name for a server
+func credentialKey(address string, port int32) string {
+	return fmt.Sprintf("%s:%d", address, port)
+}
+
+// StoreCredentials stores the login values for a server in the keyring
+func StoreCredentials(address string, port int32, values map[string]string) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return keyring.Set(keyringService, credentialKey(address, port), string(data))
+}
+
+// LoadCredentials loads the login values for a server from the keyring
+func LoadCredentials(address string, port int32) (map[string]string, error) {
+	data, err := keyring.Get(keyringService, credentialKey(address, port))
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		return nil, fmt.Errorf("invalid keyring entry: %v", err)
+	}
+	return values, nil
+}
+
+// DeleteCredentials removes the login credentials for a server from the keyring
+func DeleteCredentials(address string, port int32) error {
+	err := keyring.Delete(keyringService, credentialKey(address, port))
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// HasStoredCredentials returns whether credentials for the connected server are stored
+func (c *Client) HasStoredCredentials() bool {
+	if c.serverInfo == nil {
+		return false
+	}
+	_, err := LoadCredentials(c.serverInfo.Address, c.serverInfo.Port)
+	return err == nil
+}
+
+// canAutoRelogin returns whether an expired session may be renewed automatically;
+// providers without login fields (e.g. mTLS) need no stored credentials
+func (c *Client) canAutoRelogin() bool {
+	if c.config == nil || !c.config.Auth.AutoRelogin {
+		return false
+	}
+	return len(c.authProvider.Fields()) == 0 || c.HasStoredCredentials()
+}
+
+// storedLoginRequest builds a login request from the credentials stored in the keyring;
+// providers without login fields (e.g. mTLS) need no stored credentials
+func (c *Client) storedLoginRequest() (*proto.LoginRequest, error) {
+	if c.serverInfo == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+
+	values, err := LoadCredentials(c.serverInfo.Address, c.serverInfo.Port)
+	if err != nil && len(c.authProvider.Fields()) > 0 {
+		return nil, fmt.Errorf("no stored credentials: %v", err)
+	}
+	return c.authProvider.BuildLoginRequest(values)
+}
+
+// LoginStored logs in without user interaction, e.g. in batch mode
+func (c *Client) LoginStored() error {
+	req, err := c.storedLoginRequest()
+	if err != nil {
+		return err
+	}
+	return c.login(req, false)
+}
+
+// Relogin re-authenticates with the credentials stored in the keyring
+func (c *Client) Relogin() error {
+	if c.serverInfo == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	req, err := c.storedLoginRequest()
+	if err != nil {
+		return err
+	}
+
+	c.logger("Session expired, re-authenticating as %s...", req.Username)
+	if err := c.login(req, false); err != nil {
+		return err
+	}
+
+	// Restore the service context in the status display
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_AUTHENTICATED,
+			ServerName:       c.serverInfo.ShortName,
+			Username:         c.username,
+			CurrentService:   c.lastServiceUsed,
+		})
+	}
+
+	return nil
+}
+
+// IsIdempotentCommand estimates whether a command only reads data and can
+// therefore be replayed without asking the user
+func IsIdempotentCommand(command string) bool {
+	name := strings.SplitN(strings.TrimSpace(command), " ", 2)[0]
+	parts := strings.Split(name, ".")
+	if len(parts) < 2 {
+		return false
+	}
+
+	switch strings.ToLower(parts[1]) {
+	case "list", "show", "get", "find", "search", "view", "help", "status", "query", "tail":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,301 @@
+// dashboards.go
+/**
+ * Nexuflex Client - Dashboards
+ *
+ * This file contains the dashboards, full-screen pages composed of widgets
+ * that show the results of commands and refresh them on an interval. They
+ * are defined as YAML files in the "dashboards" directory of the user
+ * configuration, one per dashboard, so that a team can share them. Every
+ * widget runs a read-only command and shows its result as a panel (the
+ * output rendered by its content type), a table (the structured result or
+ * CSV output), a bar chart ("label,value" lines) or a watch tile (the
+ * lines matching a watch pattern, alerting while there are any).
+ *
+ *   name: Operations overview
+ *   refresh: 30s
+ *   columns: 2
+ *   widgets:
+ *     - title: Open orders
+ *       type: table
+ *       command: Order.List.Open
+ *     - title: Stock by warehouse
+ *       type: chart
+ *       command: Inventory.Report.Stock
+ *       refresh: 5m
+ *     - title: Failed imports
+ *       type: watch
+ *       command: Import.Log.Tail 200
+ *       pattern: ERROR|FAILED
+ *       span: 2
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// Refresh intervals of dashboard widgets
+const (
+	DefaultDashboardRefresh = 30 * time.Second
+	MinDashboardRefresh     = 2 * time.Second // Protects the server from hammering widgets
+)
+
+// Widget types of dashboards
+const (
+	WidgetPanel = "panel"
+	WidgetTable = "table"
+	WidgetChart = "chart"
+	WidgetWatch = "watch"
+)
+
+// widgetTypes are the widget types a dashboard can use
+var widgetTypes = []string{WidgetPanel, WidgetTable, WidgetChart, WidgetWatch}
+
+// Dashboard is a page of widgets refreshed on intervals
+type Dashboard struct {
+	Name    string            `yaml:"name"`
+	Refresh string            `yaml:"refresh,omitempty"` // Default interval of the widgets
+	Columns int               `yaml:"columns,omitempty"`
+	Widgets []DashboardWidget `yaml:"widgets"`
+}
+
+// DashboardWidget shows the result of a command on a dashboard
+type DashboardWidget struct {
+	Title   string `yaml:"title,omitempty"`
+	Type    string `yaml:"type,omitempty"` // panel (default), table, chart or watch
+	Command string `yaml:"command"`
+	Context string `yaml:"context,omitempty"` // Service context the command is sent in
+	Refresh string `yaml:"refresh,omitempty"` // Interval, the one of the dashboard if empty
+	Span    int    `yaml:"span,omitempty"`    // Columns taken, 1 if empty
+	Height  int    `yaml:"height,omitempty"`  // Rows, shared with the other widgets if empty
+	Watch   string `yaml:"watch,omitempty"`   // Watch pattern of the configuration, for watch tiles
+	Pattern string `yaml:"pattern,omitempty"` // Regex, for watch tiles without a named pattern
+
+	interval time.Duration
+	pattern  *regexp.Regexp
+}
+
+// Interval returns the refresh interval of the widget
+func (w *DashboardWidget) Interval() time.Duration {
+	return w.interval
+}
+
+// Matcher returns the pattern of a watch tile
+func (w *DashboardWidget) Matcher() *regexp.Regexp {
+	return w.pattern
+}
+
+// DisplayTitle returns the title of the widget, the command if it has none
+func (w *DashboardWidget) DisplayTitle() string {
+	if w.Title != "" {
+		return w.Title
+	}
+	return w.Command
+}
+
+// DashboardResult is the result of a widget command
+type DashboardResult struct {
+	Output      string
+	ContentType string
+	Table       *proto.TableResult
+}
+
+// DashboardDir returns the directory of the dashboard definitions
+func DashboardDir() (string, error) {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userConfigDir, "nexuflex", "dashboards"), nil
+}
+
+// dashboardPath returns the file of a dashboard; names are restricted so
+// that they cannot leave the directory
+func dashboardPath(name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\:`) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid dashboard name %q", name)
+	}
+	dir, err := DashboardDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".yaml"), nil
+}
+
+// ListDashboards returns the names of the defined dashboards in alphabetical order
+func ListDashboards() ([]string, error) {
+	dir, err := DashboardDir()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		files, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			names = append(names, strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// LoadDashboard reads and checks a dashboard; watch tiles referring to a
+// named pattern look it up in the watch patterns of the configuration
+func LoadDashboard(name string, watches map[string]string) (*Dashboard, error) {
+	path, err := dashboardPath(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		// The short extension is accepted as well
+		data, err = os.ReadFile(strings.TrimSuffix(path, ".yaml") + ".yml")
+	}
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("dashboard %q not found", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var dashboard Dashboard
+	if err := yaml.Unmarshal(data, &dashboard); err != nil {
+		return nil, fmt.Errorf("invalid dashboard %s: %v", name, err)
+	}
+	if dashboard.Name == "" {
+		dashboard.Name = name
+	}
+	if err := dashboard.prepare(watches); err != nil {
+		return nil, fmt.Errorf("invalid dashboard %s: %v", name, err)
+	}
+	return &dashboard, nil
+}
+
+// prepare checks the definition and fills in the defaults
+func (d *Dashboard) prepare(watches map[string]string) error {
+	if len(d.Widgets) == 0 {
+		return fmt.Errorf("no widgets")
+	}
+	if d.Columns <= 0 {
+		d.Columns = 1
+	}
+
+	refresh, err := parseRefresh(d.Refresh, DefaultDashboardRefresh)
+	if err != nil {
+		return err
+	}
+
+	for i := range d.Widgets {
+		widget := &d.Widgets[i]
+		if strings.TrimSpace(widget.Command) == "" {
+			return fmt.Errorf("widget %d has no command", i+1)
+		}
+		if widget.Type == "" {
+			widget.Type = WidgetPanel
+		}
+		widget.Type = strings.ToLower(widget.Type)
+		if !containsString(widgetTypes, widget.Type) {
+			return fmt.Errorf("widget %d has unknown type %q (known: %s)", i+1, widget.Type, strings.Join(widgetTypes, ", "))
+		}
+		widget.Span = min(max(widget.Span, 1), d.Columns)
+		if widget.interval, err = parseRefresh(widget.Refresh, refresh); err != nil {
+			return fmt.Errorf("widget %d: %v", i+1, err)
+		}
+
+		if widget.Type == WidgetWatch {
+			pattern := widget.Pattern
+			if widget.Watch != "" {
+				var ok bool
+				if pattern, ok = watches[widget.Watch]; !ok {
+					return fmt.Errorf("widget %d refers to unknown watch pattern %q", i+1, widget.Watch)
+				}
+			}
+			if pattern == "" {
+				return fmt.Errorf("widget %d is a watch tile without watch or pattern", i+1)
+			}
+			if widget.pattern, err = regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("widget %d: invalid pattern: %v", i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseRefresh parses a refresh interval, the fallback if it is empty
+func parseRefresh(value string, fallback time.Duration) (time.Duration, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid refresh interval %q", value)
+	}
+	return max(interval, MinDashboardRefresh), nil
+}
+
+// FetchDashboardResult runs the command of a widget and returns its result
+// without showing it in the output area or recording it in the transcript,
+// flows or the undo history. Commands that change data are refused, as the
+// widget repeats them on every refresh.
+func (c *Client) FetchDashboardResult(widget *DashboardWidget) (*DashboardResult, error) {
+	if c.client == nil || c.sessionToken == "" {
+		return nil, fmt.Errorf("not logged in")
+	}
+	if c.IsMutatingCommand(widget.Command) {
+		return nil, fmt.Errorf("%s changes data and cannot be used on a dashboard", widget.Command)
+	}
+
+	c.takeSlot()
+	defer c.releaseSlot()
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCommandTimeout)
+	defer cancel()
+
+	commandContext := widget.Context
+	if commandContext == "" {
+		commandContext = c.lastServiceUsed
+	}
+	resp, err := c.client.ExecuteCommand(ctx, &proto.CommandRequest{
+		SessionToken: c.sessionToken,
+		CommandLine:  widget.Command,
+		LastContext:  commandContext,
+	})
+	if err != nil {
+		return nil, c.rpcError("dashboard command", widget.Command, defaultCommandTimeout, err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", c.commandErrorMessage(resp))
+	}
+
+	output := resp.Output
+	if resp.StreamId != "" {
+		if output, err = c.readStreamedResult(widget.Command, resp, nil); err != nil {
+			return nil, err
+		}
+	}
+	return &DashboardResult{
+		Output:      output,
+		ContentType: plugin.NormalizeContentType(resp.ContentType),
+		Table:       resp.Table,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// deprecation.go
+/**
+ * Nexuflex Client - Command Deprecations
+ *
+ * This file contains the lookup of the deprecation the server reports for
+ * a command in its metadata: the version that deprecated it, the command
+ * to use instead and the date it is removed.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strings"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// CommandDeprecation returns the canonical name and the deprecation of the
+// command of a command line; the deprecation is nil if the command is not
+// deprecated or unknown
+func (c *Client) CommandDeprecation(commandLine string) (string, *proto.Deprecation) {
+	name := strings.SplitN(strings.TrimSpace(commandLine), " ", 2)[0]
+	if name == "" {
+		return "", nil
+	}
+
+	canonical, info := c.lookupCommandInfo(name, c.GetLastServiceUsed())
+	if info == nil || info.Deprecation == nil {
+		return canonical, nil
+	}
+	return canonical, info.Deprecation
+}
+
+// DeprecationSunset returns the date a deprecated command is removed and
+// whether the server reported a valid one
+func DeprecationSunset(deprecation *proto.Deprecation) (time.Time, bool) {
+	sunset, err := time.Parse("2006-01-02", deprecation.SunsetDate)
+	return sunset, err == nil
+}
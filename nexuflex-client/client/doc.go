@@ -0,0 +1,27 @@
+// doc.go
+/**
+ * Nexuflex Client - Package Documentation
+ *
+ * This file contains the package documentation of the client library.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+// Package client is the nexuflex client library: the connection to a
+// nexuflex server, authentication, command execution, server metadata and
+// the session features built on them. The terminal user interface is one
+// user of this package; tools and scripts can use it the same way:
+//
+//	cfg := config.GetDefaultConfig()
+//	c := client.NewClient(&cfg, log.Printf)
+//	c.SetCallbacks(nil, nil, func(output string) { fmt.Println(output) })
+//	if err := c.Connect("localhost", 50051, false); err != nil { ... }
+//	if err := c.Login("user", "password"); err != nil { ... }
+//	defer c.Logout()
+//	err := c.ExecuteCommand("Inventory.List.Items WarehouseA")
+//
+// The exported API of this package is stable within major version 1 of the
+// nexuflex-client module, see "Using the Client as a Library" in the README.
+package client
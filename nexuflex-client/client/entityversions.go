@@ -0,0 +1,213 @@
+// entityversions.go
+/**
+ * Nexuflex Client - Optimistic Concurrency
+ *
+ * This file contains the entity versions (ETags) of edit workflows: a
+ * command fetches an entity, the user modifies it and a second command
+ * saves it. Responses name the versions of the entities a command read or
+ * wrote; the client remembers the most recent ones and sends them with
+ * every command (if_match), so the server can reject a save of an entity
+ * that someone else has changed in the meantime. A rejection carries the
+ * current version on the server and is returned as a ConflictError, whose
+ * Differences compare the values the user started from, the values of the
+ * save and the values on the server now.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// maxEntityVersions limits the entity versions remembered and sent with a command
+const maxEntityVersions = 64
+
+// entityVersion is a remembered version of an entity
+type entityVersion struct {
+	version *proto.EntityVersion
+	command string // Command that returned the version, to fetch the entity again
+}
+
+// entityVersions holds the most recent entity versions, the newest last
+type entityVersions struct {
+	mu       sync.Mutex
+	versions map[string]entityVersion
+	order    []string
+}
+
+// recordEntityVersions remembers the entity versions of a response
+func (c *Client) recordEntityVersions(command string, versions []*proto.EntityVersion) {
+	if len(versions) == 0 {
+		return
+	}
+
+	c.entities.mu.Lock()
+	defer c.entities.mu.Unlock()
+	if c.entities.versions == nil {
+		c.entities.versions = make(map[string]entityVersion)
+	}
+	for _, version := range versions {
+		if version.Entity == "" || version.Etag == "" {
+			continue
+		}
+		c.entities.remove(version.Entity)
+		c.entities.versions[version.Entity] = entityVersion{version: version, command: command}
+		c.entities.order = append(c.entities.order, version.Entity)
+	}
+	for len(c.entities.order) > maxEntityVersions {
+		delete(c.entities.versions, c.entities.order[0])
+		c.entities.order = c.entities.order[1:]
+	}
+}
+
+// remove forgets the version of an entity; the caller holds the lock
+func (e *entityVersions) remove(entity string) {
+	if _, ok := e.versions[entity]; !ok {
+		return
+	}
+	delete(e.versions, entity)
+	for i, name := range e.order {
+		if name == entity {
+			e.order = append(e.order[:i], e.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// ifMatch returns the remembered versions by entity, nil if there are none
+func (c *Client) ifMatch() map[string]string {
+	c.entities.mu.Lock()
+	defer c.entities.mu.Unlock()
+
+	if len(c.entities.versions) == 0 {
+		return nil
+	}
+	versions := make(map[string]string, len(c.entities.versions))
+	for entity, remembered := range c.entities.versions {
+		versions[entity] = remembered.version.Etag
+	}
+	return versions
+}
+
+// clearEntityVersions forgets all entity versions, e.g. for another server
+func (c *Client) clearEntityVersions() {
+	c.entities.mu.Lock()
+	defer c.entities.mu.Unlock()
+	c.entities.versions = nil
+	c.entities.order = nil
+}
+
+// ConflictError reports a command rejected because an entity it changes was
+// modified on the server since the client fetched it
+type ConflictError struct {
+	Command      string
+	Entity       string
+	FetchCommand string            // Command that fetched the entity, empty if unknown
+	Fetched      map[string]string // Values of the version the user started from
+	Current      map[string]string // Values on the server now
+	Changes      map[string]string // name=value arguments of the command
+
+	currentVersion *proto.EntityVersion
+}
+
+// Error implements the error interface
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s was changed by someone else since it was fetched, command was not executed: %s",
+		e.Entity, e.Command)
+}
+
+// FieldDifference compares a field of a conflicting entity
+type FieldDifference struct {
+	Field    string
+	Fetched  string // Value the user started from
+	Local    string // Value the command would have saved
+	Server   string // Value on the server now
+	Conflict bool   // Changed both locally and on the server, to different values
+}
+
+// Differences returns the fields changed locally or on the server, sorted by name
+func (e *ConflictError) Differences() []FieldDifference {
+	fields := make(map[string]bool)
+	for _, values := range []map[string]string{e.Fetched, e.Current, e.Changes} {
+		for field := range values {
+			fields[field] = true
+		}
+	}
+
+	var differences []FieldDifference
+	for field := range fields {
+		local, changed := e.Changes[field]
+		if !changed {
+			local = e.Fetched[field]
+		}
+		difference := FieldDifference{
+			Field:   field,
+			Fetched: e.Fetched[field],
+			Local:   local,
+			Server:  e.Current[field],
+		}
+		serverChanged := difference.Server != difference.Fetched
+		if !serverChanged && !(changed && local != difference.Fetched) {
+			continue
+		}
+		difference.Conflict = serverChanged && changed && local != difference.Server
+		differences = append(differences, difference)
+	}
+	sort.Slice(differences, func(i, j int) bool { return differences[i].Field < differences[j].Field })
+	return differences
+}
+
+// newConflictError builds the error for a conflict reported by the server
+func (c *Client) newConflictError(command string, conflict *proto.ConcurrencyConflict) *ConflictError {
+	err := &ConflictError{
+		Command:        command,
+		Entity:         conflict.Entity,
+		Changes:        commandAssignments(command),
+		currentVersion: conflict.Current,
+	}
+	if conflict.Current != nil {
+		err.Current = conflict.Current.Values
+	}
+
+	c.entities.mu.Lock()
+	if remembered, ok := c.entities.versions[conflict.Entity]; ok {
+		err.Fetched = remembered.version.Values
+		err.FetchCommand = remembered.command
+	}
+	c.entities.mu.Unlock()
+	return err
+}
+
+// commandAssignments returns the name=value arguments of a command
+func commandAssignments(command string) map[string]string {
+	_, args, _ := strings.Cut(strings.TrimSpace(command), " ")
+	assignments := make(map[string]string)
+	for _, arg := range SplitArguments(args) {
+		if name, value, ok := strings.Cut(arg, "="); ok && name != "" {
+			assignments[name] = value
+		}
+	}
+	return assignments
+}
+
+// AcceptCurrentVersion makes the version on the server the one the client
+// knows, so that the command of a conflict overwrites it when sent again
+func (c *Client) AcceptCurrentVersion(conflict *ConflictError) {
+	if conflict.currentVersion == nil || conflict.currentVersion.Etag == "" {
+		return
+	}
+	version := conflict.currentVersion
+	if version.Entity == "" {
+		version = &proto.EntityVersion{Entity: conflict.Entity, Etag: version.Etag, Values: version.Values}
+	}
+	c.recordEntityVersions(conflict.FetchCommand, []*proto.EntityVersion{version})
+}
@@ -0,0 +1,74 @@
+// environment.go
+/**
+ * Nexuflex Client - Environment Tagging
+ *
+ * This file contains the environment of a session, dev, test or prod. It
+ * is the tag the policy of the administrators assigns to the connected
+ * server, or else the `environment` of the user's configuration, and
+ * selects the safeguards of the policy: the header color, the extra
+ * confirmation of commands that change data and the acknowledgment batch
+ * mode requires.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+)
+
+// AcknowledgmentError reports that batch mode was refused for an environment
+// requiring the --prod acknowledgment
+type AcknowledgmentError struct {
+	Environment string
+}
+
+// Error implements the error interface
+func (e *AcknowledgmentError) Error() string {
+	return fmt.Sprintf("the server belongs to the %s environment, confirm with --prod to run commands on it", e.Environment)
+}
+
+// Environment returns the environment tag of the session, lowercase, empty if it is untagged
+func (c *Client) Environment() string {
+	if c.serverInfo == nil {
+		return c.serverEnvironment("", 0, "")
+	}
+	return c.serverEnvironment(c.serverInfo.Address, int(c.serverInfo.Port), c.serverInfo.ShortName)
+}
+
+// serverEnvironment returns the environment tag of a server, the one of the
+// configuration if the policy assigns none
+func (c *Client) serverEnvironment(address string, port int, name string) string {
+	if environment := c.config.Policy.ServerEnvironment(address, port, name); environment != "" {
+		return environment
+	}
+	return strings.ToLower(strings.TrimSpace(c.config.UI.Environment))
+}
+
+// EnvironmentPolicy returns the safeguards of the environment of the session
+func (c *Client) EnvironmentPolicy() config.EnvironmentPolicy {
+	return c.config.Policy.Environment(c.Environment())
+}
+
+// NeedsEnvironmentConfirmation checks whether a command changes data in an
+// environment whose policy requires confirming such commands
+func (c *Client) NeedsEnvironmentConfirmation(command string) bool {
+	return c.EnvironmentPolicy().ConfirmMutating && c.IsMutatingCommand(command)
+}
+
+// CheckBatchAcknowledgment returns an AcknowledgmentError if the environment
+// requires the --prod acknowledgment in batch mode and it was not given
+func (c *Client) CheckBatchAcknowledgment(acknowledged bool) error {
+	if acknowledged || !c.EnvironmentPolicy().BatchAcknowledge {
+		return nil
+	}
+	environment := c.Environment()
+	c.logger("Batch mode refused without acknowledgment for environment %s", environment)
+	return &AcknowledgmentError{Environment: environment}
+}
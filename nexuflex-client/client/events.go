@@ -0,0 +1,150 @@
+// events.go
+/**
+ * Nexuflex Client - Session Event Bus
+ *
+ * This file contains the event bus of a session. The client publishes
+ * what happens in the session (connects, logins, context switches,
+ * commands, errors, notifications and output annotations) as events; subscribers such as the
+ * timeline page receive them as they happen, and the most recent events
+ * are kept so that the course of a session can be reconstructed later.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// maxSessionEvents limits the events kept by the event bus
+const maxSessionEvents = 1000
+
+// EventType classifies session events
+type EventType string
+
+const (
+	EventConnect      EventType = "connect"
+	EventDisconnect   EventType = "disconnect"
+	EventLogin        EventType = "login"
+	EventLogout       EventType = "logout"
+	EventContext      EventType = "context"
+	EventCommand      EventType = "command"
+	EventError        EventType = "error"
+	EventNotification EventType = "notification"
+	EventAnnotation   EventType = "annotation"
+)
+
+// EventTypes lists all event types in display order
+var EventTypes = []EventType{
+	EventConnect, EventDisconnect, EventLogin, EventLogout,
+	EventContext, EventCommand, EventError, EventNotification, EventAnnotation,
+}
+
+// Event is something that happened in a session
+type Event struct {
+	Time    time.Time
+	Type    EventType
+	Text    string
+	Server  string
+	User    string
+	Context string
+	Tags    []string // Severity and tags of an annotation
+}
+
+// EventBus distributes session events to subscribers and keeps the recent ones
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[int]func(Event)
+	nextID      int
+	history     []Event
+}
+
+// NewEventBus creates an empty event bus
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[int]func(Event))}
+}
+
+// Publish records an event and passes it to all subscribers; the
+// subscribers are called in the goroutine of the publisher
+func (b *EventBus) Publish(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	b.mu.Lock()
+	b.history = append(b.history, event)
+	if len(b.history) > maxSessionEvents {
+		b.history = b.history[len(b.history)-maxSessionEvents:]
+	}
+	subscribers := make([]func(Event), 0, len(b.subscribers))
+	for _, subscriber := range b.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	b.mu.Unlock()
+
+	for _, subscriber := range subscribers {
+		subscriber(event)
+	}
+}
+
+// Subscribe registers a function called with every published event;
+// the returned function ends the subscription
+func (b *EventBus) Subscribe(handler func(Event)) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = handler
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+// History returns the recorded events, oldest first
+func (b *EventBus) History() []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Event(nil), b.history...)
+}
+
+// Events returns the event bus of the session
+func (c *Client) Events() *EventBus {
+	return c.events
+}
+
+// publishEvent publishes an event with the current server, user and context
+func (c *Client) publishEvent(eventType EventType, text string) {
+	c.publishTaggedEvent(eventType, text, nil)
+}
+
+// publishTaggedEvent publishes an event with tags
+func (c *Client) publishTaggedEvent(eventType EventType, text string, tags []string) {
+	event := Event{
+		Type:    eventType,
+		Text:    text,
+		User:    c.username,
+		Context: c.lastServiceUsed,
+		Tags:    tags,
+	}
+	if c.serverInfo != nil {
+		event.Server = c.serverInfo.ShortName
+	}
+	c.events.Publish(event)
+}
+
+// changeContext switches the service context and publishes the switch
+func (c *Client) changeContext(service string) {
+	if service == c.lastServiceUsed {
+		return
+	}
+	c.lastServiceUsed = service
+	c.publishEvent(EventContext, service)
+}
@@ -0,0 +1,81 @@
+// expansion.go
+/**
+ * Nexuflex Client - Resolved Command Form
+ *
+ * This file contains the fully resolved form of a command as the server
+ * will run it and its comparison with what the user typed. Aliases and
+ * snippets are expanded and loose names resolved before; what is left is
+ * the service context, which the server applies to command names without
+ * a known service. A resolved form differs substantially from the typed
+ * one unless it only spells out the typed command name: other case,
+ * abbreviated parts or the service context left out. Other arguments or
+ * another command, typically brought in by an alias, are substantial.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strings"
+)
+
+// Modes of the confirmation of resolved commands (expansion_confirm)
+const (
+	ExpansionConfirmOff         = "off"
+	ExpansionConfirmSubstantial = "substantial"
+	ExpansionConfirmChanged     = "changed"
+)
+
+// QualifyCommand prefixes a command name without a known service with the
+// service context, as the server does
+func (c *Client) QualifyCommand(command string) string {
+	command = strings.TrimSpace(command)
+	return c.servicePrefix(strings.SplitN(command, " ", 2)[0]) + command
+}
+
+// ExpansionConfirmMode returns the configured confirmation of resolved commands
+func (c *Client) ExpansionConfirmMode() string {
+	switch mode := strings.ToLower(strings.TrimSpace(c.config.Commands.ExpansionConfirm)); mode {
+	case ExpansionConfirmSubstantial, ExpansionConfirmChanged:
+		return mode
+	}
+	return ExpansionConfirmOff
+}
+
+// ExpansionDiffers reports whether the resolved form of a command differs
+// from the typed one at all, and whether substantially
+func ExpansionDiffers(typed, resolved string) (changed, substantial bool) {
+	typedWords := strings.Fields(typed)
+	resolvedWords := strings.Fields(resolved)
+	if strings.Join(typedWords, " ") == strings.Join(resolvedWords, " ") {
+		return false, false
+	}
+	if len(typedWords) == 0 || len(typedWords) != len(resolvedWords) {
+		return true, true
+	}
+
+	// The arguments must be the ones typed
+	for i := 1; i < len(typedWords); i++ {
+		if !strings.EqualFold(typedWords[i], resolvedWords[i]) {
+			return true, true
+		}
+	}
+
+	// The typed name may only leave out the service or abbreviate parts
+	typedParts := strings.Split(typedWords[0], ".")
+	resolvedParts := strings.Split(resolvedWords[0], ".")
+	offset := len(resolvedParts) - len(typedParts)
+	if offset < 0 || offset > 1 {
+		return true, true
+	}
+	for i, part := range typedParts {
+		full := resolvedParts[offset+i]
+		if part == "" || len(part) > len(full) || !strings.EqualFold(full[:len(part)], part) {
+			return true, true
+		}
+	}
+	return true, false
+}
@@ -0,0 +1,242 @@
+// failover.go
+/**
+ * Nexuflex Client - Cluster Failover
+ *
+ * This file contains the failover to other nodes of a server cluster when
+ * the connection is lost. Nodes of a cluster share a discovery group: the
+ * connected node names its cluster and the other nodes at connect, the
+ * discovery reports the cluster of every server, and `failover_servers`
+ * adds nodes by hand. While reconnecting, the client tries these nodes
+ * after every failed attempt on the lost server, the most suitable first,
+ * but only those of the same environment, so that a test session never
+ * lands on production. Servers announcing portable sessions accept the
+ * session of another node; it is taken over with its context, jobs and
+ * pending approvals. Otherwise the client logs in on the new node with the
+ * stored credentials and restores the last context.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// FeaturePortableSessions is the capability of servers whose sessions are
+// valid on all nodes of their cluster
+const FeaturePortableSessions = "portable_sessions"
+
+// clusterState holds the nodes the client can fail over to
+type clusterState struct {
+	mu         sync.Mutex
+	name       string              // Cluster of the connected node
+	nodes      []*proto.ServerInfo // Other nodes named by the connected node
+	discovered []*proto.ServerInfo // Servers found by the last discovery
+}
+
+// failoverSession is the session of a lost connection, to be taken to another node
+type failoverSession struct {
+	token    string
+	username string
+	userInfo *proto.UserInfo
+	portable bool // The lost node announced portable sessions
+}
+
+// SetFailoverCallback sets the function called when the client failed over
+// to another node of the cluster instead of reconnecting to the lost server
+func (c *Client) SetFailoverCallback(onFailover func(from, to string, loggedIn, sessionKept bool)) {
+	c.onFailover = onFailover
+}
+
+// setCluster remembers the cluster of the connected node and its other nodes
+func (c *Client) setCluster(name string, nodes []*proto.ServerInfo) {
+	c.cluster.mu.Lock()
+	defer c.cluster.mu.Unlock()
+	c.cluster.name = name
+	c.cluster.nodes = nodes
+}
+
+// clearCluster forgets the cluster of the previous connection; the
+// discovered servers are kept
+func (c *Client) clearCluster() {
+	c.setCluster("", nil)
+}
+
+// rememberDiscoveredServers keeps the result of a discovery to find the
+// nodes of a cluster later
+func (c *Client) rememberDiscoveredServers(servers []*proto.ServerInfo) {
+	c.cluster.mu.Lock()
+	defer c.cluster.mu.Unlock()
+	c.cluster.discovered = servers
+}
+
+// supportsFeature returns whether the connected server announced a feature
+func (c *Client) supportsFeature(feature string) bool {
+	return slices.ContainsFunc(c.serverFeatures, func(supported string) bool {
+		return strings.EqualFold(supported, feature)
+	})
+}
+
+// currentSession returns the session to take over to another node
+func (c *Client) currentSession() failoverSession {
+	return failoverSession{
+		token:    c.sessionToken,
+		username: c.username,
+		userInfo: c.userInfo,
+		portable: c.sessionToken != "" && c.supportsFeature(FeaturePortableSessions),
+	}
+}
+
+// failoverTargets returns the nodes to fail over to from the lost server,
+// the most suitable first; nodes of another environment are left out
+func (c *Client) failoverTargets(lost RecentServer) []RecentServer {
+	if !c.config.Server.Failover {
+		return nil
+	}
+
+	c.cluster.mu.Lock()
+	candidates := slices.Clone(c.cluster.nodes)
+	if c.cluster.name != "" {
+		for _, server := range c.cluster.discovered {
+			if server.Cluster == c.cluster.name {
+				candidates = append(candidates, server)
+			}
+		}
+	}
+	c.cluster.mu.Unlock()
+
+	for _, entry := range c.config.Server.FailoverServers {
+		server, err := parseFailoverServer(entry, lost.TLS)
+		if err != nil {
+			c.logger("Ignoring failover server: %v", err)
+			continue
+		}
+		candidates = append(candidates, server)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if serverRank(candidates[i]) != serverRank(candidates[j]) {
+			return serverRank(candidates[i]) < serverRank(candidates[j])
+		}
+		return candidates[i].LoadPercent < candidates[j].LoadPercent
+	})
+
+	environment := c.serverEnvironment(lost.Address, lost.Port, lost.Name)
+	var targets []RecentServer
+	for _, server := range candidates {
+		target := RecentServer{
+			Address:     server.Address,
+			Port:        int(server.Port),
+			TLS:         server.TlsEnabled,
+			Name:        server.ShortName,
+			LastContext: lost.LastContext,
+		}
+		if target.Name == "" {
+			target.Name = net.JoinHostPort(target.Address, strconv.Itoa(target.Port))
+		}
+		if sameServer(target, lost) || slices.ContainsFunc(targets, func(known RecentServer) bool { return sameServer(known, target) }) {
+			continue
+		}
+		if c.CheckServerAvailability(server) != nil {
+			continue
+		}
+		if c.serverEnvironment(target.Address, target.Port, server.ShortName) != environment {
+			c.logger("Not failing over to %s: environment differs from %s", target.Name, lost.Name)
+			continue
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// sameServer returns whether two servers have the same address and port
+func sameServer(a, b RecentServer) bool {
+	return strings.EqualFold(a.Address, b.Address) && a.Port == b.Port
+}
+
+// parseFailoverServer parses a "host:port" entry of failover_servers
+func parseFailoverServer(entry string, useTLS bool) (*proto.ServerInfo, error) {
+	host, portText, err := net.SplitHostPort(strings.TrimSpace(entry))
+	if err != nil {
+		return nil, fmt.Errorf("invalid failover server %q: %v", entry, err)
+	}
+	port, err := strconv.Atoi(portText)
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid port in failover server %q", entry)
+	}
+	return &proto.ServerInfo{Address: host, Port: int32(port), TlsEnabled: useTLS}, nil
+}
+
+// failOver connects to another node of the cluster and continues the
+// session there if it is portable, otherwise logs in with the stored
+// credentials; it returns whether the user is logged in and whether the
+// session was kept
+func (c *Client) failOver(target RecentServer, session failoverSession) (bool, bool, error) {
+	keepState := c.config.Server.KeepStateOnReconnect && session.portable
+	if err := c.connect(target.Address, target.Port, target.TLS, keepState); err != nil {
+		return false, false, err
+	}
+
+	if session.portable && c.supportsFeature(FeaturePortableSessions) && c.resumeSession(session) {
+		if target.LastContext != "" {
+			c.SetLastServiceUsed(target.LastContext)
+		}
+		return true, true, nil
+	}
+
+	// Jobs and approvals belong to the session of the lost node
+	if keepState {
+		c.clearPendingApprovals()
+		c.cancelAllJobs()
+		c.clearEntityVersions()
+	}
+	loggedIn, err := c.loginRecent(target)
+	return loggedIn, false, err
+}
+
+// resumeSession continues a portable session on the connected node; it
+// returns false if the node does not accept the session
+func (c *Client) resumeSession(session failoverSession) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	resp, err := c.metadataClient().KeepAlive(ctx, &proto.KeepAliveRequest{
+		SessionToken: session.token,
+	})
+	cancel()
+	if err != nil || !resp.SessionValid {
+		c.logger("Session not accepted by %s, logging in again", c.serverInfo.ShortName)
+		return false
+	}
+
+	c.setSessionToken(session.token)
+	c.username = session.username
+	c.userInfo = session.userInfo
+	c.setSessionTTL(resp.SessionTtlSeconds)
+	c.logger("Session of %s resumed on %s", c.username, c.serverInfo.ShortName)
+
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_AUTHENTICATED,
+			ServerName:       c.serverInfo.ShortName,
+			Username:         c.username,
+			CurrentService:   c.lastServiceUsed,
+		})
+	}
+
+	c.recordTranscript(EntryEvent, fmt.Sprintf("session resumed on %s", c.serverInfo.ShortName))
+	c.startSession("failover")
+	return true
+}
@@ -0,0 +1,88 @@
+// feedback.go
+/**
+ * Nexuflex Client - User Feedback
+ *
+ * This file contains the submission of user feedback to the administrators
+ * of the connected server. Besides the text of the user, the feedback
+ * carries what an administrator needs to follow up a problem with a
+ * business command: the client version, the operating system, the service
+ * context, the last command sent with its status (secret parameters
+ * masked as in the protocol traces) and, if the user includes it, the text
+ * of the output area. The server queues it and answers with a reference
+ * the user can quote in queries.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// maxFeedbackScreen limits the output text sent with a feedback, in bytes
+const maxFeedbackScreen = 64 * 1024
+
+// SendFeedback sends a feedback to the admin queue of the server, with the
+// text of the output area if screen is not empty; returns the reference
+// of the server
+func (c *Client) SendFeedback(text, screen string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("feedback text must not be empty")
+	}
+	if c.client == nil || c.sessionToken == "" {
+		return "", fmt.Errorf("not logged in")
+	}
+
+	// Keep the end of the output, which shows the problem
+	if len(screen) > maxFeedbackScreen {
+		screen = screen[len(screen)-maxFeedbackScreen:]
+	}
+
+	request := &proto.FeedbackRequest{
+		SessionToken:  c.sessionToken,
+		Text:          text,
+		Screen:        screen,
+		ClientVersion: Version,
+		Os:            runtime.GOOS + "/" + runtime.GOARCH,
+		Context:       c.lastServiceUsed,
+	}
+	if trace, ok := c.lastCommandTrace(); ok {
+		request.LastCommand = trace.Command
+		request.LastCommandStatus = trace.Code.String()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.SubmitFeedback(ctx, request)
+	if err != nil {
+		return "", c.rpcError("sending the feedback", "", 0, err)
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("feedback rejected: %s", resp.ErrorMessage)
+	}
+
+	c.logger("Feedback sent, reference %s", resp.Reference)
+	return resp.Reference, nil
+}
+
+// lastCommandTrace returns the trace of the last command sent, if any
+func (c *Client) lastCommandTrace() (RPCTrace, bool) {
+	traces := c.RecentCommandTraces(1)
+	for i := len(traces) - 1; i >= 0; i-- {
+		if traces[i].Command != "" {
+			return traces[i], true
+		}
+	}
+	return RPCTrace{}, false
+}
@@ -0,0 +1,222 @@
+// fileparams.go
+/**
+ * Nexuflex Client - File Parameters
+ *
+ * This file contains the "@file:path" syntax for command parameters. A
+ * parameter value "@file:rates.csv" (or "@file:\"my rates.csv\"" for paths
+ * with blanks) refers to a local file: before the command is sent, the
+ * file is uploaded with the StageFile RPC and the reference is replaced by
+ * the handle the server returns, so that
+ *
+ *   Finance.Import.Rates @file:rates.csv
+ *   Finance.Import.Rates source=@file:~/exports/rates.csv
+ *
+ * work like any other command. A file referenced several times is
+ * uploaded once.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FileParameterPrefix starts a parameter value that refers to a local file
+const FileParameterPrefix = "@file:"
+
+// fileReference is an "@file:" reference in a command line
+type fileReference struct {
+	start, end int // Position of the reference in the command line
+	path       string
+}
+
+// findFileReferences returns the "@file:" references of a command line; a
+// reference starts a word or follows the "=" of a named parameter
+func findFileReferences(command string) ([]fileReference, error) {
+	var references []fileReference
+	for offset := 0; ; {
+		i := strings.Index(command[offset:], FileParameterPrefix)
+		if i < 0 {
+			return references, nil
+		}
+		start := offset + i
+		offset = start + len(FileParameterPrefix)
+		if start > 0 && command[start-1] != ' ' && command[start-1] != '\t' && command[start-1] != '=' {
+			continue
+		}
+
+		end := offset
+		path := ""
+		if end < len(command) && command[end] == '"' {
+			closing := strings.IndexByte(command[end+1:], '"')
+			if closing < 0 {
+				return nil, fmt.Errorf("unterminated quote in %s", command[start:])
+			}
+			path = command[end+1 : end+1+closing]
+			end += closing + 2
+		} else {
+			for end < len(command) && command[end] != ' ' && command[end] != '\t' {
+				end++
+			}
+			path = command[offset:end]
+		}
+		if path == "" {
+			return nil, fmt.Errorf("%s without a path", FileParameterPrefix)
+		}
+		references = append(references, fileReference{start: start, end: end, path: path})
+		offset = end
+	}
+}
+
+// HasFileParameters checks whether a command line refers to local files with "@file:"
+func HasFileParameters(command string) bool {
+	references, err := findFileReferences(command)
+	return err != nil || len(references) > 0
+}
+
+// StageFileParameters uploads the files referenced with "@file:" and returns
+// the command line with the references replaced by the handles of the
+// server; onProgress, if set, receives the bytes of a file sent so far
+func (c *Client) StageFileParameters(ctx context.Context, command string, onProgress func(path string, sent, total int64)) (string, error) {
+	references, err := findFileReferences(command)
+	if err != nil {
+		return "", err
+	}
+
+	handles := make(map[string]string)
+	var sb strings.Builder
+	last := 0
+	for _, reference := range references {
+		path := expandHome(reference.path)
+		handle, ok := handles[path]
+		if !ok {
+			var progress func(sent, total int64)
+			if onProgress != nil {
+				progress = func(sent, total int64) { onProgress(reference.path, sent, total) }
+			}
+			if handle, err = c.StageFile(ctx, path, progress); err != nil {
+				return "", err
+			}
+			handles[path] = handle
+		}
+		sb.WriteString(command[last:reference.start])
+		sb.WriteString(handle)
+		last = reference.end
+	}
+	sb.WriteString(command[last:])
+	return sb.String(), nil
+}
+
+// StageFile uploads a local file for use in a command parameter and returns
+// its handle on the server; onProgress, if set, receives the bytes sent so far
+func (c *Client) StageFile(ctx context.Context, path string, onProgress func(sent, total int64)) (string, error) {
+	if c.client == nil {
+		return "", fmt.Errorf("not connected to server")
+	}
+	if c.sessionToken == "" {
+		return "", fmt.Errorf("not logged in")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", path)
+	}
+
+	c.logger("Staging file %s (%d bytes)", path, info.Size())
+	c.markActivity()
+
+	stream, err := c.client.StageFile(ctx)
+	if err != nil {
+		return "", c.stageFileError(path, err)
+	}
+
+	// The first chunk names the file, even if it is empty
+	chunk := &proto.FileChunk{
+		SessionToken: c.sessionToken,
+		FileName:     filepath.Base(path),
+		Size:         info.Size(),
+	}
+	buffer := make([]byte, uploadChunkSize)
+	var sent int64
+	for first := true; ; first = false {
+		n, readErr := file.Read(buffer)
+		if n > 0 || first {
+			chunk.Data = buffer[:n]
+			if err := stream.Send(chunk); err != nil {
+				// The actual error is returned by CloseAndRecv
+				if _, recvErr := stream.CloseAndRecv(); recvErr != nil {
+					err = recvErr
+				}
+				return "", c.stageFileError(path, err)
+			}
+			sent += int64(n)
+			if onProgress != nil {
+				onProgress(sent, info.Size())
+			}
+			chunk = &proto.FileChunk{}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			stream.CloseSend()
+			return "", fmt.Errorf("error reading %s: %v", path, readErr)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", c.stageFileError(path, err)
+	}
+	if !resp.Success || resp.Handle == "" {
+		c.logger("Staging %s refused: %s", path, resp.ErrorMessage)
+		return "", fmt.Errorf("file %s not accepted: %s", filepath.Base(path), resp.ErrorMessage)
+	}
+
+	c.logger("Staged %s as %s", path, resp.Handle)
+	c.recordTranscript(EntryEvent, fmt.Sprintf("file %s staged as %s", filepath.Base(path), resp.Handle))
+	return resp.Handle, nil
+}
+
+// stageFileError describes a failed upload of a file
+func (c *Client) stageFileError(path string, err error) error {
+	c.logger("Staging %s failed: %v", path, err)
+	if status.Code(err) == codes.Unimplemented {
+		return fmt.Errorf("the server does not accept %s parameters", FileParameterPrefix)
+	}
+	return fmt.Errorf("upload of %s failed: %v", filepath.Base(path), err)
+}
+
+// expandHome replaces a leading "~" of a path with the home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, `~\`) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
@@ -0,0 +1,289 @@
+// flows.go
+/**
+ * Nexuflex Client - Command Flows
+ *
+ * This file contains recorded command flows, lightweight runbooks that
+ * are stored as YAML files alongside the team documentation. While a
+ * recording is active, every successful command is captured with the
+ * service context it was sent in and its output; the flow can then be
+ * saved, edited (e.g. to replace values with ${name} variables) and run
+ * again step by step:
+ *
+ *   name: Restock item
+ *   variables:
+ *     - name: item
+ *       prompt: Item number
+ *   steps:
+ *     - command: Inventory.Show.Item ${item}
+ *       context: Inventory
+ *     - command: Order.Create ${item} 10
+ *
+ * Values of sensitive parameters are not recorded: they become variables
+ * marked secret, which are asked for without echo when the flow runs, so
+ * that flow files can be shared. The files are written readable only by
+ * the user all the same, as edited flows may contain values.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// flowVariablePattern matches a variable placeholder "${name}" in a flow step
+var flowVariablePattern = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// flowVariableInvalid matches the characters a variable name cannot contain
+var flowVariableInvalid = regexp.MustCompile(`\W`)
+
+// Flow is a sequence of commands that can be replayed
+type Flow struct {
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description,omitempty"`
+	Variables   []FlowVariable `yaml:"variables,omitempty"`
+	Steps       []FlowStep     `yaml:"steps"`
+}
+
+// FlowVariable is a value asked for before a flow runs
+type FlowVariable struct {
+	Name    string `yaml:"name"`
+	Prompt  string `yaml:"prompt,omitempty"`
+	Default string `yaml:"default,omitempty"`
+	Secret  bool   `yaml:"secret,omitempty"` // Asked for without echo, e.g. a recorded password
+}
+
+// FlowStep is a single command of a flow
+type FlowStep struct {
+	Command string `yaml:"command"`
+	Context string `yaml:"context,omitempty"` // Service context the command is sent in
+	Output  string `yaml:"output,omitempty"`  // Output at recording time, for reference
+}
+
+// flowRecorder captures the commands of the flow being recorded
+type flowRecorder struct {
+	mu   sync.Mutex
+	flow *Flow
+}
+
+// StartFlowRecording starts recording the successful commands into a new flow
+func (c *Client) StartFlowRecording(name string) error {
+	c.flowRecorder.mu.Lock()
+	defer c.flowRecorder.mu.Unlock()
+
+	if c.flowRecorder.flow != nil {
+		return fmt.Errorf("flow %q is already being recorded", c.flowRecorder.flow.Name)
+	}
+	c.flowRecorder.flow = &Flow{Name: name}
+	c.logger("Recording flow: %s", name)
+	return nil
+}
+
+// IsRecordingFlow checks whether a flow is being recorded
+func (c *Client) IsRecordingFlow() bool {
+	c.flowRecorder.mu.Lock()
+	defer c.flowRecorder.mu.Unlock()
+	return c.flowRecorder.flow != nil
+}
+
+// StopFlowRecording ends the recording and returns the recorded flow, nil if none was recorded
+func (c *Client) StopFlowRecording() *Flow {
+	c.flowRecorder.mu.Lock()
+	defer c.flowRecorder.mu.Unlock()
+
+	flow := c.flowRecorder.flow
+	c.flowRecorder.flow = nil
+	if flow != nil {
+		c.logger("Flow recorded: %s (%d steps)", flow.Name, len(flow.Steps))
+	}
+	return flow
+}
+
+// recordFlowStep adds a successful command to the flow being recorded; the
+// values of sensitive parameters are replaced with secret variables
+func (c *Client) recordFlowStep(command, context, output string) {
+	c.flowRecorder.mu.Lock()
+	defer c.flowRecorder.mu.Unlock()
+
+	flow := c.flowRecorder.flow
+	if flow == nil {
+		return
+	}
+	step := c.replaceSecrets(command, func(parameter string) string {
+		name := flowVariableName(parameter)
+		if !flow.hasVariable(name) {
+			flow.Variables = append(flow.Variables, FlowVariable{Name: name, Prompt: parameter, Secret: true})
+		}
+		return "${" + name + "}"
+	})
+	flow.Steps = append(flow.Steps, FlowStep{
+		Command: step.line,
+		Context: context,
+		Output:  output,
+	})
+}
+
+// SaveFlow writes a flow to a YAML file
+func SaveFlow(flow *Flow, path string) error {
+	data, err := yaml.Marshal(flow)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return err
+	}
+	// WriteFile keeps the mode of a file that already exists
+	return os.Chmod(path, 0600)
+}
+
+// flowVariableName returns the variable name for a parameter, with the
+// characters a placeholder cannot contain replaced
+func flowVariableName(parameter string) string {
+	return flowVariableInvalid.ReplaceAllString(parameter, "_")
+}
+
+// hasVariable checks whether a flow declares a variable
+func (f *Flow) hasVariable(name string) bool {
+	for _, variable := range f.Variables {
+		if variable.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadFlow reads a flow from a YAML file
+func LoadFlow(path string) (*Flow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var flow Flow
+	if err := yaml.Unmarshal(data, &flow); err != nil {
+		return nil, fmt.Errorf("invalid flow file %s: %v", path, err)
+	}
+	if len(flow.Steps) == 0 {
+		return nil, fmt.Errorf("flow file %s contains no steps", path)
+	}
+	for i, step := range flow.Steps {
+		if strings.TrimSpace(step.Command) == "" {
+			return nil, fmt.Errorf("step %d of flow file %s has no command", i+1, path)
+		}
+	}
+	return &flow, nil
+}
+
+// StepVariables returns the names of the variables used by a flow step
+func (s FlowStep) StepVariables() []string {
+	var names []string
+	for _, match := range flowVariablePattern.FindAllStringSubmatch(s.Command+" "+s.Context, -1) {
+		if !containsString(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// AllVariables returns the declared variables of a flow followed by the
+// variables used in the steps without a declaration
+func (f *Flow) AllVariables() []FlowVariable {
+	variables := append([]FlowVariable(nil), f.Variables...)
+	declared := make(map[string]bool)
+	for _, variable := range variables {
+		declared[variable.Name] = true
+	}
+
+	for _, step := range f.Steps {
+		for _, name := range step.StepVariables() {
+			if !declared[name] {
+				declared[name] = true
+				variables = append(variables, FlowVariable{Name: name})
+			}
+		}
+	}
+	return variables
+}
+
+// expandFlowVariables replaces the variable placeholders in text with their values
+func expandFlowVariables(text string, values map[string]string) (string, error) {
+	var missing []string
+	expanded := flowVariablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := flowVariablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := values[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("no value for variable %s", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
+// RunFlow executes the steps of a flow in order with the given variable
+// values; onStep is called with the step number and the expanded command
+// before it is sent. The flow stops at the first step that fails or waits
+// for an approval, as later steps usually depend on it.
+func (c *Client) RunFlow(flow *Flow, values map[string]string, onStep func(step int, command string)) error {
+	c.logger("Running flow: %s", flow.Name)
+
+	for i, step := range flow.Steps {
+		command, err := expandFlowVariables(step.Command, values)
+		if err != nil {
+			return fmt.Errorf("step %d: %v", i+1, err)
+		}
+		context, err := expandFlowVariables(step.Context, values)
+		if err != nil {
+			return fmt.Errorf("step %d: %v", i+1, err)
+		}
+
+		if onStep != nil {
+			onStep(i+1, command)
+		}
+		if context != "" {
+			c.changeContext(context)
+		}
+
+		resp, err := c.sendCommand(command, c.commandIDFor(command))
+		var interrupted *InterruptedCommandError
+		if errors.As(err, &interrupted) {
+			// The session was renewed, a flow step is sent once more
+			resp, err = c.sendCommand(command, c.commandIDFor(command))
+		}
+		var pending *ApprovalPendingError
+		if errors.As(err, &pending) {
+			return fmt.Errorf("step %d (%s) waits for approval %s, flow stopped", i+1, command, pending.ApprovalID)
+		}
+		if err != nil {
+			return fmt.Errorf("step %d (%s): %v", i+1, command, err)
+		}
+		if !resp.Success {
+			return fmt.Errorf("step %d (%s) failed: %s", i+1, command, c.commandErrorMessage(resp))
+		}
+	}
+
+	c.logger("Flow completed: %s", flow.Name)
+	return nil
+}
+
+// containsString checks whether a slice contains a string
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
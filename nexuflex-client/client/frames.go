@@ -0,0 +1,120 @@
+// frames.go
+/**
+ * Nexuflex Client - Refreshing Frames
+ *
+ * This file contains the frames of refreshing displays such as dashboards
+ * or a watch on the server side. Instead of sending every refresh as new
+ * output, the server sends PATCH outputs with the lines that changed
+ * against the previous frame; the client applies them to its copy of the
+ * frame and hands the result to the user interface, which shows it in
+ * place.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// maxFrameLines limits the lines of a frame against faulty patches
+const maxFrameLines = 10000
+
+// Frame is the current state of a refreshing display of a streaming command
+type Frame struct {
+	Key     string // Unique among all streams
+	Command string
+	Lines   []string
+	Ended   bool // The stream has ended, the frame will not change anymore
+}
+
+// SetFrameCallback sets the function called with a frame whenever it changes
+func (c *Client) SetFrameCallback(onFrameChanged func(frame Frame)) {
+	c.onFrameChanged = onFrameChanged
+}
+
+// frameSet holds the frames of a single stream
+type frameSet struct {
+	stream  int64
+	command string
+	frames  map[string]*Frame
+	order   []string
+}
+
+// newFrameSet creates the frames of a stream; the streams are numbered so
+// that frame IDs of different streams do not collide
+func (c *Client) newFrameSet(command string) *frameSet {
+	return &frameSet{
+		stream:  c.frameStreams.Add(1),
+		command: command,
+		frames:  make(map[string]*Frame),
+	}
+}
+
+// apply changes a frame by a PATCH output and returns a copy of the result
+func (s *frameSet) apply(output *proto.CommandOutput) Frame {
+	frame, ok := s.frames[output.FrameId]
+	if !ok {
+		frame = &Frame{Key: fmt.Sprintf("%d/%s", s.stream, output.FrameId), Command: s.command}
+		s.frames[output.FrameId] = frame
+		s.order = append(s.order, output.FrameId)
+	}
+	frame.Lines = applyLinePatches(frame.Lines, output.LinePatches, int(output.FrameLines))
+	return frame.snapshot()
+}
+
+// end marks all frames as ended and returns copies of them
+func (s *frameSet) end() []Frame {
+	frames := make([]Frame, 0, len(s.order))
+	for _, id := range s.order {
+		frame := s.frames[id]
+		frame.Ended = true
+		frames = append(frames, frame.snapshot())
+	}
+	return frames
+}
+
+// snapshot returns a copy of a frame that is not changed by later patches
+func (f *Frame) snapshot() Frame {
+	snapshot := *f
+	snapshot.Lines = append([]string(nil), f.Lines...)
+	return snapshot
+}
+
+// applyLinePatches replaces the patched lines and sets the line count; a
+// count of 0 keeps the lines that are there
+func applyLinePatches(lines []string, patches []*proto.LinePatch, count int) []string {
+	for _, patch := range patches {
+		index := int(patch.Line)
+		if index < 0 || index >= maxFrameLines {
+			continue
+		}
+		for len(lines) <= index {
+			lines = append(lines, "")
+		}
+		lines[index] = patch.Content
+	}
+
+	if count > maxFrameLines {
+		count = maxFrameLines
+	}
+	if count > 0 {
+		for len(lines) < count {
+			lines = append(lines, "")
+		}
+		lines = lines[:count]
+	}
+	return lines
+}
+
+// notifyFrame hands a changed frame to the user interface
+func (c *Client) notifyFrame(frame Frame) {
+	if c.onFrameChanged != nil {
+		c.onFrameChanged(frame)
+	}
+}
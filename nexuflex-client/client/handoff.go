@@ -0,0 +1,150 @@
+// handoff.go
+/**
+ * Nexuflex Client - Session Handoff Between Devices
+ *
+ * This file contains the detaching of a session on one device and its
+ * attaching on another one. When detaching, the client hands the service
+ * context, the commands of its running jobs and its pending approvals to
+ * the server and receives a one-time code; the session stays open on the
+ * server until the code is used or expires. The attaching client takes
+ * over the session with the code and restores the handed-over state.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// DetachSession hands the session over to the server and returns the code for
+// attaching it on another device and the time the code expires
+func (c *Client) DetachSession() (string, time.Time, error) {
+	if c.client == nil {
+		return "", time.Time{}, fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return "", time.Time{}, fmt.Errorf("not logged in")
+	}
+
+	req := &proto.DetachSessionRequest{
+		SessionToken:   c.sessionToken,
+		CurrentService: c.lastServiceUsed,
+	}
+	for _, job := range c.GetJobs() {
+		if job.State == JobRunning {
+			req.RunningCommands = append(req.RunningCommands, job.Command)
+		}
+	}
+	for _, pending := range c.GetPendingApprovals() {
+		req.PendingApprovals = append(req.PendingApprovals, &proto.HandoffApproval{
+			ApprovalId: pending.ApprovalID,
+			Command:    pending.Command,
+		})
+	}
+
+	c.logger("Detaching session...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.DetachSession(ctx, req)
+	if err != nil {
+		c.logger("Detach request failed: %v", err)
+		return "", time.Time{}, fmt.Errorf("detach request failed: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Detach failed: %s", resp.ErrorMessage)
+		return "", time.Time{}, fmt.Errorf("detach failed: %s", resp.ErrorMessage)
+	}
+
+	c.recordTranscript(EntryEvent, fmt.Sprintf("session detached with %d jobs and %d pending approvals",
+		len(req.RunningCommands), len(req.PendingApprovals)))
+
+	// The session now belongs to the server until it is attached again
+	c.endSessionTasks()
+	c.setSessionToken("")
+	c.username = ""
+	c.clearMetadata()
+	c.clearPendingApprovals()
+	c.cancelAllJobs()
+	c.logger("Session detached")
+
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			ServerName:       c.serverInfo.ShortName,
+		})
+	}
+
+	return resp.HandoffCode, time.Unix(resp.ExpiresAt, 0), nil
+}
+
+// AttachSession takes over a session detached on another device; it returns
+// the commands of the jobs that were running there, so they can be started again
+func (c *Client) AttachSession(code string) ([]string, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken != "" {
+		return nil, fmt.Errorf("already logged in, log out first")
+	}
+
+	c.logger("Attaching session...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.AttachSession(ctx, &proto.AttachSessionRequest{
+		HandoffCode:   code,
+		ClientVersion: Version,
+	})
+	if err != nil {
+		c.logger("Attach request failed: %v", err)
+		return nil, fmt.Errorf("attach request failed: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Attach failed: %s", resp.ErrorMessage)
+		return nil, fmt.Errorf("attach failed: %s", resp.ErrorMessage)
+	}
+
+	// Take over the session with its context
+	c.setSessionToken(resp.SessionToken)
+	c.username = resp.UserInfo.GetUsername()
+	c.userInfo = resp.UserInfo
+	c.changeContext(resp.CurrentService)
+	c.logger("Session of %s attached", c.username)
+
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTED,
+			SessionStatus:    proto.StatusInfo_AUTHENTICATED,
+			ServerName:       c.serverInfo.ShortName,
+			Username:         c.username,
+			CurrentService:   c.lastServiceUsed,
+		})
+	}
+
+	// Keep waiting for the decisions on the handed-over approvals
+	for _, approval := range resp.PendingApprovals {
+		c.trackApproval(approval.ApprovalId, approval.Command)
+	}
+
+	c.recordTranscript(EntryEvent, fmt.Sprintf("session attached with %d jobs and %d pending approvals",
+		len(resp.RunningCommands), len(resp.PendingApprovals)))
+	c.startSession("attach")
+
+	return resp.RunningCommands, nil
+}
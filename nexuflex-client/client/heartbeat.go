@@ -0,0 +1,111 @@
+// heartbeat.go
+/**
+ * Nexuflex Client - Stream Heartbeats
+ *
+ * This file contains the heartbeats of streaming commands. The client asks
+ * the server for a HEARTBEAT output after `stream_heartbeat_seconds` without
+ * other output, so that a command that is still working can be told from a
+ * stream that died without the connection noticing. Every message of a
+ * stream counts as a sign of life; the jobs panel shows how long a job has
+ * been silent. With servers announcing the "stream_heartbeat" feature, a
+ * stream that stays silent for `stream_silence_seconds` is ended and fails
+ * with a StreamSilenceError; other servers may legitimately be silent for
+ * long, so their streams are never ended for silence.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// FeatureStreamHeartbeat is the capability of servers sending heartbeats in streams
+const FeatureStreamHeartbeat = "stream_heartbeat"
+
+// StreamSilenceError reports a stream ended by the client because the server
+// sent nothing, not even a heartbeat, for too long
+type StreamSilenceError struct {
+	Command string
+	Silence time.Duration
+}
+
+// Error implements the error interface
+func (e *StreamSilenceError) Error() string {
+	return fmt.Sprintf("no sign of life from the server for %s, stream of %s ended", e.Silence, e.Command)
+}
+
+// streamWatchdog ends a stream whose server stays silent for too long
+type streamWatchdog struct {
+	last   atomic.Int64 // Time of the last message, in nanoseconds since the epoch
+	cancel context.CancelCauseFunc
+	stop   chan struct{}
+}
+
+// heartbeatSeconds returns the heartbeat interval requested from the server
+func (c *Client) heartbeatSeconds() int32 {
+	return int32(max(c.config.Server.StreamHeartbeatSeconds, 0))
+}
+
+// watchStream returns the context for a stream, which is cancelled with a
+// StreamSilenceError once the stream is silent for the configured time; the
+// watchdog must be stopped when the stream ends
+func (c *Client) watchStream(ctx context.Context, command string) (context.Context, *streamWatchdog) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	w := &streamWatchdog{cancel: cancel, stop: make(chan struct{})}
+	w.signal()
+
+	threshold := time.Duration(c.config.Server.StreamSilenceSeconds) * time.Second
+	if threshold <= 0 || c.heartbeatSeconds() == 0 || !c.supportsFeature(FeatureStreamHeartbeat) {
+		return ctx, w
+	}
+	// A single late heartbeat does not end the stream
+	threshold = max(threshold, 2*time.Duration(c.heartbeatSeconds())*time.Second)
+
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				if silence := w.silence(); silence >= threshold {
+					c.logger("Stream silent for %s, ending it: %s", silence.Round(time.Second), c.MaskCommand(command))
+					cancel(&StreamSilenceError{Command: command, Silence: silence.Round(time.Second)})
+					return
+				}
+			}
+		}
+	}()
+	return ctx, w
+}
+
+// signal records a message of the stream
+func (w *streamWatchdog) signal() {
+	w.last.Store(time.Now().UnixNano())
+}
+
+// silence returns the time since the last message of the stream
+func (w *streamWatchdog) silence() time.Duration {
+	return time.Since(time.Unix(0, w.last.Load()))
+}
+
+// end stops watching the stream; err is the error the stream ended with,
+// which is replaced by the StreamSilenceError if the watchdog ended it
+func (w *streamWatchdog) end(ctx context.Context, err error) error {
+	close(w.stop)
+	var silenceErr *StreamSilenceError
+	if err != nil && errors.As(context.Cause(ctx), &silenceErr) {
+		err = silenceErr
+	}
+	w.cancel(nil)
+	return err
+}
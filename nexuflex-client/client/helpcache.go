@@ -0,0 +1,491 @@
+// helpcache.go
+/**
+ * Nexuflex Client - Offline Help
+ *
+ * This file contains the local cache of the command help of the servers
+ * and its export as a reference document. Help retrieved with
+ * GetCommandHelp is stored per server and server version in the "help"
+ * folder of the user configuration directory, so it stays available when
+ * the client is disconnected. The reference of all services and commands
+ * is written as man-page style plain text or as Markdown for team wikis.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// HelpReference is the help of the services and commands of a server
+type HelpReference struct {
+	Server   string        `json:"server"`
+	Address  string        `json:"address"`
+	Version  string        `json:"version"`
+	Updated  time.Time     `json:"updated"`
+	Services []HelpService `json:"services"`
+}
+
+// HelpService is the help of a service and its commands
+type HelpService struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Commands    []HelpCommand `json:"commands"`
+}
+
+// HelpCommand is the help of a command
+type HelpCommand struct {
+	Name        string          `json:"name"` // Service.Action[.Subaction]
+	Description string          `json:"description,omitempty"`
+	Usage       string          `json:"usage,omitempty"`
+	HelpText    string          `json:"help_text,omitempty"`
+	Parameters  []HelpParameter `json:"parameters,omitempty"`
+}
+
+// HelpParameter is the help of a command parameter
+type HelpParameter struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	DataType    string `json:"data_type,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Default     string `json:"default,omitempty"`
+}
+
+// helpCache holds the help reference of the connected server
+type helpCache struct {
+	mu        sync.Mutex
+	reference *HelpReference
+	path      string
+}
+
+// unsafeFileChars matches characters not used in the names of cache files
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// helpCacheDir returns the folder of the cached help
+func helpCacheDir() (string, error) {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userConfigDir, "nexuflex", "help"), nil
+}
+
+// newHelpCommand creates the help of a command from its metadata
+func newHelpCommand(service string, info *proto.CommandInfo, helpText string) HelpCommand {
+	name := service + "." + info.Action
+	if info.Subaction != "" {
+		name += "." + info.Subaction
+	}
+	command := HelpCommand{
+		Name:        name,
+		Description: info.Description,
+		Usage:       info.UsageExample,
+		HelpText:    helpText,
+	}
+	for _, parameter := range info.Parameters {
+		command.Parameters = append(command.Parameters, HelpParameter{
+			Name:        parameter.Name,
+			Description: parameter.Description,
+			DataType:    parameter.DataType,
+			Required:    parameter.Required,
+			Default:     parameter.DefaultValue,
+		})
+	}
+	return command
+}
+
+// Synopsis returns the command with its parameters, optional ones in brackets
+func (h *HelpCommand) Synopsis() string {
+	synopsis := h.Name
+	for _, parameter := range h.Parameters {
+		if parameter.Required {
+			synopsis += " <" + parameter.Name + ">"
+		} else {
+			synopsis += " [" + parameter.Name + "]"
+		}
+	}
+	return synopsis
+}
+
+// Find returns the help of a command by its full name or, with a context,
+// by its name within that service
+func (r *HelpReference) Find(name, context string) (*HelpCommand, bool) {
+	for i := range r.Services {
+		for j := range r.Services[i].Commands {
+			command := &r.Services[i].Commands[j]
+			if strings.EqualFold(command.Name, name) ||
+				(context != "" && strings.EqualFold(command.Name, context+"."+name)) {
+				return command, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// CommandCount returns the number of commands in the reference
+func (r *HelpReference) CommandCount() int {
+	count := 0
+	for _, service := range r.Services {
+		count += len(service.Commands)
+	}
+	return count
+}
+
+// store adds or replaces the help of a command
+func (r *HelpReference) store(service string, command HelpCommand) {
+	index := -1
+	for i := range r.Services {
+		if r.Services[i].Name == service {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		r.Services = append(r.Services, HelpService{Name: service})
+		index = len(r.Services) - 1
+	}
+
+	commands := r.Services[index].Commands
+	for i := range commands {
+		if commands[i].Name == command.Name {
+			commands[i] = command
+			return
+		}
+	}
+	r.Services[index].Commands = append(commands, command)
+}
+
+// sort orders services and commands by name
+func (r *HelpReference) sort() {
+	sort.Slice(r.Services, func(i, j int) bool { return r.Services[i].Name < r.Services[j].Name })
+	for _, service := range r.Services {
+		sort.Slice(service.Commands, func(i, j int) bool { return service.Commands[i].Name < service.Commands[j].Name })
+	}
+}
+
+// loadHelpReference reads a cached help reference
+func loadHelpReference(path string) (*HelpReference, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var reference HelpReference
+	if err := json.Unmarshal(data, &reference); err != nil {
+		return nil, fmt.Errorf("invalid help cache %s: %v", path, err)
+	}
+	return &reference, nil
+}
+
+// latestHelpReference returns the most recently updated cached help reference
+func latestHelpReference() (*HelpReference, error) {
+	dir, err := helpCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+
+	var latest *HelpReference
+	for _, path := range paths {
+		if reference, err := loadHelpReference(path); err == nil &&
+			(latest == nil || reference.Updated.After(latest.Updated)) {
+			latest = reference
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no help cached")
+	}
+	return latest, nil
+}
+
+// serverHelp returns the cached help reference of the connected server,
+// loading it on first use; must be called with the lock held
+func (c *Client) serverHelp() *HelpReference {
+	if c.serverInfo == nil {
+		return nil
+	}
+
+	dir, err := helpCacheDir()
+	if err != nil {
+		return nil
+	}
+	name := unsafeFileChars.ReplaceAllString(
+		fmt.Sprintf("%s_%d_%s", c.serverInfo.Address, c.serverInfo.Port, c.serverInfo.Version), "_")
+	path := filepath.Join(dir, name+".json")
+	if c.help.reference != nil && c.help.path == path {
+		return c.help.reference
+	}
+
+	reference, err := loadHelpReference(path)
+	if err != nil {
+		reference = &HelpReference{}
+	}
+	reference.Server = c.serverInfo.ShortName
+	reference.Address = fmt.Sprintf("%s:%d", c.serverInfo.Address, c.serverInfo.Port)
+	reference.Version = c.serverInfo.Version
+	c.help.reference, c.help.path = reference, path
+	return reference
+}
+
+// saveServerHelp writes the help reference of the connected server; must be called with the lock held
+func (c *Client) saveServerHelp() {
+	reference := c.help.reference
+	reference.Updated = time.Now()
+	reference.sort()
+
+	data, err := json.MarshalIndent(reference, "", "  ")
+	if err == nil {
+		err = state.WriteFileAtomic(c.help.path, data)
+	}
+	if err != nil {
+		c.logger("Error saving help cache: %v", err)
+	}
+}
+
+// cacheCommandHelp stores the help of a command in the cache of the connected server
+func (c *Client) cacheCommandHelp(service string, info *proto.CommandInfo, helpText string) {
+	if info == nil {
+		return
+	}
+
+	c.help.mu.Lock()
+	defer c.help.mu.Unlock()
+	if reference := c.serverHelp(); reference != nil {
+		reference.store(service, newHelpCommand(service, info, helpText))
+		c.saveServerHelp()
+	}
+}
+
+// CommandHelp returns the help of a command from the server or, if it cannot
+// be retrieved, from the cache; the result tells whether it came from the cache
+func (c *Client) CommandHelp(name string) (*HelpCommand, bool, error) {
+	if canonical, info := c.lookupCommandInfo(name, c.lastServiceUsed); info != nil && c.IsLoggedIn() {
+		service := strings.SplitN(canonical, ".", 2)[0]
+		if helpText, helpInfo, err := c.GetCommandHelp(service, info.Action, info.Subaction); err == nil {
+			if helpInfo == nil {
+				helpInfo = info
+			}
+			command := newHelpCommand(service, helpInfo, helpText)
+			return &command, false, nil
+		}
+	}
+
+	c.help.mu.Lock()
+	reference := c.serverHelp()
+	c.help.mu.Unlock()
+	if reference == nil {
+		var err error
+		if reference, err = latestHelpReference(); err != nil {
+			return nil, false, fmt.Errorf("no help available for %s", name)
+		}
+	}
+
+	if command, ok := reference.Find(name, c.lastServiceUsed); ok {
+		return command, true, nil
+	}
+	return nil, false, fmt.Errorf("no help available for %s", name)
+}
+
+// RefreshHelpReference retrieves the help of all services and commands of
+// the connected server and stores it in the cache
+func (c *Client) RefreshHelpReference() (*HelpReference, error) {
+	if !c.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+	if len(c.GetCachedServices()) == 0 {
+		if err := c.RefreshMetadata(); err != nil {
+			return nil, err
+		}
+	}
+
+	reference := &HelpReference{}
+	for _, service := range c.GetCachedServices() {
+		reference.Services = append(reference.Services, HelpService{
+			Name:        service.ServiceName,
+			Description: service.Description,
+		})
+		for _, info := range c.GetCachedCommands(service.ServiceName) {
+			helpText, helpInfo, err := c.requestCommandHelp(service.ServiceName, info.Action, info.Subaction)
+			if err != nil || helpInfo == nil {
+				helpInfo = info // The metadata still describe the command
+			}
+			reference.store(service.ServiceName, newHelpCommand(service.ServiceName, helpInfo, helpText))
+		}
+	}
+
+	c.help.mu.Lock()
+	defer c.help.mu.Unlock()
+	cached := c.serverHelp()
+	cached.Services = reference.Services
+	c.saveServerHelp()
+	return cached, nil
+}
+
+// ExportHelp writes the reference of all services and commands to a file,
+// as Markdown for .md files and as plain text otherwise; without a session
+// the most recently cached reference is used. Returns the reference and
+// whether it came from the cache.
+func (c *Client) ExportHelp(path string) (*HelpReference, bool, error) {
+	reference, err := c.RefreshHelpReference()
+	cached := err != nil
+	if cached {
+		if reference, err = latestHelpReference(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		err = reference.WriteMarkdown(file)
+	default:
+		err = reference.WriteText(file)
+	}
+	return reference, cached, err
+}
+
+// WriteText writes the reference as man-page style plain text
+func (r *HelpReference) WriteText(w io.Writer) error {
+	var sb strings.Builder
+	title := "NEXUFLEX COMMANDS"
+	sb.WriteString(fmt.Sprintf("%s\n%s\n\n", title, strings.Repeat("=", len(title))))
+	sb.WriteString(fmt.Sprintf("Server %s (%s), version %s, retrieved %s\n",
+		r.Server, r.Address, r.Version, r.Updated.Format("2006-01-02 15:04")))
+
+	for _, service := range r.Services {
+		sb.WriteString("\n\n" + strings.ToUpper(service.Name) + "\n")
+		if service.Description != "" {
+			sb.WriteString(indentText(service.Description, "    ") + "\n")
+		}
+
+		for _, command := range service.Commands {
+			sb.WriteString("\n  " + command.Synopsis() + "\n")
+			if command.Description != "" {
+				sb.WriteString(indentText(command.Description, "      ") + "\n")
+			}
+			if command.HelpText != "" && command.HelpText != command.Description {
+				sb.WriteString("\n" + indentText(command.HelpText, "      ") + "\n")
+			}
+			if len(command.Parameters) > 0 {
+				sb.WriteString("\n      PARAMETERS\n")
+				for _, parameter := range command.Parameters {
+					sb.WriteString(fmt.Sprintf("        %s (%s)\n", parameter.Name, parameter.Traits()))
+					if parameter.Description != "" {
+						sb.WriteString(indentText(parameter.Description, "            ") + "\n")
+					}
+				}
+			}
+			if command.Usage != "" {
+				sb.WriteString("\n      EXAMPLE\n" + indentText(command.Usage, "        ") + "\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// WriteMarkdown writes the reference as a Markdown document
+func (r *HelpReference) WriteMarkdown(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Command Reference: %s\n\n", r.Server))
+	sb.WriteString(fmt.Sprintf("Server `%s`, version %s, retrieved %s.\n",
+		r.Address, r.Version, r.Updated.Format("2006-01-02 15:04")))
+
+	for _, service := range r.Services {
+		sb.WriteString("\n## " + service.Name + "\n\n")
+		if service.Description != "" {
+			sb.WriteString(service.Description + "\n\n")
+		}
+		for _, command := range service.Commands {
+			sb.WriteString(fmt.Sprintf("- [%s](#%s)\n", command.Name, markdownAnchor(command.Name)))
+		}
+
+		for _, command := range service.Commands {
+			sb.WriteString("\n### " + command.Name + "\n\n")
+			sb.WriteString("```\n" + command.Synopsis() + "\n```\n\n")
+			if command.Description != "" {
+				sb.WriteString(command.Description + "\n\n")
+			}
+			if command.HelpText != "" && command.HelpText != command.Description {
+				sb.WriteString(command.HelpText + "\n\n")
+			}
+			if len(command.Parameters) > 0 {
+				sb.WriteString("| Parameter | Type | Required | Default | Description |\n")
+				sb.WriteString("|---|---|---|---|---|\n")
+				for _, parameter := range command.Parameters {
+					required := "no"
+					if parameter.Required {
+						required = "yes"
+					}
+					sb.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s | %s |\n",
+						parameter.Name, parameter.DataType, required,
+						markdownCell(parameter.Default), markdownCell(parameter.Description)))
+				}
+				sb.WriteString("\n")
+			}
+			if command.Usage != "" {
+				sb.WriteString("Example:\n\n```\n" + command.Usage + "\n```\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Traits describes the type, requirement and default of a parameter
+func (parameter HelpParameter) Traits() string {
+	traits := []string{}
+	if parameter.DataType != "" {
+		traits = append(traits, parameter.DataType)
+	}
+	if parameter.Required {
+		traits = append(traits, "required")
+	}
+	if parameter.Default != "" {
+		traits = append(traits, "default "+parameter.Default)
+	}
+	if len(traits) == 0 {
+		return "optional"
+	}
+	return strings.Join(traits, ", ")
+}
+
+// indentText indents every line of a text
+func indentText(text, indent string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = indent + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownAnchor returns the anchor of a Markdown heading as generated by common wikis
+func markdownAnchor(heading string) string {
+	return strings.ToLower(strings.NewReplacer(".", "", " ", "-").Replace(heading))
+}
+
+// markdownCell escapes a text for a Markdown table cell
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(text)
+}
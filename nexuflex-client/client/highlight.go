@@ -0,0 +1,162 @@
+// highlight.go
+/**
+ * Nexuflex Client - Output Highlighting Rules
+ *
+ * This file contains the rules that make important lines of command output
+ * stand out. Rules are declared in the [highlight] section of the
+ * configuration as "<regex> => <color> [styles]" or added at runtime; every
+ * output line matching a rule is shown in its color and styles. The color
+ * is a color name or "#rrggbb", optionally followed by ":<background>";
+ * the styles are bold, dim, italic, underline, blink, reverse and
+ * strikethrough. If several rules match, the first one by name wins.
+ *
+ * Example:
+ *   [highlight]
+ *   errors   = ERROR|FATAL => red bold
+ *   warnings = WARN(ING)? => yellow
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// highlightStyles maps the style names to the attribute flags of color tags
+var highlightStyles = map[string]string{
+	"bold":          "b",
+	"dim":           "d",
+	"italic":        "i",
+	"underline":     "u",
+	"blink":         "l",
+	"reverse":       "r",
+	"strikethrough": "s",
+}
+
+// HighlightRule describes how lines matching a pattern are shown
+type HighlightRule struct {
+	Name       string
+	Pattern    *regexp.Regexp
+	Foreground string
+	Background string
+	Styles     []string
+}
+
+// Tag returns the color tag that starts the highlighting, e.g. "[red::b]"
+func (r *HighlightRule) Tag() string {
+	var flags strings.Builder
+	for _, style := range r.Styles {
+		flags.WriteString(highlightStyles[style])
+	}
+	return fmt.Sprintf("[%s:%s:%s]", r.Foreground, r.Background, flags.String())
+}
+
+// Style returns the color and styles in the notation of the configuration
+func (r *HighlightRule) Style() string {
+	color := r.Foreground
+	if r.Background != "" {
+		color += ":" + r.Background
+	}
+	return strings.TrimSpace(color + " " + strings.Join(r.Styles, " "))
+}
+
+// Definition returns the rule in the notation of the configuration
+func (r *HighlightRule) Definition() string {
+	return fmt.Sprintf("%s %s %s", r.Pattern.String(), referenceSeparator, r.Style())
+}
+
+// ParseHighlightRules compiles the highlight definitions of the configuration;
+// validColor checks whether the output area can show a color
+func ParseHighlightRules(definitions map[string]string, validColor func(string) bool) ([]*HighlightRule, error) {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := make([]*HighlightRule, 0, len(names))
+	var invalid []string
+	for _, name := range names {
+		rule, err := parseHighlightDefinition(name, definitions[name], validColor)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(invalid) > 0 {
+		return rules, fmt.Errorf("invalid highlight rules: %s", strings.Join(invalid, "; "))
+	}
+	return rules, nil
+}
+
+// parseHighlightDefinition parses a single "<regex> => <color> [styles]" definition
+func parseHighlightDefinition(name, definition string, validColor func(string) bool) (*HighlightRule, error) {
+	sep := strings.LastIndex(definition, referenceSeparator)
+	if sep < 0 {
+		return nil, fmt.Errorf("expected \"<pattern> %s <color> [styles]\"", referenceSeparator)
+	}
+	return NewHighlightRule(name, strings.TrimSpace(definition[:sep]),
+		strings.Fields(definition[sep+len(referenceSeparator):]), validColor)
+}
+
+// NewHighlightRule creates a rule from a pattern, a color and optional styles
+func NewHighlightRule(name, pattern string, style []string, validColor func(string) bool) (*HighlightRule, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern must not be empty")
+	}
+	if len(style) == 0 {
+		return nil, fmt.Errorf("color must not be empty")
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &HighlightRule{Name: name, Pattern: re}
+	rule.Foreground, rule.Background, _ = strings.Cut(strings.ToLower(style[0]), ":")
+	for _, color := range []string{rule.Foreground, rule.Background} {
+		if color != "" && !validColor(color) {
+			return nil, fmt.Errorf("unknown color %q", color)
+		}
+	}
+
+	for _, s := range style[1:] {
+		s = strings.ToLower(s)
+		if _, ok := highlightStyles[s]; !ok {
+			return nil, fmt.Errorf("unknown style %q", s)
+		}
+		rule.Styles = append(rule.Styles, s)
+	}
+
+	return rule, nil
+}
+
+// MatchHighlight returns the first rule matching a line, nil if none matches
+func MatchHighlight(rules []*HighlightRule, line string) *HighlightRule {
+	for _, rule := range rules {
+		if rule.Pattern.MatchString(line) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// NextHighlightName returns an unused name for a rule added at runtime
+func NextHighlightName(definitions map[string]string) string {
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("rule%d", i)
+		if _, exists := definitions[name]; !exists {
+			return name
+		}
+	}
+}
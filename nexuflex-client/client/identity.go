@@ -0,0 +1,57 @@
+// identity.go
+/**
+ * Nexuflex Client - Effective User and Roles
+ *
+ * This file contains the access to the user information the server
+ * returned at login: the roles, the permission summary and whether the
+ * session is elevated. A session counts as elevated if the server says so
+ * or if the user has one of the roles configured in `elevated_roles`.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// GetUserInfo returns the user information of the current session, nil if not logged in
+func (c *Client) GetUserInfo() *proto.UserInfo {
+	if c.sessionToken == "" {
+		return nil
+	}
+	return c.userInfo
+}
+
+// ElevatedRoles returns the roles of the current user that are configured as elevated
+func (c *Client) ElevatedRoles() []string {
+	info := c.GetUserInfo()
+	if info == nil {
+		return nil
+	}
+
+	var result []string
+	for _, role := range info.Roles {
+		for _, elevated := range c.config.Auth.ElevatedRoles {
+			if strings.EqualFold(role, strings.TrimSpace(elevated)) {
+				result = append(result, role)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// IsElevated checks whether the current session has administrative privileges
+func (c *Client) IsElevated() bool {
+	info := c.GetUserInfo()
+	if info == nil {
+		return false
+	}
+	return info.Elevated || len(c.ElevatedRoles()) > 0
+}
@@ -0,0 +1,165 @@
+// instance.go
+/**
+ * Nexuflex Client - Single-Instance Mode
+ *
+ * This file contains the local socket through which a running client
+ * accepts commands from further invocations of the same user
+ * (`nexuflex-client --exec "..."`). The socket lives in the user
+ * configuration directory and is only accessible to its owner; Unix
+ * domain sockets are used on all platforms (supported on Windows 10 and
+ * later).
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrNoInstance is returned when no running client accepts commands
+var ErrNoInstance = errors.New("no running nexuflex-client instance")
+
+// instanceDialTimeout limits the wait for the running instance
+const instanceDialTimeout = 2 * time.Second
+
+// instanceRequest is a command forwarded to the running instance
+type instanceRequest struct {
+	Command string `json:"command"`
+}
+
+// instanceResponse is the answer of the running instance
+type instanceResponse struct {
+	Error string `json:"error,omitempty"`
+}
+
+// InstanceServer accepts commands forwarded by further invocations
+type InstanceServer struct {
+	listener  net.Listener
+	path      string
+	onCommand func(command string) error
+	closeOnce sync.Once
+}
+
+// instanceSocketPath returns the path of the socket of the running instance
+func instanceSocketPath() (string, error) {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userConfigDir, "nexuflex", "instance.sock"), nil
+}
+
+// ListenInstance makes this client the running instance; onCommand is called
+// for every forwarded command. Fails if another instance is already running.
+func ListenInstance(onCommand func(command string) error) (*InstanceServer, error) {
+	path, err := instanceSocketPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	// A socket nobody answers on is left over from a crashed instance
+	if conn, err := net.DialTimeout("unix", path, instanceDialTimeout); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("another nexuflex-client instance is running")
+	}
+	os.Remove(path)
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("error creating instance socket: %v", err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("error protecting instance socket: %v", err)
+	}
+
+	s := &InstanceServer{
+		listener:  listener,
+		path:      path,
+		onCommand: onCommand,
+	}
+	go s.serve()
+	return s, nil
+}
+
+// serve accepts connections until the server is closed
+func (s *InstanceServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// handle processes a forwarded command and answers with the result
+func (s *InstanceServer) handle(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(instanceDialTimeout))
+
+	var request instanceRequest
+	if err := json.NewDecoder(bufio.NewReader(conn)).Decode(&request); err != nil {
+		return
+	}
+
+	var response instanceResponse
+	if request.Command == "" {
+		response.Error = "empty command"
+	} else if err := s.onCommand(request.Command); err != nil {
+		response.Error = err.Error()
+	}
+	json.NewEncoder(conn).Encode(response)
+}
+
+// Close stops accepting commands and removes the socket
+func (s *InstanceServer) Close() {
+	s.closeOnce.Do(func() {
+		s.listener.Close()
+		os.Remove(s.path)
+	})
+}
+
+// ForwardCommand sends a command to the running instance; returns ErrNoInstance
+// if no instance is running
+func ForwardCommand(command string) error {
+	path, err := instanceSocketPath()
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("unix", path, instanceDialTimeout)
+	if err != nil {
+		return ErrNoInstance
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(instanceDialTimeout))
+
+	if err := json.NewEncoder(conn).Encode(instanceRequest{Command: command}); err != nil {
+		return fmt.Errorf("error forwarding command: %v", err)
+	}
+
+	var response instanceResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return fmt.Errorf("no answer from the running instance: %v", err)
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+// jobs.go
+/**
+ * Nexuflex Client - Background Jobs
+ *
+ * This file contains the management of streaming commands running in the
+ * background. Every job keeps its progress and status from the streaming
+ * STATUS_UPDATE messages and the most recent output lines, so that it can
+ * be shown in the jobs panel, attached to the log pane or cancelled. The
+ * time of the last message, output or heartbeat, shows how long the server
+ * has been silent.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Limits of the job management
+const (
+	maxJobOutputLines = 500 // Output lines kept per job for attaching
+	maxFinishedJobs   = 5   // Finished jobs kept for display
+)
+
+// JobState is the state of a background job
+type JobState int
+
+const (
+	JobRunning JobState = iota
+	JobCompleted
+	JobFailed
+	JobCancelled
+)
+
+// String returns the name of the state
+func (s JobState) String() string {
+	switch s {
+	case JobRunning:
+		return "running"
+	case JobCompleted:
+		return "completed"
+	case JobFailed:
+		return "failed"
+	case JobCancelled:
+		return "cancelled"
+	}
+	return "unknown"
+}
+
+// JobInfo is a snapshot of a background job
+type JobInfo struct {
+	ID       int
+	Command  string
+	State    JobState
+	Status   string // Last status message of the server
+	Progress int    // Progress in percent, -1 if unknown
+	Started  time.Time
+	Finished time.Time
+	LastSign time.Time // Last message of the server, output or heartbeat
+}
+
+// Silence returns the time the server of a running job has sent nothing
+func (j JobInfo) Silence() time.Duration {
+	if j.State != JobRunning || j.LastSign.IsZero() {
+		return 0
+	}
+	return time.Since(j.LastSign)
+}
+
+// Elapsed returns the running time of the job
+func (j JobInfo) Elapsed() time.Duration {
+	if j.State == JobRunning {
+		return time.Since(j.Started)
+	}
+	return j.Finished.Sub(j.Started)
+}
+
+// job is a background job with its cancel function and output buffer
+type job struct {
+	info     JobInfo
+	cancel   context.CancelFunc
+	output   []string
+	onOutput func(output string)
+}
+
+// jobManager holds the background jobs of the client
+type jobManager struct {
+	mu     sync.Mutex
+	jobs   map[int]*job
+	nextID int
+}
+
+// StartJob runs a streaming command in the background; onOutput receives the
+// output lines and may be replaced later with AttachJob
+func (c *Client) StartJob(command string, onOutput func(output string)) (int, error) {
+	if c.client == nil {
+		return 0, fmt.Errorf("not connected to server")
+	}
+	if err := c.checkReadOnly(command); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c.jobs.mu.Lock()
+	if c.jobs.jobs == nil {
+		c.jobs.jobs = make(map[int]*job)
+	}
+	c.jobs.nextID++
+	j := &job{
+		info: JobInfo{
+			ID:       c.jobs.nextID,
+			Command:  command,
+			State:    JobRunning,
+			Progress: -1,
+			Started:  time.Now(),
+			LastSign: time.Now(),
+		},
+		cancel:   cancel,
+		onOutput: onOutput,
+	}
+	c.jobs.jobs[j.info.ID] = j
+	c.jobs.mu.Unlock()
+
+	c.logger("Job %d started: %s", j.info.ID, c.MaskCommand(command))
+	c.notifyJobsChanged()
+
+	go func() {
+		err := c.executeStream(ctx, command,
+			func(output string) {
+				c.jobs.mu.Lock()
+				j.output = append(j.output, output)
+				if len(j.output) > maxJobOutputLines {
+					j.output = j.output[len(j.output)-maxJobOutputLines:]
+				}
+				handler := j.onOutput
+				c.jobs.mu.Unlock()
+
+				if handler != nil {
+					handler(output)
+				}
+			},
+			func(status string, percent int32) {
+				c.jobs.mu.Lock()
+				j.info.Status = status
+				j.info.Progress = int(percent)
+				c.jobs.mu.Unlock()
+				c.notifyJobsChanged()
+			},
+			func(activity string) {
+				c.jobs.mu.Lock()
+				j.info.LastSign = time.Now()
+				if activity != "" {
+					j.info.Status = activity
+				}
+				c.jobs.mu.Unlock()
+			})
+
+		c.jobs.mu.Lock()
+		j.info.Finished = time.Now()
+		switch {
+		case ctx.Err() == context.Canceled:
+			j.info.State = JobCancelled
+		case err != nil:
+			j.info.State = JobFailed
+			j.info.Status = err.Error()
+		default:
+			j.info.State = JobCompleted
+			j.info.Progress = 100
+		}
+		c.jobs.pruneFinished()
+		c.jobs.mu.Unlock()
+
+		cancel()
+		c.logger("Job %d ended: %s", j.info.ID, j.info.State)
+		c.notifyJobsChanged()
+	}()
+
+	return j.info.ID, nil
+}
+
+// pruneFinished removes the oldest finished jobs; must be called with the lock held
+func (m *jobManager) pruneFinished() {
+	var finished []*job
+	for _, j := range m.jobs {
+		if j.info.State != JobRunning {
+			finished = append(finished, j)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+
+	sort.Slice(finished, func(i, k int) bool {
+		return finished[i].info.Finished.Before(finished[k].info.Finished)
+	})
+	for _, j := range finished[:len(finished)-maxFinishedJobs] {
+		delete(m.jobs, j.info.ID)
+	}
+}
+
+// GetJobs returns the background jobs ordered by ID
+func (c *Client) GetJobs() []JobInfo {
+	c.jobs.mu.Lock()
+	defer c.jobs.mu.Unlock()
+
+	result := make([]JobInfo, 0, len(c.jobs.jobs))
+	for _, j := range c.jobs.jobs {
+		result = append(result, j.info)
+	}
+	sort.Slice(result, func(i, k int) bool {
+		return result[i].ID < result[k].ID
+	})
+	return result
+}
+
+// CountRunningJobs returns the number of running background jobs
+func (c *Client) CountRunningJobs() int {
+	c.jobs.mu.Lock()
+	defer c.jobs.mu.Unlock()
+
+	count := 0
+	for _, j := range c.jobs.jobs {
+		if j.info.State == JobRunning {
+			count++
+		}
+	}
+	return count
+}
+
+// CancelJob stops a running background job
+func (c *Client) CancelJob(id int) error {
+	c.jobs.mu.Lock()
+	j, ok := c.jobs.jobs[id]
+	running := ok && j.info.State == JobRunning
+	c.jobs.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("job %d not found", id)
+	}
+	if !running {
+		return fmt.Errorf("job %d is not running", id)
+	}
+
+	c.logger("Cancelling job %d", id)
+	j.cancel()
+	return nil
+}
+
+// AttachJob routes the output of a job to a new handler and returns the
+// output received so far
+func (c *Client) AttachJob(id int, onOutput func(output string)) ([]string, error) {
+	c.jobs.mu.Lock()
+	defer c.jobs.mu.Unlock()
+
+	j, ok := c.jobs.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("job %d not found", id)
+	}
+
+	j.onOutput = onOutput
+	output := make([]string, len(j.output))
+	copy(output, j.output)
+	return output, nil
+}
+
+// cancelAllJobs stops all running jobs (on disconnect)
+func (c *Client) cancelAllJobs() {
+	c.jobs.mu.Lock()
+	defer c.jobs.mu.Unlock()
+
+	for _, j := range c.jobs.jobs {
+		if j.info.State == JobRunning {
+			j.cancel()
+		}
+	}
+}
+
+// SetJobsChangedCallback sets the function called when a job was started, updated or ended
+func (c *Client) SetJobsChangedCallback(onChanged func()) {
+	c.onJobsChanged = onChanged
+}
+
+// notifyJobsChanged calls the jobs callback
+func (c *Client) notifyJobsChanged() {
+	if c.onJobsChanged != nil {
+		c.onJobsChanged()
+	}
+}
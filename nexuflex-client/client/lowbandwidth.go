@@ -0,0 +1,254 @@
+// lowbandwidth.go
+/**
+ * Nexuflex Client - Low-Bandwidth Mode
+ *
+ * This file contains the low-bandwidth mode for satellite and mobile
+ * links, switched on with `low_bandwidth` in the [server] section of the
+ * profile or at runtime. While it is on, the client
+ *
+ *   - leaves out the background loading of the server metadata after
+ *     login, when idle and when the metadata version changes; in read-only
+ *     mode the metadata is still loaded for the flags of the commands,
+ *   - compresses the calls with gzip, so that the server answers
+ *     compressed as well; a server not accepting compressed calls is sent
+ *     them uncompressed for the rest of the connection,
+ *   - asks for results in pages of `low_bandwidth_page_size` rows or lines
+ *     and fetches a further page only on request,
+ *   - asks streams to leave out progress updates changing by less than
+ *     `low_bandwidth_progress_delta` percent, and leaves them out itself
+ *     for servers that send them anyway.
+ *
+ * The traffic is estimated in any mode from the size of the messages on
+ * the wire, compressed and with the gRPC framing but without the HTTP/2
+ * and TLS overhead, and counted from the time the user connected.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoNextPage is returned by FetchNextPage if the last result has no further page
+var ErrNoNextPage = errors.New("the last result has no further page")
+
+// Traffic is the estimated data exchanged with the server since the user connected
+type Traffic struct {
+	Sent       int64 // Bytes of the requests
+	Received   int64 // Bytes of the responses
+	Compressed bool  // Calls are compressed
+}
+
+// SetLowBandwidth switches the low-bandwidth mode on or off; switched off,
+// metadata left out meanwhile is loaded
+func (c *Client) SetLowBandwidth(lowBandwidth bool) {
+	if c.lowBandwidth.Swap(lowBandwidth) == lowBandwidth {
+		return
+	}
+	c.logger("Low-bandwidth mode: %v", lowBandwidth)
+	if !lowBandwidth && c.sessionToken != "" && len(c.GetCachedServices()) == 0 {
+		c.refreshMetadataInBackground("low-bandwidth mode off")
+	}
+}
+
+// IsLowBandwidth checks whether the low-bandwidth mode is on
+func (c *Client) IsLowBandwidth() bool {
+	return c.lowBandwidth.Load()
+}
+
+// GetTraffic returns the estimated traffic since the user connected
+func (c *Client) GetTraffic() Traffic {
+	return Traffic{
+		Sent:       c.traffic.sent.Load(),
+		Received:   c.traffic.received.Load(),
+		Compressed: c.compressCalls(),
+	}
+}
+
+// prefetchMetadata checks whether the metadata is loaded in the background
+func (c *Client) prefetchMetadata() bool {
+	return !c.IsLowBandwidth() || c.IsReadOnly()
+}
+
+// pageSize returns the rows or lines per page requested for results, 0 for
+// the whole result
+func (c *Client) pageSize() int32 {
+	if !c.IsLowBandwidth() {
+		return 0
+	}
+	return int32(max(c.config.Server.LowBandwidthPageSize, 0))
+}
+
+// progressMinDelta returns the progress change in percent below which
+// status updates of streams are left out, 0 for all updates
+func (c *Client) progressMinDelta() int32 {
+	if !c.IsLowBandwidth() {
+		return 0
+	}
+	return int32(max(c.config.Server.LowBandwidthProgressDelta, 0))
+}
+
+// progressFilter leaves out the status updates of a stream whose progress
+// changed too little since the last one delivered
+type progressFilter struct {
+	minDelta int32
+	last     int32
+}
+
+// pass checks whether a status update is delivered; updates without
+// progress and the completion are always delivered
+func (f *progressFilter) pass(percent int32) bool {
+	if f.minDelta <= 0 || percent <= 0 {
+		return true
+	}
+	if percent < 100 && percent-f.last < f.minDelta && f.last-percent < f.minDelta {
+		return false
+	}
+	f.last = percent
+	return true
+}
+
+// pageState holds the further page of the last result
+type pageState struct {
+	mu      sync.Mutex
+	command string
+	token   string
+}
+
+// setNextPage records the page token of a result, "" if it is complete
+func (c *Client) setNextPage(command, token string) {
+	c.nextPage.mu.Lock()
+	defer c.nextPage.mu.Unlock()
+	c.nextPage.command = command
+	c.nextPage.token = token
+}
+
+// NextPage returns the command whose result continues on a further page,
+// "" if the last result is complete
+func (c *Client) NextPage() string {
+	c.nextPage.mu.Lock()
+	defer c.nextPage.mu.Unlock()
+	if c.nextPage.token == "" {
+		return ""
+	}
+	return c.nextPage.command
+}
+
+// FetchNextPage fetches the further page of the last result and delivers
+// it like the output of ExecuteCommand
+func (c *Client) FetchNextPage() error {
+	c.nextPage.mu.Lock()
+	command, token := c.nextPage.command, c.nextPage.token
+	c.nextPage.mu.Unlock()
+	if token == "" {
+		return ErrNoNextPage
+	}
+
+	c.takeSlot()
+	defer c.releaseSlot()
+	_, err := c.sendRequestInSlot(command, "", token, defaultCommandTimeout)
+	return err
+}
+
+// compressCalls checks whether calls are sent compressed
+func (c *Client) compressCalls() bool {
+	return c.IsLowBandwidth() && !c.compressionRefused.Load()
+}
+
+// compressionDialOptions returns the interceptors compressing the calls in
+// low-bandwidth mode and the counting of the traffic
+func (c *Client) compressionDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(c.compressUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(c.compressStreamInterceptor),
+		grpc.WithStatsHandler(&c.traffic),
+	}
+}
+
+// compressUnaryInterceptor compresses a unary call; a call the server
+// refuses for the compression is sent again uncompressed, as the server
+// has not processed it
+func (c *Client) compressUnaryInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !c.compressCalls() {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.UseCompressor(gzip.Name))...)
+	if !isCompressionRefused(err) {
+		return err
+	}
+	c.compressionRefused.Store(true)
+	c.logger("The server does not accept compressed calls, sending them uncompressed")
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// compressStreamInterceptor compresses a stream
+func (c *Client) compressStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if c.compressCalls() {
+		opts = append(opts, grpc.UseCompressor(gzip.Name))
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// isCompressionRefused checks whether a call failed because the server
+// cannot decompress it
+func isCompressionRefused(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unimplemented {
+		return false
+	}
+	message := strings.ToLower(st.Message())
+	return strings.Contains(message, "compress") || strings.Contains(message, "encoding")
+}
+
+// trafficCounter counts the bytes of the messages on the wire; it is the
+// stats handler of the connections
+type trafficCounter struct {
+	sent     atomic.Int64
+	received atomic.Int64
+}
+
+// reset starts the count for a connection the user made
+func (t *trafficCounter) reset() {
+	t.sent.Store(0)
+	t.received.Store(0)
+}
+
+// TagRPC implements stats.Handler
+func (t *trafficCounter) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+// HandleRPC implements stats.Handler and counts the messages of a call
+func (t *trafficCounter) HandleRPC(_ context.Context, s stats.RPCStats) {
+	switch payload := s.(type) {
+	case *stats.OutPayload:
+		t.sent.Add(int64(payload.WireLength))
+	case *stats.InPayload:
+		t.received.Add(int64(payload.WireLength))
+	}
+}
+
+// TagConn implements stats.Handler
+func (t *trafficCounter) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn implements stats.Handler
+func (t *trafficCounter) HandleConn(context.Context, stats.ConnStats) {}
@@ -0,0 +1,130 @@
+// maintenance.go
+/**
+ * Nexuflex Client - Maintenance Windows
+ *
+ * This file contains the maintenance windows announced by the server. The
+ * next window is reported when connecting and refreshed with every
+ * keep-alive, so announcements, changes and withdrawals reach running
+ * sessions. Commands can be checked against the window before they are
+ * started: a command conflicts if the window has begun or if it is
+ * expected to still run when the window starts.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// MaintenanceWindow is a maintenance announced by the server
+type MaintenanceWindow struct {
+	Start   time.Time
+	End     time.Time
+	Message string
+}
+
+// Active checks whether the window has begun and not ended at a time
+func (w MaintenanceWindow) Active(now time.Time) bool {
+	return !now.Before(w.Start) && now.Before(w.End)
+}
+
+// maintenanceState holds the announced window
+type maintenanceState struct {
+	mu     sync.Mutex
+	window *MaintenanceWindow
+}
+
+// SetMaintenanceCallback sets the function called when a maintenance window
+// is announced, changed or withdrawn; it receives nil on withdrawal
+func (c *Client) SetMaintenanceCallback(onMaintenanceChanged func(window *MaintenanceWindow)) {
+	c.onMaintenanceChanged = onMaintenanceChanged
+}
+
+// setMaintenance takes over the window reported by the server; nil or an
+// ended window withdraws the announcement
+func (c *Client) setMaintenance(announced *proto.MaintenanceWindow) {
+	var window *MaintenanceWindow
+	if announced != nil && announced.StartUnix > 0 && announced.DurationMinutes > 0 {
+		start := time.Unix(announced.StartUnix, 0)
+		window = &MaintenanceWindow{
+			Start:   start,
+			End:     start.Add(time.Duration(announced.DurationMinutes) * time.Minute),
+			Message: strings.TrimSpace(announced.Message),
+		}
+		if !time.Now().Before(window.End) {
+			window = nil
+		}
+	}
+
+	c.maintenance.mu.Lock()
+	previous := c.maintenance.window
+	changed := (previous == nil) != (window == nil) || (window != nil && *previous != *window)
+	c.maintenance.window = window
+	c.maintenance.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	if window != nil {
+		c.logger("Maintenance announced from %s to %s: %s", window.Start.Format(time.RFC3339),
+			window.End.Format(time.RFC3339), window.Message)
+	} else {
+		c.logger("Maintenance announcement withdrawn")
+	}
+	if c.onMaintenanceChanged != nil {
+		c.onMaintenanceChanged(window)
+	}
+}
+
+// UpcomingMaintenance returns the announced maintenance window unless it has ended
+func (c *Client) UpcomingMaintenance() (MaintenanceWindow, bool) {
+	c.maintenance.mu.Lock()
+	defer c.maintenance.mu.Unlock()
+
+	if c.maintenance.window == nil || !time.Now().Before(c.maintenance.window.End) {
+		return MaintenanceWindow{}, false
+	}
+	return *c.maintenance.window, true
+}
+
+// ExpectedDuration returns the typical run time of the command of a command
+// line reported in the command metadata, 0 if it is unknown
+func (c *Client) ExpectedDuration(commandLine string) time.Duration {
+	name := strings.SplitN(strings.TrimSpace(commandLine), " ", 2)[0]
+	if name == "" {
+		return 0
+	}
+	if _, info := c.lookupCommandInfo(name, c.GetLastServiceUsed()); info != nil {
+		return time.Duration(info.ExpectedSeconds) * time.Second
+	}
+	return 0
+}
+
+// MaintenanceConflict checks whether a command runs into the announced
+// maintenance window: the window has begun, or the command is expected to
+// still run when it starts. assumed is the run time of commands whose
+// metadata does not report one.
+func (c *Client) MaintenanceConflict(commandLine string, assumed time.Duration) (MaintenanceWindow, bool) {
+	window, ok := c.UpcomingMaintenance()
+	if !ok {
+		return MaintenanceWindow{}, false
+	}
+	now := time.Now()
+	if window.Active(now) {
+		return window, true
+	}
+
+	expected := c.ExpectedDuration(commandLine)
+	if expected == 0 {
+		expected = assumed
+	}
+	return window, expected > 0 && now.Add(expected).After(window.Start)
+}
@@ -0,0 +1,68 @@
+// metachannel.go
+/**
+ * Nexuflex Client - Metadata Channel
+ *
+ * This file contains the separate connection for the interactive metadata
+ * calls. All calls of a gRPC connection share one TCP connection, so on a
+ * constrained link a large command result or upload holds back the
+ * completion request behind it until it has been transferred. With
+ * `metadata_channel`, completion, help, the service and alias listings,
+ * quick actions and the keep-alive use a second connection to the same
+ * server instead; they are small and latency-sensitive, while the command
+ * connection carries the heavy traffic. Without the second connection
+ * (switched off or not established) they fall back to the command
+ * connection.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"google.golang.org/grpc"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// openMetadataChannel opens the connection for metadata calls with the
+// options of the command connection, if it is configured
+func (c *Client) openMetadataChannel(serverAddr string, opts []grpc.DialOption) {
+	c.connMu.Lock()
+	c.closeMetadataChannel()
+	c.connMu.Unlock()
+	if !c.config.Server.MetadataChannel {
+		return
+	}
+
+	conn, err := grpc.Dial(serverAddr, opts...)
+	if err != nil {
+		// Metadata calls use the command connection then
+		c.logger("Metadata channel not available: %v", err)
+		return
+	}
+	c.connMu.Lock()
+	c.metaConn = conn
+	c.meta = proto.NewNexuflexServiceClient(conn)
+	c.connMu.Unlock()
+}
+
+// closeMetadataChannel closes the connection for metadata calls; the
+// caller holds connMu
+func (c *Client) closeMetadataChannel() {
+	if c.metaConn != nil {
+		c.metaConn.Close()
+		c.metaConn = nil
+		c.meta = nil
+	}
+}
+
+// metadataClient returns the client for metadata calls: the metadata
+// channel if it is open, otherwise the command connection
+func (c *Client) metadataClient() proto.NexuflexServiceClient {
+	if c.meta != nil {
+		return c.meta
+	}
+	return c.client
+}
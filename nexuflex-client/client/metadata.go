@@ -0,0 +1,197 @@
+// metadata.go
+/**
+ * Nexuflex Client - Server Metadata Cache
+ *
+ * This file contains the cache of the services, commands and aliases of
+ * the server. The cache is refreshed after login, in the background when
+ * the client has been idle for the configured period, and whenever the
+ * server reports a new metadata version in the KeepAlive response. The
+ * low-bandwidth mode leaves these refreshes out. A background refresh is
+ * work of the session: it ends with the session and stores nothing then.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// metadataCache holds the metadata of the connected server
+type metadataCache struct {
+	mu          sync.RWMutex
+	services    []*proto.ServiceInfo
+	commands    map[string][]*proto.CommandInfo
+	version     int64
+	refreshedAt time.Time
+	refreshing  bool
+
+	// Service names fetched on demand while no services are cached
+	serviceNames []string
+}
+
+// RefreshMetadata reloads services, commands and aliases from the server
+func (c *Client) RefreshMetadata() error {
+	return c.refreshMetadata(context.Background())
+}
+
+// refreshMetadata reloads the metadata until the context ends
+func (c *Client) refreshMetadata(ctx context.Context) error {
+	c.metadata.mu.Lock()
+	if c.metadata.refreshing {
+		c.metadata.mu.Unlock()
+		return nil
+	}
+	c.metadata.refreshing = true
+	c.metadata.mu.Unlock()
+
+	defer func() {
+		c.metadata.mu.Lock()
+		c.metadata.refreshing = false
+		c.metadata.mu.Unlock()
+	}()
+
+	services, err := c.availableServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	commands := make(map[string][]*proto.CommandInfo, len(services))
+	for _, service := range services {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		serviceCommands, err := c.serviceCommands(ctx, service.ServiceName)
+		if err != nil {
+			c.logger("Commands of service %s not available: %v", service.ServiceName, err)
+			continue
+		}
+		commands[service.ServiceName] = serviceCommands
+	}
+
+	if err := c.refreshServerAliases(ctx); err != nil {
+		c.logger("Server aliases not available: %v", err)
+	}
+
+	// The session ended while the metadata was loaded
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	c.metadata.mu.Lock()
+	c.metadata.services = services
+	c.metadata.commands = commands
+	c.metadata.refreshedAt = time.Now()
+	c.metadata.mu.Unlock()
+
+	c.logger("Metadata refreshed: %d services", len(services))
+
+	if c.onMetadataRefreshed != nil {
+		c.onMetadataRefreshed()
+	}
+	return nil
+}
+
+// refreshMetadataInBackground refreshes the metadata without blocking the caller
+func (c *Client) refreshMetadataInBackground(reason string) {
+	c.goSession(func(ctx context.Context) {
+		c.logger("Refreshing metadata (%s)...", reason)
+		if err := c.refreshMetadata(ctx); err != nil {
+			c.logger("Metadata refresh failed: %v", err)
+		}
+	})
+}
+
+// clearMetadata forgets the cached metadata (on logout or reconnect)
+func (c *Client) clearMetadata() {
+	c.metadata.mu.Lock()
+	c.metadata.services = nil
+	c.metadata.commands = nil
+	c.metadata.version = 0
+	c.metadata.refreshedAt = time.Time{}
+	c.metadata.serviceNames = nil
+	c.metadata.mu.Unlock()
+
+	c.clearServerAliases()
+	c.clearQuickActions()
+}
+
+// GetCachedServices returns the cached services of the server
+func (c *Client) GetCachedServices() []*proto.ServiceInfo {
+	c.metadata.mu.RLock()
+	defer c.metadata.mu.RUnlock()
+	return c.metadata.services
+}
+
+// GetCachedCommands returns the cached commands of a service
+func (c *Client) GetCachedCommands(service string) []*proto.CommandInfo {
+	c.metadata.mu.RLock()
+	defer c.metadata.mu.RUnlock()
+	return c.metadata.commands[service]
+}
+
+// GetMetadataRefreshTime returns when the metadata was last refreshed
+func (c *Client) GetMetadataRefreshTime() time.Time {
+	c.metadata.mu.RLock()
+	defer c.metadata.mu.RUnlock()
+	return c.metadata.refreshedAt
+}
+
+// SetMetadataRefreshedCallback sets the function called after the metadata was refreshed
+func (c *Client) SetMetadataRefreshedCallback(onRefreshed func()) {
+	c.onMetadataRefreshed = onRefreshed
+}
+
+// checkMetadataVersion triggers a refresh when the server reports a new metadata version
+func (c *Client) checkMetadataVersion(version int64) {
+	if version == 0 {
+		return // Server does not report metadata versions
+	}
+
+	c.metadata.mu.Lock()
+	changed := c.metadata.version != 0 && c.metadata.version != version
+	c.metadata.version = version
+	c.metadata.mu.Unlock()
+
+	if changed && c.prefetchMetadata() {
+		c.refreshMetadataInBackground("metadata version changed")
+	}
+}
+
+// checkIdleRefresh triggers a refresh when the client has been idle for the configured period
+func (c *Client) checkIdleRefresh() {
+	minutes := c.config.Server.MetadataRefreshIdleMinutes
+	if minutes <= 0 || !c.prefetchMetadata() {
+		return
+	}
+	idle := time.Duration(minutes) * time.Minute
+
+	c.metadata.mu.RLock()
+	refreshedAt := c.metadata.refreshedAt
+	c.metadata.mu.RUnlock()
+
+	if time.Since(c.getLastActivity()) >= idle && time.Since(refreshedAt) >= idle {
+		c.refreshMetadataInBackground("idle")
+	}
+}
+
+// markActivity records user activity for the idle detection
+func (c *Client) markActivity() {
+	c.activityMu.Lock()
+	c.lastActivity = time.Now()
+	c.activityMu.Unlock()
+}
+
+// getLastActivity returns the time of the last user activity
+func (c *Client) getLastActivity() time.Time {
+	c.activityMu.Lock()
+	defer c.activityMu.Unlock()
+	return c.lastActivity
+}
@@ -0,0 +1,195 @@
+// notifications.go
+/**
+ * Nexuflex Client - Server Notifications
+ *
+ * This file contains the subscription to the notifications of the server.
+ * While a user is logged in, the client keeps a Subscribe stream open and
+ * publishes every notification as a session event. The notifications
+ * carry sequence numbers: the client remembers the highest one received
+ * and resubscribes after it when the stream breaks, so notifications
+ * missed during a disconnect are redelivered in order, and drops those
+ * it has already received. Once the user has read the notifications, a
+ * read receipt (AckNotifications) tells the server it can discard them;
+ * notifications that were received but not read are delivered again
+ * after a restart of the client. The subscription is background work of
+ * the session and ends with it.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Delays before resubscribing after the stream broke
+const (
+	notificationRetryMin = 2 * time.Second
+	notificationRetryMax = time.Minute
+)
+
+// notificationState tracks the subscription and the received notifications
+type notificationState struct {
+	mu       sync.Mutex
+	owner    string // Server and user the sequences belong to
+	received int64  // Highest sequence received
+	read     int64  // Highest sequence acknowledged as read
+}
+
+// startNotifications subscribes to the notifications of the logged-in user
+// in the background of the session
+func (c *Client) startNotifications() {
+	owner := c.notificationOwner()
+
+	c.notifications.mu.Lock()
+	// Sequences are counted per user, so they start over for another one
+	if c.notifications.owner != owner {
+		c.notifications.owner = owner
+		c.notifications.received = 0
+		c.notifications.read = 0
+	}
+	c.notifications.mu.Unlock()
+
+	c.goSession(c.runNotifications)
+}
+
+// notificationOwner identifies the server and user the notifications belong to
+func (c *Client) notificationOwner() string {
+	if c.serverInfo == nil {
+		return c.username
+	}
+	return fmt.Sprintf("%s:%d/%s", c.serverInfo.Address, c.serverInfo.Port, c.username)
+}
+
+// runNotifications keeps the subscription open until it is stopped,
+// resubscribing after the last received notification when it breaks
+func (c *Client) runNotifications(ctx context.Context) {
+	delay := notificationRetryMin
+	for ctx.Err() == nil {
+		received, err := c.receiveNotifications(ctx)
+		_, _, sessionErr := c.sessionClient()
+		if ctx.Err() != nil || sessionErr != nil {
+			// The next login subscribes again
+			return
+		}
+		if status.Code(err) == codes.Unimplemented {
+			c.logger("Server does not send notifications")
+			return
+		}
+		if received {
+			delay = notificationRetryMin
+		}
+		c.logger("Notification stream ended, resubscribing in %v: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, notificationRetryMax)
+	}
+}
+
+// receiveNotifications subscribes once and delivers the notifications until
+// the stream ends; returns whether any notification arrived
+func (c *Client) receiveNotifications(ctx context.Context) (bool, error) {
+	client, token, err := c.sessionClient()
+	if err != nil {
+		return false, err
+	}
+
+	c.notifications.mu.Lock()
+	after := c.notifications.received
+	c.notifications.mu.Unlock()
+
+	stream, err := client.Subscribe(ctx, &proto.SubscribeRequest{
+		SessionToken:  token,
+		AfterSequence: after,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		notification, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		c.deliverNotification(notification)
+	}
+}
+
+// deliverNotification publishes a notification unless it was received before
+func (c *Client) deliverNotification(notification *proto.Notification) {
+	c.notifications.mu.Lock()
+	if notification.Sequence <= c.notifications.received {
+		c.notifications.mu.Unlock()
+		return
+	}
+	if c.notifications.received > 0 && notification.Sequence > c.notifications.received+1 {
+		c.logger("Notifications %d to %d were not delivered", c.notifications.received+1, notification.Sequence-1)
+	}
+	c.notifications.received = notification.Sequence
+	c.notifications.mu.Unlock()
+
+	text := notification.Message
+	if notification.Title != "" {
+		text = notification.Title + ": " + text
+	}
+	var tags []string
+	for _, tag := range []string{notification.Severity, notification.Source} {
+		if tag != "" {
+			tags = append(tags, strings.ToLower(tag))
+		}
+	}
+	c.publishTaggedEvent(EventNotification, text, tags)
+}
+
+// AcknowledgeNotifications sends the read receipt for all notifications
+// received so far, so the server does not deliver them again
+func (c *Client) AcknowledgeNotifications() error {
+	c.notifications.mu.Lock()
+	upTo := c.notifications.received
+	if upTo <= c.notifications.read {
+		c.notifications.mu.Unlock()
+		return nil
+	}
+	c.notifications.mu.Unlock()
+
+	client, token, err := c.sessionClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.AckNotifications(ctx, &proto.AckNotificationsRequest{
+		SessionToken: token,
+		UpToSequence: upTo,
+	})
+	if err != nil {
+		return c.rpcError("acknowledging the notifications", "", 0, err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("acknowledging the notifications failed: %s", resp.ErrorMessage)
+	}
+
+	c.notifications.mu.Lock()
+	c.notifications.read = max(c.notifications.read, upTo)
+	c.notifications.mu.Unlock()
+	return nil
+}
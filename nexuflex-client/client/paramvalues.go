@@ -0,0 +1,302 @@
+// paramvalues.go
+/**
+ * Nexuflex Client - Parameter Value Memory
+ *
+ * This file contains the memory of the last values used for the
+ * parameters of server commands. After a command succeeded, its positional
+ * arguments are assigned to the parameters from the cached command
+ * metadata and stored per server in param_values.json in the user
+ * configuration directory. The values are offered as defaults in the
+ * parameter form and the completion; secret parameters are never stored,
+ * and `remember_parameters = false` turns the memory off.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// ParameterValues stores the last used parameter values per server and command
+type ParameterValues struct {
+	mu     sync.Mutex
+	values map[string]map[string]map[string]string // Server -> command -> parameter -> value
+	path   string
+}
+
+// ParameterDefault describes a parameter of a command with the value offered as default
+type ParameterDefault struct {
+	Name        string
+	Description string
+	DataType    string
+	Required    bool
+	Value       string // Last used value, otherwise the default of the server
+	Remembered  bool   // Value is the last used one
+}
+
+// NewParameterValues creates the memory and loads it from the user configuration directory
+func NewParameterValues() *ParameterValues {
+	m := &ParameterValues{values: make(map[string]map[string]map[string]string)}
+	if userConfigDir, err := os.UserConfigDir(); err == nil {
+		m.path = filepath.Join(userConfigDir, "nexuflex", "param_values.json")
+		m.load()
+	}
+	return m
+}
+
+// load reads the stored values; unreadable values are ignored
+func (m *ParameterValues) load() {
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		return
+	}
+	var values map[string]map[string]map[string]string
+	if err := json.Unmarshal(data, &values); err == nil && values != nil {
+		m.values = values
+	}
+}
+
+// save writes the values atomically; must be called with the lock held
+func (m *ParameterValues) save() error {
+	if m.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(m.values, "", "  ")
+	if err != nil {
+		return err
+	}
+	return state.WriteFileAtomic(m.path, data)
+}
+
+// remember stores the values of a command
+func (m *ParameterValues) remember(server, command string, values map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.values[server] == nil {
+		m.values[server] = make(map[string]map[string]string)
+	}
+	if m.values[server][command] == nil {
+		m.values[server][command] = make(map[string]string)
+	}
+	for name, value := range values {
+		m.values[server][command][name] = value
+	}
+	return m.save()
+}
+
+// get returns the stored values of a command
+func (m *ParameterValues) get(server, command string) map[string]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	values := make(map[string]string, len(m.values[server][command]))
+	for name, value := range m.values[server][command] {
+		values[name] = value
+	}
+	return values
+}
+
+// clear removes the values of a command, or of all commands if command is
+// empty; returns the number of commands whose values were removed
+func (m *ParameterValues) clear(server, command string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	removed := 0
+	for name := range m.values[server] {
+		if command == "" || strings.EqualFold(name, command) {
+			delete(m.values[server], name)
+			removed++
+		}
+	}
+	if len(m.values[server]) == 0 {
+		delete(m.values, server)
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, m.save()
+}
+
+// rememberParameters stores the arguments of a successful command as the
+// last values of its parameters
+func (c *Client) rememberParameters(command, context string) {
+	server := c.serverKey()
+	if !c.config.Commands.RememberParameters || server == "" {
+		return
+	}
+
+	name, args, _ := strings.Cut(strings.TrimSpace(command), " ")
+	canonical, info := c.lookupCommandInfo(name, context)
+	if info == nil {
+		return
+	}
+
+	values := make(map[string]string)
+	for i, arg := range SplitArguments(args) {
+		if i >= len(info.Parameters) {
+			break
+		}
+		if parameter := info.Parameters[i]; arg != "" && !isSecretParameter(parameter) {
+			values[parameter.Name] = arg
+		}
+	}
+	if len(values) == 0 {
+		return
+	}
+
+	if err := c.paramValues.remember(server, canonical, values); err != nil {
+		c.logger("Error saving parameter values: %v", err)
+	}
+}
+
+// ParameterDefaults returns the canonical name and the parameters of a
+// command with their defaults; the parameters are nil if the command is unknown
+func (c *Client) ParameterDefaults(command string) (string, []ParameterDefault) {
+	canonical, info := c.lookupCommandInfo(command, c.lastServiceUsed)
+	if info == nil {
+		return command, nil
+	}
+
+	remembered := c.paramValues.get(c.serverKey(), canonical)
+	defaults := make([]ParameterDefault, 0, len(info.Parameters))
+	for _, parameter := range info.Parameters {
+		def := ParameterDefault{
+			Name:        parameter.Name,
+			Description: parameter.Description,
+			DataType:    parameter.DataType,
+			Required:    parameter.Required,
+			Value:       parameter.DefaultValue,
+		}
+		if value, ok := remembered[parameter.Name]; ok {
+			def.Value = value
+			def.Remembered = true
+		}
+		defaults = append(defaults, def)
+	}
+	return canonical, defaults
+}
+
+// RememberedParameters returns the commands with remembered values on the
+// connected server, sorted by name
+func (c *Client) RememberedParameters() []string {
+	server := c.serverKey()
+
+	c.paramValues.mu.Lock()
+	defer c.paramValues.mu.Unlock()
+
+	commands := make([]string, 0, len(c.paramValues.values[server]))
+	for command := range c.paramValues.values[server] {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// RememberedValues returns the remembered values of a command on the connected server
+func (c *Client) RememberedValues(command string) map[string]string {
+	return c.paramValues.get(c.serverKey(), command)
+}
+
+// ClearParameterValues forgets the remembered values of a command on the
+// connected server, or of all its commands if command is empty
+func (c *Client) ClearParameterValues(command string) (int, error) {
+	if command != "" {
+		if canonical, info := c.lookupCommandInfo(command, c.lastServiceUsed); info != nil {
+			command = canonical
+		}
+	}
+	return c.paramValues.clear(c.serverKey(), command)
+}
+
+// lookupCommandInfo finds the cached metadata of a command name, which may
+// omit the service of the given context; returns the canonical name
+func (c *Client) lookupCommandInfo(name, context string) (string, *proto.CommandInfo) {
+	parts := strings.Split(name, ".")
+	service := ""
+	for _, info := range c.GetCachedServices() {
+		if strings.EqualFold(info.ServiceName, parts[0]) {
+			service = info.ServiceName
+			parts = parts[1:]
+			break
+		}
+	}
+	if service == "" {
+		service = context
+	}
+	if service == "" || len(parts) == 0 || len(parts) > 2 {
+		return name, nil
+	}
+
+	subaction := ""
+	if len(parts) == 2 {
+		subaction = parts[1]
+	}
+	for _, info := range c.GetCachedCommands(service) {
+		if strings.EqualFold(info.Action, parts[0]) && strings.EqualFold(info.Subaction, subaction) {
+			canonical := service + "." + info.Action
+			if info.Subaction != "" {
+				canonical += "." + info.Subaction
+			}
+			return canonical, info
+		}
+	}
+	return name, nil
+}
+
+// isSecretParameter checks whether the value of a parameter must not be stored
+func isSecretParameter(parameter *proto.ParameterInfo) bool {
+	dataType := strings.ToLower(parameter.DataType)
+	name := strings.ToLower(parameter.Name)
+	return parameter.Sensitive || dataType == "password" || dataType == "secret" ||
+		strings.Contains(name, "password") || strings.Contains(name, "secret")
+}
+
+// SplitArguments splits command arguments at spaces; arguments may be
+// enclosed in double quotes to contain spaces
+func SplitArguments(args string) []string {
+	var arguments []string
+	var current strings.Builder
+	inQuotes, started := false, false
+	for _, r := range args {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			started = true
+		case r == ' ' && !inQuotes:
+			if started {
+				arguments = append(arguments, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+	if started {
+		arguments = append(arguments, current.String())
+	}
+	return arguments
+}
+
+// QuoteArgument encloses an argument in double quotes if it is empty or contains spaces
+func QuoteArgument(arg string) string {
+	if arg == "" || strings.ContainsAny(arg, " \t") {
+		return `"` + arg + `"`
+	}
+	return arg
+}
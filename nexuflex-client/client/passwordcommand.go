@@ -0,0 +1,200 @@
+// passwordcommand.go
+/**
+ * Nexuflex Client - Password Command
+ *
+ * This file contains the delegation of password entry to an external
+ * program configured with "password_command" in the [auth] section, e.g.
+ * "pass show nexuflex/prod" or a password manager CLI; the first line of
+ * its output is the password, "{username}" in the command is replaced by
+ * the entered user name. Programs named pinentry* are driven with the
+ * Assuan protocol of GnuPG, so their dialog asks for the password. As
+ * such programs may prompt on the terminal, they run through the
+ * interactive runner, which the user interface sets to suspend itself.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// InteractiveRunner runs a function while an external program may use the terminal
+type InteractiveRunner func(run func())
+
+var (
+	interactiveRunnerMu sync.RWMutex
+	interactiveRunner   InteractiveRunner = func(run func()) { run() }
+)
+
+// SetInteractiveRunner sets how external programs that may prompt on the
+// terminal are run, e.g. with the user interface suspended
+func SetInteractiveRunner(runner InteractiveRunner) {
+	interactiveRunnerMu.Lock()
+	defer interactiveRunnerMu.Unlock()
+	interactiveRunner = runner
+}
+
+// runInteractive runs a function with the interactive runner
+func runInteractive(run func()) {
+	interactiveRunnerMu.RLock()
+	runner := interactiveRunner
+	interactiveRunnerMu.RUnlock()
+	runner(run)
+}
+
+// runPasswordCommand obtains the password of a user from the password command
+func runPasswordCommand(command, username string) (string, error) {
+	args := strings.Fields(strings.ReplaceAll(command, "{username}", username))
+	if len(args) == 0 {
+		return "", fmt.Errorf("empty password command")
+	}
+
+	var password string
+	var err error
+	if strings.HasPrefix(strings.ToLower(filepath.Base(args[0])), "pinentry") {
+		runInteractive(func() { password, err = runPinentry(args, username) })
+	} else {
+		runInteractive(func() { password, err = runSecretProgram(args) })
+	}
+	if err != nil {
+		return "", err
+	}
+	if password == "" {
+		return "", fmt.Errorf("password command returned no password")
+	}
+	return password, nil
+}
+
+// runSecretProgram runs a program that prints the password on the first
+// line of its output; it may prompt on the terminal (e.g. for a passphrase)
+func runSecretProgram(args []string) (string, error) {
+	var out bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("password command failed: %v", err)
+	}
+
+	line, _, _ := strings.Cut(out.String(), "\n")
+	return strings.TrimRight(line, "\r"), nil
+}
+
+// runPinentry asks for the password with a pinentry program
+func runPinentry(args []string, username string) (string, error) {
+	cmd := exec.Command(args[0], args[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return "", err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return "", fmt.Errorf("pinentry failed: %v", err)
+	}
+	defer func() {
+		stdin.Close()
+		cmd.Wait()
+	}()
+
+	session := &assuanSession{in: stdin, out: bufio.NewReader(stdout)}
+	if _, err := session.response(); err != nil {
+		return "", err
+	}
+
+	requests := []string{"SETTITLE nexuflex"}
+	if tty := os.Getenv("GPG_TTY"); tty != "" {
+		requests = append(requests, "OPTION ttyname="+tty)
+	}
+	description := "Password"
+	if username != "" {
+		description = "Password for " + username
+	}
+	requests = append(requests, "SETDESC "+assuanEscape(description), "SETPROMPT Password:")
+	for _, request := range requests {
+		if _, err := session.request(request); err != nil {
+			return "", err
+		}
+	}
+
+	pin, err := session.request("GETPIN")
+	if err != nil {
+		return "", err
+	}
+	session.request("BYE")
+	return pin, nil
+}
+
+// assuanSession is a connection to a program speaking the Assuan protocol
+type assuanSession struct {
+	in  io.Writer
+	out *bufio.Reader
+}
+
+// request sends a command and returns the data of the response
+func (s *assuanSession) request(command string) (string, error) {
+	if _, err := fmt.Fprintf(s.in, "%s\n", command); err != nil {
+		return "", fmt.Errorf("pinentry failed: %v", err)
+	}
+	return s.response()
+}
+
+// response reads the lines of a response up to OK or ERR and returns its data
+func (s *assuanSession) response() (string, error) {
+	var data strings.Builder
+	for {
+		line, err := s.out.ReadString('\n')
+		if err != nil {
+			return "", fmt.Errorf("pinentry failed: %v", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case line == "OK" || strings.HasPrefix(line, "OK "):
+			return data.String(), nil
+		case strings.HasPrefix(line, "ERR"):
+			return "", fmt.Errorf("pinentry: %s", strings.TrimSpace(strings.TrimPrefix(line, "ERR")))
+		case strings.HasPrefix(line, "D "):
+			data.WriteString(assuanUnescape(line[2:]))
+		}
+		// Status (S) and comment (#) lines are ignored
+	}
+}
+
+// assuanEscape percent-encodes the characters Assuan does not allow in a line
+func assuanEscape(text string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(text)
+}
+
+// assuanUnescape decodes percent-encoded characters of Assuan data
+func assuanUnescape(text string) string {
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '%' && i+2 < len(text) {
+			if b, err := strconv.ParseUint(text[i+1:i+3], 16, 8); err == nil {
+				sb.WriteByte(byte(b))
+				i += 2
+				continue
+			}
+		}
+		sb.WriteByte(text[i])
+	}
+	return sb.String()
+}
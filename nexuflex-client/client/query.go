@@ -0,0 +1,1063 @@
+// query.go
+/**
+ * Nexuflex Client - Local Queries
+ *
+ * This file contains a small SQL dialect evaluated by the client against
+ * structured table results, for when the query of a server command cannot
+ * be changed quickly. It supports
+ *
+ *   SELECT [DISTINCT] <expr> [[AS] <name>], ... | *
+ *   FROM <table>
+ *   [WHERE <condition>]
+ *   [GROUP BY <column>, ...]
+ *   [ORDER BY <expr> [ASC|DESC], ...]
+ *   [LIMIT <n>]
+ *
+ * with the aggregates count, sum, avg, min and max, the operators
+ * + - * / = != <> < <= > >= LIKE AND OR NOT and parentheses. Column names
+ * with blanks are quoted with "..." or `...`, strings with '...'. Cells
+ * that are numbers (thousands separators allowed) are compared as numbers,
+ * all others as text. The tables are looked up by name, so the caller
+ * decides what "last" or a stored result name refers to.
+ *
+ * Example:
+ *   select region, sum(amount) as total from last group by region order by total desc
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// queryKeywords cannot be used as unquoted column aliases
+var queryKeywords = map[string]bool{
+	"select": true, "distinct": true, "from": true, "where": true, "group": true, "by": true,
+	"order": true, "asc": true, "desc": true, "limit": true, "and": true, "or": true,
+	"not": true, "like": true, "as": true,
+}
+
+// queryAggregates are the aggregate functions
+var queryAggregates = map[string]bool{"count": true, "sum": true, "avg": true, "min": true, "max": true}
+
+// QueryTable evaluates a query against the tables returned by lookup and
+// returns the result as a new table
+func QueryTable(statement string, lookup func(name string) (*proto.TableResult, bool)) (*proto.TableResult, error) {
+	tokens, err := tokenizeQuery(statement)
+	if err != nil {
+		return nil, err
+	}
+	parser := &queryParser{tokens: tokens, statement: statement}
+	query, err := parser.parseSelect()
+	if err != nil {
+		return nil, err
+	}
+
+	table, ok := lookup(query.from)
+	if !ok {
+		return nil, fmt.Errorf("unknown table %q", query.from)
+	}
+	if err := query.resolve(table); err != nil {
+		return nil, err
+	}
+	return query.run(table)
+}
+
+// Tokens
+
+type queryTokenKind int
+
+const (
+	tokenEnd queryTokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenSymbol
+)
+
+type queryToken struct {
+	kind   queryTokenKind
+	text   string
+	quoted bool // Identifier written in quotes, never a keyword
+	pos    int  // Offset in the statement
+	end    int
+}
+
+// tokenizeQuery splits a statement into tokens
+func tokenizeQuery(statement string) ([]queryToken, error) {
+	var tokens []queryToken
+
+	// Offsets use the width of the runes in the statement, an invalid byte
+	// is one rune of width 1
+	var runes []rune
+	var offsets []int
+	for offset := 0; offset < len(statement); {
+		r, size := utf8.DecodeRuneInString(statement[offset:])
+		runes = append(runes, r)
+		offsets = append(offsets, offset)
+		offset += size
+	}
+	offsets = append(offsets, len(statement))
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+
+		case r == '\'' || r == '"' || r == '`':
+			var sb strings.Builder
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == r {
+					// A doubled quote stands for the quote itself
+					if i+1 < len(runes) && runes[i+1] == r {
+						sb.WriteRune(r)
+						i++
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated %c at position %d", r, start+1)
+			}
+			kind := tokenIdent
+			if r == '\'' {
+				kind = tokenString
+			}
+			tokens = append(tokens, queryToken{kind: kind, text: sb.String(), quoted: true, pos: offsets[start], end: offsets[i]})
+			continue
+
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, queryToken{kind: tokenIdent, text: string(runes[start:i]), pos: offsets[start], end: offsets[i]})
+			continue
+
+		case unicode.IsDigit(r) || r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, queryToken{kind: tokenNumber, text: string(runes[start:i]), pos: offsets[start], end: offsets[i]})
+			continue
+		}
+
+		if i+1 < len(runes) {
+			if pair := string(runes[i : i+2]); pair == "<=" || pair == ">=" || pair == "<>" || pair == "!=" {
+				tokens = append(tokens, queryToken{kind: tokenSymbol, text: pair, pos: offsets[i], end: offsets[i+2]})
+				i += 2
+				continue
+			}
+		}
+		if !strings.ContainsRune("(),*+-/=<>", r) {
+			return nil, fmt.Errorf("unexpected %q at position %d", r, i+1)
+		}
+		tokens = append(tokens, queryToken{kind: tokenSymbol, text: string(r), pos: offsets[i], end: offsets[i+1]})
+		i++
+	}
+	return append(tokens, queryToken{kind: tokenEnd, pos: len(statement), end: len(statement)}), nil
+}
+
+// Values
+
+// queryValue is a cell or a computed value
+type queryValue struct {
+	text    string
+	number  float64
+	numeric bool
+	boolean bool
+}
+
+// cellValue returns the value of a cell, a number if it parses as one
+func cellValue(text string) queryValue {
+	number, ok := parseQueryNumber(text)
+	return queryValue{text: text, number: number, numeric: ok}
+}
+
+// numberValue returns a computed number
+func numberValue(number float64) queryValue {
+	return queryValue{text: strconv.FormatFloat(math.Round(number*100)/100, 'f', -1, 64), number: number, numeric: true}
+}
+
+// boolValue returns the result of a condition
+func boolValue(b bool) queryValue {
+	value := queryValue{text: strconv.FormatBool(b), boolean: true}
+	if b {
+		value.number = 1
+	}
+	return value
+}
+
+// truthy checks whether a value satisfies a condition
+func (v queryValue) truthy() bool {
+	if v.boolean || v.numeric {
+		return v.number != 0
+	}
+	return v.text != ""
+}
+
+// parseQueryNumber parses a number; blanks and thousands separators are ignored
+func parseQueryNumber(text string) (float64, bool) {
+	text = strings.NewReplacer(",", "", " ", "", "\u00a0", "").Replace(strings.TrimSpace(text))
+	if text == "" {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	return value, err == nil && !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// compareQueryValues compares two values as numbers if both are, otherwise as text
+func compareQueryValues(a, b queryValue) int {
+	if a.numeric && b.numeric {
+		switch {
+		case a.number < b.number:
+			return -1
+		case a.number > b.number:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a.text, b.text)
+}
+
+// likeQueryValue matches a value against a LIKE pattern with % and _, ignoring case
+func likeQueryValue(text, pattern string) bool {
+	t, p := []rune(strings.ToLower(text)), []rune(strings.ToLower(pattern))
+	// Classic wildcard matching with backtracking to the last %
+	ti, pi, star, mark := 0, 0, -1, 0
+	for ti < len(t) {
+		switch {
+		case pi < len(p) && (p[pi] == '_' || p[pi] == t[ti]):
+			ti++
+			pi++
+		case pi < len(p) && p[pi] == '%':
+			star, mark = pi, ti
+			pi++
+		case star >= 0:
+			pi = star + 1
+			mark++
+			ti = mark
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '%' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+// Expressions
+
+type queryExpr interface{}
+
+type columnExpr struct {
+	name  string
+	index int
+}
+
+type literalExpr struct {
+	value queryValue
+}
+
+type unaryExpr struct {
+	op      string
+	operand queryExpr
+}
+
+type binaryExpr struct {
+	op          string
+	left, right queryExpr
+}
+
+type aggregateExpr struct {
+	function string
+	arg      queryExpr // nil for count(*)
+}
+
+// selectItem is an expression of the select list
+type selectItem struct {
+	expr  queryExpr
+	label string
+	all   bool // "*"
+}
+
+// orderItem is an expression of the ORDER BY clause
+type orderItem struct {
+	expr       queryExpr
+	output     int // Index of the select item sorted by, -1 for an expression
+	descending bool
+}
+
+// selectQuery is a parsed query
+type selectQuery struct {
+	distinct bool
+	items    []selectItem
+	from     string
+	where    queryExpr
+	groupBy  []*columnExpr
+	orderBy  []orderItem
+	limit    int // -1 without LIMIT
+	grouped  bool
+}
+
+// Parser
+
+type queryParser struct {
+	tokens    []queryToken
+	pos       int
+	statement string
+}
+
+// peek returns the next token; past the end it is the end token
+func (p *queryParser) peek() queryToken {
+	return p.tokens[min(p.pos, len(p.tokens)-1)]
+}
+
+// next consumes the next token
+func (p *queryParser) next() queryToken {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+// keyword consumes the keyword if it comes next
+func (p *queryParser) keyword(word string) bool {
+	token := p.peek()
+	if token.kind == tokenIdent && !token.quoted && strings.EqualFold(token.text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// symbol consumes the symbol if it comes next
+func (p *queryParser) symbol(text string) bool {
+	if token := p.peek(); token.kind == tokenSymbol && token.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// unexpected returns the error for the next token
+func (p *queryParser) unexpected(expected string) error {
+	token := p.peek()
+	if token.kind == tokenEnd {
+		return fmt.Errorf("expected %s at the end of the query", expected)
+	}
+	return fmt.Errorf("expected %s near %q", expected, p.statement[token.pos:])
+}
+
+func (p *queryParser) expectKeyword(word string) error {
+	if !p.keyword(word) {
+		return p.unexpected(strings.ToUpper(word))
+	}
+	return nil
+}
+
+func (p *queryParser) parseSelect() (*selectQuery, error) {
+	query := &selectQuery{limit: -1}
+	if err := p.expectKeyword("select"); err != nil {
+		return nil, err
+	}
+	query.distinct = p.keyword("distinct")
+
+	for {
+		start := p.peek().pos
+		if p.symbol("*") {
+			query.items = append(query.items, selectItem{all: true})
+		} else {
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			item := selectItem{expr: expr, label: strings.TrimSpace(p.statement[start:p.tokens[p.pos-1].end])}
+			if column, ok := expr.(*columnExpr); ok {
+				item.label = column.name
+			}
+			if p.keyword("as") {
+				token := p.next()
+				if token.kind != tokenIdent {
+					p.pos--
+					return nil, p.unexpected("a column name after AS")
+				}
+				item.label = token.text
+			} else if token := p.peek(); token.kind == tokenIdent && (token.quoted || !queryKeywords[strings.ToLower(token.text)]) {
+				item.label = p.next().text
+			}
+			query.items = append(query.items, item)
+		}
+		if !p.symbol(",") {
+			break
+		}
+	}
+
+	if err := p.expectKeyword("from"); err != nil {
+		return nil, err
+	}
+	token := p.next()
+	if token.kind != tokenIdent {
+		p.pos--
+		return nil, p.unexpected("a table name")
+	}
+	query.from = token.text
+
+	if p.keyword("where") {
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		query.where = expr
+	}
+
+	if p.keyword("group") {
+		if err := p.expectKeyword("by"); err != nil {
+			return nil, err
+		}
+		for {
+			token := p.next()
+			if token.kind != tokenIdent {
+				p.pos--
+				return nil, p.unexpected("a column name")
+			}
+			query.groupBy = append(query.groupBy, &columnExpr{name: token.text})
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.keyword("order") {
+		if err := p.expectKeyword("by"); err != nil {
+			return nil, err
+		}
+		for {
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			item := orderItem{expr: expr, output: -1}
+			if p.keyword("desc") {
+				item.descending = true
+			} else {
+				p.keyword("asc")
+			}
+			query.orderBy = append(query.orderBy, item)
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.keyword("limit") {
+		token := p.next()
+		limit, err := strconv.Atoi(token.text)
+		if token.kind != tokenNumber || err != nil {
+			p.pos--
+			return nil, p.unexpected("a number after LIMIT")
+		}
+		query.limit = limit
+	}
+
+	if p.peek().kind != tokenEnd {
+		return nil, p.unexpected("the end of the query")
+	}
+	return query, nil
+}
+
+func (p *queryParser) parseExpr() (queryExpr, error) {
+	return p.parseOr()
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseNot() (queryExpr, error) {
+	if p.keyword("not") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpr{op: "not", operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *queryParser) parseComparison() (queryExpr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	op := ""
+	switch token := p.peek(); {
+	case token.kind == tokenSymbol && (token.text == "=" || token.text == "!=" || token.text == "<>" ||
+		token.text == "<" || token.text == "<=" || token.text == ">" || token.text == ">="):
+		op = p.next().text
+	case p.keyword("like"):
+		op = "like"
+	case p.keyword("not"):
+		if err := p.expectKeyword("like"); err != nil {
+			return nil, err
+		}
+		op = "not like"
+	}
+	if op == "" {
+		return left, nil
+	}
+
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return &binaryExpr{op: op, left: left, right: right}, nil
+}
+
+func (p *queryParser) parseAdditive() (queryExpr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek().text
+		if p.peek().kind != tokenSymbol || (op != "+" && op != "-") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: op, left: left, right: right}
+	}
+}
+
+func (p *queryParser) parseMultiplicative() (queryExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek().text
+		if p.peek().kind != tokenSymbol || (op != "*" && op != "/") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: op, left: left, right: right}
+	}
+}
+
+func (p *queryParser) parseUnary() (queryExpr, error) {
+	if p.symbol("-") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpr{op: "-", operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryExpr, error) {
+	token := p.next()
+	switch token.kind {
+	case tokenNumber:
+		value := cellValue(token.text)
+		if !value.numeric {
+			p.pos--
+			return nil, p.unexpected("a number")
+		}
+		return &literalExpr{value: value}, nil
+
+	case tokenString:
+		return &literalExpr{value: cellValue(token.text)}, nil
+
+	case tokenIdent:
+		function := strings.ToLower(token.text)
+		if !token.quoted && queryAggregates[function] && p.symbol("(") {
+			aggregate := &aggregateExpr{function: function}
+			if !(function == "count" && p.symbol("*")) {
+				arg, err := p.parseExpr()
+				if err != nil {
+					return nil, err
+				}
+				aggregate.arg = arg
+			}
+			if !p.symbol(")") {
+				return nil, p.unexpected(")")
+			}
+			return aggregate, nil
+		}
+		if !token.quoted && queryKeywords[function] {
+			p.pos--
+			return nil, p.unexpected("a value or column")
+		}
+		return &columnExpr{name: token.text}, nil
+
+	case tokenSymbol:
+		if token.text == "(" {
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if !p.symbol(")") {
+				return nil, p.unexpected(")")
+			}
+			return expr, nil
+		}
+	}
+	p.pos--
+	return nil, p.unexpected("a value or column")
+}
+
+// Resolution
+
+// resolve binds the column names to the columns of the table and checks the use of aggregates
+func (q *selectQuery) resolve(table *proto.TableResult) error {
+	column := func(name string) (int, error) {
+		for i, c := range table.Columns {
+			if strings.EqualFold(c.Name, name) {
+				return i, nil
+			}
+		}
+		names := make([]string, len(table.Columns))
+		for i, c := range table.Columns {
+			names[i] = c.Name
+		}
+		return -1, fmt.Errorf("unknown column %q (columns: %s)", name, strings.Join(names, ", "))
+	}
+
+	var bind func(expr queryExpr, inAggregate bool) (bool, error)
+	bind = func(expr queryExpr, inAggregate bool) (bool, error) {
+		switch e := expr.(type) {
+		case *columnExpr:
+			index, err := column(e.name)
+			e.index = index
+			return false, err
+		case *unaryExpr:
+			return bind(e.operand, inAggregate)
+		case *binaryExpr:
+			left, err := bind(e.left, inAggregate)
+			if err != nil {
+				return false, err
+			}
+			right, err := bind(e.right, inAggregate)
+			return left || right, err
+		case *aggregateExpr:
+			if inAggregate {
+				return false, fmt.Errorf("aggregates cannot be nested")
+			}
+			if e.arg != nil {
+				if _, err := bind(e.arg, true); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if q.where != nil {
+		aggregate, err := bind(q.where, false)
+		if err != nil {
+			return err
+		}
+		if aggregate {
+			return fmt.Errorf("aggregates are not allowed in WHERE")
+		}
+	}
+	for _, group := range q.groupBy {
+		if _, err := bind(group, false); err != nil {
+			return err
+		}
+	}
+
+	q.grouped = len(q.groupBy) > 0
+	for _, item := range q.items {
+		if item.all {
+			continue
+		}
+		aggregate, err := bind(item.expr, false)
+		if err != nil {
+			return err
+		}
+		q.grouped = q.grouped || aggregate
+	}
+
+	// ORDER BY refers to a result column by name or position, or to an expression
+	for i := range q.orderBy {
+		order := &q.orderBy[i]
+		if column, ok := order.expr.(*columnExpr); ok {
+			for j, item := range q.items {
+				if !item.all && strings.EqualFold(item.label, column.name) {
+					order.output = j
+				}
+			}
+		}
+		if literal, ok := order.expr.(*literalExpr); ok && literal.value.numeric {
+			position := int(literal.value.number)
+			if float64(position) != literal.value.number || position < 1 || position > len(q.items) {
+				return fmt.Errorf("ORDER BY position %s is out of range", literal.value.text)
+			}
+			order.output = position - 1
+		}
+		if order.output < 0 {
+			if _, err := bind(order.expr, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !q.grouped {
+		return nil
+	}
+	if q.hasAll() {
+		return fmt.Errorf("* cannot be used with GROUP BY or aggregates")
+	}
+	for _, item := range q.items {
+		if err := q.checkGrouped(item.expr); err != nil {
+			return err
+		}
+	}
+	for _, order := range q.orderBy {
+		if order.output < 0 {
+			if err := q.checkGrouped(order.expr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasAll checks whether the select list contains "*"
+func (q *selectQuery) hasAll() bool {
+	for _, item := range q.items {
+		if item.all {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGrouped checks that the columns of a grouped query outside of aggregates are grouped by
+func (q *selectQuery) checkGrouped(expr queryExpr) error {
+	switch e := expr.(type) {
+	case *columnExpr:
+		for _, group := range q.groupBy {
+			if group.index == e.index {
+				return nil
+			}
+		}
+		return fmt.Errorf("column %q must be in GROUP BY or used in an aggregate", e.name)
+	case *unaryExpr:
+		return q.checkGrouped(e.operand)
+	case *binaryExpr:
+		if err := q.checkGrouped(e.left); err != nil {
+			return err
+		}
+		return q.checkGrouped(e.right)
+	}
+	return nil
+}
+
+// Evaluation
+
+// eval evaluates an expression for a group of rows; columns take the value of
+// the first row, aggregates are computed over all rows
+func eval(expr queryExpr, rows [][]string) queryValue {
+	switch e := expr.(type) {
+	case *columnExpr:
+		if len(rows) == 0 || e.index >= len(rows[0]) {
+			return queryValue{}
+		}
+		return cellValue(rows[0][e.index])
+
+	case *literalExpr:
+		return e.value
+
+	case *unaryExpr:
+		operand := eval(e.operand, rows)
+		if e.op == "not" {
+			return boolValue(!operand.truthy())
+		}
+		if !operand.numeric {
+			return queryValue{}
+		}
+		return numberValue(-operand.number)
+
+	case *binaryExpr:
+		left := eval(e.left, rows)
+		switch e.op {
+		case "and":
+			return boolValue(left.truthy() && eval(e.right, rows).truthy())
+		case "or":
+			return boolValue(left.truthy() || eval(e.right, rows).truthy())
+		}
+		right := eval(e.right, rows)
+		switch e.op {
+		case "=":
+			return boolValue(compareQueryValues(left, right) == 0)
+		case "!=", "<>":
+			return boolValue(compareQueryValues(left, right) != 0)
+		case "<":
+			return boolValue(compareQueryValues(left, right) < 0)
+		case "<=":
+			return boolValue(compareQueryValues(left, right) <= 0)
+		case ">":
+			return boolValue(compareQueryValues(left, right) > 0)
+		case ">=":
+			return boolValue(compareQueryValues(left, right) >= 0)
+		case "like":
+			return boolValue(likeQueryValue(left.text, right.text))
+		case "not like":
+			return boolValue(!likeQueryValue(left.text, right.text))
+		}
+		// Arithmetic on anything but numbers gives an empty value, as does a division by zero
+		if !left.numeric || !right.numeric {
+			return queryValue{}
+		}
+		switch e.op {
+		case "+":
+			return numberValue(left.number + right.number)
+		case "-":
+			return numberValue(left.number - right.number)
+		case "*":
+			return numberValue(left.number * right.number)
+		case "/":
+			if right.number == 0 {
+				return queryValue{}
+			}
+			return numberValue(left.number / right.number)
+		}
+
+	case *aggregateExpr:
+		return aggregate(e, rows)
+	}
+	return queryValue{}
+}
+
+// aggregate computes an aggregate over rows; empty cells are left out
+func aggregate(e *aggregateExpr, rows [][]string) queryValue {
+	if e.arg == nil {
+		return numberValue(float64(len(rows)))
+	}
+
+	var values []queryValue
+	for _, row := range rows {
+		if value := eval(e.arg, [][]string{row}); strings.TrimSpace(value.text) != "" {
+			values = append(values, value)
+		}
+	}
+
+	switch e.function {
+	case "count":
+		return numberValue(float64(len(values)))
+	case "sum", "avg":
+		sum, count := 0.0, 0
+		for _, value := range values {
+			if value.numeric {
+				sum += value.number
+				count++
+			}
+		}
+		if e.function == "sum" {
+			return numberValue(sum)
+		}
+		if count == 0 {
+			return queryValue{}
+		}
+		return numberValue(sum / float64(count))
+	}
+
+	// min and max keep the cell as it was written
+	if len(values) == 0 {
+		return queryValue{}
+	}
+	best := values[0]
+	for _, value := range values[1:] {
+		if c := compareQueryValues(value, best); (e.function == "min" && c < 0) || (e.function == "max" && c > 0) {
+			best = value
+		}
+	}
+	return best
+}
+
+// isNumericExpr checks whether an expression gives numbers, used to align the result columns
+func isNumericExpr(expr queryExpr, table *proto.TableResult) bool {
+	switch e := expr.(type) {
+	case *columnExpr:
+		return table.Columns[e.index].Numeric
+	case *literalExpr:
+		return e.value.numeric
+	case *unaryExpr:
+		return e.op == "-"
+	case *binaryExpr:
+		return strings.Contains("+-*/", e.op)
+	case *aggregateExpr:
+		return (e.function != "min" && e.function != "max") || isNumericExpr(e.arg, table)
+	}
+	return false
+}
+
+// resultRow is a row of the result with the values it is sorted by
+type resultRow struct {
+	cells []string
+	keys  []queryValue
+}
+
+// run evaluates the query against the table
+func (q *selectQuery) run(table *proto.TableResult) (*proto.TableResult, error) {
+	result := &proto.TableResult{}
+	for _, item := range q.items {
+		if item.all {
+			for _, column := range table.Columns {
+				result.Columns = append(result.Columns, &proto.TableColumn{Name: column.Name, Numeric: column.Numeric})
+			}
+			continue
+		}
+		result.Columns = append(result.Columns, &proto.TableColumn{Name: item.label, Numeric: isNumericExpr(item.expr, table)})
+	}
+
+	var rows [][]string
+	for _, row := range table.Rows {
+		cells := row.Cells
+		if len(cells) < len(table.Columns) {
+			cells = append(append([]string(nil), cells...), make([]string, len(table.Columns)-len(cells))...)
+		}
+		if q.where == nil || eval(q.where, [][]string{cells}).truthy() {
+			rows = append(rows, cells)
+		}
+	}
+
+	// A group per row, per distinct grouped values or one for all rows
+	var groups [][][]string
+	switch {
+	case len(q.groupBy) > 0:
+		index := make(map[string]int)
+		for _, row := range rows {
+			keys := make([]string, len(q.groupBy))
+			for i, group := range q.groupBy {
+				keys[i] = row[group.index]
+			}
+			key := strings.Join(keys, "\x00")
+			if i, ok := index[key]; ok {
+				groups[i] = append(groups[i], row)
+				continue
+			}
+			index[key] = len(groups)
+			groups = append(groups, [][]string{row})
+		}
+	case q.grouped:
+		groups = [][][]string{rows}
+	default:
+		for _, row := range rows {
+			groups = append(groups, [][]string{row})
+		}
+	}
+
+	var results []resultRow
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		var cells []string
+		itemCells := make([]int, len(q.items))
+		for i, item := range q.items {
+			itemCells[i] = len(cells)
+			if item.all {
+				cells = append(cells, group[0]...)
+				continue
+			}
+			cells = append(cells, eval(item.expr, group).text)
+		}
+		if q.distinct {
+			key := strings.Join(cells, "\x00")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+
+		keys := make([]queryValue, len(q.orderBy))
+		for i, order := range q.orderBy {
+			if order.output >= 0 {
+				keys[i] = cellValue(cells[itemCells[order.output]])
+			} else {
+				keys[i] = eval(order.expr, group)
+			}
+		}
+		results = append(results, resultRow{cells: cells, keys: keys})
+	}
+
+	if len(q.orderBy) > 0 {
+		sort.SliceStable(results, func(i, j int) bool {
+			for k, order := range q.orderBy {
+				c := compareQueryValues(results[i].keys[k], results[j].keys[k])
+				if order.descending {
+					c = -c
+				}
+				if c != 0 {
+					return c < 0
+				}
+			}
+			return false
+		})
+	}
+	if q.limit >= 0 && len(results) > q.limit {
+		results = results[:q.limit]
+	}
+
+	for _, row := range results {
+		result.Rows = append(result.Rows, &proto.TableRow{Cells: row.cells})
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+// query_test.go
+/**
+ * Nexuflex Client - Local Query Tests
+ *
+ * This file contains tests of the local queries against a small sales
+ * table, and a fuzz test making sure that no statement makes the parser
+ * or the evaluation panic. The seeds of the fuzz test include statements
+ * that did.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strings"
+	"testing"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// salesTable returns the table the queries run against as "last"
+func salesTable(name string) (*proto.TableResult, bool) {
+	if name != "last" {
+		return nil, false
+	}
+	table := &proto.TableResult{Columns: []*proto.TableColumn{
+		{Name: "region"}, {Name: "amount", Numeric: true}, {Name: "sales rep"},
+	}}
+	for _, row := range [][]string{
+		{"North", "1,200", "Ann"},
+		{"South", "800", "Bob"},
+		{"North", "300", "Carl"},
+		{"Süd", "50", "Dörte"},
+	} {
+		table.Rows = append(table.Rows, &proto.TableRow{Cells: row})
+	}
+	return table, true
+}
+
+// tableText returns the rows of a table as lines of cells separated by "|"
+func tableText(table *proto.TableResult) string {
+	var lines []string
+	for _, row := range table.Rows {
+		lines = append(lines, strings.Join(row.Cells, "|"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestQueryTable(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+	}{
+		{"select region, sum(amount) as total from last group by region order by total desc", "North|1500\nSouth|800\nSüd|50"},
+		{"select `sales rep` from last where amount > 500 order by `sales rep`", "Ann\nBob"},
+		{"select \"sales rep\" from last where region = 'Süd'", "Dörte"},
+		{"select count(*) from last where region like 'S%'", "2"},
+		{"select distinct region from last order by region limit 2", "North\nSouth"},
+	}
+	for _, test := range tests {
+		result, err := QueryTable(test.statement, salesTable)
+		if err != nil {
+			t.Errorf("%s: %v", test.statement, err)
+			continue
+		}
+		if got := tableText(result); got != test.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.statement, got, test.want)
+		}
+	}
+}
+
+func TestQueryTableErrors(t *testing.T) {
+	for _, statement := range []string{
+		"select region from nowhere",
+		"select region from last where",
+		"select 'unterminated from last",
+		"select region from last limit x",
+		"seleCt'\xda0'",
+		"select \xff from last",
+	} {
+		if _, err := QueryTable(statement, salesTable); err == nil {
+			t.Errorf("%q: no error", statement)
+		}
+	}
+}
+
+func FuzzQueryTable(f *testing.F) {
+	for _, statement := range []string{
+		"select * from last",
+		"select region, sum(amount) as total from last group by region order by total desc",
+		"select `sales rep` from last where amount > 500 and not region like 'S%'",
+		"select (amount + 1) * 2 / 3 - 4 from last order by 1 limit 2",
+		"seleCt'\xda0'", // Invalid UTF-8 made the offsets point beyond the statement
+		"select 'ä\xe2\x82' from last",
+	} {
+		f.Add(statement)
+	}
+	f.Fuzz(func(t *testing.T, statement string) {
+		QueryTable(statement, salesTable)
+	})
+}
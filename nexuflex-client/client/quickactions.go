@@ -0,0 +1,96 @@
+// quickactions.go
+/**
+ * Nexuflex Client - Quick Actions
+ *
+ * This file contains the quick actions contributed by the server: curated,
+ * named commands with which service owners promote their most-used
+ * workflows. They are retrieved with GetQuickActions when the command
+ * palette is opened first and cached like the other server metadata.
+ * Placeholders "${name}" in the command template are asked for before
+ * the action runs, as in flows.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quickActionCache holds the quick actions of the connected server
+type quickActionCache struct {
+	mu      sync.Mutex
+	actions []*proto.QuickAction
+	loaded  bool
+}
+
+// GetQuickActions returns the quick actions of the server, retrieving them
+// on the first call or with refresh; servers without quick actions return none
+func (c *Client) GetQuickActions(refresh bool) ([]*proto.QuickAction, error) {
+	c.quickActions.mu.Lock()
+	cached, loaded := c.quickActions.actions, c.quickActions.loaded
+	c.quickActions.mu.Unlock()
+	if loaded && !refresh {
+		return cached, nil
+	}
+
+	if c.client == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+	if c.sessionToken == "" {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var actions []*proto.QuickAction
+	resp, err := c.metadataClient().GetQuickActions(ctx, &proto.QuickActionsRequest{
+		SessionToken: c.sessionToken,
+	})
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		// Servers from before quick actions have none
+	case err != nil:
+		c.logger("Error retrieving quick actions: %v", err)
+		return nil, fmt.Errorf("error retrieving quick actions: %v", err)
+	case !resp.Success:
+		return nil, fmt.Errorf("error retrieving quick actions: %s", resp.ErrorMessage)
+	default:
+		actions = resp.Actions
+	}
+
+	c.quickActions.mu.Lock()
+	c.quickActions.actions = actions
+	c.quickActions.loaded = true
+	c.quickActions.mu.Unlock()
+	return actions, nil
+}
+
+// clearQuickActions forgets the cached quick actions (on logout or reconnect)
+func (c *Client) clearQuickActions() {
+	c.quickActions.mu.Lock()
+	c.quickActions.actions = nil
+	c.quickActions.loaded = false
+	c.quickActions.mu.Unlock()
+}
+
+// QuickActionVariables returns the names of the placeholders of a quick action
+func QuickActionVariables(action *proto.QuickAction) []string {
+	return FlowStep{Command: action.CommandTemplate}.StepVariables()
+}
+
+// ExpandQuickAction returns the command of a quick action with its placeholders replaced
+func ExpandQuickAction(action *proto.QuickAction, values map[string]string) (string, error) {
+	return expandFlowVariables(action.CommandTemplate, values)
+}
@@ -0,0 +1,72 @@
+// readonly.go
+/**
+ * Nexuflex Client - Read-Only Mode
+ *
+ * This file contains the client-enforced read-only mode. While it is on,
+ * commands that change data are refused before they are sent: commands
+ * matching `mutating_commands` or `critical_commands` in the configuration
+ * and commands flagged mutating or critical in the command metadata of the
+ * server. Queries keep working, so the mode suits change freezes and
+ * trainees shadowing a production system.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// ReadOnlyError reports that a command was refused because read-only mode is on
+type ReadOnlyError struct {
+	Command string
+}
+
+// Error implements the error interface
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("read-only mode, command was not executed: %s", e.Command)
+}
+
+// SetReadOnly switches the read-only mode on or off
+func (c *Client) SetReadOnly(readOnly bool) {
+	c.readOnly.Store(readOnly)
+	c.logger("Read-only mode: %v", readOnly)
+}
+
+// IsReadOnly checks whether the read-only mode is on
+func (c *Client) IsReadOnly() bool {
+	return c.readOnly.Load()
+}
+
+// IsMutatingCommand checks whether a command changes data according to the
+// configuration or the command metadata of the server; critical commands
+// always count as mutating
+func (c *Client) IsMutatingCommand(command string) bool {
+	name := strings.SplitN(strings.TrimSpace(command), " ", 2)[0]
+	if name == "" {
+		return false
+	}
+
+	if matchesCommandPattern(name, c.config.Commands.MutatingCommands) {
+		return true
+	}
+	if c.hasCommandFlag(name, func(info *proto.CommandInfo) bool { return info.Mutating }) {
+		return true
+	}
+	return c.IsCriticalCommand(command)
+}
+
+// checkReadOnly returns a ReadOnlyError if a command must not be sent in read-only mode
+func (c *Client) checkReadOnly(command string) error {
+	if !c.IsReadOnly() || !c.IsMutatingCommand(command) {
+		return nil
+	}
+	c.logger("Command refused in read-only mode: %s", c.MaskCommand(command))
+	return &ReadOnlyError{Command: command}
+}
@@ -0,0 +1,214 @@
+// recent.go
+/**
+ * Nexuflex Client - Recently Used Servers
+ *
+ * This file contains the list of recently connected servers together with
+ * the last user and the last service context, which is stored in the user
+ * configuration directory and used for quickly switching between servers.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+)
+
+// maxRecentServers is the number of servers kept in the list
+const maxRecentServers = 10
+
+// RecentServer is a server the client was connected to
+type RecentServer struct {
+	Address     string    `json:"address"`
+	Port        int       `json:"port"`
+	TLS         bool      `json:"tls"`
+	Name        string    `json:"name"`
+	LastUser    string    `json:"last_user,omitempty"`
+	LastContext string    `json:"last_context,omitempty"`
+	LastUsed    time.Time `json:"last_used"`
+}
+
+// RecentServers manages the list of recently used servers
+type RecentServers struct {
+	mu      sync.Mutex
+	servers []*RecentServer
+	path    string
+}
+
+// NewRecentServers creates the list and loads it from the user configuration directory
+func NewRecentServers() *RecentServers {
+	r := &RecentServers{}
+	if userConfigDir, err := os.UserConfigDir(); err == nil {
+		r.path = filepath.Join(userConfigDir, "nexuflex", "recent_servers.json")
+		r.load()
+	}
+	return r
+}
+
+// load reads the stored list; an unreadable list is ignored
+func (r *RecentServers) load() {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return
+	}
+	var servers []*RecentServer
+	if err := json.Unmarshal(data, &servers); err == nil {
+		r.servers = servers
+	}
+}
+
+// save writes the list atomically; must be called with the lock held
+func (r *RecentServers) save() error {
+	if r.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(r.servers, "", "  ")
+	if err != nil {
+		return err
+	}
+	return state.WriteFileAtomic(r.path, data)
+}
+
+// GetServers returns the servers, most recently used first
+func (r *RecentServers) GetServers() []RecentServer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]RecentServer, len(r.servers))
+	for i, server := range r.servers {
+		result[i] = *server
+	}
+	return result
+}
+
+// update changes the entry of a server and moves it to the top of the list
+func (r *RecentServers) update(address string, port int, change func(server *RecentServer)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var entry *RecentServer
+	for _, server := range r.servers {
+		if server.Address == address && server.Port == port {
+			entry = server
+			break
+		}
+	}
+	if entry == nil {
+		entry = &RecentServer{Address: address, Port: port}
+		r.servers = append(r.servers, entry)
+	}
+
+	change(entry)
+	entry.LastUsed = time.Now()
+
+	sort.SliceStable(r.servers, func(i, j int) bool {
+		return r.servers[i].LastUsed.After(r.servers[j].LastUsed)
+	})
+	if len(r.servers) > maxRecentServers {
+		r.servers = r.servers[:maxRecentServers]
+	}
+
+	return r.save()
+}
+
+// GetRecentServers returns the recently used servers, most recent first
+func (c *Client) GetRecentServers() []RecentServer {
+	return c.recentServers.GetServers()
+}
+
+// rememberServer records the connected server, user and context in the recent list
+func (c *Client) rememberServer() {
+	if c.serverInfo == nil {
+		return
+	}
+
+	err := c.recentServers.update(c.serverInfo.Address, int(c.serverInfo.Port), func(server *RecentServer) {
+		server.TLS = c.serverInfo.TlsEnabled
+		server.Name = c.serverInfo.ShortName
+		if c.username != "" {
+			server.LastUser = c.username
+		}
+		if c.lastServiceUsed != "" {
+			server.LastContext = c.lastServiceUsed
+		}
+	})
+	if err != nil {
+		c.logger("Error saving recent servers: %v", err)
+	}
+}
+
+// Reconnect connects to the current server again, e.g. after it was unavailable,
+// and logs in like ConnectRecent; returns whether the login succeeded
+func (c *Client) Reconnect() (bool, error) {
+	if c.serverInfo == nil {
+		return false, fmt.Errorf("not connected to server")
+	}
+
+	c.reconnecting.Store(false)
+	return c.connectRecent(c.currentServer(), c.config.Server.KeepStateOnReconnect)
+}
+
+// currentServer returns the connected server and context as a recent server entry
+func (c *Client) currentServer() RecentServer {
+	return RecentServer{
+		Address:     c.serverInfo.Address,
+		Port:        int(c.serverInfo.Port),
+		TLS:         c.serverInfo.TlsEnabled,
+		Name:        c.serverInfo.ShortName,
+		LastContext: c.lastServiceUsed,
+	}
+}
+
+// ConnectRecent connects to a recently used server, logs in with the credentials
+// stored in the keyring (if enabled and available) and restores the last context
+func (c *Client) ConnectRecent(server RecentServer) (bool, error) {
+	c.reconnecting.Store(false)
+	return c.connectRecent(server, false)
+}
+
+// connectRecent connects and logs in like ConnectRecent; keepState keeps the
+// state of the previous connection to the same server
+func (c *Client) connectRecent(server RecentServer, keepState bool) (bool, error) {
+	if err := c.connect(server.Address, server.Port, server.TLS, keepState); err != nil {
+		return false, err
+	}
+	return c.loginRecent(server)
+}
+
+// loginRecent logs in to the connected server with the stored credentials
+// and restores the last context of the server; false if there are none
+func (c *Client) loginRecent(server RecentServer) (bool, error) {
+	if !c.config.Auth.AutoLoginRecent || !c.HasStoredCredentials() {
+		return false, nil
+	}
+
+	values, err := LoadCredentials(c.serverInfo.Address, c.serverInfo.Port)
+	if err != nil {
+		return false, nil
+	}
+	req, err := c.authProvider.BuildLoginRequest(values)
+	if err != nil {
+		return false, fmt.Errorf("automatic login failed: %v", err)
+	}
+	if err := c.login(req, true); err != nil {
+		return false, err
+	}
+
+	// Restore the last service context
+	if server.LastContext != "" {
+		c.SetLastServiceUsed(server.LastContext)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,138 @@
+// reconnect.go
+/**
+ * Nexuflex Client - Automatic Reconnect
+ *
+ * This file contains the automatic reconnect after the keep-alive found the
+ * server unreachable (`auto_reconnect`). The client connects to the same
+ * server again with increasing delays and logs in with the stored
+ * credentials. With `keep_state_on_reconnect` the cached metadata, pending
+ * approvals and the job list of the lost connection are kept, and the user
+ * interface keeps its output; the callbacks let it mark the boundary.
+ * After every failed attempt the other nodes of the cluster are tried
+ * (see failover.go).
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+const (
+	// maxReconnectAttempts is the number of automatic reconnect attempts
+	maxReconnectAttempts = 10
+
+	// maxReconnectDelay limits the delay between reconnect attempts
+	maxReconnectDelay = 30 * time.Second
+)
+
+// SetConnectionLostCallback sets the function called when the connection to a server was lost
+func (c *Client) SetConnectionLostCallback(onLost func(server string, err error)) {
+	c.onConnectionLost = onLost
+}
+
+// SetReconnectedCallback sets the function called when a reconnect succeeded
+// or, with an error, when all attempts failed
+func (c *Client) SetReconnectedCallback(onReconnected func(server string, loggedIn bool, err error)) {
+	c.onReconnected = onReconnected
+}
+
+// KeepsStateOnReconnect returns whether the state is kept when reconnecting to the same server
+func (c *Client) KeepsStateOnReconnect() bool {
+	return c.config.Server.KeepStateOnReconnect
+}
+
+// IsReconnecting returns whether the client is reconnecting after a lost connection
+func (c *Client) IsReconnecting() bool {
+	return c.reconnecting.Load()
+}
+
+// connectionLost reports a lost connection and starts reconnecting in the background
+func (c *Client) connectionLost(err error) {
+	if c.serverInfo == nil || !c.reconnecting.CompareAndSwap(false, true) {
+		return
+	}
+
+	server := c.currentServer()
+	c.logger("Connection to %s lost: %v", server.Name, err)
+	c.publishEvent(EventDisconnect, fmt.Sprintf("%s: %v", server.Name, err))
+
+	if c.onStatusChanged != nil {
+		c.onStatusChanged(&proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_CONNECTION_ERROR,
+			SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+			ServerName:       server.Name,
+		})
+	}
+	if c.onConnectionLost != nil {
+		c.onConnectionLost(server.Name, err)
+	}
+
+	go c.autoReconnect(server, c.failoverTargets(server), c.currentSession())
+}
+
+// autoReconnect connects to the lost server again or fails over to another
+// node of its cluster; it stops when the user connects or reconnects on
+// their own in the meantime
+func (c *Client) autoReconnect(server RecentServer, targets []RecentServer, session failoverSession) {
+	var err error
+	for attempt := 1; attempt <= maxReconnectAttempts; attempt++ {
+		time.Sleep(reconnectDelay(attempt))
+		if !c.reconnecting.Load() {
+			return
+		}
+
+		c.logger("Reconnect attempt %d to %s", attempt, server.Name)
+		var loggedIn bool
+		if loggedIn, err = c.connectRecent(server, c.config.Server.KeepStateOnReconnect); err == nil {
+			c.reconnecting.Store(false)
+			c.recordReconnect()
+			if c.onReconnected != nil {
+				c.onReconnected(server.Name, loggedIn, nil)
+			}
+			return
+		}
+		c.logger("Reconnect attempt %d failed: %v", attempt, err)
+
+		// Another node of the cluster takes over while the server is down
+		for _, target := range targets {
+			if !c.reconnecting.Load() {
+				return
+			}
+			c.logger("Failover attempt %d to %s", attempt, target.Name)
+			loggedIn, sessionKept, failoverErr := c.failOver(target, session)
+			if failoverErr != nil {
+				c.logger("Failover to %s failed: %v", target.Name, failoverErr)
+				continue
+			}
+			c.reconnecting.Store(false)
+			c.recordReconnect()
+			c.logger("Failed over from %s to %s", server.Name, c.serverInfo.ShortName)
+			c.publishEvent(EventConnect, fmt.Sprintf("failover from %s to %s", server.Name, c.serverInfo.ShortName))
+			if c.onFailover != nil {
+				c.onFailover(server.Name, c.serverInfo.ShortName, loggedIn, sessionKept)
+			}
+			return
+		}
+	}
+
+	if c.reconnecting.CompareAndSwap(true, false) && c.onReconnected != nil {
+		c.onReconnected(server.Name, false, err)
+	}
+}
+
+// reconnectDelay returns the delay before a reconnect attempt, doubling from one second
+func reconnectDelay(attempt int) time.Duration {
+	delay := time.Second << (attempt - 1)
+	if delay > maxReconnectDelay {
+		return maxReconnectDelay
+	}
+	return delay
+}
@@ -0,0 +1,163 @@
+// references.go
+/**
+ * Nexuflex Client - Actionable References
+ *
+ * This file contains the recognition of references (e.g. ticket or document
+ * IDs) in command output. References are declared in the [references]
+ * section of the configuration as "<regex> => <target>", where the target
+ * is either a URL or a drill-down command. "{0}" in the target is replaced
+ * by the whole match, "{1}", "{2}", ... by the capture groups.
+ *
+ * Example:
+ *   [references]
+ *   ticket   = TCK-[0-9]+ => https://tickets.example.com/browse/{0}
+ *   document = DOC-([0-9]{6}) => Docs.Show.Document {1}
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// referenceSeparator separates the pattern from the target in a reference definition
+const referenceSeparator = "=>"
+
+// ReferenceRule describes one kind of actionable reference
+type ReferenceRule struct {
+	Name    string
+	Pattern *regexp.Regexp
+	Target  string
+}
+
+// Reference is a reference found in a text
+type Reference struct {
+	Rule   *ReferenceRule
+	Text   string
+	Target string
+	Start  int
+	End    int
+}
+
+// IsURL returns whether the reference opens a URL instead of running a command
+func (r *Reference) IsURL() bool {
+	return IsURL(r.Target)
+}
+
+// IsURL returns whether a reference target is a URL
+func IsURL(target string) bool {
+	lower := strings.ToLower(target)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// ParseReferenceRules compiles the reference definitions of the configuration
+func ParseReferenceRules(definitions map[string]string) ([]*ReferenceRule, error) {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := make([]*ReferenceRule, 0, len(names))
+	var invalid []string
+	for _, name := range names {
+		rule, err := parseReferenceRule(name, definitions[name])
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(invalid) > 0 {
+		return rules, fmt.Errorf("invalid references: %s", strings.Join(invalid, "; "))
+	}
+	return rules, nil
+}
+
+// parseReferenceRule parses a single "<regex> => <target>" definition
+func parseReferenceRule(name, definition string) (*ReferenceRule, error) {
+	sep := strings.LastIndex(definition, referenceSeparator)
+	if sep < 0 {
+		return nil, fmt.Errorf("expected \"<pattern> %s <target>\"", referenceSeparator)
+	}
+
+	pattern := strings.TrimSpace(definition[:sep])
+	target := strings.TrimSpace(definition[sep+len(referenceSeparator):])
+	if pattern == "" || target == "" {
+		return nil, fmt.Errorf("pattern and target must not be empty")
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReferenceRule{Name: name, Pattern: re, Target: target}, nil
+}
+
+// FindReferences returns the non-overlapping references in a text, ordered by position
+func FindReferences(rules []*ReferenceRule, text string) []Reference {
+	var refs []Reference
+	for _, rule := range rules {
+		for _, loc := range rule.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			if loc[1] == loc[0] {
+				continue // Ignore empty matches
+			}
+			refs = append(refs, Reference{
+				Rule:   rule,
+				Text:   text[loc[0]:loc[1]],
+				Target: expandTarget(rule.Target, text, loc),
+				Start:  loc[0],
+				End:    loc[1],
+			})
+		}
+	}
+
+	// Earlier and longer matches win over overlapping ones
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Start != refs[j].Start {
+			return refs[i].Start < refs[j].Start
+		}
+		return refs[i].End > refs[j].End
+	})
+
+	result := refs[:0]
+	lastEnd := -1
+	for _, ref := range refs {
+		if ref.Start < lastEnd {
+			continue
+		}
+		result = append(result, ref)
+		lastEnd = ref.End
+	}
+	return result
+}
+
+// expandTarget replaces the {n} placeholders of a target with the match groups
+func expandTarget(target, text string, loc []int) string {
+	var sb strings.Builder
+	for i := 0; i < len(target); i++ {
+		if target[i] == '{' {
+			if end := strings.IndexByte(target[i:], '}'); end > 1 {
+				if group, err := strconv.Atoi(target[i+1 : i+end]); err == nil && group*2+1 < len(loc) {
+					if loc[group*2] >= 0 {
+						sb.WriteString(text[loc[group*2]:loc[group*2+1]])
+					}
+					i += end
+					continue
+				}
+			}
+		}
+		sb.WriteByte(target[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,239 @@
+// report.go
+/**
+ * Nexuflex Client - HTML Session Report
+ *
+ * This file contains the export of a session transcript as a styled,
+ * self-contained HTML document. Every command is rendered as a foldable
+ * section with its outputs; the color tags of the terminal output are
+ * converted into inline styles.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// reportSection is a command together with the entries that followed it
+type reportSection struct {
+	Command TranscriptEntry
+	Entries []TranscriptEntry
+	Failed  bool
+}
+
+// reportData is passed to the report template
+type reportData struct {
+	Title         string
+	Generated     time.Time
+	ClientVersion string
+	Servers       string
+	Users         string
+	Start         time.Time
+	End           time.Time
+	Preamble      []TranscriptEntry
+	Sections      []reportSection
+}
+
+// colorTagPattern matches tview color and region tags such as [red], [yellow::b], [-] or ["id"]
+var colorTagPattern = regexp.MustCompile(`\[([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(:([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(:([lbidrus]+|-)?(:[^\]]*)?)?)?\]|\["[^"]*"\]|\[([^\[\]]*)\[\]`)
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"colors": ColorTagsToHTML,
+	"time": func(t time.Time) string {
+		return t.Format("2006-01-02 15:04:05")
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { background: #1e1e1e; color: #dddddd; font-family: sans-serif; margin: 2em; }
+h1 { font-size: 1.4em; }
+table.meta td { padding: 0.1em 1em 0.1em 0; }
+details { border-left: 3px solid #3a6ea5; margin: 0.8em 0; padding-left: 0.8em; }
+details.failed { border-left-color: #c0392b; }
+summary { cursor: pointer; font-family: monospace; color: #f0d000; }
+pre { font-family: monospace; white-space: pre-wrap; margin: 0.3em 0; }
+.time { color: #888888; font-size: 0.85em; margin-right: 1em; }
+.error { color: #ff5555; }
+.event { color: #888888; }
+@media print { body { background: #ffffff; color: #000000; } details { page-break-inside: avoid; } }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<table class="meta">
+<tr><td>Server</td><td>{{.Servers}}</td></tr>
+<tr><td>User</td><td>{{.Users}}</td></tr>
+<tr><td>Session</td><td>{{time .Start}} – {{time .End}}</td></tr>
+<tr><td>Generated</td><td>{{time .Generated}} (nexuflex-client {{.ClientVersion}})</td></tr>
+</table>
+{{range .Preamble}}<pre class="{{.Kind}}"><span class="time">{{time .Time}}</span>{{colors .Text}}</pre>
+{{end}}{{range .Sections}}<details open{{if .Failed}} class="failed"{{end}}>
+<summary><span class="time">{{time .Command.Time}}</span>{{if .Command.Context}}[{{.Command.Context}}] {{end}}&gt; {{.Command.Text}}</summary>
+{{range .Entries}}<pre class="{{.Kind}}">{{colors .Text}}</pre>
+{{end}}</details>
+{{end}}</body>
+</html>
+`))
+
+// WriteHTMLReport renders transcript entries as an HTML document
+func WriteHTMLReport(w io.Writer, title string, entries []TranscriptEntry) error {
+	data := reportData{
+		Title:         title,
+		Generated:     time.Now(),
+		ClientVersion: Version,
+	}
+
+	servers := make(map[string]bool)
+	users := make(map[string]bool)
+	var serverList, userList []string
+
+	for _, entry := range entries {
+		if data.Start.IsZero() || entry.Time.Before(data.Start) {
+			data.Start = entry.Time
+		}
+		if entry.Time.After(data.End) {
+			data.End = entry.Time
+		}
+		if entry.Server != "" && !servers[entry.Server] {
+			servers[entry.Server] = true
+			serverList = append(serverList, entry.Server)
+		}
+		if entry.User != "" && !users[entry.User] {
+			users[entry.User] = true
+			userList = append(userList, entry.User)
+		}
+
+		// Group the entries by command
+		if entry.Kind == EntryCommand {
+			data.Sections = append(data.Sections, reportSection{Command: entry})
+			continue
+		}
+		if len(data.Sections) == 0 {
+			data.Preamble = append(data.Preamble, entry)
+			continue
+		}
+		section := &data.Sections[len(data.Sections)-1]
+		section.Entries = append(section.Entries, entry)
+		if entry.Kind == EntryError {
+			section.Failed = true
+		}
+	}
+
+	data.Servers = strings.Join(serverList, ", ")
+	data.Users = strings.Join(userList, ", ")
+
+	return reportTemplate.Execute(w, data)
+}
+
+// SaveHTMLReport writes the transcript of the current session to an HTML file
+func (c *Client) SaveHTMLReport(path string) (int, error) {
+	entries := c.transcript.GetEntries()
+	if len(entries) == 0 {
+		return 0, fmt.Errorf("the transcript of this session is empty")
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	title := "nexuflex session report"
+	if c.serverInfo != nil {
+		title += " – " + c.serverInfo.ShortName
+	}
+
+	if err := WriteHTMLReport(f, title, entries); err != nil {
+		return 0, err
+	}
+	return len(entries), f.Close()
+}
+
+// ColorTagsToHTML converts text with tview color tags into escaped HTML with inline styles
+func ColorTagsToHTML(text string) template.HTML {
+	var sb strings.Builder
+	open := false
+	last := 0
+
+	for _, match := range colorTagPattern.FindAllStringSubmatchIndex(text, -1) {
+		sb.WriteString(html.EscapeString(text[last:match[0]]))
+		last = match[1]
+
+		tag := text[match[0]:match[1]]
+		switch {
+		case match[14] >= 0:
+			// Escaped tag, e.g. "[red[]" stands for the literal text "[red]"
+			sb.WriteString(html.EscapeString("[" + text[match[14]:match[15]] + "]"))
+			continue
+		case strings.HasPrefix(tag, `["`):
+			continue // Region tags have no visual effect
+		}
+
+		if open {
+			sb.WriteString("</span>")
+			open = false
+		}
+
+		style := tagStyle(submatch(text, match, 1), submatch(text, match, 3), submatch(text, match, 5))
+		if style != "" {
+			sb.WriteString(`<span style="` + style + `">`)
+			open = true
+		}
+	}
+
+	sb.WriteString(html.EscapeString(text[last:]))
+	if open {
+		sb.WriteString("</span>")
+	}
+	return template.HTML(sb.String())
+}
+
+// submatch returns the n-th submatch of a regexp match, or "" if it did not participate
+func submatch(text string, match []int, n int) string {
+	if match[2*n] < 0 {
+		return ""
+	}
+	return text[match[2*n]:match[2*n+1]]
+}
+
+// tagStyle converts the parts of a color tag into a CSS style
+func tagStyle(fg, bg, attrs string) string {
+	var styles []string
+	if fg != "" && fg != "-" && !strings.EqualFold(fg, "white") {
+		styles = append(styles, "color: "+fg)
+	}
+	if bg != "" && bg != "-" {
+		styles = append(styles, "background-color: "+bg)
+	}
+	if attrs != "-" {
+		for _, attr := range strings.ToLower(attrs) {
+			switch attr {
+			case 'b':
+				styles = append(styles, "font-weight: bold")
+			case 'i':
+				styles = append(styles, "font-style: italic")
+			case 'u':
+				styles = append(styles, "text-decoration: underline")
+			case 'd':
+				styles = append(styles, "opacity: 0.7")
+			case 's':
+				styles = append(styles, "text-decoration: line-through")
+			}
+		}
+	}
+	return strings.Join(styles, "; ")
+}
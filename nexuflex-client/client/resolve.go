@@ -0,0 +1,130 @@
+// resolve.go
+/**
+ * Nexuflex Client - Loose Command Name Matching
+ *
+ * This file contains the resolution of command names entered in any case
+ * or abbreviated (e.g. "Fin.Cr.Rep") to the canonical Service.Action.SubAction
+ * name using the cached metadata of the server.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AmbiguousCommandError reports an abbreviation that matches several names
+type AmbiguousCommandError struct {
+	Part       string
+	Candidates []string
+}
+
+// Error implements the error interface
+func (e *AmbiguousCommandError) Error() string {
+	return fmt.Sprintf("'%s' is ambiguous: %s", e.Part, strings.Join(e.Candidates, ", "))
+}
+
+// ResolveCommand replaces the command name of a command line by its canonical
+// form if loose matching is enabled; it returns the command line and whether
+// it was changed. Names that cannot be resolved are left for the server.
+func (c *Client) ResolveCommand(command string) (string, bool, error) {
+	if !c.config.Commands.LooseMatching {
+		return command, false, nil
+	}
+
+	trimmed := strings.TrimSpace(command)
+	nameAndArgs := strings.SplitN(trimmed, " ", 2)
+	name := nameAndArgs[0]
+	if !strings.Contains(name, ".") {
+		return command, false, nil
+	}
+	parts := strings.Split(name, ".")
+
+	// Service
+	var serviceNames []string
+	for _, service := range c.GetCachedServices() {
+		serviceNames = append(serviceNames, service.ServiceName)
+	}
+	service, err := matchName(parts[0], serviceNames)
+	if err != nil || service == "" {
+		return command, false, err
+	}
+	resolved := []string{service}
+
+	// Action
+	commands := c.GetCachedCommands(service)
+	var actionNames []string
+	for _, info := range commands {
+		actionNames = appendUnique(actionNames, info.Action)
+	}
+	action, err := matchName(parts[1], actionNames)
+	if err != nil || action == "" {
+		return command, false, err
+	}
+	resolved = append(resolved, action)
+
+	// Sub-action
+	if len(parts) > 2 {
+		var subactionNames []string
+		for _, info := range commands {
+			if info.Action == action && info.Subaction != "" {
+				subactionNames = appendUnique(subactionNames, info.Subaction)
+			}
+		}
+		subaction, err := matchName(parts[2], subactionNames)
+		if err != nil || subaction == "" {
+			return command, false, err
+		}
+		resolved = append(resolved, subaction)
+		resolved = append(resolved, parts[3:]...)
+	}
+
+	canonical := strings.Join(resolved, ".")
+	if len(nameAndArgs) > 1 {
+		canonical += " " + nameAndArgs[1]
+	}
+	return canonical, canonical != trimmed, nil
+}
+
+// matchName finds the name matching a part exactly (ignoring case) or as the
+// only name starting with it; returns "" if nothing matches
+func matchName(part string, names []string) (string, error) {
+	if part == "" {
+		return "", nil
+	}
+
+	var candidates []string
+	for _, name := range names {
+		if strings.EqualFold(name, part) {
+			return name, nil
+		}
+		if len(name) > len(part) && strings.EqualFold(name[:len(part)], part) {
+			candidates = append(candidates, name)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", nil
+	case 1:
+		return candidates[0], nil
+	}
+	sort.Strings(candidates)
+	return "", &AmbiguousCommandError{Part: part, Candidates: candidates}
+}
+
+// appendUnique appends a name unless it is already contained
+func appendUnique(names []string, name string) []string {
+	for _, existing := range names {
+		if existing == name {
+			return names
+		}
+	}
+	return append(names, name)
+}
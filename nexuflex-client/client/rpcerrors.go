@@ -0,0 +1,162 @@
+// rpcerrors.go
+/**
+ * Nexuflex Client - Server Call Errors
+ *
+ * This file contains the classification of failed calls to the server by
+ * their gRPC status code. Instead of a generic error string the caller
+ * gets an RPCError that tells how to recover: reconnect when the server is
+ * unavailable, log in again when the session is not accepted, or retry
+ * with a longer timeout when the deadline was exceeded. Hints the server
+ * attaches to the status (retry delay, localized message, help link) are
+ * passed on; codes of the shared error catalog are shown in the client
+ * language.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/shared/errcatalog"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultCommandTimeout is the time a command may take before the call is aborted
+const defaultCommandTimeout = 30 * time.Second
+
+// RecoveryAction is the way to recover from a failed server call
+type RecoveryAction int
+
+const (
+	// RecoverNone means the error cannot be fixed by the client
+	RecoverNone RecoveryAction = iota
+	// RecoverReconnect means the connection to the server has to be established again
+	RecoverReconnect
+	// RecoverLogin means the session was not accepted and a new login is required
+	RecoverLogin
+	// RecoverRetry means the call may succeed when it is repeated
+	RecoverRetry
+)
+
+// RPCError reports a failed call to the server with its gRPC status
+type RPCError struct {
+	Op         string // Failed operation, e.g. "command execution"
+	Command    string
+	CommandID  string // Set for critical commands whose outcome is unknown
+	Code       codes.Code
+	Message    string        // Message of the server, localized if it sent one in the client language
+	ErrorCode  string        // Code of the shared error catalog, if the server sent one
+	RetryAfter time.Duration // Delay requested by the server before a retry
+	HelpURL    string        // Link the server attached for further information
+	Timeout    time.Duration // Timeout of the call
+	Err        error
+}
+
+// Error implements the error interface
+func (e *RPCError) Error() string {
+	msg := fmt.Sprintf("%s failed: %s", e.Op, e.Message)
+	if e.CommandID != "" {
+		msg += fmt.Sprintf(" (outcome unknown, command %s is checked after reconnecting)", e.CommandID)
+	}
+	return msg
+}
+
+// Unwrap returns the original error of the call
+func (e *RPCError) Unwrap() error {
+	return e.Err
+}
+
+// Action returns how the client can recover from the error
+func (e *RPCError) Action() RecoveryAction {
+	switch e.Code {
+	case codes.Unavailable:
+		return RecoverReconnect
+	case codes.Unauthenticated:
+		return RecoverLogin
+	case codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return RecoverRetry
+	}
+	return RecoverNone
+}
+
+// Retryable checks whether repeating the call may succeed
+func (e *RPCError) Retryable() bool {
+	return e.Action() == RecoverRetry || e.Action() == RecoverReconnect
+}
+
+// rpcError classifies the error of a server call; errors without a gRPC
+// status (e.g. of the client itself) are returned unchanged
+func (c *Client) rpcError(op, command string, timeout time.Duration, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("%s failed: %v", op, err)
+	}
+
+	rpcErr := &RPCError{
+		Op:      op,
+		Command: command,
+		Code:    st.Code(),
+		Message: st.Message(),
+		Timeout: timeout,
+		Err:     err,
+	}
+
+	language := c.config.UI.Language
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.RetryInfo:
+			rpcErr.RetryAfter = d.GetRetryDelay().AsDuration()
+		case *errdetails.LocalizedMessage:
+			if language != "" && strings.HasPrefix(strings.ToLower(d.GetLocale()), strings.ToLower(language)) {
+				rpcErr.Message = d.GetMessage()
+			}
+		case *errdetails.Help:
+			if links := d.GetLinks(); len(links) > 0 && rpcErr.HelpURL == "" {
+				rpcErr.HelpURL = links[0].GetUrl()
+			}
+		}
+	}
+
+	// A catalog code is shown in the language of the client
+	if code, params, ok := errcatalog.FromStatus(st); ok {
+		rpcErr.ErrorCode = string(code)
+		rpcErr.Message = c.localizeError(string(code), params, rpcErr.Message)
+	}
+
+	return rpcErr
+}
+
+// SetErrorLocalizer sets the function that returns the message of a code of
+// the shared error catalog in the language of the client
+func (c *Client) SetErrorLocalizer(localize func(code string, params map[string]string, fallback string) string) {
+	c.errorLocalizer = localize
+}
+
+// localizeError returns the message of a catalog code; without a localizer
+// the message of the server or the English catalog message is used
+func (c *Client) localizeError(code string, params map[string]string, fallback string) string {
+	if c.errorLocalizer != nil {
+		return c.errorLocalizer(code, params, fallback)
+	}
+	if fallback != "" {
+		return fallback
+	}
+	return errcatalog.Code(code).Message(params)
+}
+
+// commandErrorMessage returns the error message of a failed command response
+func (c *Client) commandErrorMessage(resp *proto.CommandResponse) string {
+	if resp.ErrorCode == "" {
+		return resp.ErrorMessage
+	}
+	return c.localizeError(resp.ErrorCode, resp.ErrorParams, resp.ErrorMessage)
+}
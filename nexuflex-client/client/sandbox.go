@@ -0,0 +1,354 @@
+// sandbox.go
+/**
+ * Nexuflex Client - Tutorial Sandbox
+ *
+ * This file contains a small nexuflex server running inside the client for
+ * the tutorial. It listens on a random port of the loopback interface and
+ * offers a single service, "Tutorial", with harmless sample commands, so
+ * that new users can practice connecting, logging in, running commands,
+ * completion and aliases without touching a real system. Any user name is
+ * accepted with the password "tutorial". The sandbox records the commands
+ * it executed and the completions it answered, so that the tutorial can
+ * verify each step by what actually reached the server.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc"
+)
+
+// Credentials and service of the tutorial sandbox
+const (
+	SandboxPassword = "tutorial"
+	SandboxService  = "Tutorial"
+)
+
+// sandboxCommand is a command of the sandbox service
+type sandboxCommand struct {
+	info *proto.CommandInfo
+	run  func(args []string) string
+}
+
+// sandboxCommands are the commands of the sandbox service by full name
+var sandboxCommands = map[string]sandboxCommand{
+	"Tutorial.Greet": {
+		info: &proto.CommandInfo{
+			Action:       "Greet",
+			Description:  "Greets you by name",
+			UsageExample: "Tutorial.Greet Alex",
+			Parameters:   []*proto.ParameterInfo{{Name: "name", Description: "Your name", DataType: "string"}},
+		},
+		run: func(args []string) string {
+			name := strings.Join(args, " ")
+			if name == "" {
+				name = "there"
+			}
+			return fmt.Sprintf("Hello, %s! This answer came from the tutorial sandbox.", name)
+		},
+	},
+	"Tutorial.Show.Item": {
+		info: &proto.CommandInfo{
+			Action:       "Show",
+			Subaction:    "Item",
+			Description:  "Shows a sample inventory item",
+			UsageExample: "Tutorial.Show.Item 4711",
+			Parameters:   []*proto.ParameterInfo{{Name: "id", Description: "Item number", Required: true, DataType: "int"}},
+		},
+		run: func(args []string) string {
+			id := "4711"
+			if len(args) > 0 {
+				id = args[0]
+			}
+			return fmt.Sprintf("Item:     %s\nName:     Sample widget\nStock:    42\nLocation: A-01-3", id)
+		},
+	},
+}
+
+// Sandbox is the in-process server of the tutorial
+type Sandbox struct {
+	listener net.Listener
+	server   *grpc.Server
+
+	mu          sync.Mutex
+	sessions    map[string]string // Session token -> user
+	commands    []string          // Commands executed successfully
+	completions int               // Completion requests answered with suggestions
+}
+
+// StartSandbox starts the tutorial sandbox on a random loopback port
+func StartSandbox() (*Sandbox, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("cannot start the tutorial sandbox: %v", err)
+	}
+
+	s := &Sandbox{
+		listener: listener,
+		server:   grpc.NewServer(),
+		sessions: make(map[string]string),
+	}
+	proto.RegisterNexuflexServiceServer(s.server, &sandboxServer{sandbox: s})
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+// Address returns the address the sandbox listens on
+func (s *Sandbox) Address() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the port the sandbox listens on
+func (s *Sandbox) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Serves returns whether a server address refers to the sandbox
+func (s *Sandbox) Serves(address string, port int) bool {
+	if port != s.Port() {
+		return false
+	}
+	if strings.EqualFold(address, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Close stops the sandbox and ends its connections
+func (s *Sandbox) Close() {
+	s.server.Stop()
+}
+
+// Commands returns the commands the sandbox executed successfully
+func (s *Sandbox) Commands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.commands...)
+}
+
+// Completions returns the number of completion requests answered with suggestions
+func (s *Sandbox) Completions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.completions
+}
+
+// user returns the user of a session, empty if the token is unknown
+func (s *Sandbox) user(token string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[token]
+}
+
+// sandboxServer implements the RPCs of the sandbox; the others are unimplemented
+type sandboxServer struct {
+	proto.UnimplementedNexuflexServiceServer
+	sandbox *Sandbox
+}
+
+// Connect accepts every client
+func (s *sandboxServer) Connect(ctx context.Context, req *proto.ConnectRequest) (*proto.ConnectResponse, error) {
+	return &proto.ConnectResponse{Success: true, ServerName: "tutorial-sandbox", Version: Version}, nil
+}
+
+// Login accepts any user with the sandbox password
+func (s *sandboxServer) Login(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
+	method := strings.ToLower(req.AuthMethod)
+	if (method == "" || method == "password") && req.Password != SandboxPassword {
+		return &proto.LoginResponse{
+			ErrorMessage: fmt.Sprintf("the tutorial sandbox accepts any user name with the password %q", SandboxPassword),
+		}, nil
+	}
+	username := strings.TrimSpace(req.Username)
+	if username == "" {
+		username = "tutorial"
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	s.sandbox.mu.Lock()
+	s.sandbox.sessions[hex.EncodeToString(token)] = username
+	s.sandbox.mu.Unlock()
+
+	return &proto.LoginResponse{
+		Success:           true,
+		SessionToken:      hex.EncodeToString(token),
+		SessionTtlSeconds: 3600,
+		UserInfo: &proto.UserInfo{
+			Username:              username,
+			DisplayName:           username,
+			Roles:                 []string{"tutorial"},
+			SessionTimeoutMinutes: 60,
+			Permissions:           []string{SandboxService + ".*"},
+		},
+	}, nil
+}
+
+// Logout ends a session
+func (s *sandboxServer) Logout(ctx context.Context, req *proto.LogoutRequest) (*proto.LogoutResponse, error) {
+	s.sandbox.mu.Lock()
+	delete(s.sandbox.sessions, req.SessionToken)
+	s.sandbox.mu.Unlock()
+	return &proto.LogoutResponse{Success: true}, nil
+}
+
+// KeepAlive keeps sessions valid for as long as the sandbox runs
+func (s *sandboxServer) KeepAlive(ctx context.Context, req *proto.KeepAliveRequest) (*proto.KeepAliveResponse, error) {
+	valid := s.sandbox.user(req.SessionToken) != ""
+	return &proto.KeepAliveResponse{SessionValid: valid, RemainingMinutes: 60, SessionTtlSeconds: 3600}, nil
+}
+
+// ExecuteCommand runs a command of the sandbox service
+func (s *sandboxServer) ExecuteCommand(ctx context.Context, req *proto.CommandRequest) (*proto.CommandResponse, error) {
+	username := s.sandbox.user(req.SessionToken)
+	if username == "" {
+		return &proto.CommandResponse{
+			ErrorMessage: "not logged in",
+			StatusInfo:   &proto.StatusInfo{ConnectionStatus: proto.StatusInfo_CONNECTED, SessionStatus: proto.StatusInfo_LOGIN_REQUIRED},
+		}, nil
+	}
+	status := &proto.StatusInfo{
+		ConnectionStatus:        proto.StatusInfo_CONNECTED,
+		SessionStatus:           proto.StatusInfo_AUTHENTICATED,
+		CurrentService:          SandboxService,
+		SessionRemainingMinutes: 60,
+		ServerName:              "tutorial-sandbox",
+		Username:                username,
+	}
+
+	fields := strings.Fields(req.CommandLine)
+	if len(fields) == 0 {
+		return &proto.CommandResponse{ErrorMessage: "empty command", StatusInfo: status}, nil
+	}
+	name, command, ok := findSandboxCommand(fields[0], req.LastContext)
+	if !ok {
+		return &proto.CommandResponse{
+			ErrorMessage: fmt.Sprintf("unknown command %s; the sandbox knows %s", fields[0], strings.Join(sandboxCommandNames(), ", ")),
+			StatusInfo:   status,
+		}, nil
+	}
+
+	s.sandbox.mu.Lock()
+	s.sandbox.commands = append(s.sandbox.commands, name)
+	s.sandbox.mu.Unlock()
+	return &proto.CommandResponse{
+		Success:    true,
+		Output:     command.run(fields[1:]),
+		StatusInfo: status,
+		NewContext: SandboxService,
+		CommandId:  req.CommandId,
+	}, nil
+}
+
+// GetAvailableServices returns the sandbox service
+func (s *sandboxServer) GetAvailableServices(ctx context.Context, req *proto.ServicesRequest) (*proto.ServicesResponse, error) {
+	return &proto.ServicesResponse{Services: []*proto.ServiceInfo{{
+		ServiceName: SandboxService,
+		Description: "Sample commands of the tutorial",
+		Version:     Version,
+	}}}, nil
+}
+
+// GetServiceCommands returns the commands of the sandbox service
+func (s *sandboxServer) GetServiceCommands(ctx context.Context, req *proto.ServiceCommandsRequest) (*proto.ServiceCommandsResponse, error) {
+	resp := &proto.ServiceCommandsResponse{}
+	if strings.EqualFold(req.ServiceName, SandboxService) {
+		for _, name := range sandboxCommandNames() {
+			resp.Commands = append(resp.Commands, sandboxCommands[name].info)
+		}
+	}
+	return resp, nil
+}
+
+// GetCommandHelp describes a command of the sandbox service
+func (s *sandboxServer) GetCommandHelp(ctx context.Context, req *proto.CommandHelpRequest) (*proto.CommandHelpResponse, error) {
+	name := req.Service + "." + req.Action
+	if req.Subaction != "" {
+		name += "." + req.Subaction
+	}
+	_, command, ok := findSandboxCommand(name, "")
+	if !ok {
+		return &proto.CommandHelpResponse{HelpText: fmt.Sprintf("The sandbox knows %s.", strings.Join(sandboxCommandNames(), ", "))}, nil
+	}
+	return &proto.CommandHelpResponse{
+		HelpText:    fmt.Sprintf("%s\n\nExample: %s", command.info.Description, command.info.UsageExample),
+		CommandInfo: command.info,
+	}, nil
+}
+
+// AutoComplete completes the names of the sandbox commands
+func (s *sandboxServer) AutoComplete(ctx context.Context, req *proto.AutoCompleteRequest) (*proto.AutoCompleteResponse, error) {
+	partial := req.PartialInput
+	if req.CursorPosition >= 0 && int(req.CursorPosition) < len(partial) {
+		partial = partial[:req.CursorPosition]
+	}
+	if strings.ContainsAny(partial, " \t") {
+		return &proto.AutoCompleteResponse{}, nil
+	}
+
+	resp := &proto.AutoCompleteResponse{}
+	for _, name := range sandboxCommandNames() {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(partial)) {
+			resp.Suggestions = append(resp.Suggestions, name)
+		}
+	}
+	if len(resp.Suggestions) == 0 {
+		return resp, nil
+	}
+	resp.CommonPrefix = resp.Suggestions[0]
+	for _, suggestion := range resp.Suggestions[1:] {
+		for !strings.HasPrefix(suggestion, resp.CommonPrefix) {
+			resp.CommonPrefix = resp.CommonPrefix[:len(resp.CommonPrefix)-1]
+		}
+	}
+
+	s.sandbox.mu.Lock()
+	s.sandbox.completions++
+	s.sandbox.mu.Unlock()
+	return resp, nil
+}
+
+// GetAliases returns no server aliases
+func (s *sandboxServer) GetAliases(ctx context.Context, req *proto.GetAliasesRequest) (*proto.GetAliasesResponse, error) {
+	return &proto.GetAliasesResponse{}, nil
+}
+
+// findSandboxCommand looks up a command by its name, case-insensitively;
+// a name without the service is also looked up in the service context
+func findSandboxCommand(name, serviceContext string) (string, sandboxCommand, bool) {
+	candidates := []string{name}
+	if serviceContext != "" {
+		candidates = append(candidates, serviceContext+"."+name)
+	}
+	for _, candidate := range candidates {
+		for full, command := range sandboxCommands {
+			if strings.EqualFold(full, candidate) {
+				return full, command, true
+			}
+		}
+	}
+	return "", sandboxCommand{}, false
+}
+
+// sandboxCommandNames returns the full names of the sandbox commands in order
+func sandboxCommandNames() []string {
+	return slices.Sorted(maps.Keys(sandboxCommands))
+}
@@ -0,0 +1,192 @@
+// search.go
+/**
+ * Nexuflex Client - Full-Text Search
+ *
+ * This file contains a small inverted index over session transcripts and
+ * the command history, used to find commands and outputs of past sessions.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// snippetRadius is the number of characters shown around a match
+const snippetRadius = 40
+
+// SearchDocument is a searchable item (a transcript entry or a history line)
+type SearchDocument struct {
+	Time   time.Time
+	Kind   string
+	Server string
+	Text   string
+}
+
+// SearchResult is a document matching a query
+type SearchResult struct {
+	Document SearchDocument
+	Snippet  string
+	Score    int
+}
+
+// SearchIndex maps tokens to the documents containing them
+type SearchIndex struct {
+	documents []SearchDocument
+	postings  map[string]map[int]int // token -> document index -> occurrences
+}
+
+// NewSearchIndex creates an empty search index
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{
+		postings: make(map[string]map[int]int),
+	}
+}
+
+// BuildSearchIndex creates an index over all stored transcripts, the current
+// session and the given history entries
+func BuildSearchIndex(transcript *Transcript, history []string) *SearchIndex {
+	index := NewSearchIndex()
+
+	// Transcripts of past sessions, including the current one if it is persisted
+	stored, _ := LoadTranscriptFiles()
+	for _, entry := range stored {
+		index.AddTranscriptEntry(entry)
+	}
+	if transcript != nil && !transcript.IsPersisted() {
+		for _, entry := range transcript.GetEntries() {
+			index.AddTranscriptEntry(entry)
+		}
+	}
+
+	// History entries have no timestamps
+	for _, command := range history {
+		index.Add(SearchDocument{Kind: "history", Text: command})
+	}
+
+	return index
+}
+
+// AddTranscriptEntry adds a transcript entry to the index
+func (idx *SearchIndex) AddTranscriptEntry(entry TranscriptEntry) {
+	idx.Add(SearchDocument{
+		Time:   entry.Time,
+		Kind:   entry.Kind,
+		Server: entry.Server,
+		Text:   entry.Text,
+	})
+}
+
+// Add adds a document to the index
+func (idx *SearchIndex) Add(doc SearchDocument) {
+	docIndex := len(idx.documents)
+	idx.documents = append(idx.documents, doc)
+
+	for _, token := range tokenize(doc.Text) {
+		if idx.postings[token] == nil {
+			idx.postings[token] = make(map[int]int)
+		}
+		idx.postings[token][docIndex]++
+	}
+}
+
+// Size returns the number of indexed documents
+func (idx *SearchIndex) Size() int {
+	return len(idx.documents)
+}
+
+// Search returns the documents containing all query terms, best and newest matches first
+func (idx *SearchIndex) Search(query string, limit int) []SearchResult {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// Intersect the posting lists of all terms
+	scores := make(map[int]int)
+	for i, term := range terms {
+		postings := idx.postings[term]
+		if i == 0 {
+			for docIndex, count := range postings {
+				scores[docIndex] = count
+			}
+			continue
+		}
+		for docIndex := range scores {
+			count, ok := postings[docIndex]
+			if !ok {
+				delete(scores, docIndex)
+				continue
+			}
+			scores[docIndex] += count
+		}
+	}
+
+	results := make([]SearchResult, 0, len(scores))
+	for docIndex, score := range scores {
+		doc := idx.documents[docIndex]
+		results = append(results, SearchResult{
+			Document: doc,
+			Snippet:  makeSnippet(doc.Text, terms[0]),
+			Score:    score,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Document.Time.After(results[j].Document.Time)
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// tokenize splits a text into lowercase alphanumeric tokens
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+// makeSnippet returns a single-line excerpt of the text around the first occurrence of term
+func makeSnippet(text, term string) string {
+	flat := strings.Join(strings.Fields(text), " ")
+	runes := []rune(flat)
+	lower := []rune(strings.ToLower(flat))
+
+	pos := strings.Index(string(lower), term)
+	if pos < 0 {
+		pos = 0
+	} else {
+		pos = len([]rune(string(lower)[:pos]))
+	}
+	if pos > len(runes) {
+		pos = 0 // Case folding changed the text length
+	}
+
+	start := pos - snippetRadius
+	prefix := "…"
+	if start <= 0 {
+		start = 0
+		prefix = ""
+	}
+	end := pos + len([]rune(term)) + snippetRadius
+	suffix := "…"
+	if end >= len(runes) {
+		end = len(runes)
+		suffix = ""
+	}
+
+	return prefix + string(runes[start:end]) + suffix
+}
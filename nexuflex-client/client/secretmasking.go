@@ -0,0 +1,115 @@
+// secretmasking.go
+/**
+ * Nexuflex Client - Secret Masking
+ *
+ * This file contains the masking of sensitive parameter values in the
+ * local records of executed commands: the debug log, the transcript with
+ * the events of the timeline and the RPC traces of support bundles. The
+ * arguments of a command are assigned to its parameters from the cached
+ * command metadata, positional or as name=value; values of parameters the
+ * server marks sensitive (passwords, tokens, personal data) are replaced,
+ * so that these files can be shared. Parameters of unknown commands are
+ * masked if their name suggests a credential. The transcript also records
+ * the parameters of a command by name.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strconv"
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// maskedValue replaces the value of a sensitive parameter
+const maskedValue = "****"
+
+// maskedCommand is a command line prepared for the local records
+type maskedCommand struct {
+	line       string
+	parameters map[string]string // Values by parameter name, "argN" for unknown positions
+}
+
+// MaskCommand returns a command line with the values of its sensitive
+// parameters masked
+func (c *Client) MaskCommand(command string) string {
+	return c.maskCommand(command).line
+}
+
+// maskCommand assigns the arguments of a command to its parameters and
+// masks the sensitive ones; the line is unchanged if nothing is masked
+func (c *Client) maskCommand(command string) maskedCommand {
+	return c.replaceSecrets(command, func(string) string { return maskedValue })
+}
+
+// replaceSecrets assigns the arguments of a command to its parameters and
+// replaces the values of the sensitive ones with what replace returns for
+// the parameter; the line is unchanged if nothing is replaced
+func (c *Client) replaceSecrets(command string, replace func(parameter string) string) maskedCommand {
+	name, args, _ := strings.Cut(strings.TrimSpace(command), " ")
+	_, info := c.lookupCommandInfo(name, c.lastServiceUsed)
+
+	masked := maskedCommand{line: command}
+	arguments := SplitArguments(args)
+	changed := false
+	for i, arg := range arguments {
+		parameter, prefix, value, secret := commandArgument(info, i, arg)
+		if secret {
+			value = replace(parameter)
+			arguments[i] = prefix + value
+			changed = true
+		}
+		if masked.parameters == nil {
+			masked.parameters = make(map[string]string, len(arguments))
+		}
+		masked.parameters[parameter] = value
+	}
+
+	if changed {
+		quoted := make([]string, len(arguments))
+		for i, arg := range arguments {
+			quoted[i] = QuoteArgument(arg)
+		}
+		masked.line = name + " " + strings.Join(quoted, " ")
+	}
+	return masked
+}
+
+// commandArgument returns the parameter an argument is assigned to, the
+// "name=" prefix of a named argument, its value and whether it is sensitive
+func commandArgument(info *proto.CommandInfo, position int, arg string) (string, string, string, bool) {
+	if name, value, ok := strings.Cut(arg, "="); ok && name != "" {
+		name = strings.TrimLeft(name, "-")
+		if parameter := findParameter(info, name); parameter != nil {
+			return parameter.Name, arg[:len(arg)-len(value)], value, isSecretParameter(parameter)
+		}
+		if info == nil || position >= len(info.Parameters) {
+			return name, arg[:len(arg)-len(value)], value, isSecretMetadata(name)
+		}
+	}
+
+	if info != nil && position < len(info.Parameters) {
+		parameter := info.Parameters[position]
+		return parameter.Name, "", arg, isSecretParameter(parameter)
+	}
+	return "arg" + strconv.Itoa(position+1), "", arg, false
+}
+
+// findParameter returns the parameter of a command with the given name, nil
+// if the command or the parameter is unknown
+func findParameter(info *proto.CommandInfo, name string) *proto.ParameterInfo {
+	if info == nil {
+		return nil
+	}
+	for _, parameter := range info.Parameters {
+		if strings.EqualFold(parameter.Name, name) {
+			return parameter
+		}
+	}
+	return nil
+}
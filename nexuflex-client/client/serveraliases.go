@@ -0,0 +1,92 @@
+// serveraliases.go
+/**
+ * Nexuflex Client - Server Alias Metadata
+ *
+ * This file contains the cache of the aliases defined on the server
+ * (retrieved via GetAliases after login), so that completion can show what
+ * an alias expands to and which parameters it still expects.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// RefreshServerAliases reloads the aliases defined on the server
+func (c *Client) RefreshServerAliases() error {
+	return c.refreshServerAliases(context.Background())
+}
+
+// refreshServerAliases reloads the server aliases until the context ends
+func (c *Client) refreshServerAliases(ctx context.Context) error {
+	aliases, err := c.getAliases(ctx)
+	if err != nil {
+		return err
+	}
+
+	cache := make(map[string]*proto.AliasInfo, len(aliases))
+	for _, alias := range aliases {
+		cache[alias.Alias] = alias
+	}
+
+	c.aliasMu.Lock()
+	c.serverAliases = cache
+	c.aliasMu.Unlock()
+
+	return nil
+}
+
+// clearServerAliases forgets the cached server aliases (on logout or reconnect)
+func (c *Client) clearServerAliases() {
+	c.aliasMu.Lock()
+	c.serverAliases = nil
+	c.aliasMu.Unlock()
+}
+
+// LookupServerAlias returns the server alias with the given name
+func (c *Client) LookupServerAlias(name string) (*proto.AliasInfo, bool) {
+	c.aliasMu.RLock()
+	defer c.aliasMu.RUnlock()
+
+	alias, ok := c.serverAliases[name]
+	return alias, ok
+}
+
+// ServerAliasNames returns the names of all cached server aliases
+func (c *Client) ServerAliasNames() []string {
+	c.aliasMu.RLock()
+	defer c.aliasMu.RUnlock()
+
+	names := make([]string, 0, len(c.serverAliases))
+	for name := range c.serverAliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FormatParameterHints formats parameters as "<required> [optional=default]"
+func FormatParameterHints(params []*proto.ParameterInfo) string {
+	hints := make([]string, 0, len(params))
+	for _, param := range params {
+		switch {
+		case param.Required:
+			hints = append(hints, fmt.Sprintf("<%s>", param.Name))
+		case param.DefaultValue != "":
+			hints = append(hints, fmt.Sprintf("[%s=%s]", param.Name, param.DefaultValue))
+		default:
+			hints = append(hints, fmt.Sprintf("[%s]", param.Name))
+		}
+	}
+	return strings.Join(hints, " ")
+}
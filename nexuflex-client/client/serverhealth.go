@@ -0,0 +1,74 @@
+// serverhealth.go
+/**
+ * Nexuflex Client - Server Health
+ *
+ * This file contains the evaluation of the health, load and maintenance
+ * information servers report in their discovery response. Servers in
+ * maintenance mode are refused with `refuse_maintenance = true` and
+ * otherwise only connected after a warning; without a server selection
+ * the healthy server with the lowest load is chosen.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// MaintenanceError reports that a server in maintenance mode was refused
+type MaintenanceError struct {
+	Server  string
+	Message string
+}
+
+// Error implements the error interface
+func (e *MaintenanceError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("server %s is in maintenance", e.Server)
+	}
+	return fmt.Sprintf("server %s is in maintenance: %s", e.Server, e.Message)
+}
+
+// CheckServerAvailability returns a MaintenanceError if the configuration
+// refuses connections to a server in maintenance mode
+func (c *Client) CheckServerAvailability(server *proto.ServerInfo) error {
+	if server.Maintenance && c.config.Server.RefuseMaintenance {
+		return &MaintenanceError{Server: server.ShortName, Message: server.MaintenanceMessage}
+	}
+	return nil
+}
+
+// preferredServer returns the server to connect to without a selection: the
+// one with the best health and the lowest load, servers in maintenance last
+func preferredServer(servers []*proto.ServerInfo) *proto.ServerInfo {
+	var best *proto.ServerInfo
+	for _, server := range servers {
+		if best == nil || serverRank(server) < serverRank(best) ||
+			(serverRank(server) == serverRank(best) && server.LoadPercent < best.LoadPercent) {
+			best = server
+		}
+	}
+	return best
+}
+
+// serverRank orders servers by their suitability, lower is better
+func serverRank(server *proto.ServerInfo) int {
+	if server.Maintenance {
+		return 4
+	}
+	switch server.Health {
+	case proto.ServerInfo_HEALTHY:
+		return 0
+	case proto.ServerInfo_HEALTH_UNKNOWN:
+		return 1
+	case proto.ServerInfo_DEGRADED:
+		return 2
+	}
+	return 3
+}
@@ -0,0 +1,53 @@
+// serverselection.go
+/**
+ * Nexuflex Client - Server Selection
+ *
+ * This file contains the selection of a discovered server by the user.
+ * The selection callback receives the discovered servers together with a
+ * continuation and returns at once, so that a user interface can show the
+ * list from its event loop; it calls the continuation later with the
+ * chosen server, or with nil if the user closed the list. DiscoverServer
+ * waits for that choice in its own goroutine and connects to the server.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"errors"
+	"sync"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// ErrNoServerSelected reports a discovery whose server list was closed
+// without choosing a server
+var ErrNoServerSelected = errors.New("no server selection made")
+
+// SetServerSelectionCallback sets the function offering the discovered
+// servers for selection; it must not block and must call choose once, with
+// the chosen server or nil. It takes precedence over the onServerList
+// callback of SetCallbacks, which has to return the choice at once.
+func (c *Client) SetServerSelectionCallback(onServerSelection func(servers []*proto.ServerInfo, choose func(server *proto.ServerInfo))) {
+	c.onServerSelection = onServerSelection
+}
+
+// awaitServerSelection offers the servers for selection and waits for the
+// choice; calls of choose after the first are ignored
+func (c *Client) awaitServerSelection(servers []*proto.ServerInfo) (*proto.ServerInfo, error) {
+	chosen := make(chan *proto.ServerInfo, 1)
+	var once sync.Once
+	c.onServerSelection(servers, func(server *proto.ServerInfo) {
+		once.Do(func() { chosen <- server })
+	})
+
+	server := <-chosen
+	if server == nil {
+		c.logger("Server selection closed without a choice")
+		return nil, ErrNoServerSelected
+	}
+	return server, nil
+}
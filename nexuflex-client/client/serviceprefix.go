@@ -0,0 +1,165 @@
+// serviceprefix.go
+/**
+ * Nexuflex Client - Service Context Prefix
+ *
+ * This file contains the automatic service prefix of auto_fill_service_prefix.
+ * While a service context is set, e.g. with "use Inventory", a command
+ * whose name does not start with a known service is sent with the context
+ * in front ("Show.Item 4711" becomes "Inventory.Show.Item 4711"), so that
+ * the output and the history show the command that was actually run.
+ * Completion works the same way: the partial input is completed within the
+ * context and the suggestions are shown without the redundant prefix, next
+ * to the service names matching the first word. While no services are
+ * cached, e.g. in low-bandwidth mode, their names are fetched once on
+ * demand to tell a service from the action of a dotted command name.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ServicePrefixEnabled returns whether commands are prefixed with the service context
+func (c *Client) ServicePrefixEnabled() bool {
+	return c.config.UI.AutoFillServicePrefix && c.lastServiceUsed != ""
+}
+
+// ApplyServicePrefix prefixes a command without a known service with the
+// service context if auto_fill_service_prefix is enabled; it returns the
+// command and whether it was changed
+func (c *Client) ApplyServicePrefix(command string) (string, bool) {
+	if !c.ServicePrefixEnabled() {
+		return command, false
+	}
+	qualified := c.QualifyCommand(command)
+	return qualified, qualified != strings.TrimSpace(command)
+}
+
+// servicePrefix returns the prefix a command name needs to be run in the
+// service context, "" if it names a known service or there is no context
+func (c *Client) servicePrefix(name string) string {
+	if name == "" || c.lastServiceUsed == "" {
+		return ""
+	}
+
+	service, _, qualified := strings.Cut(name, ".")
+	if names := c.serviceNames(); len(names) > 0 {
+		qualified = false
+		if c.config.Commands.LooseMatching {
+			// An abbreviated or ambiguous service is left to the resolution
+			match, err := matchName(service, names)
+			qualified = match != "" || err != nil
+		} else {
+			for _, serviceName := range names {
+				if strings.EqualFold(serviceName, service) {
+					qualified = true
+					break
+				}
+			}
+		}
+	}
+	if qualified {
+		return ""
+	}
+	return c.lastServiceUsed + "."
+}
+
+// serviceNames returns the names of the services of the server: those of
+// the cached metadata or, while none are cached, those fetched on demand;
+// nil if they cannot be fetched
+func (c *Client) serviceNames() []string {
+	if services := c.GetCachedServices(); len(services) > 0 {
+		names := make([]string, 0, len(services))
+		for _, info := range services {
+			names = append(names, info.ServiceName)
+		}
+		return names
+	}
+
+	c.metadata.mu.RLock()
+	names := c.metadata.serviceNames
+	c.metadata.mu.RUnlock()
+	if names != nil {
+		return names
+	}
+
+	services, err := c.GetAvailableServices()
+	if err != nil {
+		c.logger("Services not available for the service prefix: %v", err)
+		return nil
+	}
+	names = make([]string, 0, len(services))
+	for _, info := range services {
+		names = append(names, info.ServiceName)
+	}
+	c.metadata.mu.Lock()
+	c.metadata.serviceNames = names
+	c.metadata.mu.Unlock()
+	return names
+}
+
+// CompleteInContext completes a partial input like AutoComplete; with the
+// service prefix enabled, a command name without a known service is
+// completed within the service context and the suggestions are returned
+// without the prefix. While the first word has no dot yet, the services
+// matching it are suggested as well.
+func (c *Client) CompleteInContext(partialInput string, cursorPos int) ([]string, string, error) {
+	if !c.ServicePrefixEnabled() {
+		return c.AutoComplete(partialInput, cursorPos)
+	}
+	name, _, _ := strings.Cut(strings.TrimLeft(partialInput, " "), " ")
+	prefix := c.servicePrefix(name)
+	if name == "" {
+		prefix = c.lastServiceUsed + "."
+	}
+	if prefix == "" {
+		return c.AutoComplete(partialInput, cursorPos)
+	}
+
+	suggestions, _, err := c.AutoComplete(prefix+partialInput, cursorPos+len(prefix))
+	if err != nil {
+		return nil, "", err
+	}
+	for i, suggestion := range suggestions {
+		if len(suggestion) >= len(prefix) && strings.EqualFold(suggestion[:len(prefix)], prefix) {
+			suggestions[i] = suggestion[len(prefix):]
+		}
+	}
+
+	// Names of other services are still completed
+	if !strings.Contains(name, ".") {
+		if others, _, err := c.AutoComplete(partialInput, cursorPos); err == nil {
+			for _, other := range others {
+				suggestions = appendUnique(suggestions, other)
+			}
+		}
+	}
+	return suggestions, CommonPrefix(suggestions), nil
+}
+
+// CommonPrefix returns the longest common prefix of the values without
+// splitting a UTF-8 encoded character
+func CommonPrefix(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	prefix := values[0]
+	for _, value := range values[1:] {
+		i := 0
+		for i < len(prefix) && i < len(value) && prefix[i] == value[i] {
+			i++
+		}
+		// Back off to the start of a character the values differ in
+		for i < len(prefix) && i > 0 && !utf8.RuneStart(prefix[i]) {
+			i--
+		}
+		prefix = prefix[:i]
+	}
+	return prefix
+}
@@ -0,0 +1,43 @@
+// serviceprefix_test.go
+/**
+ * Nexuflex Client - Service Context Prefix Tests
+ *
+ * This file contains tests of the common prefix of completion
+ * suggestions, which must not end inside a UTF-8 encoded character.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{nil, ""},
+		{[]string{"Inventory.Show.Item"}, "Inventory.Show.Item"},
+		{[]string{"Inventory.Show.Item", "Inventory.Show.List"}, "Inventory.Show."},
+		{[]string{"Inventory.Show", "Finance.Show"}, ""},
+		{[]string{"Lager.Bestand", "Lager.Bestände"}, "Lager.Best"},
+		{[]string{"Lager.Größe", "Lager.Grün"}, "Lager.Gr"}, // ö and ü share their first byte
+		{[]string{"Kunde.日本", "Kunde.日付"}, "Kunde.日"},
+		{[]string{"Show", "Show.Item"}, "Show"},
+	}
+	for _, test := range tests {
+		got := CommonPrefix(test.values)
+		if got != test.want {
+			t.Errorf("CommonPrefix(%q) = %q, want %q", test.values, got, test.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("CommonPrefix(%q) = %q is not valid UTF-8", test.values, got)
+		}
+	}
+}
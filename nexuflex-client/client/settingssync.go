@@ -0,0 +1,327 @@
+// settingssync.go
+/**
+ * Nexuflex Client - Settings Sync
+ *
+ * This file contains the synchronization of the personal settings of a
+ * user through the application server, so that operators roaming between
+ * terminal servers find their aliases, snippets, favorite timeline filters
+ * and theme on every workstation. The settings are encrypted on the client
+ * with AES-256-GCM under a key derived from a sync passphrase, which is
+ * stored in the keyring of each workstation; the server only keeps the
+ * encrypted blob and the time of the change it contains.
+ *
+ * Conflicts are resolved by timestamp: the settings changed last win as a
+ * whole. Local settings count as changed at the modification time of the
+ * alias and configuration files once they differ from what was synced
+ * last; the hash and time of the last sync are kept in settings_sync.json.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/zalando/go-keyring"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// FeatureSettingsSync is the capability of servers storing synced settings
+const FeatureSettingsSync = "settings_sync"
+
+// Format of the encrypted settings: magic, salt, nonce, ciphertext
+const (
+	syncMagic      = "nxs1"
+	syncSaltSize   = 16
+	syncIterations = 210000
+	syncKeySize    = 32 // AES-256
+)
+
+// SyncOutcome tells what a settings sync did
+type SyncOutcome int
+
+const (
+	SyncUnchanged SyncOutcome = iota // Local and stored settings are the same
+	SyncPushed                       // The local settings were newer and stored on the server
+	SyncPulled                       // The stored settings were newer and are to be applied
+)
+
+// SyncedSettings are the personal settings roaming with the user
+type SyncedSettings struct {
+	Aliases         map[string]string `json:"aliases,omitempty"`
+	Snippets        map[string]string `json:"snippets,omitempty"`
+	TimelineFilters map[string]string `json:"timeline_filters,omitempty"`
+	ColorScheme     string            `json:"color_scheme,omitempty"`
+	Density         string            `json:"density,omitempty"`
+}
+
+// syncState is the last sync of this workstation
+type syncState struct {
+	Hash          string `json:"hash"`
+	UpdatedUnixMs int64  `json:"updated_unix_ms"`
+}
+
+// ErrNoSyncPassphrase reports that no sync passphrase is stored for the user
+var ErrNoSyncPassphrase = errors.New("no sync passphrase set")
+
+// syncPassphraseKey returns the keyring account of the sync passphrase of the user
+func (c *Client) syncPassphraseKey() string {
+	return "settings-sync:" + c.username
+}
+
+// SetSyncPassphrase stores the passphrase encrypting the synced settings of
+// the logged-in user in the keyring; an empty passphrase removes it
+func (c *Client) SetSyncPassphrase(passphrase string) error {
+	if c.username == "" {
+		return fmt.Errorf("not logged in")
+	}
+	if passphrase == "" {
+		err := keyring.Delete(keyringService, c.syncPassphraseKey())
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return keyring.Set(keyringService, c.syncPassphraseKey(), passphrase)
+}
+
+// CanSyncSettings returns whether settings can be synced in this session:
+// enabled, supported by the server and a passphrase stored for the user
+func (c *Client) CanSyncSettings() bool {
+	if !c.config.Commands.SyncSettings || c.sessionToken == "" || !c.supportsFeature(FeatureSettingsSync) {
+		return false
+	}
+	_, err := keyring.Get(keyringService, c.syncPassphraseKey())
+	return err == nil
+}
+
+// SetSettingsSyncCallback sets the function called after login when the
+// settings can be synced; it collects the local settings and calls SyncSettings
+func (c *Client) SetSettingsSyncCallback(onSettingsSync func()) {
+	c.onSettingsSync = onSettingsSync
+}
+
+// SyncSettings compares the local settings with the ones stored on the
+// server; the newer ones win. The returned settings are to be applied if
+// the outcome is SyncPulled.
+func (c *Client) SyncSettings(local SyncedSettings) (SyncedSettings, SyncOutcome, error) {
+	if c.client == nil || c.sessionToken == "" {
+		return local, SyncUnchanged, fmt.Errorf("not logged in")
+	}
+	passphrase, err := keyring.Get(keyringService, c.syncPassphraseKey())
+	if err != nil {
+		return local, SyncUnchanged, ErrNoSyncPassphrase
+	}
+
+	data, err := json.Marshal(local)
+	if err != nil {
+		return local, SyncUnchanged, err
+	}
+	last := loadSyncState()
+	localHash := settingsHash(data)
+	localUpdated := last.UpdatedUnixMs
+	if localHash != last.Hash {
+		localUpdated = localSettingsModified().UnixMilli()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stored, err := c.client.GetSyncedSettings(ctx, &proto.GetSyncedSettingsRequest{SessionToken: c.sessionToken})
+	if err != nil {
+		return local, SyncUnchanged, c.rpcError("loading the synced settings", "", 0, err)
+	}
+	if stored.ErrorMessage != "" {
+		return local, SyncUnchanged, fmt.Errorf("loading the synced settings failed: %s", stored.ErrorMessage)
+	}
+
+	// The stored settings are newer: take them
+	if stored.Found && stored.UpdatedUnixMs > localUpdated {
+		return c.pullSettings(stored.EncryptedSettings, stored.UpdatedUnixMs, passphrase)
+	}
+	if stored.Found && stored.UpdatedUnixMs == localUpdated {
+		return local, SyncUnchanged, nil
+	}
+
+	encrypted, err := encryptSettings(data, passphrase)
+	if err != nil {
+		return local, SyncUnchanged, err
+	}
+	resp, err := c.client.SyncSettings(ctx, &proto.SyncSettingsRequest{
+		SessionToken:      c.sessionToken,
+		EncryptedSettings: encrypted,
+		UpdatedUnixMs:     localUpdated,
+	})
+	if err != nil {
+		return local, SyncUnchanged, c.rpcError("storing the synced settings", "", 0, err)
+	}
+	// Another workstation stored newer settings in the meantime
+	if resp.Conflict {
+		return c.pullSettings(resp.EncryptedSettings, resp.UpdatedUnixMs, passphrase)
+	}
+	if !resp.Success {
+		return local, SyncUnchanged, fmt.Errorf("storing the synced settings failed: %s", resp.ErrorMessage)
+	}
+
+	c.saveSyncState(syncState{Hash: localHash, UpdatedUnixMs: localUpdated})
+	c.logger("Settings synced to the server")
+	return local, SyncPushed, nil
+}
+
+// pullSettings decrypts the settings stored on the server and records them
+// as synced
+func (c *Client) pullSettings(encrypted []byte, updated int64, passphrase string) (SyncedSettings, SyncOutcome, error) {
+	var settings SyncedSettings
+	data, err := decryptSettings(encrypted, passphrase)
+	if err != nil {
+		return settings, SyncUnchanged, err
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, SyncUnchanged, fmt.Errorf("invalid synced settings: %v", err)
+	}
+
+	// Hash what will be applied, as the local settings are collected again next time
+	if normalized, err := json.Marshal(settings); err == nil {
+		c.saveSyncState(syncState{Hash: settingsHash(normalized), UpdatedUnixMs: updated})
+	}
+	c.logger("Settings synced from the server")
+	return settings, SyncPulled, nil
+}
+
+// settingsHash identifies the content of serialized settings
+func settingsHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// localSettingsModified returns the time of the last change of the files
+// holding the synced settings
+func localSettingsModified() time.Time {
+	var latest time.Time
+	paths := []string{config.LoadedConfigPath()}
+	if dir, err := state.Dir(); err == nil {
+		paths = append(paths, filepath.Join(dir, "local_aliases.txt"))
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	if latest.IsZero() {
+		latest = time.Now()
+	}
+	return latest
+}
+
+// syncStatePath returns the path of the file recording the last sync
+func syncStatePath() string {
+	dir, err := state.Dir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "settings_sync.json")
+}
+
+// loadSyncState reads the record of the last sync; empty if there was none
+func loadSyncState() syncState {
+	var last syncState
+	if path := syncStatePath(); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			_ = json.Unmarshal(data, &last)
+		}
+	}
+	return last
+}
+
+// saveSyncState records a sync
+func (c *Client) saveSyncState(last syncState) {
+	path := syncStatePath()
+	if path == "" {
+		return
+	}
+	data, err := json.MarshalIndent(last, "", "  ")
+	if err == nil {
+		err = state.WriteFileAtomic(path, data)
+	}
+	if err != nil {
+		c.logger("Could not record the settings sync: %v", err)
+	}
+}
+
+// syncKey derives the 256-bit encryption key from the passphrase and a
+// salt with PBKDF2-HMAC-SHA256
+func syncKey(passphrase string, salt []byte) []byte {
+	return pbkdf2.Key([]byte(passphrase), salt, syncIterations, syncKeySize, sha256.New)
+}
+
+// encryptSettings encrypts serialized settings with a key derived from the passphrase
+func encryptSettings(data []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, syncSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	gcm, err := syncCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := append([]byte(syncMagic), salt...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, data, []byte(syncMagic)), nil
+}
+
+// decryptSettings decrypts settings encrypted with encryptSettings
+func decryptSettings(encrypted []byte, passphrase string) ([]byte, error) {
+	if !bytes.HasPrefix(encrypted, []byte(syncMagic)) || len(encrypted) < len(syncMagic)+syncSaltSize {
+		return nil, fmt.Errorf("unknown format of the synced settings")
+	}
+	rest := encrypted[len(syncMagic):]
+	gcm, err := syncCipher(passphrase, rest[:syncSaltSize])
+	if err != nil {
+		return nil, err
+	}
+	rest = rest[syncSaltSize:]
+	if len(rest) < gcm.NonceSize() {
+		return nil, fmt.Errorf("unknown format of the synced settings")
+	}
+
+	data, err := gcm.Open(nil, rest[:gcm.NonceSize()], rest[gcm.NonceSize():], []byte(syncMagic))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt the synced settings: wrong sync passphrase")
+	}
+	return data, nil
+}
+
+// syncCipher returns the AES-GCM cipher for a passphrase and salt
+func syncCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(syncKey(passphrase, salt))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,48 @@
+// settingssync_test.go
+/**
+ * Nexuflex Client - Settings Sync Tests
+ *
+ * This file contains tests of the encryption of synced settings: the key
+ * derivation against a PBKDF2-HMAC-SHA256 value computed independently,
+ * so that settings encrypted by earlier versions can still be decrypted,
+ * and a round trip with the right and a wrong passphrase.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestSyncKey(t *testing.T) {
+	const want = "d619ed83590c2476d2ca9a26f1e1882640eb6875f90838bd9058e56906bade5b"
+	key := syncKey("correct horse battery staple", []byte("nexuflex-salt-16"))
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("syncKey() = %s, want %s", got, want)
+	}
+}
+
+func TestEncryptSettings(t *testing.T) {
+	data := []byte(`{"aliases":{"ll":"Inventory.List.Items"}}`)
+	encrypted, err := encryptSettings(data, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := decryptSettings(encrypted, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, data) {
+		t.Errorf("decrypted %q, want %q", decrypted, data)
+	}
+	if _, err := decryptSettings(encrypted, "wrong"); err == nil {
+		t.Error("decrypting with a wrong passphrase succeeded")
+	}
+}
@@ -0,0 +1,382 @@
+// shellimport.go
+/**
+ * Nexuflex Client - Shell Import
+ *
+ * This file contains the conversion of shell history files and alias
+ * definitions into nexuflex history entries and aliases, for operators
+ * moving from shell scripts around the batch mode to the interactive
+ * client. Recognized are invocations of the client itself:
+ *
+ *   nexuflex-client -server erp01 Finance.Reports.Daily  ->  Finance.Reports.Daily
+ *   nexuflex-client -exec "Inventory.Show.Item 4711"     ->  Inventory.Show.Item 4711
+ *   alias stock='nexuflex-client Inventory.Show.Item'     ->  alias stock=Inventory.Show.Item
+ *
+ * Bash history files may contain "#<timestamp>" lines, zsh history files
+ * the extended format ": <timestamp>:<duration>;<command>"; both are
+ * understood. Lines using shell variables or command substitutions, and
+ * lines not invoking the client, are skipped since they have no nexuflex
+ * equivalent.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Kinds of import candidates
+const (
+	ImportHistory = "history"
+	ImportAlias   = "alias"
+)
+
+// clientExecutables are the names under which the client is invoked in shell lines
+var clientExecutables = map[string]bool{
+	"nexuflex-client": true,
+	"nexuflex":        true,
+}
+
+// clientValueFlags are the command line flags of the client taking a value
+var clientValueFlags = map[string]bool{
+	"config":           true,
+	"server":           true,
+	"port":             true,
+	"discover-timeout": true,
+	"lang":             true,
+	"exec":             true,
+	"api-key":          true,
+	"scope":            true,
+}
+
+// zshHistoryPrefix matches the prefix of an entry in the extended zsh history format
+var zshHistoryPrefix = regexp.MustCompile(`^: *\d+:\d+;`)
+
+// ImportCandidate is a history entry or alias converted from a shell file
+type ImportCandidate struct {
+	Kind    string // ImportHistory or ImportAlias
+	Name    string // Name of an alias, empty for history entries
+	Command string // The nexuflex command
+	Line    int    // Line number in the shell file
+	Source  string // The shell line the candidate was converted from
+}
+
+// shellWord is a word of a shell line
+type shellWord struct {
+	text     string
+	operator bool // Unquoted control or redirection operator, e.g. "|" or ">"
+	expands  bool // Contains an unquoted or double-quoted "$" or "`"
+}
+
+// ImportShellHistory converts the client invocations in a bash or zsh
+// history file into history entries; repeated commands are returned once
+func ImportShellHistory(path string) ([]ImportCandidate, error) {
+	var candidates []ImportCandidate
+	seen := make(map[string]bool)
+
+	err := readShellFile(path, func(number int, line string) {
+		line = zshHistoryPrefix.ReplaceAllString(line, "")
+		if strings.HasPrefix(line, "#") {
+			// Comments and the timestamps bash writes with HISTTIMEFORMAT
+			return
+		}
+		command, ok := ConvertShellCommand(line)
+		if !ok || seen[command] {
+			return
+		}
+		seen[command] = true
+		candidates = append(candidates, ImportCandidate{
+			Kind:    ImportHistory,
+			Command: command,
+			Line:    number,
+			Source:  line,
+		})
+	})
+	return candidates, err
+}
+
+// ImportShellAliases converts the aliases in a zsh or bash file (e.g. .zshrc)
+// whose expansion invokes the client; a later definition of a name wins
+func ImportShellAliases(path string) ([]ImportCandidate, error) {
+	var candidates []ImportCandidate
+	index := make(map[string]int)
+
+	err := readShellFile(path, func(number int, line string) {
+		words := splitShellWords(line)
+		if len(words) < 2 || words[0].text != "alias" || words[0].operator {
+			return
+		}
+
+		for _, word := range words[1:] {
+			if word.operator {
+				break
+			}
+			name, expansion, ok := strings.Cut(word.text, "=")
+			if !ok || name == "" || strings.HasPrefix(name, "-") {
+				// Options such as "alias -g" and lookups without "=" define nothing
+				continue
+			}
+			command, ok := ConvertShellCommand(expansion)
+			if !ok {
+				continue
+			}
+
+			candidate := ImportCandidate{
+				Kind:    ImportAlias,
+				Name:    name,
+				Command: command,
+				Line:    number,
+				Source:  line,
+			}
+			if i, exists := index[name]; exists {
+				candidates[i] = candidate
+				continue
+			}
+			index[name] = len(candidates)
+			candidates = append(candidates, candidate)
+		}
+	})
+	return candidates, err
+}
+
+// ConvertShellCommand converts a shell line invoking the client into the
+// nexuflex command it runs; ok is false if the line does not run a command
+// through the client or depends on shell expansions
+func ConvertShellCommand(line string) (string, bool) {
+	words := splitShellWords(line)
+
+	// Find the invocation of the client, e.g. after environment assignments or "sudo"
+	start := -1
+	for i, word := range words {
+		if !word.operator && isClientExecutable(word.text) {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 {
+		return "", false
+	}
+
+	// Arguments end at the next operator, e.g. a pipe or a redirection
+	var args []shellWord
+	for _, word := range words[start:] {
+		if word.operator {
+			break
+		}
+		args = append(args, word)
+	}
+
+	command, ok := clientCommand(args)
+	if !ok || strings.TrimSpace(command) == "" {
+		return "", false
+	}
+	return command, true
+}
+
+// clientCommand extracts the command from the arguments of a client
+// invocation, following the flag syntax of the client
+func clientCommand(args []shellWord) (string, bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg.text == "--" {
+			return joinCommandWords(args[i+1:])
+		}
+		if !strings.HasPrefix(arg.text, "-") || arg.text == "-" {
+			return joinCommandWords(args[i:])
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg.text, "-"), "=")
+		switch {
+		case name == "version" || name == "check-config":
+			// The client exits without running a command
+			return "", false
+		case !clientValueFlags[name]:
+			continue
+		case !hasValue:
+			if i+1 >= len(args) {
+				return "", false
+			}
+			i++
+			value = args[i].text
+			arg = args[i]
+		}
+
+		if name == "exec" {
+			if arg.expands {
+				return "", false
+			}
+			return strings.TrimSpace(value), true
+		}
+	}
+	return "", false
+}
+
+// joinCommandWords joins the words of a command, quoting words with blanks
+// the way the command line of the client expects them
+func joinCommandWords(words []shellWord) (string, bool) {
+	if len(words) == 0 {
+		return "", false
+	}
+	// "upload - <command>" reads the standard input, which the shell line redirected
+	if words[0].text == "upload" && len(words) > 1 && words[1].text == "-" {
+		return "", false
+	}
+
+	parts := make([]string, 0, len(words))
+	for _, word := range words {
+		if word.expands {
+			return "", false
+		}
+		parts = append(parts, quoteCommandWord(word.text))
+	}
+	return strings.Join(parts, " "), true
+}
+
+// quoteCommandWord encloses the value of a word with blanks in double quotes,
+// e.g. `name="a b"` or `@file:"my rates.csv"`
+func quoteCommandWord(text string) string {
+	if !strings.ContainsAny(text, " \t") {
+		return text
+	}
+	prefix := ""
+	if name, value, ok := strings.Cut(text, "="); ok && !strings.ContainsAny(name, " \t") {
+		prefix, text = name+"=", value
+	}
+	if strings.HasPrefix(text, FileParameterPrefix) {
+		prefix, text = prefix+FileParameterPrefix, strings.TrimPrefix(text, FileParameterPrefix)
+	}
+	return prefix + `"` + text + `"`
+}
+
+// isClientExecutable checks whether a word names the client executable
+func isClientExecutable(word string) bool {
+	name := strings.ToLower(filepath.Base(strings.ReplaceAll(word, `\`, "/")))
+	return clientExecutables[strings.TrimSuffix(name, ".exe")]
+}
+
+// splitShellWords splits a shell line into words, following the quoting
+// rules of the POSIX shell; a comment ends the line
+func splitShellWords(line string) []shellWord {
+	var words []shellWord
+	var current strings.Builder
+	var word shellWord
+	inWord := false
+
+	finish := func() {
+		if inWord {
+			word.text = current.String()
+			words = append(words, word)
+		}
+		current.Reset()
+		word = shellWord{}
+		inWord = false
+	}
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t':
+			finish()
+
+		case r == '#' && !inWord:
+			finish()
+			return words
+
+		case r == '\'':
+			inWord = true
+			end := indexRune(runes, i+1, '\'')
+			current.WriteString(string(runes[i+1 : end]))
+			i = end
+
+		case r == '"':
+			inWord = true
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && strings.ContainsRune(`"\$`+"`", runes[i+1]) {
+					i++
+				} else if runes[i] == '$' || runes[i] == '`' {
+					word.expands = true
+				}
+				current.WriteRune(runes[i])
+			}
+
+		case r == '\\':
+			inWord = true
+			if i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+			}
+
+		case strings.ContainsRune("|&;<>()", r):
+			// A redirection like "2>" belongs to the operator, not to a word
+			if current.Len() > 0 && strings.Trim(current.String(), "0123456789") == "" && (r == '<' || r == '>') {
+				current.Reset()
+				inWord = false
+			}
+			finish()
+			words = append(words, shellWord{text: string(r), operator: true})
+
+		default:
+			if r == '$' || r == '`' {
+				word.expands = true
+			}
+			inWord = true
+			current.WriteRune(r)
+		}
+	}
+	finish()
+	return words
+}
+
+// indexRune returns the index of the next r from start, or the length if there is none
+func indexRune(runes []rune, start int, r rune) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return len(runes)
+}
+
+// readShellFile calls fn for every non-empty line of a shell file; lines
+// continued with a trailing backslash are joined
+func readShellFile(path string, fn func(number int, line string)) error {
+	file, err := os.Open(expandHome(path))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var pending strings.Builder
+	start, number := 0, 0
+	for scanner.Scan() {
+		number++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if pending.Len() == 0 {
+			start = number
+		}
+		if strings.HasSuffix(line, `\`) {
+			pending.WriteString(strings.TrimSuffix(line, `\`))
+			continue
+		}
+		pending.WriteString(line)
+		if text := strings.TrimSpace(pending.String()); text != "" {
+			fn(start, text)
+		}
+		pending.Reset()
+	}
+	if text := strings.TrimSpace(pending.String()); text != "" {
+		fn(start, text)
+	}
+	return scanner.Err()
+}
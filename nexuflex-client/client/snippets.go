@@ -0,0 +1,69 @@
+// snippets.go
+/**
+ * Nexuflex Client - Text Snippets
+ *
+ * This file contains the expansion of text snippets. A snippet is a short
+ * name for a text that is typed often, e.g. a fiscal quarter or a cost
+ * center; typing the snippet prefix and the name, followed by a space,
+ * replaces it with the text anywhere in the input line. Aliases only
+ * replace the first word of a command, snippets any word.
+ * Snippets are declared in the [snippets] section of the configuration
+ * file, so every profile has its own.
+ *
+ * Example (with the default prefix ";"):
+ *   [snippets]
+ *   q4 = Q4_2024
+ *   cc = costcenter=4711
+ *
+ *   "Finance.Reports.Quarter ;q4 " becomes "Finance.Reports.Quarter Q4_2024 "
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultSnippetPrefix starts the words that are expanded as snippets
+const DefaultSnippetPrefix = ";"
+
+// ValidateSnippet checks the name and the text of a snippet
+func ValidateSnippet(name, text string) error {
+	if name == "" {
+		return fmt.Errorf("snippet name must not be empty")
+	}
+	if strings.ContainsAny(name, " \t=") {
+		return fmt.Errorf("snippet name must not contain spaces or '='")
+	}
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("snippet text must not be empty")
+	}
+	if strings.ContainsAny(text, "\r\n") {
+		return fmt.Errorf("snippet text must be a single line")
+	}
+	return nil
+}
+
+// ExpandLastSnippet replaces the last word of a text with its snippet if it
+// consists of the prefix and the name of a snippet; returns false if it does not
+func ExpandLastSnippet(text string, snippets map[string]string, prefix string) (string, bool) {
+	if prefix == "" || len(snippets) == 0 {
+		return text, false
+	}
+
+	start := strings.LastIndexAny(text, " \t") + 1
+	word := text[start:]
+	if !strings.HasPrefix(word, prefix) {
+		return text, false
+	}
+	expansion, ok := snippets[word[len(prefix):]]
+	if !ok {
+		return text, false
+	}
+	return text[:start] + expansion, true
+}
@@ -0,0 +1,104 @@
+// streamedresult.go
+/**
+ * Nexuflex Client - Streamed Results
+ *
+ * This file contains the transparent upgrade of large results to a
+ * stream. With `prefer_streaming`, ExecuteCommand tells the server from
+ * which size (`stream_threshold_kb`) it prefers a stream; a server holding
+ * back a larger output answers with a stream ID instead of the output.
+ * The client then fetches the output with ExecuteStreamingCommand and
+ * delivers it while it arrives, so the user interface starts rendering
+ * right away instead of waiting for one large message. To the callers
+ * of ExecuteCommand the result looks the same as an unary one.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// streamedResultTimeout limits the transfer of a streamed result
+const streamedResultTimeout = 10 * time.Minute
+
+// streamingPreference returns whether large results should be streamed and
+// the size in bytes from which they should, 0 for the server default
+func (c *Client) streamingPreference() (bool, int32) {
+	if !c.config.Server.PreferStreaming {
+		return false, 0
+	}
+	return true, int32(c.config.Server.StreamThresholdKB) * 1024
+}
+
+// receiveStreamedResult fetches the output the server held back for
+// streaming and returns it completely; plain text output is delivered to
+// the output callback while it arrives, through post if it is set
+func (c *Client) receiveStreamedResult(command string, resp *proto.CommandResponse, post func(func())) (string, error) {
+	// Other content types are rendered as a whole once complete
+	deliver := c.onOutputReceived
+	if plugin.NormalizeContentType(resp.ContentType) != plugin.ContentTypePlain && c.onContentReceived != nil {
+		deliver = nil
+	}
+	if deliver != nil && post != nil {
+		onOutput := deliver
+		deliver = func(output string) {
+			post(func() { onOutput(output) })
+		}
+	}
+	return c.readStreamedResult(command, resp, deliver)
+}
+
+// readStreamedResult fetches the output the server held back for streaming,
+// passing the text to deliver while it arrives if it is set
+func (c *Client) readStreamedResult(command string, resp *proto.CommandResponse, deliver func(output string)) (string, error) {
+	c.logger("Streaming result of %d bytes: %s", resp.OutputBytes, c.MaskCommand(command))
+
+	ctx, cancel := context.WithTimeout(context.Background(), streamedResultTimeout)
+	defer cancel()
+
+	stream, err := c.client.ExecuteStreamingCommand(ctx, &proto.CommandRequest{
+		SessionToken: c.sessionToken,
+		StreamId:     resp.StreamId,
+	})
+	if err != nil {
+		return "", c.rpcError("streaming the result", command, 0, err)
+	}
+
+	// Keep-alives pause while a stream is running
+	c.activeStreams.Add(1)
+	defer c.activeStreams.Add(-1)
+
+	var output strings.Builder
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return output.String(), c.rpcError("receiving the streamed result", command, 0, err)
+		}
+
+		switch chunk.Type {
+		case proto.CommandOutput_TEXT:
+			output.WriteString(chunk.Content)
+			if deliver != nil {
+				// The output callback ends every delivery with a line break
+				deliver(strings.TrimSuffix(chunk.Content, "\n"))
+			}
+		case proto.CommandOutput_ERROR:
+			return output.String(), fmt.Errorf("streaming the result failed: %s", chunk.Content)
+		}
+	}
+	return output.String(), nil
+}
@@ -0,0 +1,243 @@
+// supportbundle.go
+/**
+ * Nexuflex Client - Support Bundles
+ *
+ * This file contains the support bundle: a zip file with everything needed
+ * to look into a problem with the client or the server, meant to be
+ * attached to a bug report. It holds the version information of client and
+ * server, the configuration file, the end of the debug log, the terminal
+ * capabilities and the protocol traces of the last commands. Credentials
+ * are masked in all of them before they are written.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+)
+
+// supportLogBytes is the size of the end of the debug log in a support bundle
+const supportLogBytes = 512 * 1024
+
+var (
+	// urlCredentials matches the user information of URLs
+	urlCredentials = regexp.MustCompile(`(://[^/\s:@]+):[^@\s/]+@`)
+	// logSecret matches credentials written as "password=..." or "token: ..."
+	logSecret = regexp.MustCompile(`(?i)(authorization|password|secret|token)(["']?\s*[:=]\s*)("[^"]*"|\S+)`)
+)
+
+// DebugLogPath returns the path of the log written with --debug
+func DebugLogPath() string {
+	return filepath.Join(os.TempDir(), "nexuflex-client.log")
+}
+
+// WriteSupportBundle writes a support bundle to a zip file; terminal describes
+// the capabilities of the terminal and commands is the number of last
+// commands whose protocol traces are included. It returns the names of the
+// files in the bundle.
+func (c *Client) WriteSupportBundle(path string, terminal []string, commands int) ([]string, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	var names []string
+	add := func(name, content string) error {
+		w, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			return err
+		}
+		names = append(names, name)
+		return nil
+	}
+
+	if err := add("version.txt", c.supportVersion()); err != nil {
+		return nil, err
+	}
+	configText, err := supportConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := add("config.ini", configText); err != nil {
+		return nil, err
+	}
+	if log, ok := supportDebugLog(); ok {
+		if err := add("debug.log", log); err != nil {
+			return nil, err
+		}
+	}
+	if err := add("terminal.txt", supportTerminal(terminal)); err != nil {
+		return nil, err
+	}
+	if err := add("traces.txt", formatTraces(c.RecentCommandTraces(commands))); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// supportVersion describes the builds of client and server and the session
+func (c *Client) supportVersion() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "client: %s\n", BuildInfo())
+	fmt.Fprintf(&sb, "go: %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&sb, "created: %s\n", time.Now().Format(time.RFC3339))
+
+	report := c.CheckCompatibility()
+	if info := c.GetServerInfo(); info != nil {
+		fmt.Fprintf(&sb, "server: %s (%s:%d)\n", info.ShortName, info.Address, info.Port)
+		fmt.Fprintf(&sb, "server version: %s\n", report.ServerVersion)
+		fmt.Fprintf(&sb, "server features: %s\n", strings.Join(report.ServerFeatures, ", "))
+	} else {
+		sb.WriteString("server: not connected\n")
+	}
+	if report.Reason != "" {
+		fmt.Fprintf(&sb, "compatibility: %s\n", report.Reason)
+	}
+	fmt.Fprintf(&sb, "logged in: %t\n", c.IsLoggedIn())
+	fmt.Fprintf(&sb, "verbose: %t\n", c.IsVerbose())
+	return sb.String()
+}
+
+// supportConfig returns the loaded configuration file with the values of
+// credentials masked, followed by the problems found in it
+func supportConfig() (string, error) {
+	path := config.LoadedConfigPath()
+	if path == "" {
+		return "; No configuration file, the defaults are used\n", nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "; %s\n", path)
+	for _, line := range strings.Split(strings.TrimRight(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n"), "\n") {
+		if key, _, ok := strings.Cut(line, "="); ok && !strings.HasPrefix(strings.TrimSpace(line), ";") &&
+			!strings.HasPrefix(strings.TrimSpace(line), "#") && isSecretMetadata(strings.TrimSpace(key)) {
+			line = key + "= ****"
+		}
+		sb.WriteString(urlCredentials.ReplaceAllString(line, "$1:****@") + "\n")
+	}
+
+	if diagnostics := config.LoadDiagnostics(); len(diagnostics) > 0 {
+		sb.WriteString("\n; Problems found when loading:\n")
+		for _, diagnostic := range diagnostics {
+			sb.WriteString("; " + diagnostic.String() + "\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+// supportDebugLog returns the end of the debug log with credentials masked
+func supportDebugLog() (string, bool) {
+	file, err := os.Open(DebugLogPath())
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", false
+	}
+	offset := max(0, info.Size()-supportLogBytes)
+	data := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(data, offset); err != nil && err != io.EOF {
+		return "", false
+	}
+
+	text := string(data)
+	if offset > 0 {
+		// Start at the first complete line
+		if i := strings.IndexByte(text, '\n'); i >= 0 {
+			text = text[i+1:]
+		}
+	}
+	text = logSecret.ReplaceAllString(text, "$1$2****")
+	return urlCredentials.ReplaceAllString(text, "$1:****@"), true
+}
+
+// supportTerminal describes the terminal from the environment and the
+// capabilities reported by the user interface
+func supportTerminal(terminal []string) string {
+	var sb strings.Builder
+	for _, name := range []string{"TERM", "TERM_PROGRAM", "TERM_PROGRAM_VERSION", "COLORTERM", "LANG", "LC_ALL"} {
+		fmt.Fprintf(&sb, "%s=%s\n", name, os.Getenv(name))
+	}
+	for _, line := range terminal {
+		sb.WriteString(line + "\n")
+	}
+	return sb.String()
+}
+
+// formatTraces writes protocol traces as text, one block per RPC
+func formatTraces(traces []RPCTrace) string {
+	if len(traces) == 0 {
+		return "No RPCs recorded\n"
+	}
+
+	var sb strings.Builder
+	for _, trace := range traces {
+		kind := "unary"
+		if trace.Stream {
+			kind = "stream"
+		}
+		fmt.Fprintf(&sb, "%s  %s  %s  %s  %s\n", trace.Started.Format("2006-01-02T15:04:05.000Z07:00"),
+			trace.Method, kind, trace.Duration.Round(time.Millisecond), trace.Code)
+		if trace.Command != "" {
+			fmt.Fprintf(&sb, "  command: %s\n", trace.Command)
+		}
+		fmt.Fprintf(&sb, "  request: %d message(s), %d bytes; response: %d message(s), %d bytes\n",
+			trace.Requests, trace.RequestBytes, trace.Responses, trace.ResponseBytes)
+		writeTraceMetadata(&sb, "request metadata", trace.RequestMetadata)
+		writeTraceMetadata(&sb, "response metadata", trace.ResponseMetadata)
+		if trace.Message != "" {
+			fmt.Fprintf(&sb, "  status: %s\n", trace.Message)
+		}
+	}
+	return sb.String()
+}
+
+// writeTraceMetadata writes masked metadata in the order of the keys
+func writeTraceMetadata(sb *strings.Builder, label string, md map[string][]string) {
+	if len(md) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(md))
+	for key := range md {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(sb, "  %s:\n", label)
+	for _, key := range keys {
+		fmt.Fprintf(sb, "    %s: %s\n", key, strings.Join(md[key], ", "))
+	}
+}
@@ -0,0 +1,167 @@
+// telemetry.go
+/**
+ * Nexuflex Client - Opt-in Telemetry
+ *
+ * This file contains the collection of client information and feature
+ * usage counts that are reported to the server only if the user has
+ * explicitly enabled telemetry in the configuration.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// Telemetry collects the information for the opt-in client report
+type Telemetry struct {
+	mu      sync.Mutex
+	enabled bool
+	usage   map[string]int32
+}
+
+// NewTelemetry creates a new telemetry collector
+func NewTelemetry(enabled bool) *Telemetry {
+	return &Telemetry{
+		enabled: enabled,
+		usage:   make(map[string]int32),
+	}
+}
+
+// IsEnabled returns whether telemetry is enabled
+func (t *Telemetry) IsEnabled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.enabled
+}
+
+// SetEnabled enables or disables telemetry; disabling discards all collected counts
+func (t *Telemetry) SetEnabled(enabled bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.enabled = enabled
+	if !enabled {
+		t.usage = make(map[string]int32)
+	}
+}
+
+// RecordFeature increments the usage count of a client feature
+func (t *Telemetry) RecordFeature(feature string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Nothing is collected unless the user has opted in
+	if !t.enabled {
+		return
+	}
+	t.usage[feature]++
+}
+
+// BuildReport creates the report exactly as it would be sent to the server
+func (t *Telemetry) BuildReport(sessionToken string) *proto.ClientInfoRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	usage := make(map[string]int32, len(t.usage))
+	for feature, count := range t.usage {
+		usage[feature] = count
+	}
+
+	return &proto.ClientInfoRequest{
+		SessionToken:  sessionToken,
+		ClientVersion: Version,
+		Os:            runtime.GOOS + "/" + runtime.GOARCH,
+		TerminalType:  detectTerminalType(),
+		FeatureUsage:  usage,
+	}
+}
+
+// DescribeReport returns a human-readable description of the report contents
+func (t *Telemetry) DescribeReport() []string {
+	report := t.BuildReport("")
+
+	lines := []string{
+		fmt.Sprintf("client_version: %s", report.ClientVersion),
+		fmt.Sprintf("os: %s", report.Os),
+		fmt.Sprintf("terminal_type: %s", report.TerminalType),
+	}
+
+	// Sort features for a stable display
+	features := make([]string, 0, len(report.FeatureUsage))
+	for feature := range report.FeatureUsage {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+
+	if len(features) == 0 {
+		lines = append(lines, "feature_usage: -")
+	} else {
+		lines = append(lines, "feature_usage:")
+		for _, feature := range features {
+			lines = append(lines, fmt.Sprintf("  %s: %d", feature, report.FeatureUsage[feature]))
+		}
+	}
+
+	return lines
+}
+
+// detectTerminalType determines the terminal type from the environment
+func detectTerminalType() string {
+	term := os.Getenv("TERM")
+	if program := os.Getenv("TERM_PROGRAM"); program != "" {
+		if term != "" {
+			return term + " (" + program + ")"
+		}
+		return program
+	}
+	if term == "" {
+		return "unknown"
+	}
+	return term
+}
+
+// GetTelemetry returns the telemetry collector of the client
+func (c *Client) GetTelemetry() *Telemetry {
+	return c.telemetry
+}
+
+// ReportClientInfo sends the telemetry report to the server if telemetry is enabled
+func (c *Client) ReportClientInfo() error {
+	if !c.telemetry.IsEnabled() {
+		return nil
+	}
+
+	if c.client == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ReportClientInfo(ctx, c.telemetry.BuildReport(c.sessionToken))
+	if err != nil {
+		c.logger("Error reporting client info: %v", err)
+		return fmt.Errorf("error reporting client info: %v", err)
+	}
+
+	if !resp.Success {
+		c.logger("Client info report rejected: %s", resp.ErrorMessage)
+		return fmt.Errorf("client info report rejected: %s", resp.ErrorMessage)
+	}
+
+	c.logger("Client info reported")
+	return nil
+}
@@ -0,0 +1,156 @@
+// thresholds.go
+/**
+ * Nexuflex Client - Result Thresholds
+ *
+ * This file contains the threshold rules for command results. Rules are
+ * declared in the [threshold] section of the configuration as
+ * "<command> <metric> > <limit> [=> <severity>]"; the command is a name or
+ * a prefix ending in "*", the metric is one of rows (table rows or lines of
+ * output), duration (a Go duration like 30s) or bytes. Every finished
+ * command matching a rule is checked against the limit; a breach is a
+ * warning unless the rule names another severity.
+ *
+ * Example:
+ *   [threshold]
+ *   open_items = Finance.List.OpenItems rows > 10000
+ *   slow       = * duration > 30s => info
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+)
+
+// Metrics of a command result that thresholds can check
+const (
+	MetricRows     = "rows"
+	MetricDuration = "duration"
+	MetricBytes    = "bytes"
+)
+
+// ThresholdRule flags results of matching commands exceeding a limit
+type ThresholdRule struct {
+	Name     string
+	Command  string // Command name, or a prefix ending in "*"
+	Metric   string
+	Limit    int64 // Rows, bytes or nanoseconds
+	Severity string
+}
+
+// ResultMetrics are the measured values of a command result
+type ResultMetrics struct {
+	Rows     int
+	Duration time.Duration
+	Bytes    int
+}
+
+// ParseThresholdRules parses the threshold definitions of the configuration
+func ParseThresholdRules(definitions map[string]string) ([]*ThresholdRule, error) {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := make([]*ThresholdRule, 0, len(names))
+	var invalid []string
+	for _, name := range names {
+		rule, err := parseThresholdRule(name, definitions[name])
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(invalid) > 0 {
+		return rules, fmt.Errorf("invalid threshold rules: %s", strings.Join(invalid, "; "))
+	}
+	return rules, nil
+}
+
+// parseThresholdRule parses a single "<command> <metric> > <limit> [=> <severity>]" definition
+func parseThresholdRule(name, definition string) (*ThresholdRule, error) {
+	severity := plugin.SeverityWarning
+	if condition, target, ok := strings.Cut(definition, referenceSeparator); ok {
+		severity = strings.ToLower(strings.TrimSpace(target))
+		if plugin.NormalizeSeverity(severity) != severity {
+			return nil, fmt.Errorf("unknown severity %q (info, warning or error)", target)
+		}
+		definition = condition
+	}
+
+	fields := strings.Fields(definition)
+	if len(fields) != 4 || fields[2] != ">" {
+		return nil, fmt.Errorf("expected \"<command> <metric> > <limit> [%s <severity>]\"", referenceSeparator)
+	}
+
+	rule := &ThresholdRule{Name: name, Command: fields[0], Metric: strings.ToLower(fields[1]), Severity: severity}
+	switch rule.Metric {
+	case MetricRows, MetricBytes:
+		limit, err := strconv.ParseInt(strings.NewReplacer(",", "", "_", "").Replace(fields[3]), 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid limit %q", fields[3])
+		}
+		rule.Limit = limit
+	case MetricDuration:
+		limit, err := time.ParseDuration(fields[3])
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid duration %q", fields[3])
+		}
+		rule.Limit = int64(limit)
+	default:
+		return nil, fmt.Errorf("unknown metric %q (rows, duration or bytes)", fields[1])
+	}
+	return rule, nil
+}
+
+// CheckThresholds returns an annotation for every rule the result of a
+// command exceeds
+func CheckThresholds(rules []*ThresholdRule, command string, metrics ResultMetrics) []plugin.Annotation {
+	name, _, _ := strings.Cut(strings.TrimSpace(command), " ")
+	var annotations []plugin.Annotation
+	for _, rule := range rules {
+		if !matchesCommandPattern(name, []string{rule.Command}) {
+			continue
+		}
+
+		var value, limit string
+		switch rule.Metric {
+		case MetricRows:
+			if int64(metrics.Rows) <= rule.Limit {
+				continue
+			}
+			value, limit = strconv.Itoa(metrics.Rows), strconv.FormatInt(rule.Limit, 10)
+		case MetricBytes:
+			if int64(metrics.Bytes) <= rule.Limit {
+				continue
+			}
+			value, limit = strconv.Itoa(metrics.Bytes), strconv.FormatInt(rule.Limit, 10)
+		case MetricDuration:
+			if int64(metrics.Duration) <= rule.Limit {
+				continue
+			}
+			value, limit = metrics.Duration.Round(100*time.Millisecond).String(), time.Duration(rule.Limit).String()
+		}
+
+		annotations = append(annotations, plugin.Annotation{
+			Severity: rule.Severity,
+			Text:     fmt.Sprintf("%s: %s %s > %s", rule.Name, rule.Metric, value, limit),
+			Tags:     []string{"threshold"},
+			Source:   "threshold",
+		})
+	}
+	return annotations
+}
@@ -0,0 +1,198 @@
+// timelinefilters.go
+/**
+ * Nexuflex Client - Timeline Filters
+ *
+ * This file contains the named filters of the session timeline. Filters
+ * are declared in the [timeline_filter] section of the configuration file,
+ * so every profile has its own, as a list of conditions that must all be
+ * met: event types, "#tag", "service=<name>", "severity=<level>",
+ * "text=<regex>" and "since=<duration>" (e.g. 30m or 24h, counted back
+ * from now). Several values of the same condition are alternatives. A
+ * leading "*" marks a favorite; the timeline page cycles through the
+ * favorites with a single key.
+ *
+ * Example:
+ *   [timeline_filter]
+ *   errors    = * error notification severity=error
+ *   inventory = service=Inventory since=24h
+ *   timeouts  = * text=time(d)?\sout since=1h
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// favoriteMarker marks a timeline filter as a favorite
+const favoriteMarker = "*"
+
+// TimelineFilter selects the events shown on the timeline page
+type TimelineFilter struct {
+	Name       string
+	Favorite   bool
+	Types      []EventType
+	Tags       []string
+	Services   []string
+	Severities []string
+	Pattern    *regexp.Regexp
+	Since      time.Duration // Only events of this last period, if set
+}
+
+// ValidateTimelineFilterName checks the name of a timeline filter
+func ValidateTimelineFilterName(name string) error {
+	if name == "" {
+		return fmt.Errorf("filter name must not be empty")
+	}
+	if strings.ContainsAny(name, " \t=@") {
+		return fmt.Errorf("filter name must not contain spaces, '=' or '@'")
+	}
+	return nil
+}
+
+// ParseTimelineFilters parses the timeline filter definitions of the
+// configuration, sorted by name
+func ParseTimelineFilters(definitions map[string]string) ([]*TimelineFilter, error) {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	filters := make([]*TimelineFilter, 0, len(names))
+	var invalid []string
+	for _, name := range names {
+		filter, err := ParseTimelineFilter(name, definitions[name])
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		filters = append(filters, filter)
+	}
+
+	if len(invalid) > 0 {
+		return filters, fmt.Errorf("invalid timeline filters: %s", strings.Join(invalid, "; "))
+	}
+	return filters, nil
+}
+
+// ParseTimelineFilter parses a single filter definition
+func ParseTimelineFilter(name, definition string) (*TimelineFilter, error) {
+	if err := ValidateTimelineFilterName(name); err != nil {
+		return nil, err
+	}
+
+	filter := &TimelineFilter{Name: name}
+	words := strings.Fields(definition)
+	if len(words) > 0 && words[0] == favoriteMarker {
+		filter.Favorite = true
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("filter has no conditions")
+	}
+
+	for _, word := range words {
+		key, value, hasValue := strings.Cut(word, "=")
+		switch {
+		case strings.HasPrefix(word, "#") && len(word) > 1:
+			filter.Tags = append(filter.Tags, strings.ToLower(word[1:]))
+		case !hasValue:
+			eventType := EventType(strings.ToLower(word))
+			if !slices.Contains(EventTypes, eventType) {
+				return nil, fmt.Errorf("unknown event type %q", word)
+			}
+			filter.Types = append(filter.Types, eventType)
+		case value == "":
+			return nil, fmt.Errorf("%s has no value", key)
+		case key == "service":
+			filter.Services = append(filter.Services, value)
+		case key == "severity":
+			filter.Severities = append(filter.Severities, strings.ToLower(value))
+		case key == "text":
+			if filter.Pattern != nil {
+				return nil, fmt.Errorf("only one text pattern is allowed")
+			}
+			pattern, err := regexp.Compile("(?i)" + value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid text pattern: %v", err)
+			}
+			filter.Pattern = pattern
+		case key == "since":
+			since, err := time.ParseDuration(value)
+			if err != nil || since <= 0 {
+				return nil, fmt.Errorf("invalid duration %q", value)
+			}
+			filter.Since = since
+		default:
+			return nil, fmt.Errorf("unknown condition %q", key)
+		}
+	}
+	return filter, nil
+}
+
+// Matches checks whether an event meets all conditions of the filter
+func (f *TimelineFilter) Matches(event Event, now time.Time) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+	if f.Since > 0 && event.Time.Before(now.Add(-f.Since)) {
+		return false
+	}
+	if len(f.Tags) > 0 && !containsFold(event.Tags, f.Tags) {
+		return false
+	}
+	if len(f.Severities) > 0 && !containsFold(eventSeverities(event), f.Severities) {
+		return false
+	}
+	if len(f.Services) > 0 && !matchesService(event, f.Services) {
+		return false
+	}
+	if f.Pattern != nil && !f.Pattern.MatchString(event.Text) {
+		return false
+	}
+	return true
+}
+
+// eventSeverities returns the severities of an event: the tags carrying
+// them for annotations and notifications, "error" for errors
+func eventSeverities(event Event) []string {
+	if event.Type == EventError {
+		return append([]string{"error"}, event.Tags...)
+	}
+	return event.Tags
+}
+
+// matchesService checks whether an event happened in one of the services,
+// or came from it in case of a notification
+func matchesService(event Event, services []string) bool {
+	for _, service := range services {
+		if strings.EqualFold(event.Context, service) ||
+			strings.HasPrefix(strings.ToLower(event.Context), strings.ToLower(service)+".") {
+			return true
+		}
+	}
+	return event.Type == EventNotification && containsFold(event.Tags, services)
+}
+
+// containsFold checks whether the values contain one of the wanted ones,
+// ignoring case
+func containsFold(values, wanted []string) bool {
+	for _, value := range values {
+		for _, want := range wanted {
+			if strings.EqualFold(value, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+// tlsconfig.go
+/**
+ * Nexuflex Client - TLS Verification
+ *
+ * This file contains the transport credentials of TLS connections, taken
+ * from the [tls] section of the configuration. The certificate of the
+ * server is verified against the system roots or the CA bundle in
+ * ca_file; cert_file and key_file present a client certificate for mutual
+ * TLS. Servers can additionally be pinned with pinned_sha256: the SHA-256
+ * fingerprints of certificates or public keys (SubjectPublicKeyInfo) of
+ * which one must be in a chain the server was verified with, written in
+ * hex (colons allowed) or base64. Further certificates the server sends
+ * are not tied to it and do not count. insecure_skip_verify turns off the
+ * verification of the chain and the host name for test systems; the pins
+ * are still checked then, against the certificate of the server only.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"google.golang.org/grpc/credentials"
+)
+
+// tlsCredentials returns the transport credentials of a TLS connection
+func (c *Client) tlsCredentials() (credentials.TransportCredentials, error) {
+	tlsConfig, err := buildTLSConfig(c.config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig.InsecureSkipVerify {
+		c.logger("Warning: the certificate of the server is not verified (insecure_skip_verify)")
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// buildTLSConfig creates the TLS configuration of the [tls] section
+func buildTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	// CA bundle instead of the system roots
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the CA bundle: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates in %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	// Client certificate for mutual TLS
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		if cfg.CertFile == "" || cfg.KeyFile == "" {
+			return nil, fmt.Errorf("a client certificate requires cert_file and key_file in the [tls] section")
+		}
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	// Pinned certificates or public keys of the server
+	pins, err := parsePins(cfg.PinnedSHA256)
+	if err != nil {
+		return nil, err
+	}
+	if len(pins) > 0 {
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifyPins(state, pins)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// parsePins decodes the configured SHA-256 fingerprints
+func parsePins(entries []string) ([][]byte, error) {
+	var pins [][]byte
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		pin, err := hex.DecodeString(strings.ReplaceAll(entry, ":", ""))
+		if err != nil || len(pin) != sha256.Size {
+			pin, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(entry, "sha256/"))
+		}
+		if err != nil || len(pin) != sha256.Size {
+			return nil, fmt.Errorf("invalid pinned_sha256 entry %q: expected a SHA-256 fingerprint in hex or base64", entry)
+		}
+		pins = append(pins, pin)
+	}
+	return pins, nil
+}
+
+// verifyPins checks that a certificate of a verified chain or its public key
+// matches a pin; without verified chains only the certificate of the server counts
+func verifyPins(state tls.ConnectionState, pins [][]byte) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("the server presented no certificate")
+	}
+	leaf := state.PeerCertificates[0]
+	candidates := []*x509.Certificate{leaf}
+	for _, chain := range state.VerifiedChains {
+		if len(chain) > 1 {
+			candidates = append(candidates, chain[1:]...)
+		}
+	}
+
+	for _, cert := range candidates {
+		certSum := sha256.Sum256(cert.Raw)
+		keySum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		for _, pin := range pins {
+			if bytes.Equal(pin, certSum[:]) || bytes.Equal(pin, keySum[:]) {
+				return nil
+			}
+		}
+	}
+	leafKey := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+	return fmt.Errorf("the certificate of the server does not match pinned_sha256 (public key sha256/%s)",
+		base64.StdEncoding.EncodeToString(leafKey[:]))
+}
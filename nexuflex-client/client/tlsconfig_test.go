@@ -0,0 +1,65 @@
+// tlsconfig_test.go
+/**
+ * Nexuflex Client - TLS Verification Tests
+ *
+ * This file contains tests of the pin check: a pin counts for the
+ * certificates of a verified chain, but not for further certificates the
+ * server merely sends along, and without verification only for the
+ * certificate of the server.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+)
+
+// testCertificate returns a certificate with the given content and a pin of it
+func testCertificate(name string) (*x509.Certificate, []byte) {
+	cert := &x509.Certificate{Raw: []byte(name), RawSubjectPublicKeyInfo: []byte(name + " key")}
+	sum := sha256.Sum256(cert.Raw)
+	return cert, sum[:]
+}
+
+func TestVerifyPins(t *testing.T) {
+	leaf, leafPin := testCertificate("leaf")
+	ca, caPin := testCertificate("ca")
+	extra, extraPin := testCertificate("extra")
+	keySum := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+
+	verified := tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{leaf, extra},
+		VerifiedChains:   [][]*x509.Certificate{{leaf, ca}},
+	}
+	unverified := tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{leaf, ca},
+	}
+
+	tests := []struct {
+		name  string
+		state tls.ConnectionState
+		pin   []byte
+		ok    bool
+	}{
+		{"leaf of a verified chain", verified, leafPin, true},
+		{"public key of the leaf", verified, keySum[:], true},
+		{"CA of a verified chain", verified, caPin, true},
+		{"certificate sent along", verified, extraPin, false},
+		{"leaf without verification", unverified, leafPin, true},
+		{"CA without verification", unverified, caPin, false},
+		{"no certificate", tls.ConnectionState{}, leafPin, false},
+	}
+	for _, tt := range tests {
+		err := verifyPins(tt.state, [][]byte{tt.pin})
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: verifyPins() = %v, want ok=%v", tt.name, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// transcript.go
+/**
+ * Nexuflex Client - Session Transcript
+ *
+ * This file contains the recording of the session transcript (commands,
+ * outputs and errors with timestamps, server and user), which is kept in
+ * memory and appended to a JSON Lines file per client session.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+)
+
+// Kinds of transcript entries
+const (
+	EntryCommand = "command"
+	EntryOutput  = "output"
+	EntryError   = "error"
+	EntryEvent   = "event"
+)
+
+// TranscriptEntry is a single recorded element of a session
+type TranscriptEntry struct {
+	Time    time.Time `json:"time"`
+	Kind    string    `json:"kind"`
+	Server  string    `json:"server,omitempty"`
+	User    string    `json:"user,omitempty"`
+	Context string    `json:"context,omitempty"`
+	Text    string    `json:"text"`
+
+	// Parameters of a command by name, sensitive values masked
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// Transcript records the entries of the current client session
+type Transcript struct {
+	mu       sync.Mutex
+	entries  []TranscriptEntry
+	filePath string
+	persist  bool
+}
+
+// NewTranscript creates a transcript; if persist is set, entries are appended to a session file
+func NewTranscript(persist bool) *Transcript {
+	t := &Transcript{persist: persist}
+
+	if persist {
+		if dir, err := TranscriptDir(); err == nil {
+			name := time.Now().Format("20060102-150405") + ".jsonl"
+			t.filePath = filepath.Join(dir, name)
+		}
+	}
+
+	return t
+}
+
+// TranscriptDir returns the directory containing the transcript files
+func TranscriptDir() (string, error) {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userConfigDir, "nexuflex", "transcripts"), nil
+}
+
+// Record adds an entry to the transcript
+func (t *Transcript) Record(entry TranscriptEntry) {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries = append(t.entries, entry)
+
+	if t.persist && t.filePath != "" {
+		t.appendToFile(entry)
+	}
+}
+
+// appendToFile writes an entry to the session file; errors only disable persistence
+func (t *Transcript) appendToFile(entry TranscriptEntry) {
+	if err := os.MkdirAll(filepath.Dir(t.filePath), 0700); err != nil {
+		t.persist = false
+		return
+	}
+
+	f, err := os.OpenFile(t.filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		t.persist = false
+		return
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	f.Write(append(data, '\n'))
+}
+
+// GetEntries returns a copy of all entries of the current session
+func (t *Transcript) GetEntries() []TranscriptEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]TranscriptEntry, len(t.entries))
+	copy(result, t.entries)
+	return result
+}
+
+// IsPersisted returns whether the entries are written to a session file
+func (t *Transcript) IsPersisted() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.persist && t.filePath != ""
+}
+
+// GetFilePath returns the path of the session file (empty if not persisted)
+func (t *Transcript) GetFilePath() string {
+	return t.filePath
+}
+
+// LoadTranscriptFiles loads the entries of all stored transcripts, oldest
+// first; transcripts compressed by the retention maintenance are included
+func LoadTranscriptFiles() ([]TranscriptEntry, error) {
+	dir, err := TranscriptDir()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && (strings.HasSuffix(file.Name(), ".jsonl") ||
+			strings.HasSuffix(file.Name(), ".jsonl"+state.CompressedSuffix)) {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var entries []TranscriptEntry
+	for _, name := range names {
+		fileEntries, err := loadTranscriptFile(filepath.Join(dir, name))
+		if err != nil {
+			continue // Skip unreadable transcripts
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	return entries, nil
+}
+
+// loadTranscriptFile reads one JSON Lines transcript, skipping corrupt lines
+func loadTranscriptFile(path string) ([]TranscriptEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, state.CompressedSuffix) {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	var entries []TranscriptEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var entry TranscriptEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, scanner.Err()
+}
+
+// GetTranscript returns the transcript of the current session
+func (c *Client) GetTranscript() *Transcript {
+	return c.transcript
+}
+
+// recordCommand adds a command with its parameters, the sensitive values masked
+func (c *Client) recordCommand(command maskedCommand) {
+	c.recordTranscriptEntry(EntryCommand, command.line, command.parameters)
+}
+
+// recordTranscript adds an entry with the current server, user and context
+func (c *Client) recordTranscript(kind, text string) {
+	c.recordTranscriptEntry(kind, text, nil)
+}
+
+// recordTranscriptEntry adds an entry with the current server, user and context
+func (c *Client) recordTranscriptEntry(kind, text string, parameters map[string]string) {
+	switch kind {
+	case EntryCommand:
+		c.publishEvent(EventCommand, text)
+	case EntryError:
+		c.publishEvent(EventError, text)
+	case EntryEvent:
+		c.publishEvent(EventNotification, text)
+	}
+
+	if c.transcript == nil {
+		return
+	}
+
+	entry := TranscriptEntry{
+		Kind:       kind,
+		User:       c.username,
+		Context:    c.lastServiceUsed,
+		Text:       text,
+		Parameters: parameters,
+	}
+	if c.serverInfo != nil {
+		entry.Server = c.serverInfo.ShortName
+	}
+
+	c.transcript.Record(entry)
+}
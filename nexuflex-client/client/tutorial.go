@@ -0,0 +1,124 @@
+// tutorial.go
+/**
+ * Nexuflex Client - Tutorial Progress
+ *
+ * This file contains the steps of the tutorial and the record of the steps
+ * a user has completed, which is kept in tutorial.json in the user
+ * configuration directory. A step counts as completed when the tutorial
+ * has verified it; the record tells a returning user how far they got and
+ * whether they finished the tutorial.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+)
+
+// Steps of the tutorial
+const (
+	TutorialConnect    = "connect"
+	TutorialLogin      = "login"
+	TutorialCommand    = "command"
+	TutorialCompletion = "completion"
+	TutorialAlias      = "alias"
+)
+
+// TutorialSteps are the steps of the tutorial in order
+var TutorialSteps = []string{TutorialConnect, TutorialLogin, TutorialCommand, TutorialCompletion, TutorialAlias}
+
+// TutorialProgress records the tutorial steps the user has completed
+type TutorialProgress struct {
+	Completed map[string]time.Time `json:"completed,omitempty"` // Step -> time of completion
+	Finished  *time.Time           `json:"finished,omitempty"`  // Time all steps were completed
+}
+
+// tutorialPath returns the path of the file recording the progress
+func tutorialPath() string {
+	dir, err := state.Dir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "tutorial.json")
+}
+
+// LoadTutorialProgress reads the progress of the user; empty if the
+// tutorial was never started
+func LoadTutorialProgress() *TutorialProgress {
+	progress := &TutorialProgress{}
+	if path := tutorialPath(); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			_ = json.Unmarshal(data, progress)
+		}
+	}
+	if progress.Completed == nil {
+		progress.Completed = make(map[string]time.Time)
+	}
+	return progress
+}
+
+// Started returns whether the user has completed a step before
+func (p *TutorialProgress) Started() bool {
+	return len(p.Completed) > 0 || p.Finished != nil
+}
+
+// Done returns whether a step has been completed
+func (p *TutorialProgress) Done(step string) bool {
+	_, ok := p.Completed[step]
+	return ok
+}
+
+// Complete records a completed step, and the end of the tutorial once all
+// steps are completed, and saves the progress
+func (p *TutorialProgress) Complete(step string) error {
+	if !p.Done(step) {
+		p.Completed[step] = time.Now()
+	}
+	if p.Finished == nil {
+		finished := true
+		for _, s := range TutorialSteps {
+			finished = finished && p.Done(s)
+		}
+		if finished {
+			now := time.Now()
+			p.Finished = &now
+		}
+	}
+	return p.save()
+}
+
+// Reset forgets the progress
+func (p *TutorialProgress) Reset() error {
+	p.Completed = make(map[string]time.Time)
+	p.Finished = nil
+	path := tutorialPath()
+	if path == "" {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// save writes the progress
+func (p *TutorialProgress) save() error {
+	path := tutorialPath()
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return state.WriteFileAtomic(path, data)
+}
@@ -0,0 +1,140 @@
+// undo.go
+/**
+ * Nexuflex Client - Undo
+ *
+ * This file contains the session-scoped undo. A command response can
+ * advertise a compensating action, the command that reverses its effect;
+ * the client keeps the compensating actions of the session for their
+ * validity window and hands out the most recent one for "undo". Actions
+ * of an earlier session, e.g. before a logout or a re-login, are not
+ * offered, since the server only accepts them in the session they were
+ * issued for.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// maxUndoEntries is the number of compensating actions kept per session
+const maxUndoEntries = 20
+
+// ErrNothingToUndo is returned by TakeUndo if no compensating action is available
+var ErrNothingToUndo = errors.New("nothing to undo")
+
+// UndoEntry is a command that can be undone with its compensating action
+type UndoEntry struct {
+	Command     string    // Command that was executed
+	UndoCommand string    // Command executed to undo it
+	Description string    // What is undone, as described by the server
+	Expires     time.Time // End of the validity window
+
+	sessionToken string
+}
+
+// undoStack holds the compensating actions, the newest last
+type undoStack struct {
+	mu      sync.Mutex
+	entries []UndoEntry
+}
+
+// SetUndoCallback sets the function called when a command can be undone
+func (c *Client) SetUndoCallback(onUndoAvailable func(entry UndoEntry)) {
+	c.onUndoAvailable = onUndoAvailable
+}
+
+// recordUndo keeps the compensating action advertised in a command response
+func (c *Client) recordUndo(command string, undo *proto.UndoAction) {
+	if undo == nil || undo.Command == "" {
+		return
+	}
+
+	window := time.Duration(undo.ValidSeconds) * time.Second
+	if window <= 0 {
+		window = time.Duration(c.config.Commands.UndoSeconds) * time.Second
+	}
+	entry := UndoEntry{
+		Command:      command,
+		UndoCommand:  undo.Command,
+		Description:  undo.Description,
+		Expires:      time.Now().Add(window),
+		sessionToken: c.sessionToken,
+	}
+
+	c.undo.mu.Lock()
+	c.undo.entries = append(c.undo.entries, entry)
+	if len(c.undo.entries) > maxUndoEntries {
+		c.undo.entries = c.undo.entries[len(c.undo.entries)-maxUndoEntries:]
+	}
+	c.undo.mu.Unlock()
+
+	c.logger("Command can be undone until %s: %s", entry.Expires.Format(time.TimeOnly), c.MaskCommand(undo.Command))
+	if c.onUndoAvailable != nil {
+		c.onUndoAvailable(entry)
+	}
+}
+
+// UndoEntries returns the compensating actions that can still be executed, the newest first
+func (c *Client) UndoEntries() []UndoEntry {
+	c.undo.mu.Lock()
+	defer c.undo.mu.Unlock()
+
+	c.pruneUndo()
+	entries := make([]UndoEntry, 0, len(c.undo.entries))
+	for i := len(c.undo.entries) - 1; i >= 0; i-- {
+		entries = append(entries, c.undo.entries[i])
+	}
+	return entries
+}
+
+// LastUndo returns the most recent compensating action that can still be executed
+func (c *Client) LastUndo() (UndoEntry, bool) {
+	c.undo.mu.Lock()
+	defer c.undo.mu.Unlock()
+
+	c.pruneUndo()
+	if len(c.undo.entries) == 0 {
+		return UndoEntry{}, false
+	}
+	return c.undo.entries[len(c.undo.entries)-1], true
+}
+
+// TakeUndo removes the most recent compensating action to execute it; it
+// is consumed even if the server rejects it, so a failing undo is not
+// offered again
+func (c *Client) TakeUndo() (UndoEntry, error) {
+	c.undo.mu.Lock()
+	c.pruneUndo()
+	if len(c.undo.entries) == 0 {
+		c.undo.mu.Unlock()
+		return UndoEntry{}, ErrNothingToUndo
+	}
+	entry := c.undo.entries[len(c.undo.entries)-1]
+	c.undo.entries = c.undo.entries[:len(c.undo.entries)-1]
+	c.undo.mu.Unlock()
+
+	c.logger("Undoing %s with %s", entry.Command, entry.UndoCommand)
+	c.recordTranscript(EntryEvent, "undo "+c.MaskCommand(entry.Command))
+	return entry, nil
+}
+
+// pruneUndo removes expired actions and those of other sessions; the caller holds the lock
+func (c *Client) pruneUndo() {
+	now := time.Now()
+	valid := c.undo.entries[:0]
+	for _, entry := range c.undo.entries {
+		if entry.sessionToken == c.sessionToken && c.sessionToken != "" && now.Before(entry.Expires) {
+			valid = append(valid, entry)
+		}
+	}
+	c.undo.entries = valid
+}
@@ -0,0 +1,139 @@
+// upload.go
+/**
+ * Nexuflex Client - Data Uploads
+ *
+ * This file contains the streaming of local data into upload commands
+ * (e.g. imports). The data is read from a file or, in batch mode, from
+ * standard input and sent to the server in chunks, so files of any size
+ * and the output of other programs can be loaded without temporary files:
+ *
+ *   nexuflex-client upload - Service.Import.Data < file.csv
+ *   export-tool | nexuflex-client upload - Service.Import.Data
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+)
+
+// uploadChunkSize is the amount of data sent per message
+const uploadChunkSize = 32 * 1024
+
+// StdinSource is the upload source that reads from standard input
+const StdinSource = "-"
+
+// ParseUploadArgs splits the arguments "<file|-> <command>" of the upload command
+func ParseUploadArgs(args string) (string, string, error) {
+	source, command, _ := strings.Cut(strings.TrimSpace(args), " ")
+	command = strings.TrimSpace(command)
+	if source == "" || command == "" {
+		return "", "", fmt.Errorf("usage: upload <file|-> <command>")
+	}
+	return source, command, nil
+}
+
+// OpenUploadSource opens a file for uploading; "-" stands for stdin, which is
+// only available if stdin is not nil
+func OpenUploadSource(source string, stdin io.Reader) (io.ReadCloser, error) {
+	if source != StdinSource {
+		return os.Open(source)
+	}
+	if stdin == nil {
+		return nil, fmt.Errorf("standard input can only be uploaded in batch mode")
+	}
+	return io.NopCloser(stdin), nil
+}
+
+// UploadData streams data into an upload command and returns the output of the
+// command; onProgress, if set, receives the number of bytes sent so far
+func (c *Client) UploadData(ctx context.Context, command string, data io.Reader, onProgress func(sent int64)) (string, error) {
+	if c.client == nil {
+		return "", fmt.Errorf("not connected to server")
+	}
+
+	if c.sessionToken == "" {
+		return "", fmt.Errorf("not logged in")
+	}
+
+	if err := c.checkReadOnly(command); err != nil {
+		return "", err
+	}
+
+	masked := c.maskCommand(command)
+	c.logger("Uploading data to command: %s", masked.line)
+	c.recordCommand(maskedCommand{line: "upload " + masked.line, parameters: masked.parameters})
+	c.markActivity()
+
+	stream, err := c.client.UploadCommandData(ctx)
+	if err != nil {
+		c.logger("Upload failed: %v", err)
+		return "", fmt.Errorf("upload failed: %v", err)
+	}
+
+	// The first chunk opens the command, even if there is no data at all
+	chunk := &proto.UploadChunk{
+		SessionToken: c.sessionToken,
+		CommandLine:  command,
+		LastContext:  c.lastServiceUsed,
+	}
+	buffer := make([]byte, uploadChunkSize)
+	var sent int64
+	for {
+		n, readErr := data.Read(buffer)
+		if n > 0 || chunk.CommandLine != "" {
+			chunk.Data = buffer[:n]
+			if err := stream.Send(chunk); err != nil {
+				c.logger("Upload failed after %d bytes: %v", sent, err)
+				return "", fmt.Errorf("upload failed after %d bytes: %v", sent, err)
+			}
+			sent += int64(n)
+			if onProgress != nil {
+				onProgress(sent)
+			}
+			chunk = &proto.UploadChunk{}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			stream.CloseSend()
+			c.logger("Error reading upload data: %v", readErr)
+			return "", fmt.Errorf("error reading upload data: %v", readErr)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		c.logger("Upload failed: %v", err)
+		return "", fmt.Errorf("upload failed: %v", err)
+	}
+
+	if c.onStatusChanged != nil && resp.StatusInfo != nil {
+		c.onStatusChanged(resp.StatusInfo)
+	}
+
+	if !resp.Success {
+		c.logger("Upload command failed: %s", resp.ErrorMessage)
+		c.recordTranscript(EntryError, resp.ErrorMessage)
+		return "", fmt.Errorf("upload command failed: %s", c.commandErrorMessage(resp))
+	}
+
+	c.logger("Uploaded %d bytes to %s", sent, masked.line)
+	c.recordTranscript(EntryOutput, resp.Output)
+	if resp.NewContext != "" {
+		c.changeContext(resp.NewContext)
+	}
+	return resp.Output, nil
+}
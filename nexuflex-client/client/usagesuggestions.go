@@ -0,0 +1,107 @@
+// usagesuggestions.go
+/**
+ * Nexuflex Client - Usage Suggestions
+ *
+ * This file contains the analysis of the command history for long commands
+ * the user types again and again. Every command of at least
+ * `suggest_min_length` characters found `suggest_min_count` times or more
+ * is suggested as an alias, unless an alias or snippet already stands for
+ * it; the commands saving the most typing come first. The proposed alias
+ * name is built from the initials of the command name ("Inventory.Show.Item
+ * 4711" becomes "isi") and numbered if the name is taken.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+)
+
+// UsageSuggestion is a repeated command worth an alias
+type UsageSuggestion struct {
+	Command string
+	Count   int    // Times the command is in the history
+	Alias   string // Proposed alias name
+}
+
+// SuggestAliases returns the repeated long commands of a history that have
+// no alias or snippet yet, the ones saving the most typing first; taken
+// reports alias names that cannot be proposed
+func SuggestAliases(history []string, aliases, snippets map[string]string, minCount, minLength int,
+	taken func(name string) bool) []UsageSuggestion {
+	covered := make(map[string]bool, len(aliases)+len(snippets))
+	for _, command := range aliases {
+		covered[strings.TrimSpace(command)] = true
+	}
+	for _, text := range snippets {
+		covered[strings.TrimSpace(text)] = true
+	}
+
+	counts := make(map[string]int)
+	for _, entry := range history {
+		if entry = strings.TrimSpace(entry); len(entry) >= minLength && !covered[entry] {
+			counts[entry]++
+		}
+	}
+
+	var suggestions []UsageSuggestion
+	for command, count := range counts {
+		if count >= minCount {
+			suggestions = append(suggestions, UsageSuggestion{Command: command, Count: count})
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		savingI := suggestions[i].Count * len(suggestions[i].Command)
+		savingJ := suggestions[j].Count * len(suggestions[j].Command)
+		if savingI != savingJ {
+			return savingI > savingJ
+		}
+		return suggestions[i].Command < suggestions[j].Command
+	})
+
+	// Proposed names must not collide with each other either
+	proposed := make(map[string]bool)
+	for i := range suggestions {
+		name := proposeAliasName(suggestions[i].Command, func(name string) bool {
+			_, exists := aliases[name]
+			return exists || proposed[name] || IsReservedKeyword(name) || plugin.IsCommand(name) || taken(name)
+		})
+		proposed[name] = true
+		suggestions[i].Alias = name
+	}
+	return suggestions
+}
+
+// proposeAliasName builds an alias name from the initials of the command
+// name, numbered from 2 while the name is taken
+func proposeAliasName(command string, taken func(name string) bool) string {
+	name, _, _ := strings.Cut(command, " ")
+	var initials strings.Builder
+	for _, part := range strings.Split(name, ".") {
+		for _, r := range part {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				initials.WriteRune(unicode.ToLower(r))
+				break
+			}
+		}
+	}
+	base := initials.String()
+	if len(base) < 2 {
+		base = "cmd"
+	}
+
+	candidate := base
+	for n := 2; taken(candidate); n++ {
+		candidate = base + strconv.Itoa(n)
+	}
+	return candidate
+}
@@ -0,0 +1,253 @@
+// verbose.go
+/**
+ * Nexuflex Client - Verbose Protocol Tracing
+ *
+ * This file contains the verbose mode for debugging the protocol.
+ * Interceptors on the connection record every RPC: the method as it goes
+ * over the wire, the request metadata and the response headers, the
+ * duration, the size of the messages and the status code. While verbose
+ * mode is on, the traces are handed to the user interface, which shows
+ * them as collapsed debug blocks in the output area; the last traces are
+ * kept in any case for support bundles. Streams are traced once they end.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// traceHistorySize is the number of traces kept for support bundles
+const traceHistorySize = 500
+
+// RPCTrace describes a finished RPC
+type RPCTrace struct {
+	Method           string              // Full method name as sent to the server
+	Command          string              // Command line of command RPCs, secret parameters masked
+	Stream           bool                // Streaming call
+	Started          time.Time           // Start of the call
+	Duration         time.Duration       // Until the reply or the end of the stream
+	RequestMetadata  map[string][]string // Outgoing metadata, secrets masked
+	ResponseMetadata map[string][]string // Response headers, secrets masked
+	RequestBytes     int                 // Encoded size of the request messages
+	ResponseBytes    int                 // Encoded size of the response messages
+	Requests         int                 // Number of request messages
+	Responses        int                 // Number of response messages
+	Code             codes.Code
+	Message          string // Status message, empty on success
+}
+
+// SetVerbose switches the tracing of the RPCs on or off
+func (c *Client) SetVerbose(verbose bool) {
+	c.verbose.Store(verbose)
+}
+
+// IsVerbose checks whether the RPCs are traced
+func (c *Client) IsVerbose() bool {
+	return c.verbose.Load()
+}
+
+// SetRPCTraceCallback sets the function called with the trace of every RPC in verbose mode
+func (c *Client) SetRPCTraceCallback(onRPCTrace func(trace RPCTrace)) {
+	c.onRPCTrace = onRPCTrace
+}
+
+// traceDialOptions returns the interceptors tracing the RPCs; they run
+// after the API version mapping, so the method is the one on the wire
+func (c *Client) traceDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(c.traceUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(c.traceStreamInterceptor),
+	}
+}
+
+// traceHistory holds the last traces, the oldest first
+type traceHistory struct {
+	mu     sync.Mutex
+	traces []RPCTrace
+}
+
+// traceUnaryInterceptor records a unary call
+func (c *Client) traceUnaryInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	trace := newRPCTrace(ctx, method, false)
+	var header metadata.MD
+	trace.countRequest(req, c.MaskCommand)
+
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+
+	if err == nil {
+		trace.countResponse(reply)
+	}
+	trace.ResponseMetadata = maskMetadata(header)
+	c.finishTrace(trace, err)
+	return err
+}
+
+// traceStreamInterceptor wraps a stream so that it is traced when it ends
+func (c *Client) traceStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	trace := newRPCTrace(ctx, method, true)
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		c.finishTrace(trace, err)
+		return nil, err
+	}
+	return &tracedStream{ClientStream: stream, client: c, trace: trace}, nil
+}
+
+// newRPCTrace starts the trace of a call
+func newRPCTrace(ctx context.Context, method string, stream bool) *RPCTrace {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return &RPCTrace{
+		Method:          method,
+		Stream:          stream,
+		Started:         time.Now(),
+		RequestMetadata: maskMetadata(md),
+	}
+}
+
+// countRequest adds a request message to the trace; mask masks the
+// sensitive parameters of a command line
+func (t *RPCTrace) countRequest(msg any, mask func(string) string) {
+	if request, ok := msg.(*proto.CommandRequest); ok && t.Command == "" {
+		t.Command = mask(request.CommandLine)
+	}
+	t.Requests++
+	t.RequestBytes += messageSize(msg)
+}
+
+// countResponse adds a response message to the trace
+func (t *RPCTrace) countResponse(msg any) {
+	t.Responses++
+	t.ResponseBytes += messageSize(msg)
+}
+
+// finishTrace completes a trace with the result of the call, keeps it and
+// hands it to the user interface in verbose mode
+func (c *Client) finishTrace(trace *RPCTrace, err error) {
+	trace.Duration = time.Since(trace.Started)
+	if st, ok := status.FromError(err); ok {
+		trace.Code = st.Code()
+		trace.Message = st.Message()
+	} else {
+		trace.Code = codes.Unknown
+		trace.Message = err.Error()
+	}
+
+	c.traces.mu.Lock()
+	if len(c.traces.traces) >= traceHistorySize {
+		c.traces.traces = append(c.traces.traces[:0], c.traces.traces[1:]...)
+	}
+	c.traces.traces = append(c.traces.traces, *trace)
+	c.traces.mu.Unlock()
+
+	if c.onRPCTrace != nil && c.IsVerbose() {
+		c.onRPCTrace(*trace)
+	}
+}
+
+// RecentCommandTraces returns the traces since the RPC of the n-th last
+// command, the oldest first
+func (c *Client) RecentCommandTraces(n int) []RPCTrace {
+	c.traces.mu.Lock()
+	defer c.traces.mu.Unlock()
+
+	start, commands := len(c.traces.traces), 0
+	for start > 0 && commands < n {
+		start--
+		if c.traces.traces[start].Command != "" {
+			commands++
+		}
+	}
+	return append([]RPCTrace(nil), c.traces.traces[start:]...)
+}
+
+// tracedStream counts the messages of a stream and finishes its trace at the end
+type tracedStream struct {
+	grpc.ClientStream
+	client *Client
+	trace  *RPCTrace
+	once   sync.Once
+}
+
+// SendMsg counts a request message
+func (s *tracedStream) SendMsg(m any) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.trace.countRequest(m, s.client.MaskCommand)
+	}
+	return err
+}
+
+// RecvMsg counts a response message; the end of the stream finishes the trace
+func (s *tracedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil {
+		s.trace.countResponse(m)
+		return nil
+	}
+
+	s.once.Do(func() {
+		if header, headerErr := s.ClientStream.Header(); headerErr == nil {
+			s.trace.ResponseMetadata = maskMetadata(header)
+		}
+		if errors.Is(err, io.EOF) {
+			s.client.finishTrace(s.trace, nil)
+		} else {
+			s.client.finishTrace(s.trace, err)
+		}
+	})
+	return err
+}
+
+// messageSize returns the encoded size of a protobuf message
+func messageSize(msg any) int {
+	if m, ok := msg.(protobuf.Message); ok {
+		return protobuf.Size(m)
+	}
+	return 0
+}
+
+// maskMetadata copies metadata with the values of credentials masked
+func maskMetadata(md metadata.MD) map[string][]string {
+	if len(md) == 0 {
+		return nil
+	}
+	masked := make(map[string][]string, len(md))
+	for key, values := range md {
+		if isSecretMetadata(key) {
+			masked[key] = []string{"****"}
+			continue
+		}
+		masked[key] = append([]string(nil), values...)
+	}
+	return masked
+}
+
+// isSecretMetadata checks whether a metadata key carries credentials
+func isSecretMetadata(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range []string{"authorization", "token", "password", "secret", "cookie", "key"} {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
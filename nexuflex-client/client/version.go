@@ -0,0 +1,189 @@
+// version.go
+/**
+ * Nexuflex Client - Build Information and Server Compatibility
+ *
+ * This file contains the build metadata of the client, which is embedded
+ * at build time via ldflags, and the compatibility check between client
+ * and server based on versions and negotiated capabilities.
+ *
+ * Example:
+ *   go build -ldflags "-X github.com/msto63/nexuflex/nexuflex-client/client.Version=1.1.0
+ *     -X github.com/msto63/nexuflex/nexuflex-client/client.Commit=$(git rev-parse --short HEAD)
+ *     -X github.com/msto63/nexuflex/nexuflex-client/client.BuildDate=$(date -u +%Y-%m-%d)"
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// Build metadata, overridden via ldflags
+var (
+	Version   = "1.0.0"
+	Commit    = ""
+	BuildDate = ""
+)
+
+// MinServerVersion is the oldest server version the client can work with
+const MinServerVersion = "1.0.0"
+
+// OptionalServerFeatures lists the server capabilities the client makes use of if available
+var OptionalServerFeatures = []string{"streaming", "aliases", "telemetry"}
+
+// Compatibility is the verdict of the client/server compatibility check
+type Compatibility int
+
+const (
+	// CompatibilityUnknown means that the server did not report enough information
+	CompatibilityUnknown Compatibility = iota
+	// Compatible means that client and server fully work together
+	Compatible
+	// CompatibleWithLimitations means that optional features are not available
+	CompatibleWithLimitations
+	// Incompatible means that the server version is known not to work with this client
+	Incompatible
+)
+
+// CompatibilityReport contains the result of the compatibility check
+type CompatibilityReport struct {
+	ClientVersion   string
+	ServerVersion   string
+	ServerFeatures  []string
+	MissingFeatures []string
+	Verdict         Compatibility
+	Reason          string
+}
+
+// BuildInfo returns a one-line description of the client build
+func BuildInfo() string {
+	commit, date := Commit, BuildDate
+
+	// Fall back to the VCS information recorded by the Go toolchain
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if commit == "" && len(setting.Value) >= 7 {
+					commit = setting.Value[:7]
+				}
+			case "vcs.time":
+				if date == "" {
+					date = setting.Value
+				}
+			}
+		}
+	}
+
+	if commit == "" {
+		commit = "unknown"
+	}
+	if date == "" {
+		date = "unknown"
+	}
+
+	return fmt.Sprintf("%s (commit %s, built %s)", Version, commit, date)
+}
+
+// CheckCompatibility compares the client with the connected server
+func (c *Client) CheckCompatibility() *CompatibilityReport {
+	report := &CompatibilityReport{
+		ClientVersion: Version,
+		Verdict:       CompatibilityUnknown,
+	}
+
+	if c.serverInfo == nil {
+		report.Reason = "not connected"
+		return report
+	}
+
+	report.ServerVersion = c.serverInfo.Version
+	report.ServerFeatures = c.serverFeatures
+
+	if report.ServerVersion == "" {
+		report.Reason = "server did not report a version"
+		return report
+	}
+
+	// Versions below the minimum or with a different major version are incompatible
+	if compareVersions(report.ServerVersion, MinServerVersion) < 0 {
+		report.Verdict = Incompatible
+		report.Reason = fmt.Sprintf("server version %s is older than the required %s", report.ServerVersion, MinServerVersion)
+		return report
+	}
+	if majorVersion(report.ServerVersion) != majorVersion(Version) {
+		report.Verdict = Incompatible
+		report.Reason = fmt.Sprintf("major versions differ (client %s, server %s)", Version, report.ServerVersion)
+		return report
+	}
+
+	// Check which optional capabilities the server lacks
+	supported := make(map[string]bool, len(c.serverFeatures))
+	for _, feature := range c.serverFeatures {
+		supported[strings.ToLower(feature)] = true
+	}
+	for _, feature := range OptionalServerFeatures {
+		if !supported[feature] {
+			report.MissingFeatures = append(report.MissingFeatures, feature)
+		}
+	}
+
+	if len(report.MissingFeatures) > 0 {
+		report.Verdict = CompatibleWithLimitations
+		report.Reason = "missing features: " + strings.Join(report.MissingFeatures, ", ")
+	} else {
+		report.Verdict = Compatible
+	}
+
+	return report
+}
+
+// GetServerFeatures returns the features the server reported at connect
+func (c *Client) GetServerFeatures() []string {
+	return c.serverFeatures
+}
+
+// compareVersions compares two dotted version strings numerically
+func compareVersions(a, b string) int {
+	partsA := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	partsB := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var numA, numB int
+		if i < len(partsA) {
+			numA = leadingNumber(partsA[i])
+		}
+		if i < len(partsB) {
+			numB = leadingNumber(partsB[i])
+		}
+		if numA != numB {
+			if numA < numB {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// majorVersion returns the major component of a version string
+func majorVersion(version string) int {
+	return leadingNumber(strings.SplitN(strings.TrimPrefix(version, "v"), ".", 2)[0])
+}
+
+// leadingNumber parses the leading digits of a version component (e.g. "3-beta" -> 3)
+func leadingNumber(s string) int {
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	n, _ := strconv.Atoi(s[:end])
+	return n
+}
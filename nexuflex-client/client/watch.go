@@ -0,0 +1,92 @@
+// watch.go
+/**
+ * Nexuflex Client - Watch Patterns
+ *
+ * This file contains the watch patterns operators use to be alerted when
+ * something specific shows up in busy output, e.g. an order number in a
+ * tailed log. Patterns are declared in the [watch] section of the
+ * configuration as "<name> = <regex>" or added at runtime; every incoming
+ * output line matching one of them is a watch hit.
+ *
+ * Example:
+ *   [watch]
+ *   order = ORDER-4711
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// WatchPattern is a pattern whose matches in the output raise an alert
+type WatchPattern struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// ParseWatchPatterns compiles the watch patterns of the configuration
+func ParseWatchPatterns(definitions map[string]string) ([]*WatchPattern, error) {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	patterns := make([]*WatchPattern, 0, len(names))
+	var invalid []string
+	for _, name := range names {
+		pattern, err := NewWatchPattern(name, definitions[name])
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	if len(invalid) > 0 {
+		return patterns, fmt.Errorf("invalid watch patterns: %s", strings.Join(invalid, "; "))
+	}
+	return patterns, nil
+}
+
+// NewWatchPattern creates a watch pattern from a regular expression
+func NewWatchPattern(name, pattern string) (*WatchPattern, error) {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern must not be empty")
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &WatchPattern{Name: name, Pattern: re}, nil
+}
+
+// MatchWatch returns the first watch pattern matching a line, nil if none matches
+func MatchWatch(patterns []*WatchPattern, line string) *WatchPattern {
+	for _, pattern := range patterns {
+		if pattern.Pattern.MatchString(line) {
+			return pattern
+		}
+	}
+	return nil
+}
+
+// NextWatchName returns an unused name for a watch pattern added at runtime
+func NextWatchName(definitions map[string]string) string {
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("watch%d", i)
+		if _, exists := definitions[name]; !exists {
+			return name
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// workspace.go
+/**
+ * Nexuflex Client - Workspaces
+ *
+ * This file contains the workspaces, named snapshots of a working setup:
+ * the connected server and service context, the content of the output
+ * area and the log pane, the split layout, the watch patterns and the
+ * running jobs. Workspaces are stored as JSON files in the user
+ * configuration directory; the name of the workspace saved or loaded last
+ * is remembered so that it can be restored at startup.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+)
+
+// lastWorkspaceFile holds the name of the workspace saved or loaded last
+const lastWorkspaceFile = ".last"
+
+// Workspace is a saved working setup
+type Workspace struct {
+	Name        string            `json:"name"`
+	Saved       time.Time         `json:"saved"`
+	Server      *RecentServer     `json:"server,omitempty"` // Session, nil if not connected
+	Output      string            `json:"output,omitempty"` // Output area with color tags
+	LogOutput   string            `json:"log_output,omitempty"`
+	SplitActive bool              `json:"split_active"`
+	SplitRatio  int               `json:"split_ratio"`
+	Watches     map[string]string `json:"watches,omitempty"`
+	Jobs        []WorkspaceJob    `json:"jobs,omitempty"`
+}
+
+// WorkspaceJob is a job that is started again when the workspace is loaded
+type WorkspaceJob struct {
+	Command string `json:"command"`
+	LogPane bool   `json:"log_pane"` // Output goes to the log pane
+}
+
+// workspaceDir returns the directory of the workspace files
+func workspaceDir() (string, error) {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userConfigDir, "nexuflex", "workspaces"), nil
+}
+
+// workspacePath returns the file of a workspace; names are restricted so
+// that they cannot leave the directory
+func workspacePath(name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\:`) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid workspace name %q", name)
+	}
+	dir, err := workspaceDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// CurrentWorkspace returns the session part of a workspace: the connected
+// server with the user and context, and the running jobs
+func (c *Client) CurrentWorkspace(name string) *Workspace {
+	workspace := &Workspace{Name: name}
+	if c.serverInfo != nil && c.client != nil {
+		server := c.currentServer()
+		server.LastUser = c.username
+		workspace.Server = &server
+	}
+	for _, job := range c.GetJobs() {
+		if job.State == JobRunning {
+			workspace.Jobs = append(workspace.Jobs, WorkspaceJob{Command: job.Command})
+		}
+	}
+	return workspace
+}
+
+// SaveWorkspace writes a workspace and remembers it as the last workspace
+func SaveWorkspace(workspace *Workspace) error {
+	path, err := workspacePath(workspace.Name)
+	if err != nil {
+		return err
+	}
+
+	workspace.Saved = time.Now()
+	data, err := json.MarshalIndent(workspace, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := state.WriteFileAtomic(path, data); err != nil {
+		return err
+	}
+	return rememberWorkspace(workspace.Name)
+}
+
+// LoadWorkspace reads a workspace and remembers it as the last workspace
+func LoadWorkspace(name string) (*Workspace, error) {
+	path, err := workspacePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("workspace %q not found", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var workspace Workspace
+	if err := json.Unmarshal(data, &workspace); err != nil {
+		return nil, fmt.Errorf("workspace %q is damaged: %v", name, err)
+	}
+	workspace.Name = name
+	return &workspace, rememberWorkspace(name)
+}
+
+// ListWorkspaces returns the names of the saved workspaces in alphabetical order
+func ListWorkspaces() ([]string, error) {
+	dir, err := workspaceDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		names = append(names, strings.TrimSuffix(filepath.Base(file), ".json"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// DeleteWorkspace removes a saved workspace
+func DeleteWorkspace(name string) error {
+	path, err := workspacePath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); os.IsNotExist(err) {
+		return fmt.Errorf("workspace %q not found", name)
+	} else if err != nil {
+		return err
+	}
+
+	if last, _ := LastWorkspace(); last == name {
+		dir, _ := workspaceDir()
+		os.Remove(filepath.Join(dir, lastWorkspaceFile))
+	}
+	return nil
+}
+
+// LastWorkspace returns the name of the workspace saved or loaded last, "" if there is none
+func LastWorkspace() (string, error) {
+	dir, err := workspaceDir()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, lastWorkspaceFile))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	return strings.TrimSpace(string(data)), err
+}
+
+// rememberWorkspace records the name of the workspace saved or loaded last
+func rememberWorkspace(name string) error {
+	dir, err := workspaceDir()
+	if err != nil {
+		return err
+	}
+	return state.WriteFileAtomic(filepath.Join(dir, lastWorkspaceFile), []byte(name+"\n"))
+}
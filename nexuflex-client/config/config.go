@@ -1,130 +1,405 @@
-// config.go
-/**
- * Nexuflex Client - Configuration Management
- *
- * This file contains the data structures and functions for managing
- * the client configuration.
- *
- * @author msto63
- * @version 1.0.0
- * @date 2025-03-12
- */
-
-package config
-
-import (
-	"os"
-	"path/filepath"
-
-	"gopkg.in/ini.v1"
-)
-
-// Config represents the overall configuration of the client
-type Config struct {
-	Server   ServerConfig   `ini:"server"`
-	UI       UIConfig       `ini:"ui"`
-	Commands CommandsConfig `ini:"commands"`
-}
-
-// ServerConfig contains the configuration for the server connection
-type ServerConfig struct {
-	Address                string `ini:"address"`
-	Port                   int    `ini:"port"`
-	UseTLS                 bool   `ini:"use_tls"`
-	DiscoveryToken         string `ini:"discovery_token"`
-	AutoDiscover           bool   `ini:"auto_discover"`
-	DiscoverTimeoutSeconds int    `ini:"discover_timeout_seconds"`
-}
-
-// UIConfig contains configuration options for the user interface
-type UIConfig struct {
-	ColorScheme           string `ini:"color_scheme"`
-	HeaderText            string `ini:"header_text"`
-	ShowTimestamps        bool   `ini:"show_timestamps"`
-	EnableSounds          bool   `ini:"enable_sounds"`
-	MaxOutputLines        int    `ini:"max_output_lines"`
-	MaxHistoryEntries     int    `ini:"max_history_entries"`
-	AutoCompleteEnabled   bool   `ini:"auto_complete_enabled"`
-	AutoFillServicePrefix bool   `ini:"auto_fill_service_prefix"`
-	Language              string `ini:"language"`
-}
-
-// CommandsConfig contains configuration options for command processing
-type CommandsConfig struct {
-	SaveHistory           bool `ini:"save_history"`
-	UseLocalAliases       bool `ini:"use_local_aliases"`
-	MaxLocalAliases       int  `ini:"max_local_aliases"`
-	EnableMultilineInput  bool `ini:"enable_multiline_input"`
-	SaveHistoryOnShutdown bool `ini:"save_history_on_shutdown"`
-}
-
-// LoadConfig loads the configuration from a file
-func LoadConfig(configPath string) (Config, error) {
-	// Default configuration as base
-	config := GetDefaultConfig()
-
-	// If no path is specified, try standard paths
-	if configPath == "" {
-		// Determine user's directory
-		userConfigDir, err := os.UserConfigDir()
-		if err == nil {
-			// First try the configuration file in the user directory
-			configPath = filepath.Join(userConfigDir, "nexuflex", "client.ini")
-			if _, err := os.Stat(configPath); os.IsNotExist(err) {
-				// Try alternative: configuration file in current directory
-				configPath = "client.ini"
-				if _, err := os.Stat(configPath); os.IsNotExist(err) {
-					// No configuration file found, use default configuration
-					return config, nil
-				}
-			}
-		} else {
-			// Error determining user directory, use default configuration
-			return config, nil
-		}
-	}
-
-	// Load configuration file
-	cfg, err := ini.Load(configPath)
-	if err != nil {
-		// If the file cannot be loaded, use default configuration
-		return config, err
-	}
-
-	// Map configuration to structure
-	err = cfg.MapTo(&config)
-	if err != nil {
-		return config, err
-	}
-
-	return config, nil
-}
-
-// SaveConfig saves the configuration to a file
-func SaveConfig(config Config, configPath string) error {
-	// If no path is specified, use default path
-	if configPath == "" {
-		userConfigDir, err := os.UserConfigDir()
-		if err != nil {
-			return err
-		}
-		// Ensure directory exists
-		configDir := filepath.Join(userConfigDir, "nexuflex")
-		if err := os.MkdirAll(configDir, 0755); err != nil {
-			return err
-		}
-		configPath = filepath.Join(configDir, "client.ini")
-	}
-
-	// Create new .ini file
-	cfg := ini.Empty()
-
-	// Write configuration to .ini file
-	err := ini.ReflectFrom(cfg, &config)
-	if err != nil {
-		return err
-	}
-
-	// Save file
-	return cfg.SaveTo(configPath)
-}
+// config.go
+/**
+ * Nexuflex Client - Configuration Management
+ *
+ * This file contains the data structures and functions for managing
+ * the client configuration.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2025-03-12
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// loadedConfigPath is the path of the configuration file that was loaded last
+var loadedConfigPath string
+
+// Config represents the overall configuration of the client
+type Config struct {
+	Server    ServerConfig    `ini:"server"`
+	UI        UIConfig        `ini:"ui"`
+	Commands  CommandsConfig  `ini:"commands"`
+	Auth      AuthConfig      `ini:"auth"`
+	Session   SessionConfig   `ini:"session"`
+	Telemetry TelemetryConfig `ini:"telemetry"`
+	Retention RetentionConfig `ini:"retention"`
+	TLS       TLSConfig       `ini:"tls"`
+
+	// References maps a reference name to "<regex> => <URL or command>"
+	References map[string]string `ini:"-"`
+
+	// Highlights maps a rule name to "<regex> => <color> [styles]"
+	Highlights map[string]string `ini:"-"`
+
+	// Watches maps a watch pattern name to its regex
+	Watches map[string]string `ini:"-"`
+
+	// ServiceColors maps a service to the color of its output gutter
+	ServiceColors map[string]string `ini:"-"`
+
+	// FunctionKeys maps a function key ("F5" to "F12") to the command it runs
+	FunctionKeys map[string]string `ini:"-"`
+
+	// Annotations maps a rule name to "<regex> => <severity> [#tag...] [link]"
+	Annotations map[string]string `ini:"-"`
+
+	// Thresholds maps a rule name to "<command> <metric> > <limit> [=> <severity>]"
+	Thresholds map[string]string `ini:"-"`
+
+	// Snippets maps a snippet name to the text it expands to
+	Snippets map[string]string `ini:"-"`
+
+	// TimelineFilters maps a filter name to "[*] <condition...>"
+	TimelineFilters map[string]string `ini:"-"`
+
+	// Policy is the environment policy of the administrators, never saved with the configuration
+	Policy Policy `ini:"-"`
+}
+
+// Function keys that can be bound to commands; F1 to F4 are kept for the client
+const (
+	MinFunctionKey = 5
+	MaxFunctionKey = 12
+)
+
+// ServerConfig contains the configuration for the server connection
+type ServerConfig struct {
+	Address                    string   `ini:"address"`
+	Port                       int      `ini:"port"`
+	UseTLS                     bool     `ini:"use_tls"`
+	DiscoveryToken             string   `ini:"discovery_token"`
+	DiscoveryAddress           string   `ini:"discovery_address"` // Multicast group and port of the discovery
+	AutoDiscover               bool     `ini:"auto_discover"`
+	DiscoverTimeoutSeconds     int      `ini:"discover_timeout_seconds"`
+	KeepAliveSeconds           int      `ini:"keep_alive_seconds"`            // Used while the server reports no session TTL
+	KeepAliveTTLPercent        int      `ini:"keep_alive_ttl_percent"`        // Share of the session TTL between keep-alives
+	MetadataRefreshIdleMinutes int      `ini:"metadata_refresh_idle_minutes"` // 0 disables the idle refresh
+	RefuseMaintenance          bool     `ini:"refuse_maintenance"`            // Never connect to servers in maintenance
+	AutoReconnect              bool     `ini:"auto_reconnect"`                // Reconnect when the keep-alive finds the server unreachable
+	KeepStateOnReconnect       bool     `ini:"keep_state_on_reconnect"`       // Keep output, jobs and metadata when reconnecting to the same server
+	Failover                   bool     `ini:"failover"`                      // Fail over to other nodes of the cluster while reconnecting
+	FailoverServers            []string `ini:"failover_servers" delim:","`    // Additional nodes as host:port
+	APIVersion                 string   `ini:"api_version"`                   // auto, v1 or legacy
+	MaxInFlight                int      `ini:"max_in_flight"`                 // Commands and streams at the same time, 0 for no limit
+	PreferStreaming            bool     `ini:"prefer_streaming"`              // Let the server stream large results of ordinary commands
+	StreamThresholdKB          int      `ini:"stream_threshold_kb"`           // Result size from which streaming is preferred, 0 for the server default
+	MetadataChannel            bool     `ini:"metadata_channel"`              // Separate connection for completion, help and keep-alive
+	StreamHeartbeatSeconds     int      `ini:"stream_heartbeat_seconds"`      // Heartbeat interval requested for streams, 0 for none
+	StreamSilenceSeconds       int      `ini:"stream_silence_seconds"`        // Silence after which a stream fails, 0 to wait forever
+	LowBandwidth               bool     `ini:"low_bandwidth"`                 // Save traffic on satellite and mobile links
+	LowBandwidthPageSize       int      `ini:"low_bandwidth_page_size"`       // Rows or lines per page of a result in low-bandwidth mode
+	LowBandwidthProgressDelta  int      `ini:"low_bandwidth_progress_delta"`  // Progress change in percent below which updates are left out
+}
+
+// UIConfig contains configuration options for the user interface
+type UIConfig struct {
+	ColorScheme           string   `ini:"color_scheme"`
+	HeaderText            string   `ini:"header_text"`
+	HeaderWidgets         []string `ini:"header_widgets" delim:","` // title, env, server, user, jobs, notifications, clock, spacer
+	Environment           string   `ini:"environment"`              // Environment tag shown in the header, e.g. prod or test
+	ShowTimestamps        bool     `ini:"show_timestamps"`
+	EnableSounds          bool     `ini:"enable_sounds"`
+	MaxOutputLines        int      `ini:"max_output_lines"`
+	MaxHistoryEntries     int      `ini:"max_history_entries"`
+	AutoCompleteEnabled   bool     `ini:"auto_complete_enabled"`
+	AutoFillServicePrefix bool     `ini:"auto_fill_service_prefix"`
+	CompletionCacheSize   int      `ini:"completion_cache_size"`        // Server answers kept for completion, 0 disables the cache
+	CompletionCacheTTL    int      `ini:"completion_cache_ttl_seconds"` // Time a cached answer stays valid
+	Language              string   `ini:"language"`
+	LogStreamCommands     []string `ini:"log_stream_commands" delim:","`
+	SplitRatio            int      `ini:"split_ratio"`
+	Hyperlinks            bool     `ini:"hyperlinks"`
+	CompactWidth          int      `ini:"compact_width"`
+	CompactHeight         int      `ini:"compact_height"`
+	KeyHints              bool     `ini:"key_hints"`
+	PastePreview          bool     `ini:"paste_preview"`
+	SingleInstance        bool     `ini:"single_instance"`
+	RoleBadge             bool     `ini:"role_badge"`
+	FoldOutputLines       int      `ini:"fold_output_lines"` // 0 disables folding
+	FoldKeepLines         int      `ini:"fold_keep_lines"`
+	WrapIndicator         bool     `ini:"wrap_indicator"`
+	WrapOutput            bool     `ini:"wrap_output"`               // Wrap long lines instead of scrolling sideways
+	ScrollLines           int      `ini:"scroll_lines"`              // Rows scrolled per mouse wheel tick
+	FrameRate             int      `ini:"frame_rate"`                // Redraws per second for incoming output
+	InputDebounceMs       int      `ini:"input_debounce_ms"`         // Idle time before the input text is processed
+	WatchBell             bool     `ini:"watch_bell"`                // Ring the terminal bell on a watch hit
+	WatchBookmarks        bool     `ini:"watch_bookmarks"`           // Remember watch hits for "watch-pattern jump"
+	RestoreWorkspace      bool     `ini:"restore_workspace"`         // Load the last workspace at startup
+	ServiceColors         bool     `ini:"service_colors"`            // Mark output with the color of its service
+	ServicePalette        []string `ini:"service_palette" delim:","` // Colors of services without an entry in [service_color]
+	RenderMarkdown        bool     `ini:"render_markdown"`           // Format Markdown results instead of showing the source
+	Density               string   `ini:"density"`                   // Spacing: compact, normal or spacious
+}
+
+// CommandsConfig contains configuration options for command processing
+type CommandsConfig struct {
+	SaveHistory           bool     `ini:"save_history"`
+	UseLocalAliases       bool     `ini:"use_local_aliases"`
+	MaxLocalAliases       int      `ini:"max_local_aliases"`
+	MaxAliasDepth         int      `ini:"max_alias_depth"` // Aliases expanded one after another
+	SnippetPrefix         string   `ini:"snippet_prefix"`  // Starts the words expanded as snippets
+	EnableMultilineInput  bool     `ini:"enable_multiline_input"`
+	SaveHistoryOnShutdown bool     `ini:"save_history_on_shutdown"`
+	SaveTranscripts       bool     `ini:"save_transcripts"`
+	ApprovalPollSeconds   int      `ini:"approval_poll_seconds"`
+	CriticalCommands      []string `ini:"critical_commands" delim:","`
+	MutatingCommands      []string `ini:"mutating_commands" delim:","` // Blocked in read-only mode
+	ReadOnly              bool     `ini:"read_only"`                   // Start in read-only mode
+	LooseMatching         bool     `ini:"loose_matching"`
+	RememberParameters    bool     `ini:"remember_parameters"`     // Offer the last used parameter values as defaults
+	UndoSeconds           int      `ini:"undo_seconds"`            // Undo window of commands whose server sets none
+	MaintenanceJobMinutes int      `ini:"maintenance_job_minutes"` // Assumed run time of background jobs when warning about maintenance
+	SuggestAliases        bool     `ini:"suggest_aliases"`         // Suggest aliases for repeated long commands
+	SuggestMinCount       int      `ini:"suggest_min_count"`       // Repetitions from which a command is suggested
+	SuggestMinLength      int      `ini:"suggest_min_length"`      // Length from which a command is suggested
+	SyncSettings          bool     `ini:"sync_settings"`           // Sync aliases, snippets, filters and theme through the server
+	ExpansionConfirm      string   `ini:"expansion_confirm"`       // Echo resolved commands and confirm them: off, substantial or changed
+}
+
+// AuthConfig contains configuration options for authentication
+type AuthConfig struct {
+	RememberCredentials bool     `ini:"remember_credentials"`
+	AutoRelogin         bool     `ini:"auto_relogin"`
+	Provider            string   `ini:"provider"`
+	Username            string   `ini:"username"`
+	TokenCommand        string   `ini:"token_command"`
+	PasswordCommand     string   `ini:"password_command"` // Program printing the password, e.g. "pass show nexuflex/prod"
+	OIDCIssuer          string   `ini:"oidc_issuer"`
+	KerberosSPN         string   `ini:"kerberos_spn"`
+	ClientCert          string   `ini:"client_cert"`
+	ClientKey           string   `ini:"client_key"`
+	CACert              string   `ini:"ca_cert"`
+	AutoLoginRecent     bool     `ini:"auto_login_recent"`
+	ElevatedRoles       []string `ini:"elevated_roles" delim:","`
+}
+
+// SessionConfig contains the settings applied to every new session of the profile
+type SessionConfig struct {
+	DefaultContext string   `ini:"default_context"`
+	OnConnect      []string `ini:"on_connect" delim:";"` // Commands executed after login
+}
+
+// TelemetryConfig contains the opt-in settings for reporting client information
+type TelemetryConfig struct {
+	Enabled bool `ini:"enabled"`
+}
+
+// RetentionConfig contains the limits for the local state in the configuration directory
+type RetentionConfig struct {
+	CompressTranscriptsDays int `ini:"compress_transcripts_days"` // 0 disables compression
+	DeleteTranscriptsDays   int `ini:"delete_transcripts_days"`   // 0 keeps transcripts
+	QuarantineDays          int `ini:"quarantine_days"`           // 0 keeps quarantined lines
+	MaxCacheMB              int `ini:"max_cache_mb"`              // 0 disables the cap
+}
+
+// TLSConfig contains the verification of TLS connections and the client certificate
+type TLSConfig struct {
+	CAFile             string   `ini:"ca_file"`                 // CA bundle (PEM) instead of the system roots
+	CertFile           string   `ini:"cert_file"`               // Client certificate (PEM) for mutual TLS
+	KeyFile            string   `ini:"key_file"`                // Private key of the client certificate
+	InsecureSkipVerify bool     `ini:"insecure_skip_verify"`    // Do not verify the certificate chain and host name
+	PinnedSHA256       []string `ini:"pinned_sha256" delim:","` // Accepted SHA-256 fingerprints of a certificate or public key of the server
+}
+
+// LoadConfig loads the configuration from a file
+func LoadConfig(configPath string) (Config, error) {
+	// Default configuration as base
+	config := GetDefaultConfig()
+	loadDiagnostics = nil
+
+	// If no path is specified, try standard paths
+	if configPath == "" {
+		// Determine user's directory
+		userConfigDir, err := os.UserConfigDir()
+		if err == nil {
+			// First try the configuration file in the user directory
+			configPath = filepath.Join(userConfigDir, "nexuflex", "client.ini")
+			if _, err := os.Stat(configPath); os.IsNotExist(err) {
+				// Try alternative: configuration file in current directory
+				configPath = "client.ini"
+				if _, err := os.Stat(configPath); os.IsNotExist(err) {
+					// No configuration file found, use default configuration
+					return config, nil
+				}
+			}
+		} else {
+			// Error determining user directory, use default configuration
+			return config, nil
+		}
+	}
+
+	// Load configuration file
+	cfg, err := ini.Load(configPath)
+	if err != nil {
+		// If the file cannot be loaded, use default configuration and
+		// point to the lines that could not be read
+		loadDiagnostics, _ = ValidateConfigFile(configPath)
+		return config, err
+	}
+
+	// Map configuration to structure
+	err = cfg.MapTo(&config)
+	if err != nil {
+		return config, err
+	}
+
+	// Values in which ; and # are not the start of a comment, read once more
+	// without inline comments: the commands of on_connect are separated by ;
+	// and the free-form sections hold tags, colors and command lines
+	raw, err := ini.LoadSources(ini.LoadOptions{IgnoreInlineComment: true}, configPath)
+	if err != nil {
+		return config, err
+	}
+	if session, err := raw.GetSection("session"); err == nil && session.HasKey("on_connect") {
+		config.Session.OnConnect = session.Key("on_connect").Strings(";")
+	}
+
+	// Free-form sections that cannot be mapped to the structure
+	config.References = loadKeyValueSection(raw, "references", config.References)
+	config.Highlights = loadKeyValueSection(raw, "highlight", config.Highlights)
+	config.Watches = loadKeyValueSection(raw, "watch", config.Watches)
+	config.ServiceColors = loadKeyValueSection(raw, "service_color", config.ServiceColors)
+	config.FunctionKeys = loadKeyValueSection(raw, "fkeys", config.FunctionKeys)
+	config.Annotations = loadKeyValueSection(raw, "annotate", config.Annotations)
+	config.Thresholds = loadKeyValueSection(raw, "threshold", config.Thresholds)
+	config.Snippets = loadKeyValueSection(raw, "snippets", config.Snippets)
+	config.TimelineFilters = loadKeyValueSection(raw, "timeline_filter", config.TimelineFilters)
+
+	// Report what the mapping ignored or could not convert
+	loadDiagnostics, _ = ValidateConfigFile(configPath)
+
+	// Remember the path so that changes are saved to the same file
+	loadedConfigPath = configPath
+
+	return config, nil
+}
+
+// LoadedConfigPath returns the path of the configuration file loaded last,
+// or an empty string if the defaults are used
+func LoadedConfigPath() string {
+	return loadedConfigPath
+}
+
+// SaveConfig saves the configuration to a file
+func SaveConfig(config Config, configPath string) error {
+	// If no path is specified, use the loaded file
+	if configPath == "" {
+		configPath = loadedConfigPath
+	}
+
+	// Otherwise use default path
+	if configPath == "" {
+		userConfigDir, err := os.UserConfigDir()
+		if err != nil {
+			return err
+		}
+		// Ensure directory exists
+		configDir := filepath.Join(userConfigDir, "nexuflex")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			return err
+		}
+		configPath = filepath.Join(configDir, "client.ini")
+	}
+
+	// Create new .ini file
+	cfg := ini.Empty()
+
+	// Write configuration to .ini file
+	err := ini.ReflectFrom(cfg, &config)
+	if err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "references", config.References); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "highlight", config.Highlights); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "watch", config.Watches); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "service_color", config.ServiceColors); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "fkeys", config.FunctionKeys); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "annotate", config.Annotations); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "threshold", config.Thresholds); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "snippets", config.Snippets); err != nil {
+		return err
+	}
+	if err := saveKeyValueSection(cfg, "timeline_filter", config.TimelineFilters); err != nil {
+		return err
+	}
+
+	// Save file
+	return cfg.SaveTo(configPath)
+}
+
+// FunctionKeyNumber returns the number of a function key name such as "F5"
+// if it can be bound to a command
+func FunctionKeyNumber(name string) (int, bool) {
+	if len(name) < 2 || (name[0] != 'F' && name[0] != 'f') {
+		return 0, false
+	}
+	number, err := strconv.Atoi(strings.TrimSpace(name[1:]))
+	if err != nil || number < MinFunctionKey || number > MaxFunctionKey {
+		return 0, false
+	}
+	return number, true
+}
+
+// loadKeyValueSection reads all keys of a section into a map
+func loadKeyValueSection(cfg *ini.File, name string, defaults map[string]string) map[string]string {
+	section, err := cfg.GetSection(name)
+	if err != nil {
+		return defaults
+	}
+
+	values := make(map[string]string, len(section.Keys()))
+	for _, key := range section.Keys() {
+		values[key.Name()] = key.Value()
+	}
+	return values
+}
+
+// saveKeyValueSection writes a map as a section with keys in sorted order
+func saveKeyValueSection(cfg *ini.File, name string, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	section, err := cfg.NewSection(name)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, err := section.NewKey(key, values[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
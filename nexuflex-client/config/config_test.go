@@ -0,0 +1,104 @@
+// config_test.go
+/**
+ * Nexuflex Client - Configuration Tests
+ *
+ * This file contains tests of loading and saving configuration files,
+ * in particular of values containing ; and #, which the INI format
+ * otherwise takes for the start of a comment.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeConfig writes a configuration file into a temporary directory
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "client.ini")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// loadConfig loads a configuration file and fails the test on errors
+func loadConfig(t *testing.T, path string) Config {
+	t.Helper()
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	return cfg
+}
+
+func TestOnConnectCommands(t *testing.T) {
+	path := writeConfig(t, "[session]\non_connect = Monitor.Show.Dashboard; use Finance\n")
+	want := []string{"Monitor.Show.Dashboard", "use Finance"}
+
+	cfg := loadConfig(t, path)
+	if !reflect.DeepEqual(cfg.Session.OnConnect, want) {
+		t.Fatalf("OnConnect = %q, want %q", cfg.Session.OnConnect, want)
+	}
+
+	saved := filepath.Join(t.TempDir(), "saved.ini")
+	if err := SaveConfig(cfg, saved); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadConfig(t, saved).Session.OnConnect; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnConnect after saving = %q, want %q", got, want)
+	}
+}
+
+func TestFreeFormSectionsKeepTags(t *testing.T) {
+	path := writeConfig(t, `[annotate]
+; Rules of the runbook
+deadlock = ORA-00060 => error #db #locking https://wiki.example.com/runbooks/deadlock
+
+[service_color]
+Inventory = #ff8800
+`)
+	wantRule := "ORA-00060 => error #db #locking https://wiki.example.com/runbooks/deadlock"
+
+	cfg := loadConfig(t, path)
+	if got := cfg.Annotations["deadlock"]; got != wantRule {
+		t.Errorf("annotation = %q, want %q", got, wantRule)
+	}
+	if got := cfg.ServiceColors["Inventory"]; got != "#ff8800" {
+		t.Errorf("service color = %q, want %q", got, "#ff8800")
+	}
+
+	saved := filepath.Join(t.TempDir(), "saved.ini")
+	if err := SaveConfig(cfg, saved); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadConfig(t, saved).Annotations["deadlock"]; got != wantRule {
+		t.Errorf("annotation after saving = %q, want %q", got, wantRule)
+	}
+}
+
+func TestSnippetsKeepCommentCharacters(t *testing.T) {
+	path := writeConfig(t, "[snippets]\nsel = select * from orders; -- #4711\n")
+	want := "select * from orders; -- #4711"
+
+	cfg := loadConfig(t, path)
+	if got := cfg.Snippets["sel"]; got != want {
+		t.Fatalf("snippet = %q, want %q", got, want)
+	}
+
+	saved := filepath.Join(t.TempDir(), "saved.ini")
+	if err := SaveConfig(cfg, saved); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadConfig(t, saved).Snippets["sel"]; got != want {
+		t.Errorf("snippet after saving = %q, want %q", got, want)
+	}
+}
@@ -1,44 +1,130 @@
-// defaults.go
-/**
- * Nexuflex Client - Default Configurations
- *
- * This file contains the default configurations for the client.
- *
- * @author msto63
- * @version 1.0.0
- * @date 2025-03-12
- */
-
-package config
-
-// GetDefaultConfig returns the default configuration for the client
-func GetDefaultConfig() Config {
-	return Config{
-		Server: ServerConfig{
-			Address:                "",
-			Port:                   50051,
-			UseTLS:                 false,
-			DiscoveryToken:         "NEXUFLEX_DISCOVERY",
-			AutoDiscover:           true,
-			DiscoverTimeoutSeconds: 5,
-		},
-		UI: UIConfig{
-			ColorScheme:           "default",
-			HeaderText:            "nexuflex Terminal",
-			ShowTimestamps:        true,
-			EnableSounds:          false,
-			MaxOutputLines:        1000,
-			MaxHistoryEntries:     100,
-			AutoCompleteEnabled:   true,
-			AutoFillServicePrefix: true,
-			Language:              "en",
-		},
-		Commands: CommandsConfig{
-			SaveHistory:           true,
-			UseLocalAliases:       true,
-			MaxLocalAliases:       50,
-			EnableMultilineInput:  true,
-			SaveHistoryOnShutdown: true,
-		},
-	}
-}
+// defaults.go
+/**
+ * Nexuflex Client - Default Configurations
+ *
+ * This file contains the default configurations for the client.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2025-03-12
+ */
+
+package config
+
+// GetDefaultConfig returns the default configuration for the client
+func GetDefaultConfig() Config {
+	return Config{
+		Server: ServerConfig{
+			Address:                    "",
+			Port:                       50051,
+			UseTLS:                     false,
+			DiscoveryToken:             "NEXUFLEX_DISCOVERY",
+			DiscoveryAddress:           "239.0.0.1:5000",
+			AutoDiscover:               true,
+			DiscoverTimeoutSeconds:     5,
+			KeepAliveSeconds:           60,
+			KeepAliveTTLPercent:        50,
+			MetadataRefreshIdleMinutes: 10,
+			AutoReconnect:              true,
+			KeepStateOnReconnect:       true,
+			Failover:                   true,
+			APIVersion:                 "auto",
+			MaxInFlight:                4,
+			PreferStreaming:            true,
+			StreamThresholdKB:          256,
+			MetadataChannel:            true,
+			StreamHeartbeatSeconds:     10,
+			StreamSilenceSeconds:       60,
+			LowBandwidth:               false,
+			LowBandwidthPageSize:       100,
+			LowBandwidthProgressDelta:  10,
+		},
+		UI: UIConfig{
+			ColorScheme:           "default",
+			HeaderText:            "nexuflex Terminal",
+			HeaderWidgets:         []string{"title", "spacer", "env", "server", "jobs", "notifications", "clock"},
+			Environment:           "",
+			ShowTimestamps:        true,
+			EnableSounds:          false,
+			MaxOutputLines:        1000,
+			MaxHistoryEntries:     100,
+			AutoCompleteEnabled:   true,
+			AutoFillServicePrefix: true,
+			CompletionCacheSize:   256,
+			CompletionCacheTTL:    300,
+			Language:              "en",
+			LogStreamCommands:     []string{"Monitor.Tail.AppLog"},
+			SplitRatio:            70,
+			Hyperlinks:            true,
+			CompactWidth:          100,
+			CompactHeight:         24,
+			KeyHints:              true,
+			PastePreview:          true,
+			SingleInstance:        false,
+			RoleBadge:             true,
+			FoldOutputLines:       200,
+			FoldKeepLines:         20,
+			WrapIndicator:         true,
+			WrapOutput:            true,
+			ScrollLines:           3,
+			FrameRate:             30,
+			InputDebounceMs:       100,
+			WatchBell:             true,
+			WatchBookmarks:        true,
+			RestoreWorkspace:      false,
+			ServiceColors:         true,
+			ServicePalette:        []string{"aqua", "lime", "fuchsia", "yellow", "orange", "lightskyblue", "violet"},
+			RenderMarkdown:        true,
+			Density:               "normal",
+		},
+		Commands: CommandsConfig{
+			SaveHistory:           true,
+			UseLocalAliases:       true,
+			MaxLocalAliases:       50,
+			MaxAliasDepth:         8,
+			SnippetPrefix:         ";",
+			EnableMultilineInput:  true,
+			SaveHistoryOnShutdown: true,
+			SaveTranscripts:       true,
+			ApprovalPollSeconds:   10,
+			LooseMatching:         false,
+			RememberParameters:    true,
+			UndoSeconds:           300,
+			MaintenanceJobMinutes: 30,
+			SuggestAliases:        true,
+			SuggestMinCount:       5,
+			SuggestMinLength:      20,
+			SyncSettings:          true,
+			ExpansionConfirm:      "off",
+		},
+		Auth: AuthConfig{
+			RememberCredentials: false,
+			AutoRelogin:         false,
+			Provider:            "password",
+			AutoLoginRecent:     true,
+			ElevatedRoles:       []string{"admin", "root"},
+		},
+		Session: SessionConfig{
+			DefaultContext: "",
+			OnConnect:      []string{},
+		},
+		Telemetry: TelemetryConfig{
+			Enabled: false,
+		},
+		Retention: RetentionConfig{
+			CompressTranscriptsDays: 7,
+			DeleteTranscriptsDays:   180,
+			QuarantineDays:          30,
+			MaxCacheMB:              100,
+		},
+		References:    map[string]string{},
+		Highlights:    map[string]string{},
+		Watches:       map[string]string{},
+		ServiceColors: map[string]string{},
+		FunctionKeys:  map[string]string{},
+		Annotations:   map[string]string{},
+		Thresholds:    map[string]string{},
+		Snippets:      map[string]string{},
+		Policy:        GetDefaultPolicy(),
+	}
+}
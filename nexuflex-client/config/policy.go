@@ -0,0 +1,155 @@
+// policy.go
+/**
+ * Nexuflex Client - Environment Policy
+ *
+ * This file contains the environment policy, a file administrators
+ * distribute next to the client to define how sessions in the tagged
+ * environments (prod, test, dev, ...) are guarded: the header color, an
+ * extra confirmation for commands that change data and the `--prod`
+ * acknowledgment required in batch mode. The policy can also tag servers,
+ * so that a production server is treated as such even if a user profile
+ * forgets its `environment`. Without a policy file the built-in defaults
+ * apply.
+ *
+ * Example (/etc/nexuflex/policy.ini):
+ *   [prod]
+ *   header_color = maroon
+ *   confirm_mutating = true
+ *   batch_acknowledge = true
+ *
+ *   [servers]
+ *   erp.example.com = prod
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// PolicyFileVariable names the environment variable that overrides the location of the policy file
+const PolicyFileVariable = "NEXUFLEX_POLICY"
+
+// EnvironmentPolicy contains the safeguards of one environment
+type EnvironmentPolicy struct {
+	HeaderColor      string `ini:"header_color"`      // Background of the header, empty for the theme color
+	ConfirmMutating  bool   `ini:"confirm_mutating"`  // Confirm commands that change data before sending them
+	BatchAcknowledge bool   `ini:"batch_acknowledge"` // Batch mode requires the --prod flag
+}
+
+// Policy contains the environment policy distributed by administrators
+type Policy struct {
+	// Environments maps an environment tag to its safeguards
+	Environments map[string]EnvironmentPolicy
+
+	// Servers maps a server address, "address:port" or server name to an environment tag
+	Servers map[string]string
+
+	// Path is the file the policy was loaded from, empty for the defaults
+	Path string
+}
+
+// environmentAliases map further environment tags to the ones of the policy
+var environmentAliases = map[string]string{
+	"production": "prod",
+	"staging":    "test",
+	"qa":         "test",
+	"local":      "dev",
+}
+
+// GetDefaultPolicy returns the policy used without a policy file
+func GetDefaultPolicy() Policy {
+	return Policy{
+		Environments: map[string]EnvironmentPolicy{
+			"prod": {HeaderColor: "maroon", ConfirmMutating: true, BatchAcknowledge: true},
+			"test": {HeaderColor: "olive"},
+			"dev":  {HeaderColor: "darkgreen"},
+		},
+		Servers: map[string]string{},
+	}
+}
+
+// PolicyPath returns the location of the policy file: the path in
+// NEXUFLEX_POLICY, or the system-wide file of the platform
+func PolicyPath() string {
+	if path := os.Getenv(PolicyFileVariable); path != "" {
+		return path
+	}
+	if runtime.GOOS == "windows" {
+		programData := os.Getenv("ProgramData")
+		if programData == "" {
+			programData = `C:\ProgramData`
+		}
+		return filepath.Join(programData, "nexuflex", "policy.ini")
+	}
+	return filepath.Join("/etc", "nexuflex", "policy.ini")
+}
+
+// LoadPolicy loads the policy file; a missing file yields the default policy
+func LoadPolicy(path string) (Policy, error) {
+	policy := GetDefaultPolicy()
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return policy, nil
+	}
+
+	file, err := ini.Load(path)
+	if err != nil {
+		return policy, err
+	}
+
+	for _, section := range file.Sections() {
+		if section.Name() == ini.DefaultSection {
+			continue
+		}
+		name := strings.ToLower(section.Name())
+		switch name {
+		case "servers":
+			for _, key := range section.Keys() {
+				policy.Servers[strings.ToLower(key.Name())] = strings.ToLower(strings.TrimSpace(key.Value()))
+			}
+		default:
+			// Keys missing in the file keep the built-in safeguards of the environment
+			environment := policy.Environments[name]
+			if err := section.MapTo(&environment); err != nil {
+				return policy, err
+			}
+			policy.Environments[name] = environment
+		}
+	}
+
+	policy.Path = path
+	return policy, nil
+}
+
+// Environment returns the safeguards of an environment tag
+func (p Policy) Environment(tag string) EnvironmentPolicy {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if environment, ok := p.Environments[tag]; ok {
+		return environment
+	}
+	return p.Environments[environmentAliases[tag]]
+}
+
+// ServerEnvironment returns the environment tag the policy assigns to a server
+func (p Policy) ServerEnvironment(address string, port int, name string) string {
+	for _, key := range []string{
+		strings.ToLower(address) + ":" + strconv.Itoa(port),
+		strings.ToLower(address),
+		strings.ToLower(name),
+	} {
+		if environment, ok := p.Servers[key]; ok && key != "" {
+			return environment
+		}
+	}
+	return ""
+}
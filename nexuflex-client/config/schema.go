@@ -0,0 +1,403 @@
+// schema.go
+/**
+ * Nexuflex Client - Configuration Schema
+ *
+ * This file contains the validation of client.ini against the schema of
+ * the configuration. The sections and keys with their types are taken
+ * from the ini tags of Config, so new settings are validated without
+ * further work; value ranges and allowed values are listed in
+ * schemaRules. Every problem is reported with file and line and, where
+ * possible, a suggested correction, because the ini mapping silently
+ * ignores unknown keys and values that do not fit the type.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic is a problem found in the configuration file
+type Diagnostic struct {
+	File       string
+	Line       int
+	Section    string
+	Key        string // Empty for problems of a whole section
+	Message    string
+	Suggestion string // Suggested correction, empty if there is none
+}
+
+// String formats the diagnostic as "file:line: [section] key: message (suggestion)"
+func (d Diagnostic) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s:%d: ", d.File, d.Line)
+	if d.Section != "" {
+		fmt.Fprintf(&sb, "[%s] ", d.Section)
+	}
+	if d.Key != "" {
+		sb.WriteString(d.Key + ": ")
+	}
+	sb.WriteString(d.Message)
+	if d.Suggestion != "" {
+		fmt.Fprintf(&sb, " (%s)", d.Suggestion)
+	}
+	return sb.String()
+}
+
+// valueKind is the type of the value of a configuration key
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindBool
+	kindInt
+	kindList
+)
+
+// schemaRule restricts the values of an integer or string key
+type schemaRule struct {
+	min, max int      // Range of integer values
+	allowed  []string // Allowed string values, lowercase
+}
+
+// schemaRules lists the ranges and allowed values of keys by "section.key";
+// integer keys without an entry must not be negative
+var schemaRules = map[string]schemaRule{
+	"server.port":                         {min: 1, max: 65535},
+	"server.keep_alive_seconds":           {min: 1, max: 86400},
+	"server.keep_alive_ttl_percent":       {min: 1, max: 100},
+	"server.api_version":                  {allowed: []string{"auto", "v1", "legacy"}},
+	"server.stream_heartbeat_seconds":     {min: 0, max: 3600},
+	"server.stream_silence_seconds":       {min: 0, max: 86400},
+	"server.low_bandwidth_page_size":      {min: 1, max: 100000},
+	"server.low_bandwidth_progress_delta": {min: 0, max: 100},
+	"ui.split_ratio":                      {min: 10, max: 90},
+	"ui.scroll_lines":                     {min: 1, max: 50},
+	"ui.frame_rate":                       {min: 1, max: 120},
+	"ui.input_debounce_ms":                {min: 0, max: 2000},
+	"ui.completion_cache_size":            {min: 0, max: 10000},
+	"ui.completion_cache_ttl_seconds":     {min: 1, max: 86400},
+	"ui.density":                          {allowed: []string{"compact", "normal", "spacious"}},
+	"commands.undo_seconds":               {min: 1, max: 86400},
+	"commands.max_alias_depth":            {min: 1, max: 32},
+	"commands.maintenance_job_minutes":    {min: 0, max: 1440},
+	"commands.suggest_min_count":          {min: 2, max: 1000},
+	"commands.suggest_min_length":         {min: 1, max: 1000},
+	"commands.expansion_confirm":          {allowed: []string{"off", "substantial", "changed"}},
+}
+
+// freeFormSections are the sections whose keys are chosen by the user
+var freeFormSections = []string{"references", "highlight", "watch", "service_color", "fkeys", "annotate", "threshold", "snippets", "timeline_filter"}
+
+// Values accepted for boolean keys, as by the ini mapping
+var (
+	trueValues  = []string{"1", "t", "true", "y", "yes", "on"}
+	falseValues = []string{"0", "f", "false", "n", "no", "off"}
+)
+
+// loadDiagnostics holds the problems found when the configuration was loaded last
+var loadDiagnostics []Diagnostic
+
+// LoadDiagnostics returns the problems found in the configuration file loaded last
+func LoadDiagnostics() []Diagnostic {
+	return loadDiagnostics
+}
+
+// configSchema returns the keys with their value types by section, taken
+// from the ini tags of Config
+func configSchema() map[string]map[string]valueKind {
+	schema := make(map[string]map[string]valueKind)
+	configType := reflect.TypeOf(Config{})
+	for i := 0; i < configType.NumField(); i++ {
+		section := configType.Field(i)
+		name := section.Tag.Get("ini")
+		if name == "" || name == "-" || section.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		keys := make(map[string]valueKind)
+		for j := 0; j < section.Type.NumField(); j++ {
+			field := section.Type.Field(j)
+			key := field.Tag.Get("ini")
+			if key == "" || key == "-" {
+				continue
+			}
+			switch field.Type.Kind() {
+			case reflect.Bool:
+				keys[key] = kindBool
+			case reflect.Int, reflect.Int64:
+				keys[key] = kindInt
+			case reflect.Slice:
+				keys[key] = kindList
+			default:
+				keys[key] = kindString
+			}
+		}
+		schema[name] = keys
+	}
+	for _, name := range freeFormSections {
+		schema[name] = nil
+	}
+	return schema
+}
+
+// ValidateConfigFile checks a configuration file against the schema and
+// returns its problems in the order of the lines
+func ValidateConfigFile(configPath string) ([]Diagnostic, error) {
+	file, err := os.Open(configPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	schema := configSchema()
+	var diagnostics []Diagnostic
+	report := func(line int, section, key, message, suggestion string) {
+		diagnostics = append(diagnostics, Diagnostic{
+			File:       configPath,
+			Line:       line,
+			Section:    section,
+			Key:        key,
+			Message:    message,
+			Suggestion: suggestion,
+		})
+	}
+
+	section := ""
+	known := true // Whether the current section is in the schema
+	seen := make(map[string]int)
+	scanner := bufio.NewScanner(file)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if number == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		// Section heading
+		if line[0] == '[' {
+			end := strings.IndexByte(line, ']')
+			if end < 0 {
+				report(number, "", "", "section heading without closing bracket", "write ["+strings.TrimSpace(line[1:])+"]")
+				continue
+			}
+			section = strings.TrimSpace(line[1:end])
+			_, known = schema[section]
+			if !known {
+				report(number, section, "", "unknown section; its keys are ignored", suggestName(section, mapKeys(schema)))
+			}
+			continue
+		}
+
+		// Key and value
+		separator := strings.IndexAny(line, "=:")
+		if separator < 0 {
+			report(number, section, "", fmt.Sprintf("line %q is neither a section nor a key", line), "write <key> = <value>")
+			continue
+		}
+		key := strings.TrimSpace(line[:separator])
+		value := configValue(line[separator+1:])
+
+		if section == "" {
+			report(number, "", key, "key outside of a section is ignored", sectionOfKey(schema, key))
+			continue
+		}
+		if !known {
+			continue
+		}
+		keys := schema[section]
+		if section == "fkeys" {
+			if _, ok := FunctionKeyNumber(key); !ok {
+				report(number, section, key, "not a function key that can be bound; it is ignored",
+					fmt.Sprintf("use F%d to F%d", MinFunctionKey, MaxFunctionKey))
+			}
+			continue
+		}
+		if keys == nil {
+			// Free-form section
+			continue
+		}
+
+		kind, ok := keys[key]
+		if !ok {
+			suggestion := suggestName(key, mapKeys(keys))
+			if suggestion == "" {
+				suggestion = sectionOfKey(schema, key)
+			}
+			report(number, section, key, "unknown key is ignored", suggestion)
+			continue
+		}
+
+		id := section + "." + key
+		if first, ok := seen[id]; ok {
+			report(number, section, key, fmt.Sprintf("key is set again and replaces line %d", first), "remove one of the lines")
+		}
+		seen[id] = number
+
+		if message, suggestion := checkValue(id, kind, value); message != "" {
+			report(number, section, key, message, suggestion)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return diagnostics, err
+	}
+	return diagnostics, nil
+}
+
+// configValue returns the value of a key line without quotes and inline comments
+func configValue(raw string) string {
+	value := strings.TrimSpace(raw)
+	for _, quote := range []string{`"""`, `"`, "`"} {
+		if len(value) >= 2*len(quote) && strings.HasPrefix(value, quote) {
+			if end := strings.Index(value[len(quote):], quote); end >= 0 {
+				return value[len(quote) : len(quote)+end]
+			}
+		}
+	}
+	if i := strings.IndexAny(value, "#;"); i >= 0 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return value
+}
+
+// checkValue checks a value against the type and the rule of its key and
+// returns the problem with a suggestion, or an empty message
+func checkValue(id string, kind valueKind, value string) (string, string) {
+	rule, hasRule := schemaRules[id]
+	switch kind {
+	case kindBool:
+		lower := strings.ToLower(value)
+		if value == "" || contains(trueValues, lower) || contains(falseValues, lower) {
+			return "", ""
+		}
+		return fmt.Sprintf("%q is not a boolean; the default is used", value), "use true or false"
+
+	case kindInt:
+		if value == "" {
+			return "", ""
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			if f, ferr := strconv.ParseFloat(value, 64); ferr == nil {
+				return fmt.Sprintf("%q is not a whole number; the default is used", value),
+					fmt.Sprintf("use %d", int(f))
+			}
+			return fmt.Sprintf("%q is not a number; the default is used", value), "use a whole number"
+		}
+		if !hasRule {
+			rule = schemaRule{min: 0, max: -1}
+		}
+		if number < rule.min {
+			if rule.max < rule.min {
+				return fmt.Sprintf("%d must not be negative", number), "use 0 or more"
+			}
+			return fmt.Sprintf("%d is below the minimum %d", number, rule.min), fmt.Sprintf("use %d to %d", rule.min, rule.max)
+		}
+		if rule.max >= rule.min && number > rule.max {
+			return fmt.Sprintf("%d is above the maximum %d", number, rule.max), fmt.Sprintf("use %d to %d", rule.min, rule.max)
+		}
+
+	case kindString:
+		if !hasRule || len(rule.allowed) == 0 || value == "" || contains(rule.allowed, strings.ToLower(value)) {
+			return "", ""
+		}
+		suggestion := "use one of " + strings.Join(rule.allowed, ", ")
+		if closest := closestName(strings.ToLower(value), rule.allowed); closest != "" {
+			suggestion = fmt.Sprintf("did you mean %q?", closest)
+		}
+		return fmt.Sprintf("%q is not an allowed value", value), suggestion
+	}
+	return "", ""
+}
+
+// sectionOfKey suggests the section a key belongs to, if it is known in one
+func sectionOfKey(schema map[string]map[string]valueKind, key string) string {
+	var sections []string
+	for section, keys := range schema {
+		if _, ok := keys[key]; ok {
+			sections = append(sections, "["+section+"]")
+		}
+	}
+	if len(sections) == 0 {
+		return ""
+	}
+	sort.Strings(sections)
+	return "move it to " + strings.Join(sections, " or ")
+}
+
+// suggestName returns "did you mean ...?" for the closest of the names, or nothing
+func suggestName(name string, names []string) string {
+	if closest := closestName(name, names); closest != "" {
+		return fmt.Sprintf("did you mean %q?", closest)
+	}
+	return ""
+}
+
+// closestName returns the name with the smallest edit distance to a
+// misspelled one, if it is close enough to be a likely typo
+func closestName(name string, names []string) string {
+	sort.Strings(names)
+	best, bestDistance := "", 0
+	for _, candidate := range names {
+		distance := editDistance(strings.ToLower(name), candidate)
+		if best == "" || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	if best == "" || bestDistance > max(2, len(name)/3) {
+		return ""
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance of two strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+// mapKeys returns the keys of a map
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// contains reports whether a list contains a value
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+// discovery.go
+/**
+* Nexuflex Client - Server Discovery Implementation
+*
+* This file contains the implementation of the discovery mechanism
+* for automatic detection of nexuflex servers on the network. A JSON
+* request packet carrying the discovery token is sent to the multicast
+* group; every server answers with a JSON response packet describing
+* itself. Responses are collected until the timeout and deduplicated by
+* address and port.
+*
+* @author msto63
+* @version 1.0.0
+* @date 2025-03-12
+ */
+
+package discovery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UDP multicast addresses for server discovery
+const (
+	DefaultMulticastAddress = "239.0.0.1:5000"
+	DiscoveryTimeout        = 5 * time.Second
+	DiscoveryPacketSize     = 64 * 1024 // Largest UDP payload, so that no response is truncated
+)
+
+// Types of discovery packets
+const (
+	PacketRequest  = "request"
+	PacketResponse = "response"
+)
+
+// DiscoveryPacket represents a discovery packet
+type DiscoveryPacket struct {
+	Type    string `json:"type"`    // "request" or "response"
+	Token   string `json:"token"`   // Security token
+	Address string `json:"address"` // Server address (only for "response")
+	Port    int    `json:"port"`    // Server port (only for "response")
+	Name    string `json:"name"`    // Server name (only for "response")
+	Version string `json:"version"` // Server version (only for "response")
+
+	// Optional details of a "response"
+	Hostname           string `json:"hostname,omitempty"`
+	Description        string `json:"description,omitempty"`
+	TLS                bool   `json:"tls,omitempty"`
+	Health             string `json:"health,omitempty"` // healthy, degraded or unhealthy
+	LoadPercent        int    `json:"load_percent,omitempty"`
+	Maintenance        bool   `json:"maintenance,omitempty"`
+	MaintenanceMessage string `json:"maintenance_message,omitempty"`
+	Cluster            string `json:"cluster,omitempty"`
+}
+
+// Discover sends a discovery request to the multicast group and returns
+// the responses received until the timeout, one per server in the order
+// they arrived. Responses with another token are ignored; a response
+// without an address names the address it was sent from. If receiving
+// fails before the timeout, the servers found until then are returned
+// together with the error.
+func Discover(multicastAddr, discoveryToken string, timeout time.Duration) ([]DiscoveryPacket, error) {
+	// Parse multicast address
+	addr, err := net.ResolveUDPAddr("udp4", multicastAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid multicast address: %v", err)
+	}
+
+	// Create UDP socket; the responses are sent back to its port
+	conn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating UDP socket: %v", err)
+	}
+	defer conn.Close()
+
+	// Set timeout
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return nil, fmt.Errorf("error setting timeout: %v", err)
+	}
+
+	// Send discovery packet
+	request, err := json.Marshal(DiscoveryPacket{Type: PacketRequest, Token: discoveryToken})
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.WriteToUDP(request, addr)
+	if err != nil {
+		return nil, fmt.Errorf("error sending discovery packet: %v", err)
+	}
+
+	// Wait for responses
+	buffer := make([]byte, DiscoveryPacketSize)
+	var servers []DiscoveryPacket
+	seen := make(map[string]bool) // "address:port" of the servers found
+
+	for {
+		n, remoteAddr, err := conn.ReadFromUDP(buffer)
+		if err != nil {
+			// If timeout reached, exit
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				break
+			}
+			return servers, fmt.Errorf("error receiving discovery response: %v", err)
+		}
+
+		var response DiscoveryPacket
+		if err := json.Unmarshal(buffer[:n], &response); err != nil || response.Type != PacketResponse {
+			continue
+		}
+		if discoveryToken != "" && response.Token != "" && response.Token != discoveryToken {
+			continue
+		}
+		if response.Port <= 0 || response.Port > 65535 {
+			continue
+		}
+		if response.Address == "" {
+			response.Address = remoteAddr.IP.String()
+		}
+
+		key := strings.ToLower(net.JoinHostPort(response.Address, strconv.Itoa(response.Port)))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		servers = append(servers, response)
+	}
+
+	return servers, nil
+}
+
+// PerformMulticastDiscovery performs a multicast discovery and logs the
+// servers found
+func PerformMulticastDiscovery(multicastAddr, discoveryToken string, timeout time.Duration) error {
+	servers, err := Discover(multicastAddr, discoveryToken, timeout)
+
+	// Output results, also those found before an error
+	if err == nil || len(servers) > 0 {
+		log.Printf("Servers found: %d", len(servers))
+	}
+	for _, server := range servers {
+		log.Printf("  %s:%d: %s", server.Address, server.Port, server.Name)
+	}
+
+	return err
+}
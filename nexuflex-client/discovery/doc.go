@@ -0,0 +1,16 @@
+// doc.go
+/**
+ * Nexuflex Client - Package Documentation
+ *
+ * This file contains the package documentation of the discovery package.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+// Package discovery contains the UDP multicast discovery of nexuflex
+// servers in the local network. It has no dependencies on the rest of the
+// client and its exported API is stable within major version 1 of the
+// nexuflex-client module.
+package discovery
@@ -0,0 +1,63 @@
+// collate.go
+/**
+ * Nexuflex Client - Collation
+ *
+ * This file contains the locale-aware comparison of texts for sorting
+ * lists and tables. Sorting by byte values puts umlauts and accented
+ * letters after "z" ("Ärzte" after "Zahnärzte"); the collation of the
+ * Unicode Collation Algorithm, tailored to the loaded language, sorts
+ * them the way users of that language expect. Differences of case and
+ * accents only decide between otherwise equal texts.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package i18n
+
+import (
+	"sort"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// The collator of the loaded language; a collator must not be used concurrently
+var (
+	collatorMu       sync.Mutex
+	collator         *collate.Collator
+	collatorLanguage string
+)
+
+// currentCollator returns the collator of the loaded language, creating it
+// if the language changed; the caller holds collatorMu
+func currentCollator() *collate.Collator {
+	if collator == nil || collatorLanguage != currentLanguage {
+		tag, err := language.Parse(currentLanguage)
+		if err != nil {
+			tag = language.Und
+		}
+		collator = collate.New(tag)
+		collatorLanguage = currentLanguage
+	}
+	return collator
+}
+
+// Compare compares two texts in the collation order of the loaded language
+func Compare(a, b string) int {
+	collatorMu.Lock()
+	defer collatorMu.Unlock()
+	return currentCollator().CompareString(a, b)
+}
+
+// SortStrings sorts texts in the collation order of the loaded language
+func SortStrings(texts []string) {
+	collatorMu.Lock()
+	defer collatorMu.Unlock()
+	c := currentCollator()
+	sort.SliceStable(texts, func(i, j int) bool {
+		return c.CompareString(texts[i], texts[j]) < 0
+	})
+}
@@ -0,0 +1,318 @@
+// mockserver.go
+/**
+ * Nexuflex Client - Mock Server for Tests
+ *
+ * This file contains a test double of the nexuflex server: an in-process
+ * gRPC server implementing NexuflexService on a random loopback port.
+ * A test registers the users that may log in and the commands of the
+ * services with their output, error or the approval they wait for, points
+ * the client at Address and Port and checks what the client shows. The
+ * server completes the names of the registered commands and records the
+ * command lines it received, so a test can also verify what was actually
+ * sent. RPCs a test does not
+ * need answer with Unimplemented, as an older server would.
+ *
+ * Example:
+ *   server, err := mockserver.Start()
+ *   defer server.Close()
+ *   server.AddUser("alice", "secret")
+ *   server.AddCommand("Inventory.Show.Item", "Stock: 42")
+ *   server.AddFailingCommand("Inventory.Delete.Item", "item is locked")
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package mockserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"google.golang.org/grpc"
+)
+
+// ServerName is the name the mock server reports on Connect
+const ServerName = "mock-server"
+
+// command is a registered command with its result
+type command struct {
+	info       *proto.CommandInfo
+	output     string
+	err        string // Error message; the command fails if it is set
+	approvalID string // Approval the command waits for if it is set
+}
+
+// Server is the mock server
+type Server struct {
+	listener net.Listener
+	server   *grpc.Server
+
+	mu       sync.Mutex
+	users    map[string]string   // User -> password
+	sessions map[string]string   // Session token -> user
+	commands map[string]*command // Full command name -> command
+	received []string            // Command lines received by ExecuteCommand
+}
+
+// Start starts a mock server on a random loopback port
+func Start() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("cannot start the mock server: %v", err)
+	}
+
+	s := &Server{
+		listener: listener,
+		server:   grpc.NewServer(),
+		users:    make(map[string]string),
+		sessions: make(map[string]string),
+		commands: make(map[string]*command),
+	}
+	proto.RegisterNexuflexServiceServer(s.server, &service{server: s})
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+// Address returns the address the server listens on
+func (s *Server) Address() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the port the server listens on
+func (s *Server) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Close stops the server and ends its connections
+func (s *Server) Close() {
+	s.server.Stop()
+}
+
+// AddUser allows a user to log in with a password
+func (s *Server) AddUser(username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[username] = password
+}
+
+// AddCommand registers a command, e.g. "Inventory.Show.Item", answering with an output
+func (s *Server) AddCommand(name, output string) {
+	s.addCommand(name, &command{output: output})
+}
+
+// AddFailingCommand registers a command failing with an error message
+func (s *Server) AddFailingCommand(name, message string) {
+	s.addCommand(name, &command{err: message})
+}
+
+// AddApprovalCommand registers a command waiting for the approval of a second user
+func (s *Server) AddApprovalCommand(name, approvalID string) {
+	s.addCommand(name, &command{approvalID: approvalID})
+}
+
+// addCommand registers a command under its full name
+func (s *Server) addCommand(name string, cmd *command) {
+	parts := strings.SplitN(name, ".", 3)
+	cmd.info = &proto.CommandInfo{Action: parts[min(1, len(parts)-1)], Description: "Command " + name}
+	if len(parts) > 2 {
+		cmd.info.Subaction = parts[2]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commands[name] = cmd
+}
+
+// Received returns the command lines the server received
+func (s *Server) Received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.received...)
+}
+
+// user returns the user of a session, empty if the token is unknown
+func (s *Server) user(token string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[token]
+}
+
+// commandNames returns the names of the registered commands in order
+func (s *Server) commandNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.commands))
+	for name := range s.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// services returns the names of the services of the registered commands
+func (s *Server) services() []string {
+	var services []string
+	for _, name := range s.commandNames() {
+		service, _, _ := strings.Cut(name, ".")
+		if len(services) == 0 || services[len(services)-1] != service {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// service implements the RPCs of the mock server; the others are unimplemented
+type service struct {
+	proto.UnimplementedNexuflexServiceServer
+	server *Server
+}
+
+// Connect accepts every client
+func (s *service) Connect(ctx context.Context, req *proto.ConnectRequest) (*proto.ConnectResponse, error) {
+	return &proto.ConnectResponse{Success: true, ServerName: ServerName, Version: "1.0.0"}, nil
+}
+
+// Login accepts the registered users with their password
+func (s *service) Login(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+
+	password, ok := s.server.users[req.Username]
+	if !ok || password != req.Password {
+		return &proto.LoginResponse{ErrorMessage: "invalid user name or password"}, nil
+	}
+
+	token := fmt.Sprintf("session-%d", len(s.server.sessions)+1)
+	s.server.sessions[token] = req.Username
+	return &proto.LoginResponse{
+		Success:           true,
+		SessionToken:      token,
+		SessionTtlSeconds: 3600,
+		UserInfo: &proto.UserInfo{
+			Username:              req.Username,
+			DisplayName:           req.Username,
+			SessionTimeoutMinutes: 60,
+		},
+	}, nil
+}
+
+// Logout ends a session
+func (s *service) Logout(ctx context.Context, req *proto.LogoutRequest) (*proto.LogoutResponse, error) {
+	s.server.mu.Lock()
+	delete(s.server.sessions, req.SessionToken)
+	s.server.mu.Unlock()
+	return &proto.LogoutResponse{Success: true}, nil
+}
+
+// KeepAlive keeps sessions valid for as long as the server runs
+func (s *service) KeepAlive(ctx context.Context, req *proto.KeepAliveRequest) (*proto.KeepAliveResponse, error) {
+	valid := s.server.user(req.SessionToken) != ""
+	return &proto.KeepAliveResponse{SessionValid: valid, RemainingMinutes: 60, SessionTtlSeconds: 3600}, nil
+}
+
+// ExecuteCommand answers a registered command with its output or error
+func (s *service) ExecuteCommand(ctx context.Context, req *proto.CommandRequest) (*proto.CommandResponse, error) {
+	username := s.server.user(req.SessionToken)
+	if username == "" {
+		return &proto.CommandResponse{
+			ErrorMessage: "not logged in",
+			StatusInfo:   &proto.StatusInfo{ConnectionStatus: proto.StatusInfo_CONNECTED, SessionStatus: proto.StatusInfo_LOGIN_REQUIRED},
+		}, nil
+	}
+
+	s.server.mu.Lock()
+	s.server.received = append(s.server.received, req.CommandLine)
+	name, _, _ := strings.Cut(strings.TrimSpace(req.CommandLine), " ")
+	cmd, ok := s.server.commands[name]
+	if !ok && req.LastContext != "" {
+		name = req.LastContext + "." + name
+		cmd, ok = s.server.commands[name]
+	}
+	s.server.mu.Unlock()
+
+	status := &proto.StatusInfo{
+		ConnectionStatus:        proto.StatusInfo_CONNECTED,
+		SessionStatus:           proto.StatusInfo_AUTHENTICATED,
+		SessionRemainingMinutes: 60,
+		ServerName:              ServerName,
+		Username:                username,
+	}
+	switch {
+	case !ok:
+		return &proto.CommandResponse{ErrorMessage: "unknown command " + name, StatusInfo: status}, nil
+	case cmd.err != "":
+		return &proto.CommandResponse{ErrorMessage: cmd.err, StatusInfo: status}, nil
+	case cmd.approvalID != "":
+		return &proto.CommandResponse{
+			Success:        true,
+			StatusInfo:     status,
+			ExecutionState: proto.CommandResponse_PENDING_APPROVAL,
+			ApprovalId:     cmd.approvalID,
+		}, nil
+	}
+
+	service, _, _ := strings.Cut(name, ".")
+	status.CurrentService = service
+	return &proto.CommandResponse{
+		Success:    true,
+		Output:     cmd.output,
+		StatusInfo: status,
+		NewContext: service,
+		CommandId:  req.CommandId,
+	}, nil
+}
+
+// GetAvailableServices returns the services of the registered commands
+func (s *service) GetAvailableServices(ctx context.Context, req *proto.ServicesRequest) (*proto.ServicesResponse, error) {
+	resp := &proto.ServicesResponse{}
+	for _, name := range s.server.services() {
+		resp.Services = append(resp.Services, &proto.ServiceInfo{ServiceName: name, Version: "1.0.0"})
+	}
+	return resp, nil
+}
+
+// GetServiceCommands returns the registered commands of a service
+func (s *service) GetServiceCommands(ctx context.Context, req *proto.ServiceCommandsRequest) (*proto.ServiceCommandsResponse, error) {
+	resp := &proto.ServiceCommandsResponse{}
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+	for name, cmd := range s.server.commands {
+		if service, _, _ := strings.Cut(name, "."); strings.EqualFold(service, req.ServiceName) {
+			resp.Commands = append(resp.Commands, cmd.info)
+		}
+	}
+	return resp, nil
+}
+
+// AutoComplete completes the names of the registered commands
+func (s *service) AutoComplete(ctx context.Context, req *proto.AutoCompleteRequest) (*proto.AutoCompleteResponse, error) {
+	partial := req.PartialInput
+	if req.CursorPosition >= 0 && int(req.CursorPosition) < len(partial) {
+		partial = partial[:req.CursorPosition]
+	}
+	resp := &proto.AutoCompleteResponse{}
+	if strings.ContainsAny(partial, " \t") {
+		return resp, nil
+	}
+
+	for _, name := range s.server.commandNames() {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(partial)) {
+			resp.Suggestions = append(resp.Suggestions, name)
+		}
+	}
+	resp.CommonPrefix = client.CommonPrefix(resp.Suggestions)
+	return resp, nil
+}
+
+// GetAliases returns no server aliases
+func (s *service) GetAliases(ctx context.Context, req *proto.GetAliasesRequest) (*proto.GetAliasesResponse, error) {
+	return &proto.GetAliasesResponse{}, nil
+}
@@ -0,0 +1,226 @@
+// mockserver_test.go
+/**
+ * Nexuflex Client - Mock Server Tests
+ *
+ * This file contains tests running the client against the mock server:
+ * login, command execution, completion and the error flows, without a
+ * user interface in between.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package mockserver_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/internal/mockserver"
+)
+
+// outputRecorder collects the output the client delivers
+type outputRecorder struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+// record is the output callback of the client
+func (r *outputRecorder) record(output string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines = append(r.lines, output)
+}
+
+// contains checks whether an output contains a text
+func (r *outputRecorder) contains(text string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, line := range r.lines {
+		if strings.Contains(line, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// startServer starts a mock server with a user and some commands
+func startServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	server, err := mockserver.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+
+	server.AddUser("alice", "secret")
+	server.AddCommand("Inventory.Show.Item", "Stock: 42")
+	server.AddCommand("Inventory.Show.List", "3 items")
+	server.AddFailingCommand("Inventory.Delete.Item", "item is locked")
+	server.AddApprovalCommand("Inventory.Purge.All", "A-17")
+	return server
+}
+
+// discardLog drops the log of the client, whose background work may still
+// log after a test has ended
+func discardLog(format string, args ...interface{}) {}
+
+// newClient returns a client connected to the server, with its state kept
+// in a temporary directory
+func newClient(t *testing.T, server *mockserver.Server) (*client.Client, *outputRecorder) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("APPDATA", t.TempDir())
+
+	cfg := config.GetDefaultConfig()
+	c := client.NewClient(&cfg, discardLog)
+	output := &outputRecorder{}
+	c.SetCallbacks(nil, nil, output.record)
+	if err := c.Connect(server.Address(), server.Port(), false); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c, output
+}
+
+func TestLogin(t *testing.T) {
+	server := startServer(t)
+	c, output := newClient(t, server)
+
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if !c.IsLoggedIn() {
+		t.Error("the client is not logged in after the login")
+	}
+	if !output.contains("Welcome, alice!") {
+		t.Errorf("no welcome message in %q", output.lines)
+	}
+}
+
+func TestLoginWithWrongPassword(t *testing.T) {
+	server := startServer(t)
+	c, _ := newClient(t, server)
+
+	err := c.Login("alice", "wrong")
+	if err == nil || !strings.Contains(err.Error(), "invalid user name or password") {
+		t.Fatalf("Login with a wrong password: got %v", err)
+	}
+	if c.IsLoggedIn() {
+		t.Error("the client is logged in after a failed login")
+	}
+}
+
+func TestExecuteCommand(t *testing.T) {
+	server := startServer(t)
+	c, output := newClient(t, server)
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if err := c.ExecuteCommand("Inventory.Show.Item 4711"); err != nil {
+		t.Fatalf("ExecuteCommand: %v", err)
+	}
+	if !output.contains("Stock: 42") {
+		t.Errorf("no command output in %q", output.lines)
+	}
+	if received := server.Received(); len(received) != 1 || received[0] != "Inventory.Show.Item 4711" {
+		t.Errorf("the server received %q", received)
+	}
+}
+
+func TestExecuteFailingCommand(t *testing.T) {
+	server := startServer(t)
+	c, output := newClient(t, server)
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	c.ExecuteCommand("Inventory.Delete.Item 4711")
+	if !output.contains("item is locked") {
+		t.Errorf("no error message in %q", output.lines)
+	}
+}
+
+func TestCommandPendingApproval(t *testing.T) {
+	server := startServer(t)
+	c, output := newClient(t, server)
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	err := c.ExecuteCommand("Inventory.Purge.All")
+	var pending *client.ApprovalPendingError
+	if !errors.As(err, &pending) || pending.ApprovalID != "A-17" {
+		t.Fatalf("got %v, want the pending approval A-17", err)
+	}
+	if pending := c.GetPendingApprovals(); len(pending) != 1 {
+		t.Errorf("got pending approvals %v, want the command", pending)
+	}
+	if output.contains("approval") {
+		t.Errorf("the pending approval was written as output: %q", output.lines)
+	}
+}
+
+func TestAutoComplete(t *testing.T) {
+	server := startServer(t)
+	c, _ := newClient(t, server)
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	suggestions, prefix, err := c.AutoComplete("Inventory.Sh", len("Inventory.Sh"))
+	if err != nil {
+		t.Fatalf("AutoComplete: %v", err)
+	}
+	if len(suggestions) != 2 {
+		t.Errorf("got suggestions %q, want the two Show commands", suggestions)
+	}
+	if prefix != "Inventory.Show." {
+		t.Errorf("got common prefix %q, want %q", prefix, "Inventory.Show.")
+	}
+}
+
+func TestServicePrefixWithoutMetadata(t *testing.T) {
+	server := startServer(t)
+	c, _ := newClient(t, server)
+	c.SetLowBandwidth(true) // No metadata is loaded after the login
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if services := c.GetCachedServices(); len(services) != 0 {
+		t.Fatalf("services cached in low-bandwidth mode: %v", services)
+	}
+	c.SetLastServiceUsed("Inventory")
+
+	// The dotted name starts with an action, not a service
+	if got := c.QualifyCommand("Show.Item 4711"); got != "Inventory.Show.Item 4711" {
+		t.Errorf("got %q, want the command in the service context", got)
+	}
+	if got := c.QualifyCommand("Inventory.Show.List"); got != "Inventory.Show.List" {
+		t.Errorf("got %q, want the qualified command unchanged", got)
+	}
+}
+
+func TestCloseDuringMetadataRefresh(t *testing.T) {
+	server := startServer(t)
+	c, _ := newClient(t, server)
+	if err := c.Login("alice", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	// The refresh started by the login ends with the session
+	c.Close()
+	if services := c.GetCachedServices(); len(services) != 0 {
+		t.Errorf("services cached after Close: %v", services)
+	}
+	if _, err := c.GetAvailableServices(); err == nil {
+		t.Error("services retrieved after Close")
+	}
+}
@@ -0,0 +1,316 @@
+// driver.go
+/**
+ * Nexuflex Client - Scripted UI Driver
+ *
+ * This file contains a driver that runs the user interface headlessly on
+ * a tcell simulation screen, for end-to-end tests and scripted checks.
+ * It injects key events, typed text and mouse clicks, and reads the
+ * rendered screen back as text, as a whole or by region, so a test can
+ * follow a login, a command or an error dialog the way a user sees it.
+ *
+ * The application runs in its own goroutine and processes the injected
+ * events asynchronously; checks therefore wait for the expected screen
+ * with WaitForText or WaitFor instead of reading it right away. What is
+ * read is a copy of the screen taken whenever the application shows a
+ * frame, so reading never races with drawing.
+ *
+ * Example:
+ *   d, err := uitest.Start(tui, 100, 30)
+ *   defer d.Stop()
+ *   d.Type("Inventory.Show.Item 4711")
+ *   d.Press(tcell.KeyEnter)
+ *   err = d.WaitForText("Stock:", 2*time.Second)
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package uitest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// pollInterval is the time between two reads of the screen while waiting
+const pollInterval = 10 * time.Millisecond
+
+// stopTimeout limits the wait for the application to end
+const stopTimeout = 5 * time.Second
+
+// Application is a user interface the driver can run, e.g. the client's
+// TUI or a tview application wrapped with ForApplication
+type Application interface {
+	SetScreen(screen tcell.Screen)
+	Run() error
+	Stop()
+}
+
+// tviewApplication adapts a tview application to the Application interface
+type tviewApplication struct {
+	app *tview.Application
+}
+
+// ForApplication wraps a tview application, e.g. to test a single dialog
+func ForApplication(app *tview.Application) Application {
+	return tviewApplication{app: app}
+}
+
+func (a tviewApplication) SetScreen(screen tcell.Screen) { a.app.SetScreen(screen) }
+func (a tviewApplication) Run() error                    { return a.app.Run() }
+func (a tviewApplication) Stop()                         { a.app.Stop() }
+
+// Driver runs an application on a simulation screen
+type Driver struct {
+	app    Application
+	screen *capturingScreen
+	done   chan error
+}
+
+// capturingScreen is a simulation screen that keeps a copy of every frame shown
+type capturingScreen struct {
+	tcell.SimulationScreen
+
+	mu            sync.Mutex
+	cells         []tcell.SimCell
+	width, height int
+}
+
+// Show shows the frame and copies it; called by the application while drawing
+func (s *capturingScreen) Show() {
+	s.SimulationScreen.Show()
+	s.capture()
+}
+
+// Sync redraws the whole screen and copies it
+func (s *capturingScreen) Sync() {
+	s.SimulationScreen.Sync()
+	s.capture()
+}
+
+// capture copies the shown cells; their runes are shared with the screen
+// until it writes the cell again, so they are copied as well
+func (s *capturingScreen) capture() {
+	cells, width, height := s.SimulationScreen.GetContents()
+	copied := make([]tcell.SimCell, len(cells))
+	for i, cell := range cells {
+		copied[i] = tcell.SimCell{
+			Style: cell.Style,
+			Runes: append([]rune(nil), cell.Runes...),
+		}
+	}
+
+	s.mu.Lock()
+	s.cells, s.width, s.height = copied, width, height
+	s.mu.Unlock()
+}
+
+// GetContents returns the copy of the last frame shown
+func (s *capturingScreen) GetContents() ([]tcell.SimCell, int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cells, s.width, s.height
+}
+
+// Start runs the application on a simulation screen of the given size
+func Start(app Application, width, height int) (*Driver, error) {
+	screen := &capturingScreen{SimulationScreen: tcell.NewSimulationScreen("UTF-8")}
+	if err := screen.Init(); err != nil {
+		return nil, err
+	}
+
+	// tview initializes the screen it is given once more, which resets its size
+	d := &Driver{app: app, screen: screen, done: make(chan error, 1)}
+	app.SetScreen(screen)
+	screen.SetSize(width, height)
+	go func() {
+		d.done <- app.Run()
+	}()
+	return d, nil
+}
+
+// Stop ends the application and returns the error Run returned
+func (d *Driver) Stop() error {
+	d.app.Stop()
+	select {
+	case err := <-d.done:
+		return err
+	case <-time.After(stopTimeout):
+		return errors.New("application did not stop")
+	}
+}
+
+// Done returns a channel receiving the result of Run when the application
+// ends by itself, e.g. after an "exit" command
+func (d *Driver) Done() <-chan error {
+	return d.done
+}
+
+// Press injects a key, e.g. tcell.KeyEnter or tcell.KeyTab
+func (d *Driver) Press(key tcell.Key) {
+	d.screen.InjectKey(key, 0, tcell.ModNone)
+}
+
+// PressMod injects a key or rune with modifiers, e.g. Ctrl+Space
+func (d *Driver) PressMod(key tcell.Key, r rune, mod tcell.ModMask) {
+	d.screen.InjectKey(key, r, mod)
+}
+
+// Type injects the characters of a text as single keystrokes
+func (d *Driver) Type(text string) {
+	for _, r := range text {
+		d.screen.InjectKey(tcell.KeyRune, r, tcell.ModNone)
+	}
+}
+
+// Submit types a line and presses Enter
+func (d *Driver) Submit(line string) {
+	d.Type(line)
+	d.Press(tcell.KeyEnter)
+}
+
+// Click injects a click of the left mouse button at a screen position
+func (d *Driver) Click(x, y int) {
+	d.screen.InjectMouse(x, y, tcell.Button1, tcell.ModNone)
+	d.screen.InjectMouse(x, y, tcell.ButtonNone, tcell.ModNone)
+}
+
+// Resize changes the size of the simulation screen
+func (d *Driver) Resize(width, height int) {
+	d.screen.SetSize(width, height)
+	d.screen.PostEvent(tcell.NewEventResize(width, height))
+}
+
+// Text returns the rendered screen as lines of text without trailing blanks
+func (d *Driver) Text() string {
+	lines := d.Lines()
+	return strings.Join(lines, "\n")
+}
+
+// Lines returns the rows of the rendered screen without trailing blanks
+func (d *Driver) Lines() []string {
+	cells, width, height := d.screen.GetContents()
+	return regionLines(cells, width, 0, 0, width, height)
+}
+
+// Region returns the text of a rectangle of the rendered screen
+func (d *Driver) Region(x, y, width, height int) string {
+	cells, screenWidth, screenHeight := d.screen.GetContents()
+	width = min(width, screenWidth-x)
+	height = min(height, screenHeight-y)
+	if x < 0 || y < 0 || width <= 0 || height <= 0 {
+		return ""
+	}
+	return strings.Join(regionLines(cells, screenWidth, x, y, width, height), "\n")
+}
+
+// Row returns a row of the rendered screen, e.g. the status bar
+func (d *Driver) Row(y int) string {
+	lines := d.Lines()
+	if y < 0 {
+		y += len(lines)
+	}
+	if y < 0 || y >= len(lines) {
+		return ""
+	}
+	return lines[y]
+}
+
+// Find returns the position of the first occurrence of a text on the screen
+func (d *Driver) Find(text string) (int, int, bool) {
+	for y, line := range d.Lines() {
+		if index := strings.Index(line, text); index >= 0 {
+			// Columns count cells, not bytes
+			return len([]rune(line[:index])), y, true
+		}
+	}
+	return 0, 0, false
+}
+
+// Style returns the style of a cell of the rendered screen
+func (d *Driver) Style(x, y int) tcell.Style {
+	cells, width, height := d.screen.GetContents()
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return tcell.StyleDefault
+	}
+	return cells[y*width+x].Style
+}
+
+// WaitFor waits until the rendered screen fulfills a condition
+func (d *Driver) WaitFor(condition func(screen string) bool, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		text := d.Text()
+		if condition(text) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return &TimeoutError{Screen: text}
+		}
+		select {
+		case err := <-d.done:
+			// Keep the result for Stop and Done
+			d.done <- err
+			return fmt.Errorf("application ended while waiting: %v", err)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// WaitForText waits until a text appears on the screen
+func (d *Driver) WaitForText(text string, timeout time.Duration) error {
+	err := d.WaitFor(func(screen string) bool {
+		return strings.Contains(screen, text)
+	}, timeout)
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		timeoutErr.Expected = text
+	}
+	return err
+}
+
+// WaitForGone waits until a text is no longer on the screen
+func (d *Driver) WaitForGone(text string, timeout time.Duration) error {
+	return d.WaitFor(func(screen string) bool {
+		return !strings.Contains(screen, text)
+	}, timeout)
+}
+
+// TimeoutError reports a screen that did not reach the expected state in time
+type TimeoutError struct {
+	Expected string // Expected text, empty for other conditions
+	Screen   string // Screen at the timeout
+}
+
+// Error includes the screen at the timeout, which is what a failing test needs to show
+func (e *TimeoutError) Error() string {
+	if e.Expected != "" {
+		return fmt.Sprintf("%q did not appear on the screen:\n%s", e.Expected, e.Screen)
+	}
+	return fmt.Sprintf("screen did not reach the expected state:\n%s", e.Screen)
+}
+
+// regionLines extracts the text of a rectangle from the cells of a screen
+func regionLines(cells []tcell.SimCell, screenWidth, x, y, width, height int) []string {
+	lines := make([]string, 0, height)
+	for row := y; row < y+height; row++ {
+		var sb strings.Builder
+		for col := x; col < x+width; col++ {
+			cell := cells[row*screenWidth+col]
+			if len(cell.Runes) == 0 {
+				sb.WriteByte(' ')
+				continue
+			}
+			sb.WriteString(string(cell.Runes))
+		}
+		lines = append(lines, strings.TrimRight(sb.String(), " "))
+	}
+	return lines
+}
@@ -0,0 +1,130 @@
+// driver_test.go
+/**
+ * Nexuflex Client - Scripted UI Driver Tests
+ *
+ * This file contains tests of the driver on a small tview application
+ * echoing the submitted lines, the way the client's TUI is driven in the
+ * end-to-end tests of the ui package.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package uitest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// waitTimeout limits the waits of the tests
+const waitTimeout = 2 * time.Second
+
+// startEcho starts an application echoing the submitted lines above the input field
+func startEcho(t *testing.T) *Driver {
+	t.Helper()
+	app := tview.NewApplication().EnableMouse(true)
+	output := tview.NewTextView().SetChangedFunc(func() { app.Draw() })
+	input := tview.NewInputField().SetLabel("> ")
+	input.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			fmt.Fprintf(output, "echo: %s\n", input.GetText())
+			input.SetText("")
+		}
+	})
+	button := tview.NewButton("Clear").SetSelectedFunc(func() { output.Clear() })
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(output, 0, 1, false).
+		AddItem(button, 1, 0, false).
+		AddItem(input, 1, 0, true)
+	app.SetRoot(layout, true)
+
+	d, err := Start(ForApplication(app), 40, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := d.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	if err := d.WaitForText(">", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestSubmitAndRead(t *testing.T) {
+	d := startEcho(t)
+
+	d.Submit("hello")
+	if err := d.WaitForText("echo: hello", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	if row := d.Row(0); row != "echo: hello" {
+		t.Errorf("got first row %q", row)
+	}
+	if row := d.Row(-1); row != ">" {
+		t.Errorf("got input row %q, want the empty input field", row)
+	}
+	x, y, ok := d.Find("hello")
+	if !ok || x != 6 || y != 0 {
+		t.Errorf("Find returned %d, %d, %v", x, y, ok)
+	}
+	if region := d.Region(0, 0, 4, 1); region != "echo" {
+		t.Errorf("got region %q", region)
+	}
+}
+
+func TestClick(t *testing.T) {
+	d := startEcho(t)
+
+	d.Submit("hello")
+	if err := d.WaitForText("echo: hello", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	x, y, ok := d.Find("Clear")
+	if !ok {
+		t.Fatalf("no button on the screen:\n%s", d.Text())
+	}
+	d.Click(x, y)
+	if err := d.WaitForGone("echo: hello", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResize(t *testing.T) {
+	d := startEcho(t)
+
+	d.Resize(20, 5)
+	if err := d.WaitFor(func(screen string) bool {
+		return len(d.Lines()) == 5
+	}, waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range d.Lines() {
+		if len([]rune(line)) > 20 {
+			t.Errorf("line %q is wider than the screen", line)
+		}
+	}
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	d := startEcho(t)
+
+	err := d.WaitForText("never shown", 50*time.Millisecond)
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("got %v, want a TimeoutError", err)
+	}
+	if timeoutErr.Expected != "never shown" || !strings.Contains(timeoutErr.Screen, ">") {
+		t.Errorf("got %+v", timeoutErr)
+	}
+}
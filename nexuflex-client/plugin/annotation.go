@@ -0,0 +1,118 @@
+// annotation.go
+/**
+ * Nexuflex Client - Output Annotations
+ *
+ * This file contains the registry of the annotators contributed by
+ * extensions. After a command has finished, every annotator receives the
+ * command and its output and may return annotations: a severity, a short
+ * text, tags and a link, e.g. to the runbook of a known error signature.
+ * The user interface shows them as a badge line under the output of the
+ * command and records them in the timeline.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package plugin
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Severities of annotations
+const (
+	SeverityInfo    = "info"
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+)
+
+// Annotation is a remark attached to the output of a command
+type Annotation struct {
+	Severity string   // info, warning or error
+	Text     string   // Short description, e.g. "Known deadlock signature"
+	Tags     []string // Tags for filtering the timeline, without "#"
+	Link     string   // URL or command, e.g. the runbook; may be empty
+	Source   string   // Name of the annotator, set by the registry
+}
+
+// Result is a finished command passed to the annotators
+type Result struct {
+	Command  string
+	Service  string
+	Output   string // Output as received, without color tags
+	Error    string // Error message of a failed command, empty on success
+	Duration time.Duration
+}
+
+// Annotator returns the annotations of a finished command
+type Annotator func(result Result) []Annotation
+
+var (
+	annotatorsMu sync.RWMutex
+	annotators   = make(map[string]Annotator)
+)
+
+// RegisterAnnotator adds an annotator; it is meant to be called from an init
+// function. An annotator of the same name is replaced.
+func RegisterAnnotator(name string, annotator Annotator) {
+	annotatorsMu.Lock()
+	defer annotatorsMu.Unlock()
+	annotators[name] = annotator
+}
+
+// Annotate passes a finished command through all annotators in the order of
+// their names; a failing annotator is logged and skipped
+func Annotate(result Result) []Annotation {
+	annotatorsMu.RLock()
+	names := make([]string, 0, len(annotators))
+	for name := range annotators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	current := make([]Annotator, len(names))
+	for i, name := range names {
+		current[i] = annotators[name]
+	}
+	annotatorsMu.RUnlock()
+
+	var annotations []Annotation
+	for i, annotator := range current {
+		for _, annotation := range runAnnotator(names[i], annotator, result) {
+			if strings.TrimSpace(annotation.Text) == "" {
+				continue
+			}
+			annotation.Severity = NormalizeSeverity(annotation.Severity)
+			annotation.Source = names[i]
+			annotations = append(annotations, annotation)
+		}
+	}
+	return annotations
+}
+
+// NormalizeSeverity maps a severity to info, warning or error
+func NormalizeSeverity(severity string) string {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case SeverityError, "critical", "fatal":
+		return SeverityError
+	case SeverityWarning, "warn":
+		return SeverityWarning
+	default:
+		return SeverityInfo
+	}
+}
+
+// runAnnotator calls an annotator and logs a panic instead of propagating it
+func runAnnotator(name string, annotator Annotator, result Result) (annotations []Annotation) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Annotator %s failed: %v", name, r)
+			annotations = nil
+		}
+	}()
+	return annotator(result)
+}
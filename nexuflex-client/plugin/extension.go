@@ -0,0 +1,77 @@
+// extension.go
+/**
+ * Nexuflex Client - Extension Interface
+ *
+ * This file contains the interface for compiled-in client extensions.
+ * Downstream builds implement Extension in their own package and register
+ * it from an init function, which adds organization-specific client
+ * commands and output decorators without changes to the upstream files.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package plugin
+
+// Host gives extensions access to the running client
+type Host interface {
+	// ExecuteCommand sends a command to the server; the output appears in the output area
+	ExecuteCommand(command string) error
+	// WriteOutput appends text (with color tags) to the output area
+	WriteOutput(text string)
+	// ShowInfo shows an information message in the status bar
+	ShowInfo(message string)
+	// ShowError shows an error message in the status bar
+	ShowError(message string)
+}
+
+// Command is a client command contributed by an extension
+type Command struct {
+	// Name is the command keyword, matched case-insensitively
+	Name string
+	// Usage is shown in the help, e.g. "ticket <id>"
+	Usage string
+	// Description is shown in the help next to the usage
+	Description string
+	// Handler runs the command with the text after the keyword
+	Handler func(host Host, args string) error
+}
+
+// ConnectInfo describes the server the client has connected to
+type ConnectInfo struct {
+	Address    string
+	Port       int
+	TLS        bool
+	ServerName string
+	Version    string
+}
+
+// Extension is a compiled-in client extension
+type Extension interface {
+	// Name identifies the extension in logs and the help
+	Name() string
+	// RegisterCommands returns the client commands of the extension
+	RegisterCommands() []Command
+	// OnConnect is called after the client has connected to a server
+	OnConnect(info ConnectInfo)
+	// OnOutput may change server output before it is displayed
+	OnOutput(output string) string
+	// OnShutdown is called when the client exits
+	OnShutdown()
+}
+
+// Base implements Extension with no-op methods; embed it to override only what is needed
+type Base struct{}
+
+// RegisterCommands returns no commands
+func (Base) RegisterCommands() []Command { return nil }
+
+// OnConnect does nothing
+func (Base) OnConnect(info ConnectInfo) {}
+
+// OnOutput returns the output unchanged
+func (Base) OnOutput(output string) string { return output }
+
+// OnShutdown does nothing
+func (Base) OnShutdown() {}
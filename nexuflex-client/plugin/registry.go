@@ -0,0 +1,147 @@
+// registry.go
+/**
+ * Nexuflex Client - Extension Registry
+ *
+ * This file contains the registry of the compiled-in extensions, which is
+ * consulted by the client core (connect) and the user interface (commands,
+ * output, shutdown). A failing extension is logged and skipped so that it
+ * cannot take the client down.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package plugin
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	mu         sync.RWMutex
+	extensions []Extension
+	commands   = make(map[string]Command)
+)
+
+// Register adds an extension; it is meant to be called from an init function.
+// Commands whose name is already taken by another extension are ignored.
+func Register(ext Extension) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	extensions = append(extensions, ext)
+
+	var extCommands []Command
+	safeCall(ext, "RegisterCommands", func() {
+		extCommands = ext.RegisterCommands()
+	})
+	for _, cmd := range extCommands {
+		name := strings.ToLower(strings.TrimSpace(cmd.Name))
+		if name == "" || cmd.Handler == nil {
+			continue
+		}
+		if _, exists := commands[name]; exists {
+			log.Printf("Extension %s: command '%s' already registered, ignored", ext.Name(), name)
+			continue
+		}
+		cmd.Name = name
+		commands[name] = cmd
+	}
+}
+
+// Extensions returns the registered extensions in registration order
+func Extensions() []Extension {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	result := make([]Extension, len(extensions))
+	copy(result, extensions)
+	return result
+}
+
+// Commands returns the commands of all extensions sorted by name
+func Commands() []Command {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	result := make([]Command, 0, len(commands))
+	for _, cmd := range commands {
+		result = append(result, cmd)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// FindCommand looks up an extension command by name
+func FindCommand(name string) (Command, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	cmd, ok := commands[strings.ToLower(name)]
+	return cmd, ok
+}
+
+// IsCommand checks whether a name is taken by an extension command
+func IsCommand(name string) bool {
+	_, ok := FindCommand(name)
+	return ok
+}
+
+// RunCommand executes an extension command; a panic in the handler is returned as an error
+func RunCommand(cmd Command, host Host, args string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("command '%s' failed: %v", cmd.Name, r)
+		}
+	}()
+	return cmd.Handler(host, args)
+}
+
+// NotifyConnect informs all extensions about a new server connection
+func NotifyConnect(info ConnectInfo) {
+	for _, ext := range Extensions() {
+		safeCall(ext, "OnConnect", func() {
+			ext.OnConnect(info)
+		})
+	}
+}
+
+// DecorateOutput passes server output through the output decorators of all extensions
+func DecorateOutput(output string) string {
+	for _, ext := range Extensions() {
+		decorated := output
+		ok := safeCall(ext, "OnOutput", func() {
+			decorated = ext.OnOutput(output)
+		})
+		if ok {
+			output = decorated
+		}
+	}
+	return output
+}
+
+// NotifyShutdown informs all extensions that the client exits
+func NotifyShutdown() {
+	for _, ext := range Extensions() {
+		safeCall(ext, "OnShutdown", ext.OnShutdown)
+	}
+}
+
+// safeCall runs a method of an extension and logs a panic instead of propagating it
+func safeCall(ext Extension, method string, call func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Extension %s: %s failed: %v", ext.Name(), method, r)
+			ok = false
+		}
+	}()
+	call()
+	return true
+}
@@ -0,0 +1,91 @@
+// renderer.go
+/**
+ * Nexuflex Client - Output Renderers
+ *
+ * This file contains the registry of the output renderers contributed by
+ * extensions. Servers declare the content type of a command result, e.g.
+ * "application/json"; the user interface looks up the renderer of the type
+ * here first and then among its built-in renderers, so an extension can
+ * add a format or replace a built-in one from its init function.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ContentTypePlain is the content type of results that declare none
+const ContentTypePlain = "text/plain"
+
+// Content is a command result of a declared content type
+type Content struct {
+	Type    string // Normalized content type, e.g. "application/json"
+	Command string // Command that produced the result
+	Body    string // Result as sent by the server
+	Width   int    // Width of the output area in cells, 0 if unknown
+}
+
+// Renderer turns content into text with color tags for the output area;
+// an error shows the content as plain text instead
+type Renderer func(host Host, content Content) (string, error)
+
+var renderers = make(map[string]Renderer)
+
+// RegisterRenderer sets the renderer of a content type; it is meant to be
+// called from an init function and replaces a renderer registered before
+func RegisterRenderer(contentType string, renderer Renderer) {
+	mu.Lock()
+	defer mu.Unlock()
+	renderers[NormalizeContentType(contentType)] = renderer
+}
+
+// FindRenderer looks up the renderer an extension registered for a content type
+func FindRenderer(contentType string) (Renderer, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	renderer, ok := renderers[NormalizeContentType(contentType)]
+	return renderer, ok
+}
+
+// RendererTypes returns the content types extensions registered renderers for
+func RendererTypes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	types := make([]string, 0, len(renderers))
+	for contentType := range renderers {
+		types = append(types, contentType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Render runs a renderer; a panic is returned as an error
+func Render(renderer Renderer, host Host, content Content) (text string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("renderer for '%s' failed: %v", content.Type, r)
+		}
+	}()
+	return renderer(host, content)
+}
+
+// NormalizeContentType lowercases a content type and removes its
+// parameters, e.g. "; charset=utf-8"; an empty type is text/plain
+func NormalizeContentType(contentType string) string {
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if contentType == "" {
+		return ContentTypePlain
+	}
+	return contentType
+}
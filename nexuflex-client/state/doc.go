@@ -0,0 +1,17 @@
+// doc.go
+/**
+ * Nexuflex Client - Package Documentation
+ *
+ * This file contains the package documentation of the state package.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+// Package state manages the local state of the client in its configuration
+// directory: crash-safe line-based data files with quarantine of corrupt
+// lines, and the retention of transcripts, quarantine files and caches.
+// Its exported API is stable within major version 1 of the nexuflex-client
+// module.
+package state
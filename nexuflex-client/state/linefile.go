@@ -0,0 +1,193 @@
+// linefile.go
+/**
+ * Nexuflex Client - Line-Based Data Files
+ *
+ * This file contains the reading and writing of the line-based files of
+ * the client (history, aliases). Lines are read with a bounded buffer,
+ * corrupt lines are moved to a quarantine file next to the original
+ * instead of aborting the load, and files are replaced atomically via a
+ * temporary file so that a crash while saving cannot destroy them.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package state
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineLength is the maximum length of a line in a data file
+const maxLineLength = 64 * 1024
+
+// QuarantineSuffix is appended to the file name for the quarantined lines
+const QuarantineSuffix = ".corrupt"
+
+// CorruptLinesError reports lines that could not be loaded and were quarantined
+type CorruptLinesError struct {
+	Path           string
+	Count          int
+	QuarantinePath string
+}
+
+// Error implements the error interface
+func (e *CorruptLinesError) Error() string {
+	return fmt.Sprintf("%d corrupt line(s) in %s moved to %s", e.Count, e.Path, e.QuarantinePath)
+}
+
+// ReadLines calls accept for every valid line of a file; lines that are too
+// long, not valid UTF-8 or rejected by accept are moved to the quarantine
+// file and removed from the original. A missing file is not an error.
+func ReadLines(path string, accept func(line string) bool) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var corrupt [][]byte
+	var valid []string
+	reader := bufio.NewReaderSize(f, 4096)
+	for {
+		line, tooLong, err := readLine(reader)
+		if len(line) > 0 || tooLong {
+			text := string(bytes.TrimSuffix(line, []byte{'\r'}))
+			switch {
+			case tooLong, !utf8.ValidString(text), bytes.IndexByte(line, 0) >= 0:
+				corrupt = append(corrupt, line)
+			case text == "":
+				// Skip empty lines
+			case !accept(text):
+				corrupt = append(corrupt, line)
+			default:
+				valid = append(valid, text)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(corrupt) == 0 {
+		return nil
+	}
+
+	quarantinePath := path + QuarantineSuffix
+	if err := quarantineLines(quarantinePath, corrupt); err != nil {
+		return err
+	}
+
+	// Close the file before replacing it (required on Windows)
+	f.Close()
+	if err := WriteLinesAtomic(path, valid); err != nil {
+		return err
+	}
+	return &CorruptLinesError{Path: path, Count: len(corrupt), QuarantinePath: quarantinePath}
+}
+
+// readLine reads one line of at most maxLineLength bytes; longer lines are
+// consumed completely and reported as too long with their first bytes
+func readLine(reader *bufio.Reader) ([]byte, bool, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, isPrefix, err := reader.ReadLine()
+		if err != nil {
+			return line, tooLong, err
+		}
+
+		if len(line)+len(chunk) > maxLineLength {
+			tooLong = true
+			if room := maxLineLength - len(line); room > 0 {
+				line = append(line, chunk[:room]...)
+			}
+		} else {
+			line = append(line, chunk...)
+		}
+
+		if !isPrefix {
+			return line, tooLong, nil
+		}
+	}
+}
+
+// quarantineLines appends corrupt lines to the quarantine file
+func quarantineLines(path string, lines [][]byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "# quarantined %s\n", time.Now().Format(time.RFC3339))
+	for _, line := range lines {
+		w.Write(line)
+		w.WriteByte('\n')
+	}
+	return w.Flush()
+}
+
+// WriteLinesAtomic replaces a file with the given lines via a temporary file
+func WriteLinesAtomic(path string, lines []string) error {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	return WriteFileAtomic(path, buf.Bytes())
+}
+
+// WriteFileAtomic replaces a file with the given data via a temporary file
+func WriteFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	// Remove the temporary file if anything goes wrong
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
@@ -0,0 +1,302 @@
+// retention.go
+/**
+ * Nexuflex Client - Retention of Local State
+ *
+ * This file contains the maintenance of the files the client keeps in its
+ * configuration directory, configured in the [retention] section: session
+ * transcripts are compressed after some days and deleted later, quarantine
+ * files with corrupt lines are pruned, and the cache directory is capped
+ * by deleting its oldest files. The maintenance runs in the background at
+ * startup; "state usage" shows the disk consumption per category.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package state
+
+import (
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+)
+
+// CompressedSuffix is appended to the name of a compressed transcript
+const CompressedSuffix = ".gz"
+
+// Categories of local state
+const (
+	CategoryConfig      = "config"
+	CategoryHistory     = "history"
+	CategoryTranscripts = "transcripts"
+	CategoryCache       = "cache"
+	CategoryQuarantine  = "quarantine"
+	CategoryOther       = "other"
+)
+
+// categories lists the categories in display order
+var categories = []string{CategoryConfig, CategoryHistory, CategoryTranscripts, CategoryCache, CategoryQuarantine, CategoryOther}
+
+// CategoryUsage is the disk consumption of a category of local state
+type CategoryUsage struct {
+	Category string
+	Files    int
+	Bytes    int64
+}
+
+// MaintenanceResult summarizes a maintenance run
+type MaintenanceResult struct {
+	Compressed int   // Transcripts compressed
+	Deleted    int   // Files deleted
+	Freed      int64 // Bytes freed
+}
+
+// Dir returns the configuration directory holding the local state
+func Dir() (string, error) {
+	userConfigDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userConfigDir, "nexuflex"), nil
+}
+
+// CacheDir returns the directory for cached data, which is capped by max_cache_mb
+func CacheDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cache"), nil
+}
+
+// stateCategory returns the category of a file, given by its path relative to the state directory
+func stateCategory(relPath string) string {
+	first := strings.SplitN(filepath.ToSlash(relPath), "/", 2)[0]
+	switch {
+	case strings.HasSuffix(relPath, QuarantineSuffix):
+		return CategoryQuarantine
+	case first == "transcripts":
+		return CategoryTranscripts
+	case first == "cache":
+		return CategoryCache
+	case first == "lang" || strings.HasSuffix(relPath, ".ini"):
+		return CategoryConfig
+	case relPath == "history.txt":
+		return CategoryHistory
+	}
+	return CategoryOther
+}
+
+// Usage returns the disk consumption of the local state per category
+func Usage() ([]CategoryUsage, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[string]*CategoryUsage, len(categories))
+	for _, category := range categories {
+		usage[category] = &CategoryUsage{Category: category}
+	}
+
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == dir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil // Removed in the meantime
+		}
+		rel, _ := filepath.Rel(dir, path)
+		category := usage[stateCategory(rel)]
+		category.Files++
+		category.Bytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]CategoryUsage, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, *usage[category])
+	}
+	return result, nil
+}
+
+// Maintain applies the retention settings to the local state; errors
+// with single files are logged and do not stop the maintenance
+func Maintain(retention config.RetentionConfig, logger func(format string, v ...interface{})) MaintenanceResult {
+	var result MaintenanceResult
+	dir, err := Dir()
+	if err != nil {
+		return result
+	}
+	now := time.Now()
+
+	// Transcripts: compress, then delete
+	transcriptDir := filepath.Join(dir, "transcripts")
+	for _, file := range listFiles(transcriptDir) {
+		age := now.Sub(file.modified)
+		switch {
+		case retention.DeleteTranscriptsDays > 0 && age > days(retention.DeleteTranscriptsDays):
+			removeStateFile(file, &result, logger)
+		case retention.CompressTranscriptsDays > 0 && age > days(retention.CompressTranscriptsDays) &&
+			strings.HasSuffix(file.path, ".jsonl"):
+			if saved, err := compressFile(file.path); err != nil {
+				logger("Error compressing transcript %s: %v", file.path, err)
+			} else {
+				result.Compressed++
+				result.Freed += saved
+			}
+		}
+	}
+
+	// Quarantined lines
+	if retention.QuarantineDays > 0 {
+		for _, file := range listFiles(dir) {
+			if strings.HasSuffix(file.path, QuarantineSuffix) && now.Sub(file.modified) > days(retention.QuarantineDays) {
+				removeStateFile(file, &result, logger)
+			}
+		}
+	}
+
+	// Cache: delete the oldest files until the cap is met
+	if retention.MaxCacheMB > 0 {
+		if cacheDir, err := CacheDir(); err == nil {
+			files := listFiles(cacheDir)
+			sort.Slice(files, func(i, j int) bool { return files[i].modified.Before(files[j].modified) })
+			var total int64
+			for _, file := range files {
+				total += file.size
+			}
+			limit := int64(retention.MaxCacheMB) * 1024 * 1024
+			for _, file := range files {
+				if total <= limit {
+					break
+				}
+				total -= file.size
+				removeStateFile(file, &result, logger)
+			}
+		}
+	}
+
+	if result.Compressed > 0 || result.Deleted > 0 {
+		logger("State maintenance: %d transcripts compressed, %d files deleted, %d bytes freed",
+			result.Compressed, result.Deleted, result.Freed)
+	}
+	return result
+}
+
+// stateFile is a file considered by the maintenance
+type stateFile struct {
+	path     string
+	size     int64
+	modified time.Time
+}
+
+// listFiles returns the regular files of a directory, without subdirectories
+func listFiles(dir string) []stateFile {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var files []stateFile
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, stateFile{
+			path:     filepath.Join(dir, entry.Name()),
+			size:     info.Size(),
+			modified: info.ModTime(),
+		})
+	}
+	return files
+}
+
+// removeStateFile deletes a file and counts it
+func removeStateFile(file stateFile, result *MaintenanceResult, logger func(format string, v ...interface{})) {
+	if err := os.Remove(file.path); err != nil {
+		logger("Error deleting %s: %v", file.path, err)
+		return
+	}
+	result.Deleted++
+	result.Freed += file.size
+}
+
+// compressFile replaces a file with a gzip-compressed copy that keeps its
+// modification time and returns the bytes saved
+func compressFile(path string) (int64, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	// Write to a temporary file first, so an interrupted run leaves the original intact
+	tmpPath := path + CompressedSuffix + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+	zw := gzip.NewWriter(dst)
+	zw.Name = filepath.Base(path)
+	zw.ModTime = info.ModTime()
+	_, err = io.Copy(zw, src)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return 0, err
+	}
+
+	compressedPath := path + CompressedSuffix
+	if err := os.Rename(tmpPath, compressedPath); err != nil {
+		os.Remove(tmpPath)
+		return 0, err
+	}
+	os.Chtimes(compressedPath, info.ModTime(), info.ModTime())
+	src.Close()
+	if err := os.Remove(path); err != nil {
+		return 0, err
+	}
+
+	compressed, err := os.Stat(compressedPath)
+	if err != nil {
+		return 0, nil
+	}
+	return info.Size() - compressed.Size(), nil
+}
+
+// days converts a number of days into a duration
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
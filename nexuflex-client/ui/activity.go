@@ -0,0 +1,108 @@
+// activity.go
+/**
+ * Nexuflex Client - Activity Indicator
+ *
+ * This file contains the spinner in the status bar shown while commands
+ * or streams are waiting for the server, and the submission of commands
+ * beyond the `max_in_flight` limit, which are queued with a note on how
+ * many commands are ahead of them instead of blocking the command line.
+ * Commands are sent from a goroutine of their own; only their output and
+ * result are processed on the UI goroutine, so the spinner keeps turning
+ * while the server works.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// spinnerInterval is the time between two frames of the spinner
+const spinnerInterval = 120 * time.Millisecond
+
+// spinnerFrames are the frames of the activity spinner
+var spinnerFrames = []rune("⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏")
+
+// startActivityIndicator animates the spinner while commands are in flight
+func (t *TUI) startActivityIndicator() {
+	t.client.SetActivityCallback(func() {
+		go t.app.QueueUpdateDraw(t.renderStatus)
+	})
+
+	go func() {
+		ticker := time.NewTicker(spinnerInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			// The spinner waits while the user is typing
+			if t.client.CommandsInFlight() == 0 || t.isTyping() {
+				continue
+			}
+			t.app.QueueUpdateDraw(func() {
+				t.spinnerFrame = (t.spinnerFrame + 1) % len(spinnerFrames)
+				t.renderStatus()
+			})
+		}
+	}()
+}
+
+// activitySegment returns the status bar segment with the spinner and the
+// queued commands, empty while nothing is in flight
+func (t *TUI) activitySegment() string {
+	inFlight, queued := t.client.CommandsInFlight(), t.client.QueuedCommands()
+	if inFlight == 0 && queued == 0 {
+		return ""
+	}
+
+	text := fmt.Sprintf("[yellow]%c[white] %d", spinnerFrames[t.spinnerFrame], inFlight)
+	if queued > 0 {
+		text += " " + fmt.Sprintf(i18n.GetMessage("status.queued"), queued)
+	}
+	return text
+}
+
+// executeCommand sends a command entered by the user, or queues it while
+// the limit of commands in flight is reached; the output and the result are
+// processed once the server has answered
+func (t *TUI) executeCommand(command string) {
+	// Local files referenced with @file: are uploaded first
+	if client.HasFileParameters(command) {
+		t.stageFilesAndExecute(command)
+		return
+	}
+
+	service := t.commandService(command)
+	seq := t.blockCounter
+	queued, dequeued := false, false
+	post := func(process func()) {
+		t.app.QueueUpdateDraw(func() {
+			if queued && !dequeued {
+				dequeued = true
+				t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+					fmt.Sprintf(i18n.GetMessage("commands.dequeued"), tview.Escape(command)))))
+			}
+			t.outputService = service
+			process()
+			t.outputService = ""
+		})
+	}
+
+	var ahead int
+	ahead, queued = t.client.SendCommandAsync(command, post, func(sent time.Time, err error) {
+		t.annotateResult(seq, command, service, sent, err)
+		t.handleCommandError(err)
+		t.announceNextPage()
+		t.advanceTutorial()
+	})
+	if queued {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.queued"), ahead))
+	}
+}
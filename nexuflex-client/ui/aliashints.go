@@ -0,0 +1,143 @@
+// aliashints.go
+/**
+ * Nexuflex Client - Alias Hints in Completion
+ *
+ * This file contains the display of what a local or server alias expands
+ * to, including the parameters a server alias still expects, and the
+ * inline expansion of an alias in the input field (Ctrl+Space).
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// aliasHint describes the expansion of an alias
+type aliasHint struct {
+	Expansion   string
+	Parameters  string
+	Description string
+}
+
+// lookupAlias returns the expansion of a local or server alias
+func (t *TUI) lookupAlias(word string) (aliasHint, bool) {
+	// Local aliases take precedence, as they are expanded before sending;
+	// chains of aliases show the full expansion and the aliases on the way
+	if _, ok := t.aliasManager.GetAlias(word); ok {
+		expansion, chain, err := t.aliasManager.ExpandAliases(word)
+		hint := aliasHint{Expansion: expansion}
+		switch {
+		case err != nil:
+			hint.Description = err.Error()
+		case len(chain) > 1:
+			hint.Description = strings.Join(chain, " → ")
+		}
+		return hint, true
+	}
+
+	return t.lookupServerAlias(word)
+}
+
+// lookupServerAlias returns the expansion and parameters of a server alias
+func (t *TUI) lookupServerAlias(word string) (aliasHint, bool) {
+	alias, ok := t.client.LookupServerAlias(word)
+	if !ok {
+		return aliasHint{}, false
+	}
+
+	return aliasHint{
+		Expansion:   alias.ExpandedCommand,
+		Parameters:  client.FormatParameterHints(alias.Parameters),
+		Description: alias.Description,
+	}, true
+}
+
+// formatAliasHint formats an alias as "name → expansion <params>"
+func formatAliasHint(name string, hint aliasHint) string {
+	text := fmt.Sprintf("[yellow]%s[white] → %s", name, tview.Escape(hint.Expansion))
+	if hint.Parameters != "" {
+		text += " [gray]" + tview.Escape(hint.Parameters) + "[white]"
+	}
+	if hint.Description != "" {
+		text += " [gray]- " + tview.Escape(hint.Description) + "[white]"
+	}
+	return text
+}
+
+// showAliasHint shows the expansion of the alias at the start of the input;
+// returns false if the first word is not an alias or is still being typed
+func (t *TUI) showAliasHint(text string) bool {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return false
+	}
+
+	// Only complete words (followed by a space or arguments) are treated as alias
+	if len(fields) == 1 && !strings.HasSuffix(text, " ") {
+		if _, ok := t.lookupAlias(fields[0]); !ok || t.hasLongerAlias(fields[0]) {
+			return false
+		}
+	}
+
+	hint, ok := t.lookupAlias(fields[0])
+	if !ok {
+		return false
+	}
+
+	t.output.Write([]byte(formatAliasHint(fields[0], hint) + "\n"))
+	return true
+}
+
+// hasLongerAlias returns whether other server aliases start with the given prefix
+func (t *TUI) hasLongerAlias(prefix string) bool {
+	for _, name := range t.client.ServerAliasNames() {
+		if name != prefix && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// annotateSuggestion appends the expansion to suggestions that are aliases
+func (t *TUI) annotateSuggestion(suggestion string) string {
+	if hint, ok := t.lookupAlias(strings.TrimSpace(suggestion)); ok {
+		return formatAliasHint(strings.TrimSpace(suggestion), hint)
+	}
+	return tview.Escape(suggestion)
+}
+
+// expandAliasInline replaces the alias at the start of the input with its expansion
+func (t *TUI) expandAliasInline() {
+	text := t.input.GetText()
+	trimmed := strings.TrimLeft(text, " ")
+	parts := strings.SplitN(trimmed, " ", 2)
+	if parts[0] == "" {
+		return
+	}
+
+	hint, ok := t.lookupAlias(parts[0])
+	if !ok {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.no_alias"), parts[0]))
+		return
+	}
+
+	expanded := hint.Expansion + " "
+	if len(parts) > 1 {
+		expanded += strings.TrimLeft(parts[1], " ")
+	}
+	t.input.SetText(expanded)
+
+	if hint.Parameters != "" {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.alias_parameters"), hint.Parameters))
+	}
+}
@@ -0,0 +1,87 @@
+// annotations.go
+/**
+ * Nexuflex Client - Output Annotations
+ *
+ * This file contains the annotation of finished commands. The output of a
+ * command is passed through the configured annotation rules and the
+ * annotators of extensions; their annotations are shown as a badge line
+ * under the output, with the severity, the text, the tags and the link as
+ * an actionable reference, and recorded in the timeline, where
+ * "timeline annotation #<tag>" lists them by tag or severity.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	"github.com/rivo/tview"
+)
+
+// annotationBadges are the badge colors of the severities, "[fg:bg]"
+var annotationBadges = map[string]string{
+	plugin.SeverityInfo:    "[black:aqua]",
+	plugin.SeverityWarning: "[black:yellow]",
+	plugin.SeverityError:   "[white:red]",
+}
+
+// initAnnotations compiles the configured annotation rules
+func (t *TUI) initAnnotations() {
+	rules, err := client.ParseAnnotationRules(t.client.GetConfig().Annotations)
+	t.annotationRules = rules
+	if err != nil {
+		t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.annotation_rules"), err))))
+	}
+}
+
+// annotateResult annotates the result of a finished command in block seq
+func (t *TUI) annotateResult(seq int64, command, service string, started time.Time, err error) {
+	result := plugin.Result{
+		Command:  command,
+		Service:  service,
+		Output:   t.lastResult.String(),
+		Duration: time.Since(started),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	t.checkThresholds(seq, result)
+	if result.Output == "" && result.Error == "" {
+		return
+	}
+
+	annotations := client.MatchAnnotations(t.annotationRules, result.Output+result.Error)
+	annotations = append(annotations, plugin.Annotate(result)...)
+	for _, annotation := range annotations {
+		t.output.Write([]byte(t.formatAnnotation(annotation) + "\n"))
+		t.client.RecordAnnotation(command, annotation)
+	}
+}
+
+// formatAnnotation renders the badge line of an annotation
+func (t *TUI) formatAnnotation(annotation plugin.Annotation) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("  %s %s [-:-] %s", annotationBadges[annotation.Severity],
+		strings.ToUpper(annotation.Severity), tview.Escape(annotation.Text)))
+	if len(annotation.Tags) > 0 {
+		sb.WriteString(" [gray]#" + tview.Escape(strings.Join(annotation.Tags, " #")) + "[-]")
+	}
+
+	// The link is an actionable reference like the configured ones
+	if annotation.Link != "" {
+		ref := client.Reference{Text: annotation.Link, Target: annotation.Link}
+		id := t.registerReference(ref)
+		sb.WriteString(fmt.Sprintf(` ["%s"][aqua::u]%s[-::-][""]`, id, tview.Escape(annotation.Link)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,140 @@
+// approvals.go
+/**
+ * Nexuflex Client - Approval Commands
+ *
+ * This file contains the client commands of the four-eyes approval
+ * workflow ("approvals", "approve", "reject"), the notice that an own
+ * command waits for approval and the display of the decisions on them.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// handleApprovalPending shows that an own command waits for the approval of a second user
+func (t *TUI) handleApprovalPending(pending *client.ApprovalPendingError) {
+	t.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.approval_pending"), tview.Escape(pending.ApprovalID)))))
+
+	// Update the number of pending approvals in the status bar
+	t.updateStatus("", t.lastStatusInfo)
+}
+
+// handleApprovalDecided shows the decision on an own command; called from the polling goroutine
+func (t *TUI) handleApprovalDecided(pending *client.PendingApproval, approval *proto.ApprovalInfo) {
+	t.app.QueueUpdateDraw(func() {
+		switch approval.Decision {
+		case proto.ApprovalInfo_APPROVED:
+			t.output.Write([]byte(fmt.Sprintf("[green]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.approval_approved"), pending.Command, approval.DecidedBy))))
+			if approval.Output != "" {
+				t.handleOutput(approval.Output)
+			}
+		case proto.ApprovalInfo_REJECTED:
+			t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.approval_rejected"), pending.Command, approval.DecidedBy, approval.Comment))))
+		default:
+			t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.approval_expired"), pending.Command))))
+		}
+
+		// Update the number of pending approvals in the status bar
+		t.updateStatus("", t.lastStatusInfo)
+	})
+}
+
+// handleApprovalsCommand processes the "approvals [mine]" client command
+func (t *TUI) handleApprovalsCommand(args string) {
+	own := strings.EqualFold(strings.TrimSpace(args), "mine")
+
+	approvals, err := t.client.ListApprovals(own)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	var sb strings.Builder
+	if own {
+		sb.WriteString(i18n.GetMessage("commands.approvals_own") + "\n")
+	} else {
+		sb.WriteString(i18n.GetMessage("commands.approvals_waiting") + "\n")
+	}
+
+	if len(approvals) == 0 {
+		sb.WriteString("  " + i18n.GetMessage("commands.approvals_none") + "\n")
+	}
+	for _, approval := range approvals {
+		requested := time.Unix(approval.RequestedAt, 0).Format("2006-01-02 15:04")
+		sb.WriteString(fmt.Sprintf("  [yellow]%s[white] %s [gray]%s, %s[white] %s\n",
+			approval.ApprovalId, formatDecision(approval.Decision), approval.RequestedBy, requested,
+			tview.Escape(approval.CommandLine)))
+	}
+
+	t.output.Write([]byte(sb.String()))
+}
+
+// handleApproveCommand processes the "approve <id> [comment]" and "reject <id> [comment]" client commands
+func (t *TUI) handleApproveCommand(args string, approve bool) {
+	parts := strings.SplitN(strings.TrimSpace(args), " ", 2)
+	if parts[0] == "" {
+		if approve {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "approve <id> [comment]"))
+		} else {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "reject <id> [comment]"))
+		}
+		return
+	}
+
+	approvalID := parts[0]
+	comment := ""
+	if len(parts) > 1 {
+		comment = strings.TrimSpace(parts[1])
+	}
+
+	// Show the command to be decided on before sending the decision
+	approval, err := t.client.GetApprovalStatus(approvalID)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	question := "commands.confirm_reject"
+	if approve {
+		question = "commands.confirm_approve"
+	}
+
+	t.showConfirmation(fmt.Sprintf(i18n.GetMessage(question), approval.CommandLine, approval.RequestedBy), func() {
+		if err := t.client.Approve(approvalID, approve, comment); err != nil {
+			t.ShowError(err.Error())
+			return
+		}
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.approval_decided"), approvalID))
+	})
+}
+
+// formatDecision returns the colored name of an approval decision
+func formatDecision(decision proto.ApprovalInfo_Decision) string {
+	switch decision {
+	case proto.ApprovalInfo_APPROVED:
+		return "[green]" + i18n.GetMessage("commands.decision_approved") + "[white]"
+	case proto.ApprovalInfo_REJECTED:
+		return "[red]" + i18n.GetMessage("commands.decision_rejected") + "[white]"
+	case proto.ApprovalInfo_EXPIRED:
+		return "[gray]" + i18n.GetMessage("commands.decision_expired") + "[white]"
+	default:
+		return "[yellow]" + i18n.GetMessage("commands.decision_pending") + "[white]"
+	}
+}
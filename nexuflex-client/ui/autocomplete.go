@@ -1,236 +1,370 @@
-// autocomplete.go
-/**
-* Nexuflex Client - Auto-completion Implementation
-*
-* This file contains the implementation of command completion
-* for the user interface.
-*
-* @author msto63
-* @version 1.0.0
-* @date 2025-03-12
- */
-
-package ui
-
-import (
-	"fmt"
-	"strings"
-
-	"github.com/rivo/tview"
-)
-
-// AutoCompleter provides functions for command completion
-type AutoCompleter struct {
-	output            *tview.TextView
-	localCommands     map[string]bool
-	fallbackHandler   func(text string) ([]string, string, error)
-	cachedSuggestions map[string][]string
-}
-
-// NewAutoCompleter creates a new AutoCompleter
-func NewAutoCompleter(output *tview.TextView, fallbackHandler func(text string) ([]string, string, error)) *AutoCompleter {
-	// Register standard commands
-	localCommands := map[string]bool{
-		"help":       true,
-		"?":          true,
-		"exit":       true,
-		"quit":       true,
-		"clear":      true,
-		"cls":        true,
-		"connect":    true,
-		"disconnect": true,
-		"login":      true,
-		"logout":     true,
-		"alias":      true,
-		"unalias":    true,
-		"history":    true,
-		"use":        true,
-	}
-
-	return &AutoCompleter{
-		output:            output,
-		localCommands:     localCommands,
-		fallbackHandler:   fallbackHandler,
-		cachedSuggestions: make(map[string][]string),
-	}
-}
-
-// Complete attempts to complete the entered text
-func (ac *AutoCompleter) Complete(text string) ([]string, string) {
-	// Trim whitespace at beginning and end
-	text = strings.TrimSpace(text)
-
-	// Handle empty text
-	if text == "" {
-		// Return local commands and server context
-		suggestions := make([]string, 0, len(ac.localCommands))
-		for cmd := range ac.localCommands {
-			suggestions = append(suggestions, cmd)
-		}
-
-		// Ask server for completions
-		if ac.fallbackHandler != nil {
-			serverSuggestions, _, _ := ac.fallbackHandler(text)
-			suggestions = append(suggestions, serverSuggestions...)
-		}
-
-		return suggestions, ""
-	}
-
-	// Complete local commands
-	if !strings.Contains(text, ".") {
-		// Look for possible local commands
-		localSuggestions := make([]string, 0)
-		for cmd := range ac.localCommands {
-			if strings.HasPrefix(cmd, text) {
-				localSuggestions = append(localSuggestions, cmd)
-			}
-		}
-
-		// If local completions found
-		if len(localSuggestions) > 0 {
-			return localSuggestions, findCommonPrefix(localSuggestions)
-		}
-	}
-
-	// Try server-side completion
-	if ac.fallbackHandler != nil {
-		// First check cache
-		if suggestions, ok := ac.cachedSuggestions[text]; ok {
-			return suggestions, findCommonPrefix(suggestions)
-		}
-
-		// Ask server
-		suggestions, commonPrefix, err := ac.fallbackHandler(text)
-		if err == nil && len(suggestions) > 0 {
-			// Store in cache
-			ac.cachedSuggestions[text] = suggestions
-			return suggestions, commonPrefix
-		}
-	}
-
-	return []string{}, ""
-}
-
-// ShowSuggestions displays completion suggestions in the output area
-func (ac *AutoCompleter) ShowSuggestions(suggestions []string) {
-	if len(suggestions) == 0 {
-		return
-	}
-
-	// Prepare text output
-	var sb strings.Builder
-	sb.WriteString("[blue]Possible completions:[white]\n")
-
-	// Group suggestions
-	groups := groupSuggestions(suggestions)
-
-	// Output groups
-	for group, items := range groups {
-		if group != "" {
-			sb.WriteString(fmt.Sprintf("[yellow]%s:[white]\n", group))
-		}
-
-		// Format entries in columns
-		columns := formatInColumns(items, 4, 20)
-		sb.WriteString(columns)
-		sb.WriteString("\n")
-	}
-
-	// Output
-	if ac.output != nil {
-		ac.output.Write([]byte(sb.String()))
-	}
-}
-
-// InvalidateCache clears the suggestions cache
-func (ac *AutoCompleter) InvalidateCache() {
-	ac.cachedSuggestions = make(map[string][]string)
-}
-
-// AddLocalCommand adds a local command to completion
-func (ac *AutoCompleter) AddLocalCommand(command string) {
-	ac.localCommands[command] = true
-}
-
-// RemoveLocalCommand removes a local command from completion
-func (ac *AutoCompleter) RemoveLocalCommand(command string) {
-	delete(ac.localCommands, command)
-}
-
-// Helper functions
-
-// findCommonPrefix finds the common prefix in a list of strings
-func findCommonPrefix(strings []string) string {
-	if len(strings) == 0 {
-		return ""
-	}
-	if len(strings) == 1 {
-		return strings[0]
-	}
-
-	prefix := strings[0]
-	for i := 1; i < len(strings); i++ {
-		j := 0
-		for j < len(prefix) && j < len(strings[i]) && prefix[j] == strings[i][j] {
-			j++
-		}
-		prefix = prefix[:j]
-	}
-
-	return prefix
-}
-
-// groupSuggestions groups suggestions by service
-func groupSuggestions(suggestions []string) map[string][]string {
-	groups := make(map[string][]string)
-
-	for _, suggestion := range suggestions {
-		var group, item string
-
-		if strings.Contains(suggestion, ".") {
-			parts := strings.SplitN(suggestion, ".", 2)
-			group = parts[0]
-			item = suggestion
-		} else {
-			group = ""
-			item = suggestion
-		}
-
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]string, 0)
-		}
-
-		groups[group] = append(groups[group], item)
-	}
-
-	return groups
-}
-
-// formatInColumns formats a list of strings in columns
-func formatInColumns(items []string, numColumns, columnWidth int) string {
-	if len(items) == 0 {
-		return ""
-	}
-
-	var sb strings.Builder
-	for i, item := range items {
-		// Start new line
-		if i%numColumns == 0 && i > 0 {
-			sb.WriteString("\n")
-		}
-
-		// Format item and add to line
-		format := fmt.Sprintf("%%-%ds", columnWidth)
-		formattedItem := fmt.Sprintf(format, item)
-
-		// Limit to maximum length
-		if len(formattedItem) > columnWidth {
-			formattedItem = formattedItem[:columnWidth-3] + "..."
-		}
-
-		sb.WriteString(formattedItem)
-	}
-
-	return sb.String()
-}
+// autocomplete.go
+/**
+* Nexuflex Client - Auto-completion Implementation
+*
+* This file contains the implementation of command completion
+* for the user interface. Answers of the server are cached with a size
+* limit and a time to live, and dropped when the scope they were
+* given in changes; the Tab key completes through this cache.
+*
+* @author msto63
+* @version 1.0.0
+* @date 2025-03-12
+ */
+
+package ui
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// Default limits of the cache of server suggestions, used when the
+// configuration sets none
+const (
+	DefaultCompletionCacheSize = 256
+	DefaultCompletionCacheTTL  = 5 * time.Minute
+)
+
+// AutoCompleter provides functions for command completion
+type AutoCompleter struct {
+	output            *tview.TextView
+	localCommands     map[string]bool
+	fallbackHandler   func(text string) ([]string, string, error)
+	cachedSuggestions *suggestionCache
+}
+
+// suggestionCache keeps server suggestions per input, the most recently used
+// first; entries expire after the TTL, and all entries are dropped when the
+// scope changes, e.g. after a context switch, a login or new server metadata
+type suggestionCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	scope   func() string
+	current string
+	order   *list.List // Of *suggestionEntry, the most recently used at the front
+	entries map[string]*list.Element
+}
+
+// suggestionEntry is a cached answer of the server
+type suggestionEntry struct {
+	text         string
+	suggestions  []string
+	commonPrefix string
+	expires      time.Time
+}
+
+// newSuggestionCache creates an empty cache with the given limits
+func newSuggestionCache(size int, ttl time.Duration) *suggestionCache {
+	return &suggestionCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// checkScope drops all entries if the scope changed; the caller holds the lock
+func (c *suggestionCache) checkScope() {
+	if c.scope == nil {
+		return
+	}
+	if scope := c.scope(); scope != c.current {
+		c.current = scope
+		c.order.Init()
+		c.entries = make(map[string]*list.Element)
+	}
+}
+
+// get returns the cached suggestions and their common prefix for an input
+// unless they expired
+func (c *suggestionCache) get(text string) ([]string, string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checkScope()
+	element, ok := c.entries[text]
+	if !ok {
+		return nil, "", false
+	}
+	entry := element.Value.(*suggestionEntry)
+	if time.Now().After(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, text)
+		return nil, "", false
+	}
+	c.order.MoveToFront(element)
+	return append([]string(nil), entry.suggestions...), entry.commonPrefix, true
+}
+
+// put caches suggestions, evicting the least recently used entries beyond the size
+func (c *suggestionCache) put(text string, suggestions []string, commonPrefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+	c.checkScope()
+	entry := &suggestionEntry{text: text, suggestions: suggestions, commonPrefix: commonPrefix, expires: time.Now().Add(c.ttl)}
+	if element, ok := c.entries[text]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[text] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*suggestionEntry).text)
+	}
+}
+
+// clear drops all entries
+func (c *suggestionCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+// NewAutoCompleter creates a new AutoCompleter
+func NewAutoCompleter(output *tview.TextView, fallbackHandler func(text string) ([]string, string, error)) *AutoCompleter {
+	// Register standard commands
+	localCommands := map[string]bool{
+		"help":       true,
+		"?":          true,
+		"exit":       true,
+		"quit":       true,
+		"clear":      true,
+		"cls":        true,
+		"connect":    true,
+		"disconnect": true,
+		"login":      true,
+		"logout":     true,
+		"alias":      true,
+		"unalias":    true,
+		"history":    true,
+		"use":        true,
+	}
+
+	return &AutoCompleter{
+		output:            output,
+		localCommands:     localCommands,
+		fallbackHandler:   fallbackHandler,
+		cachedSuggestions: newSuggestionCache(DefaultCompletionCacheSize, DefaultCompletionCacheTTL),
+	}
+}
+
+// SetCacheLimits sets the maximum number of cached server answers and how
+// long each stays valid; a size of 0 disables the cache
+func (ac *AutoCompleter) SetCacheLimits(size int, ttl time.Duration) {
+	ac.cachedSuggestions.mu.Lock()
+	defer ac.cachedSuggestions.mu.Unlock()
+	ac.cachedSuggestions.size = size
+	ac.cachedSuggestions.ttl = ttl
+	for ac.cachedSuggestions.order.Len() > max(size, 0) {
+		oldest := ac.cachedSuggestions.order.Back()
+		ac.cachedSuggestions.order.Remove(oldest)
+		delete(ac.cachedSuggestions.entries, oldest.Value.(*suggestionEntry).text)
+	}
+}
+
+// SetCacheScope sets the function describing what the server answers depend
+// on, e.g. client.Client.CompletionScope; when its value changes, the cache is
+// invalidated before it is used again
+func (ac *AutoCompleter) SetCacheScope(scope func() string) {
+	ac.cachedSuggestions.mu.Lock()
+	defer ac.cachedSuggestions.mu.Unlock()
+	ac.cachedSuggestions.scope = scope
+	if scope != nil {
+		ac.cachedSuggestions.current = scope()
+	}
+}
+
+// Complete attempts to complete the entered text
+func (ac *AutoCompleter) Complete(text string) ([]string, string) {
+	// Trim whitespace at beginning and end
+	text = strings.TrimSpace(text)
+
+	// Handle empty text
+	if text == "" {
+		// Return local commands and server context
+		suggestions := make([]string, 0, len(ac.localCommands))
+		for cmd := range ac.localCommands {
+			suggestions = append(suggestions, cmd)
+		}
+		i18n.SortStrings(suggestions)
+
+		// Ask server for completions
+		if ac.fallbackHandler != nil {
+			serverSuggestions, _, _ := ac.fallbackHandler(text)
+			suggestions = append(suggestions, serverSuggestions...)
+		}
+
+		return suggestions, ""
+	}
+
+	// Complete local commands
+	if !strings.Contains(text, ".") {
+		// Look for possible local commands
+		localSuggestions := make([]string, 0)
+		for cmd := range ac.localCommands {
+			if strings.HasPrefix(cmd, text) {
+				localSuggestions = append(localSuggestions, cmd)
+			}
+		}
+
+		// If local completions found
+		if len(localSuggestions) > 0 {
+			i18n.SortStrings(localSuggestions)
+			return localSuggestions, client.CommonPrefix(localSuggestions)
+		}
+	}
+
+	// Try server-side completion
+	if suggestions, commonPrefix, err := ac.CompleteFromServer(text); err == nil && len(suggestions) > 0 {
+		return suggestions, commonPrefix
+	}
+
+	return []string{}, ""
+}
+
+// CompleteFromServer completes the text with the fallback handler, answering
+// from the cache while a previous answer for the same text is valid; only
+// answers with suggestions are cached
+func (ac *AutoCompleter) CompleteFromServer(text string) ([]string, string, error) {
+	if ac.fallbackHandler == nil {
+		return nil, "", nil
+	}
+
+	// First check cache
+	if suggestions, commonPrefix, ok := ac.cachedSuggestions.get(text); ok {
+		return suggestions, commonPrefix, nil
+	}
+
+	// Ask server
+	suggestions, commonPrefix, err := ac.fallbackHandler(text)
+	if err == nil && len(suggestions) > 0 {
+		// Store in cache
+		ac.cachedSuggestions.put(text, suggestions, commonPrefix)
+	}
+	return suggestions, commonPrefix, err
+}
+
+// ShowSuggestions displays completion suggestions in the output area
+func (ac *AutoCompleter) ShowSuggestions(suggestions []string) {
+	if len(suggestions) == 0 {
+		return
+	}
+
+	// Prepare text output
+	var sb strings.Builder
+	sb.WriteString("[blue]Possible completions:[white]\n")
+
+	// Group suggestions
+	groups := groupSuggestions(suggestions)
+
+	// Output groups in the order of the user's language
+	names := make([]string, 0, len(groups))
+	for group := range groups {
+		names = append(names, group)
+	}
+	i18n.SortStrings(names)
+	for _, group := range names {
+		items := groups[group]
+		i18n.SortStrings(items)
+		if group != "" {
+			sb.WriteString(fmt.Sprintf("[yellow]%s:[white]\n", group))
+		}
+
+		// Format entries in columns
+		columns := formatInColumns(items, 4, 20)
+		sb.WriteString(columns)
+		sb.WriteString("\n")
+	}
+
+	// Output
+	if ac.output != nil {
+		ac.output.Write([]byte(sb.String()))
+	}
+}
+
+// InvalidateCache clears the suggestions cache
+func (ac *AutoCompleter) InvalidateCache() {
+	ac.cachedSuggestions.clear()
+}
+
+// AddLocalCommand adds a local command to completion
+func (ac *AutoCompleter) AddLocalCommand(command string) {
+	ac.localCommands[command] = true
+}
+
+// RemoveLocalCommand removes a local command from completion
+func (ac *AutoCompleter) RemoveLocalCommand(command string) {
+	delete(ac.localCommands, command)
+}
+
+// Helper functions
+
+// groupSuggestions groups suggestions by service
+func groupSuggestions(suggestions []string) map[string][]string {
+	groups := make(map[string][]string)
+
+	for _, suggestion := range suggestions {
+		var group, item string
+
+		if strings.Contains(suggestion, ".") {
+			parts := strings.SplitN(suggestion, ".", 2)
+			group = parts[0]
+			item = suggestion
+		} else {
+			group = ""
+			item = suggestion
+		}
+
+		if _, ok := groups[group]; !ok {
+			groups[group] = make([]string, 0)
+		}
+
+		groups[group] = append(groups[group], item)
+	}
+
+	return groups
+}
+
+// formatInColumns formats a list of strings in columns
+func formatInColumns(items []string, numColumns, columnWidth int) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	for i, item := range items {
+		// Start new line
+		if i%numColumns == 0 && i > 0 {
+			sb.WriteString("\n")
+		}
+
+		// Format item and add to line
+		format := fmt.Sprintf("%%-%ds", columnWidth)
+		formattedItem := fmt.Sprintf(format, item)
+
+		// Limit to maximum length
+		if len(formattedItem) > columnWidth {
+			formattedItem = formattedItem[:columnWidth-3] + "..."
+		}
+
+		sb.WriteString(formattedItem)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,277 @@
+// blocks.go
+/**
+ * Nexuflex Client - Output Blocks
+ *
+ * This file contains the block model of the output area. Every command
+ * starts a block holding its echo and everything written until the next
+ * command; the block begins with an empty region tag carrying its
+ * sequence number, which is invisible and survives rewrites of the text
+ * such as expanded folds or saved workspaces. The blocks know their
+ * command, service and start time and can be pinned, so that "clear" can
+ * remove selected blocks only. Cleared blocks are soft-deleted: the
+ * blocks removed by the last "clear" can be restored.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// pinMark is shown in front of the echo of a pinned block
+const pinMark = "[aqua]⚑[-] "
+
+// blockMarker matches the invisible region tag at the start of a block
+var blockMarker = regexp.MustCompile(`\["blk-(\d+)"\]\[""\]`)
+
+// outputBlock is the output of a command in the output area
+type outputBlock struct {
+	command string
+	service string
+	started time.Time
+	pinned  bool
+}
+
+// blockSegment is the text of a block; the text in front of the first
+// block, e.g. the welcome message, has the sequence number 0
+type blockSegment struct {
+	seq  int64
+	text string
+}
+
+// blockOptions selects the blocks removed by "clear"; a block is removed
+// if it matches all options given
+type blockOptions struct {
+	keepPinned bool
+	olderThan  time.Duration
+	service    string
+	restore    bool
+}
+
+// startBlock begins the block of a command in the output area
+func (t *TUI) startBlock(command string) {
+	if t.blocks == nil {
+		t.blocks = make(map[int64]*outputBlock)
+	}
+	if len(t.blocks) > 0 {
+		t.output.Write([]byte(t.blockSeparator()))
+	}
+	// Sequence numbers continue across runs, so blocks of loaded workspaces sort before new ones
+	t.blockCounter = max(t.blockCounter+1, time.Now().UnixMilli())
+	t.blocks[t.blockCounter] = &outputBlock{
+		command: command,
+		service: t.commandService(command),
+		started: time.Now(),
+	}
+	t.output.Write([]byte(blockTag(t.blockCounter)))
+}
+
+// echoCommand starts the block of a command and writes its echo line
+func (t *TUI) echoCommand(command string) {
+	t.startBlock(command)
+	t.output.Write([]byte(fmt.Sprintf("%s> [yellow]%s[white]\n", t.timestamp(), command)))
+}
+
+// blockTag returns the invisible tag at the start of a block
+func blockTag(seq int64) string {
+	return fmt.Sprintf(`["blk-%d"][""]`, seq)
+}
+
+// splitBlocks splits the text of the output area into its blocks
+func splitBlocks(text string) []blockSegment {
+	var segments []blockSegment
+	locations := blockMarker.FindAllStringSubmatchIndex(text, -1)
+	if len(locations) == 0 || locations[0][0] > 0 {
+		end := len(text)
+		if len(locations) > 0 {
+			end = locations[0][0]
+		}
+		segments = append(segments, blockSegment{seq: 0, text: text[:end]})
+	}
+	for i, loc := range locations {
+		seq, _ := strconv.ParseInt(text[loc[2]:loc[3]], 10, 64)
+		end := len(text)
+		if i+1 < len(locations) {
+			end = locations[i+1][0]
+		}
+		segments = append(segments, blockSegment{seq: seq, text: text[loc[0]:end]})
+	}
+	return segments
+}
+
+// joinBlocks joins blocks into the text of the output area
+func joinBlocks(segments []blockSegment) string {
+	var sb strings.Builder
+	for _, segment := range segments {
+		sb.WriteString(segment.text)
+	}
+	return sb.String()
+}
+
+// parseClearOptions parses the options of the "clear" command
+func parseClearOptions(args string) (blockOptions, error) {
+	var options blockOptions
+	fields := strings.Fields(args)
+	for i := 0; i < len(fields); i++ {
+		switch strings.ToLower(fields[i]) {
+		case "--keep-pinned":
+			options.keepPinned = true
+		case "--restore":
+			options.restore = true
+		case "--older-than":
+			if i+1 >= len(fields) {
+				return options, fmt.Errorf("--older-than needs a duration")
+			}
+			i++
+			duration, err := time.ParseDuration(fields[i])
+			if err != nil || duration <= 0 {
+				return options, fmt.Errorf("invalid duration '%s'", fields[i])
+			}
+			options.olderThan = duration
+		case "--service":
+			if i+1 >= len(fields) {
+				return options, fmt.Errorf("--service needs a service name")
+			}
+			i++
+			options.service = fields[i]
+		default:
+			return options, fmt.Errorf("unknown option '%s'", fields[i])
+		}
+	}
+	return options, nil
+}
+
+// removesBlock checks whether "clear" with the options removes a block;
+// blocks without known metadata count as old and belong to no service
+func (t *TUI) removesBlock(segment blockSegment, options blockOptions) bool {
+	block := t.blocks[segment.seq]
+	if block == nil {
+		block = &outputBlock{}
+	}
+	if options.keepPinned && block.pinned {
+		return false
+	}
+	if options.olderThan > 0 && !block.started.IsZero() && time.Since(block.started) < options.olderThan {
+		return false
+	}
+	if options.service != "" && !strings.EqualFold(block.service, options.service) {
+		return false
+	}
+	return true
+}
+
+// handleClearCommand processes "clear [--keep-pinned] [--older-than <duration>]
+// [--service <name>] | clear --restore"
+func (t *TUI) handleClearCommand(args string) {
+	options, err := parseClearOptions(args)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"),
+			"clear [--keep-pinned] [--older-than <duration>] [--service <name>] | clear --restore"))
+		return
+	}
+
+	if options.restore {
+		t.restoreClearedBlocks()
+		return
+	}
+
+	segments := splitBlocks(t.output.GetText(false))
+	var kept, removed []blockSegment
+	for _, segment := range segments {
+		if t.removesBlock(segment, options) {
+			removed = append(removed, segment)
+		} else {
+			kept = append(kept, segment)
+		}
+	}
+	t.clearedBlocks = removed
+
+	// Line numbers of watch hits are no longer valid
+	t.forgetWatchHits(t.output)
+	if len(kept) == 0 {
+		t.output.SetText("")
+		t.clearReferences()
+		return
+	}
+	t.output.SetText(joinBlocks(kept))
+	t.output.ScrollToEnd()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.clear_partial"), len(removed), len(kept)))
+}
+
+// restoreClearedBlocks puts the blocks removed by the last "clear" back in their place
+func (t *TUI) restoreClearedBlocks() {
+	if len(t.clearedBlocks) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.clear_nothing_to_restore"))
+		return
+	}
+
+	segments := append(splitBlocks(t.output.GetText(false)), t.clearedBlocks...)
+	sort.SliceStable(segments, func(i, j int) bool { return segments[i].seq < segments[j].seq })
+	count := len(t.clearedBlocks)
+	t.clearedBlocks = nil
+
+	t.forgetWatchHits(t.output)
+	t.output.SetText(joinBlocks(segments))
+	t.output.ScrollToEnd()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.clear_restored"), count))
+}
+
+// handlePinCommand processes "pin [<n>]" and "unpin [<n>]": the block of
+// the n-th last command (default the last one before "pin") is pinned or unpinned
+func (t *TUI) handlePinCommand(args string, pin bool) {
+	n := 1
+	if args = strings.TrimSpace(args); args != "" {
+		value, err := strconv.Atoi(args)
+		if err != nil || value < 1 {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "pin|unpin [<n>]"))
+			return
+		}
+		n = value
+	}
+
+	// The block of the "pin" command itself does not count
+	text := t.output.GetText(false)
+	var present []int64
+	for _, segment := range splitBlocks(text) {
+		if block := t.blocks[segment.seq]; block != nil && segment.seq != t.blockCounter {
+			present = append(present, segment.seq)
+		}
+	}
+	if n > len(present) {
+		t.ShowError(i18n.GetMessage("commands.pin_no_block"))
+		return
+	}
+	seq := present[len(present)-n]
+	block := t.blocks[seq]
+
+	if block.pinned != pin {
+		block.pinned = pin
+		tag := blockTag(seq)
+		if pin {
+			text = strings.Replace(text, tag, tag+pinMark, 1)
+		} else {
+			text = strings.Replace(text, tag+pinMark, tag, 1)
+		}
+		row, col := t.output.GetScrollOffset()
+		t.output.SetText(text)
+		t.output.ScrollTo(row, col)
+	}
+
+	message := "commands.pinned"
+	if !pin {
+		message = "commands.unpinned"
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage(message), tview.Escape(block.command)))
+}
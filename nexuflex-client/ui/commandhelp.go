@@ -0,0 +1,103 @@
+// commandhelp.go
+/**
+ * Nexuflex Client - Server Command Help
+ *
+ * This file contains "help <command>", which shows the help of a server
+ * command (from the local cache when the server cannot be reached), and
+ * "help export <file>", which writes the reference of all services and
+ * commands as plain text or, for .md files, as Markdown.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// handleHelpCommand processes "help <command>" and "help export <file>"
+func (t *TUI) handleHelpCommand(args string) {
+	args = strings.TrimSpace(args)
+	if action, path, _ := strings.Cut(args, " "); strings.EqualFold(action, "export") {
+		t.exportHelp(strings.TrimSpace(path))
+		return
+	}
+
+	name := args
+	if resolved, _, err := t.client.ResolveCommand(name); err == nil {
+		name = resolved
+	}
+	command, cached, err := t.client.CommandHelp(name)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+	t.showCommandHelp(command, cached)
+}
+
+// showCommandHelp writes the help of a command to the output
+func (t *TUI) showCommandHelp(command *client.HelpCommand, cached bool) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[yellow]%s[white]\n", tview.Escape(command.Synopsis())))
+	if command.Description != "" {
+		sb.WriteString("  " + tview.Escape(command.Description) + "\n")
+	}
+	if command.HelpText != "" && command.HelpText != command.Description {
+		sb.WriteString(tview.Escape(command.HelpText) + "\n")
+	}
+
+	if len(command.Parameters) > 0 {
+		sb.WriteString(i18n.GetMessage("commands.help_parameters") + "\n")
+		for _, parameter := range command.Parameters {
+			line := fmt.Sprintf("  [blue]%s[white] [gray](%s)[white]", tview.Escape(parameter.Name), tview.Escape(parameter.Traits()))
+			if parameter.Description != "" {
+				line += "  " + tview.Escape(parameter.Description)
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	if command.Usage != "" {
+		sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.help_example"), tview.Escape(command.Usage)) + "\n")
+	}
+	if cached {
+		sb.WriteString("[gray]" + i18n.GetMessage("commands.help_cached") + "[white]\n")
+	}
+
+	t.output.Write([]byte(sb.String()))
+}
+
+// exportHelp writes the command reference in the background, as retrieving
+// the help of every command may take a while
+func (t *TUI) exportHelp(path string) {
+	if path == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "help export <file.txt|file.md>"))
+		return
+	}
+	t.ShowInfo(i18n.GetMessage("commands.help_exporting"))
+
+	go func() {
+		reference, cached, err := t.client.ExportHelp(path)
+		t.app.QueueUpdateDraw(func() {
+			if err != nil {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.help_export"), err))
+				return
+			}
+
+			message := fmt.Sprintf(i18n.GetMessage("success.help_exported"),
+				reference.CommandCount(), len(reference.Services), path)
+			if cached {
+				message += " " + fmt.Sprintf(i18n.GetMessage("commands.help_export_cached"),
+					reference.Server, reference.Updated.Format("2006-01-02 15:04"))
+			}
+			t.output.Write([]byte(tview.Escape(message) + "\n"))
+		})
+	}()
+}
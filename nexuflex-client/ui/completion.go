@@ -0,0 +1,101 @@
+// completion.go
+/**
+ * Nexuflex Client - Numbered Completion Candidates
+ *
+ * This file contains the numbered list of completion candidates shown when
+ * Tab finds several of them. A candidate is put into the input field by
+ * typing ":<n>" and Enter or by pressing Alt+<n> for the first nine, so a
+ * long prefix does not have to be typed again. Every way of showing the
+ * candidates goes through completionList, so the numbers are the same
+ * wherever they are shown.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// completionPickPattern matches the input ":<n>" that picks a numbered candidate
+var completionPickPattern = regexp.MustCompile(`^\s*:(\d+)\s*$`)
+
+// completionList holds the completion candidates that were shown last
+type completionList struct {
+	candidates []string
+}
+
+// set replaces the candidates
+func (l *completionList) set(candidates []string) {
+	l.candidates = append(l.candidates[:0], candidates...)
+}
+
+// clear forgets the candidates
+func (l *completionList) clear() {
+	l.candidates = nil
+}
+
+// get returns candidate n (1-based)
+func (l *completionList) get(n int) (string, bool) {
+	if n < 1 || n > len(l.candidates) {
+		return "", false
+	}
+	return l.candidates[n-1], true
+}
+
+// showCompletions writes the numbered completion candidates to the output
+func (t *TUI) showCompletions(suggestions []string) {
+	suggestions = slices.Clone(suggestions)
+	i18n.SortStrings(suggestions)
+	t.completions.set(suggestions)
+
+	t.output.Write([]byte(i18n.GetMessage("commands.completions_title") + "\n"))
+	for i, suggestion := range suggestions {
+		t.output.Write([]byte(fmt.Sprintf("  [gray]%2d[white]  %s\n", i+1, t.annotateSuggestion(suggestion)+t.deprecationNote(suggestion))))
+	}
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", i18n.GetMessage("commands.completions_pick"))))
+}
+
+// pickCompletion puts candidate n into the input field
+func (t *TUI) pickCompletion(n int) {
+	candidate, ok := t.completions.get(n)
+	if !ok {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.completion_number"), n))
+		return
+	}
+	t.input.SetText(candidate)
+}
+
+// handleCompletionInput picks a candidate if the entered line is ":<n>";
+// returns true if the line was consumed
+func (t *TUI) handleCompletionInput(line string) bool {
+	match := completionPickPattern.FindStringSubmatch(line)
+	if match == nil || len(t.completions.candidates) == 0 {
+		return false
+	}
+
+	n, _ := strconv.Atoi(match[1])
+	t.pickCompletion(n)
+	return true
+}
+
+// handleCompletionKeys picks one of the first nine candidates with Alt+<n>;
+// returns true if the key was consumed
+func (t *TUI) handleCompletionKeys(event *tcell.EventKey) bool {
+	if event.Key() != tcell.KeyRune || event.Modifiers()&tcell.ModAlt == 0 ||
+		event.Rune() < '1' || event.Rune() > '9' || len(t.completions.candidates) == 0 {
+		return false
+	}
+
+	t.pickCompletion(int(event.Rune() - '0'))
+	return true
+}
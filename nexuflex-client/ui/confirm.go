@@ -0,0 +1,60 @@
+// confirm.go
+/**
+ * Nexuflex Client - Confirmation Dialogs
+ *
+ * This file contains a modal yes/no confirmation dialog shared by the
+ * features that need an explicit user decision before acting.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// showConfirmation shows a modal dialog and calls onConfirm if the user agrees
+func (t *TUI) showConfirmation(message string, onConfirm func()) {
+	t.showChoice(message, onConfirm, nil)
+}
+
+// showConfirmationOrCancel shows a modal dialog and calls onConfirm if the
+// user agrees, onCancel if the user declines or closes the dialog
+func (t *TUI) showConfirmationOrCancel(message string, onConfirm, onCancel func()) {
+	t.showModalChoice(message, onConfirm, onCancel, onCancel)
+}
+
+// showChoice shows a modal yes/no dialog and calls onYes or onNo with the
+// answer; closing the dialog with Escape calls neither
+func (t *TUI) showChoice(message string, onYes, onNo func()) {
+	t.showModalChoice(message, onYes, onNo, nil)
+}
+
+// showModalChoice shows a modal yes/no dialog and calls onYes or onNo with
+// the answer, onClose when the dialog is closed with Escape
+func (t *TUI) showModalChoice(message string, onYes, onNo, onClose func()) {
+	yes := i18n.GetMessage("ui.yes_button")
+	no := i18n.GetMessage("ui.no_button")
+
+	modal := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{yes, no}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			t.pages.RemovePage("modal")
+			t.app.SetFocus(t.input)
+			switch {
+			case buttonLabel == yes && onYes != nil:
+				onYes()
+			case buttonLabel == no && onNo != nil:
+				onNo()
+			case buttonIndex < 0 && onClose != nil:
+				onClose()
+			}
+		})
+
+	t.pages.AddPage("modal", modal, true, true)
+}
@@ -0,0 +1,76 @@
+// conflicts.go
+/**
+ * Nexuflex Client - Concurrency Conflicts
+ *
+ * This file contains the handling of commands the server rejected because
+ * the entity they change was modified by someone else since it was
+ * fetched. The output area shows the fields that differ, with the value
+ * the user started from, the value of the command and the value on the
+ * server now; fields changed on both sides to different values are marked.
+ * A dialog then offers to overwrite the server version with the command,
+ * to fetch the entity again or to drop the command.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// conflictMarker marks fields changed both locally and on the server
+const conflictMarker = "✖"
+
+// handleConflict shows the differences of a conflict and asks how to go on
+func (t *TUI) handleConflict(conflict *client.ConflictError) {
+	t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n", tview.Escape(
+		fmt.Sprintf(i18n.GetMessage("error.conflict"), conflict.Entity)))))
+
+	if differences := conflict.Differences(); len(differences) > 0 {
+		records := [][]string{{"", i18n.GetMessage("ui.conflict_field"), i18n.GetMessage("ui.conflict_fetched"),
+			i18n.GetMessage("ui.conflict_local"), i18n.GetMessage("ui.conflict_server")}}
+		for _, difference := range differences {
+			marker := ""
+			if difference.Conflict {
+				marker = conflictMarker
+			}
+			records = append(records, []string{marker, difference.Field, difference.Fetched,
+				difference.Local, difference.Server})
+		}
+		t.output.Write([]byte(alignTable(recordsTable(records)) + "\n"))
+	}
+
+	overwrite := i18n.GetMessage("ui.conflict_overwrite_button")
+	reload := i18n.GetMessage("ui.conflict_reload_button")
+	cancel := i18n.GetMessage("ui.cancel_button")
+	buttons := []string{overwrite, reload, cancel}
+	if conflict.FetchCommand == "" {
+		buttons = []string{overwrite, cancel}
+	}
+
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf(i18n.GetMessage("ui.confirm_conflict"), conflict.Entity, conflict.Command)).
+		AddButtons(buttons).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			t.pages.RemovePage("modal")
+			t.app.SetFocus(t.input)
+			switch buttonLabel {
+			case overwrite:
+				// Sent against the current version, the command replaces it
+				t.client.AcceptCurrentVersion(conflict)
+				t.echoCommand(conflict.Command)
+				t.executeCommand(conflict.Command)
+			case reload:
+				t.echoCommand(conflict.FetchCommand)
+				t.executeCommand(conflict.FetchCommand)
+			}
+		})
+	t.pages.AddPage("modal", modal, true, true)
+}
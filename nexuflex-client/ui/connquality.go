@@ -0,0 +1,93 @@
+// connquality.go
+/**
+ * Nexuflex Client - Connection Quality Indicator
+ *
+ * This file contains the signal strength indicator of the connection in
+ * the status bar and the "connection" client command, which shows the
+ * measurements behind it: the round-trip times of the keep-alive
+ * heartbeats, their jitter, the missed heartbeats and the reconnects, and
+ * the estimated traffic.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// qualityBars are the bars of the signal strength indicator
+var qualityBars = []rune("▂▄▆█")
+
+// handleQualityChanged is called by the client when the connection quality changes
+func (t *TUI) handleQualityChanged() {
+	go t.app.QueueUpdateDraw(func() {
+		t.renderStatus()
+	})
+}
+
+// qualitySegment returns the signal strength indicator for the status bar,
+// "" before the first heartbeat
+func (t *TUI) qualitySegment() string {
+	quality := t.client.GetConnectionQuality()
+	if quality.Bars < 0 {
+		return ""
+	}
+
+	color := "green"
+	switch {
+	case quality.Bars <= 1:
+		color = "red"
+	case quality.Bars == 2:
+		color = "yellow"
+	}
+	return fmt.Sprintf("[%s]%s[gray]%s[white]", color,
+		string(qualityBars[:quality.Bars]), string(qualityBars[quality.Bars:]))
+}
+
+// handleConnectionCommand processes the "connection" client command
+func (t *TUI) handleConnectionCommand() {
+	serverInfo := t.client.GetServerInfo()
+	if serverInfo == nil || !t.client.IsConnected() {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+
+	quality := t.client.GetConnectionQuality()
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.connection_title"), tview.Escape(serverInfo.ShortName),
+		quality.Since.Format("15:04:05")) + "\n")
+
+	if quality.Bars < 0 {
+		sb.WriteString(i18n.GetMessage("commands.connection_no_heartbeat") + "\n")
+		t.output.Write([]byte(sb.String()))
+		return
+	}
+
+	sb.WriteString(fmt.Sprintf("  %s %s\n", t.qualitySegment(),
+		fmt.Sprintf(i18n.GetMessage("commands.connection_score"), quality.Score)))
+	sb.WriteString("  " + fmt.Sprintf(i18n.GetMessage("commands.connection_latency"),
+		roundMillis(quality.LastRTT), roundMillis(quality.AverageRTT), roundMillis(quality.Jitter)) + "\n")
+	sb.WriteString("  " + fmt.Sprintf(i18n.GetMessage("commands.connection_heartbeats"),
+		quality.Heartbeats, quality.Missed, quality.Loss*100) + "\n")
+	sb.WriteString("  " + fmt.Sprintf(i18n.GetMessage("commands.connection_reconnects"), quality.Reconnects) + "\n")
+	sb.WriteString("  " + t.trafficLine() + "\n")
+
+	t.output.Write([]byte(sb.String()))
+}
+
+// roundMillis rounds a round-trip time for display
+func roundMillis(d time.Duration) time.Duration {
+	if d < 10*time.Millisecond {
+		return d.Round(100 * time.Microsecond)
+	}
+	return d.Round(time.Millisecond)
+}
@@ -0,0 +1,84 @@
+// copy.go
+/**
+ * Nexuflex Client - Copying Output to the Clipboard
+ *
+ * This file contains the "copy" client command and the copy key for a
+ * selected reference. Terminal selections copy the rows as displayed, with
+ * a line break wherever a long line was wrapped; the client instead copies
+ * logical lines without color tags, so commands and IDs arrive in one
+ * piece. The text is put into the system clipboard with the terminal's
+ * clipboard sequence (OSC 52).
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// recordResult appends output to the result of the current command
+func (t *TUI) recordResult(output string) {
+	t.lastResult.WriteString(output)
+	t.lastResult.WriteString("\n")
+}
+
+// handleCopyCommand processes the "copy [n]" client command: without an argument
+// it copies the result of the last command, otherwise the last n lines of the output
+func (t *TUI) handleCopyCommand(args string) {
+	args = strings.TrimSpace(args)
+	if args == "" {
+		result := strings.TrimRight(t.lastResult.String(), "\n")
+		if result == "" {
+			t.ShowError(i18n.GetMessage("error.copy_nothing"))
+			return
+		}
+		t.copyToClipboard(result)
+		return
+	}
+
+	count, err := strconv.Atoi(args)
+	if err != nil || count < 1 {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "copy [n]"))
+		return
+	}
+
+	lines := strings.Split(strings.TrimRight(t.output.GetText(true), "\n"), "\n")
+	if count > len(lines) {
+		count = len(lines)
+	}
+	t.copyToClipboard(strings.Join(lines[len(lines)-count:], "\n"))
+}
+
+// copyToClipboard puts text into the system clipboard of the terminal
+func (t *TUI) copyToClipboard(text string) {
+	if t.screen == nil {
+		t.ShowError(i18n.GetMessage("error.clipboard"))
+		return
+	}
+
+	t.screen.SetClipboard([]byte(text))
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.copied"), strings.Count(text, "\n")+1))
+}
+
+// handleCopyKeys copies the selected reference with "c"; returns true if the key was consumed.
+// Folds are left out: their placeholder is selected automatically and "c" starts many commands.
+func (t *TUI) handleCopyKeys(event *tcell.EventKey) bool {
+	if event.Key() != tcell.KeyRune || event.Rune() != 'c' || t.input.GetText() != "" {
+		return false
+	}
+
+	if ref, ok := t.references[t.selectedReference]; ok {
+		t.copyToClipboard(ref.Text)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// credentials.go
+/**
+ * Nexuflex Client - Stored Credentials
+ *
+ * This file contains the handling of credentials stored in the keyring,
+ * including the replay of commands that were interrupted by an expired
+ * session that has been renewed automatically.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// replayInterruptedCommand re-executes a command after the session was renewed;
+// commands that may change data are only replayed after confirmation
+func (t *TUI) replayInterruptedCommand(command string) {
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", i18n.GetMessage("commands.session_renewed"))))
+
+	replay := func() {
+		t.handleCommandError(t.client.ExecuteCommand(command))
+	}
+
+	if client.IsIdempotentCommand(command) {
+		replay()
+		return
+	}
+
+	t.showConfirmation(fmt.Sprintf(i18n.GetMessage("commands.confirm_replay"), command), replay)
+}
+
+// storeLoginCredentials stores the credentials of a successful login in the keyring
+func (t *TUI) storeLoginCredentials(values map[string]string) {
+	serverInfo := t.client.GetServerInfo()
+	if serverInfo == nil {
+		return
+	}
+
+	if err := client.StoreCredentials(serverInfo.Address, serverInfo.Port, values); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.keyring"), err))
+	}
+}
+
+// handleCredentialsCommand processes the "credentials" client command
+func (t *TUI) handleCredentialsCommand(args string) {
+	serverInfo := t.client.GetServerInfo()
+	if serverInfo == nil {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		if t.client.HasStoredCredentials() {
+			t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.credentials_stored"),
+				serverInfo.Address, serverInfo.Port) + "\n"))
+		} else {
+			t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.credentials_not_stored"),
+				serverInfo.Address, serverInfo.Port) + "\n"))
+		}
+
+	case "forget":
+		if err := client.DeleteCredentials(serverInfo.Address, serverInfo.Port); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.keyring"), err))
+			return
+		}
+		t.ShowInfo(i18n.GetMessage("commands.credentials_deleted"))
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "credentials [forget]"))
+	}
+}
@@ -0,0 +1,287 @@
+// dashboards.go
+/**
+ * Nexuflex Client - Dashboard Page
+ *
+ * This file contains the "dashboard" client command and the full-screen
+ * dashboard page. The widgets of a dashboard are laid out in a grid of
+ * the configured number of columns, row by row; every widget refreshes in
+ * its own goroutine on its interval and is rendered with the renderers of
+ * the structured output types. Tab moves between the widgets to scroll
+ * them, "r" refreshes all of them at once and Escape closes the page,
+ * which stops the refreshing.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	"github.com/rivo/tview"
+)
+
+// dashboardUsage is the syntax of the dashboard command
+const dashboardUsage = "dashboard [list] | dashboard open <name>"
+
+// dashboardWidget is a widget on the open dashboard page
+type dashboardWidget struct {
+	widget  *client.DashboardWidget
+	view    *tview.TextView
+	refresh chan struct{} // Requests an immediate refresh
+}
+
+// handleDashboardCommand processes the "dashboard [list|open <name>]" client command
+func (t *TUI) handleDashboardCommand(args string) {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	switch strings.ToLower(sub) {
+	case "", "list":
+		t.showDashboards()
+	case "open":
+		t.openDashboard(strings.TrimSpace(rest))
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), dashboardUsage))
+	}
+}
+
+// showDashboards lists the defined dashboards
+func (t *TUI) showDashboards() {
+	names, err := client.ListDashboards()
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.dashboard"), err))
+		return
+	}
+	if len(names) == 0 {
+		dir, _ := client.DashboardDir()
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.dashboards_none"), dir))
+		return
+	}
+
+	t.output.Write([]byte(i18n.GetMessage("commands.dashboards_title") + "\n"))
+	for _, name := range names {
+		t.output.Write([]byte(fmt.Sprintf("  %s\n", tview.Escape(name))))
+	}
+}
+
+// openDashboard opens the dashboard page and starts refreshing its widgets
+func (t *TUI) openDashboard(name string) {
+	if name == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), dashboardUsage))
+		return
+	}
+	if !t.client.IsLoggedIn() {
+		t.ShowError(i18n.GetMessage("error.not_logged_in"))
+		return
+	}
+	dashboard, err := client.LoadDashboard(name, t.client.GetConfig().Watches)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.dashboard"), err))
+		return
+	}
+
+	widgets := make([]*dashboardWidget, len(dashboard.Widgets))
+	for i := range dashboard.Widgets {
+		widget := &dashboard.Widgets[i]
+		view := tview.NewTextView().SetDynamicColors(true).SetWrap(false)
+		view.SetBorder(true).SetTitle(" " + tview.Escape(widget.DisplayTitle()) + " ")
+		view.SetText("[gray]" + i18n.GetMessage("ui.dashboard_loading"))
+		widgets[i] = &dashboardWidget{widget: widget, view: view, refresh: make(chan struct{}, 1)}
+	}
+
+	header := tview.NewTextView().SetDynamicColors(true)
+	header.SetText(fmt.Sprintf("[::b]%s[::-]  [gray]%s", tview.Escape(dashboard.Name),
+		i18n.GetMessage("ui.dashboard_keys")))
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(header, 1, 0, false).
+		AddItem(dashboardGrid(dashboard, widgets), 0, 1, true)
+
+	stop := make(chan struct{})
+	focused := 0
+	layout.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEscape:
+			close(stop)
+			t.pages.RemovePage("dashboard")
+			t.app.SetFocus(t.input)
+		case event.Key() == tcell.KeyTab || event.Key() == tcell.KeyBacktab:
+			step := 1
+			if event.Key() == tcell.KeyBacktab {
+				step = len(widgets) - 1
+			}
+			focused = (focused + step) % len(widgets)
+			t.app.SetFocus(widgets[focused].view)
+		case event.Key() == tcell.KeyRune && event.Rune() == 'r':
+			for _, w := range widgets {
+				select {
+				case w.refresh <- struct{}{}:
+				default:
+				}
+			}
+		default:
+			return event
+		}
+		return nil
+	})
+
+	t.pages.AddPage("dashboard", layout, true, true)
+	t.app.SetFocus(widgets[0].view)
+
+	for _, w := range widgets {
+		go t.refreshDashboardWidget(w, stop)
+	}
+}
+
+// dashboardGrid places the widgets row by row into a grid; a widget that
+// does not fit into the rest of a row starts the next one
+func dashboardGrid(dashboard *client.Dashboard, widgets []*dashboardWidget) *tview.Grid {
+	grid := tview.NewGrid()
+	columns := make([]int, dashboard.Columns)
+	grid.SetColumns(columns...)
+
+	var rows []int
+	row, col := 0, 0
+	for _, w := range widgets {
+		if col+w.widget.Span > dashboard.Columns {
+			row, col = row+1, 0
+		}
+		if row == len(rows) {
+			rows = append(rows, 0)
+		}
+		// A row is as high as its highest widget with a fixed height
+		if w.widget.Height > 0 {
+			rows[row] = max(rows[row], w.widget.Height)
+		}
+		grid.AddItem(w.view, row, col, 1, w.widget.Span, 0, 0, false)
+		col += w.widget.Span
+	}
+	grid.SetRows(rows...)
+	return grid
+}
+
+// refreshDashboardWidget runs the command of a widget on its interval
+// until the page is closed
+func (t *TUI) refreshDashboardWidget(w *dashboardWidget, stop chan struct{}) {
+	ticker := time.NewTicker(w.widget.Interval())
+	defer ticker.Stop()
+
+	for {
+		result, err := t.client.FetchDashboardResult(w.widget)
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		t.app.QueueUpdateDraw(func() {
+			t.showDashboardResult(w, result, err)
+		})
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		case <-w.refresh:
+		}
+	}
+}
+
+// showDashboardResult renders the result of a widget; after an error the
+// widget keeps its previous content below the error
+func (t *TUI) showDashboardResult(w *dashboardWidget, result *client.DashboardResult, err error) {
+	title := w.widget.DisplayTitle()
+	if err != nil {
+		w.view.SetBorderColor(tcell.ColorRed)
+		w.view.SetTitle(fmt.Sprintf(" %s · %s ", tview.Escape(title), i18n.GetMessage("ui.dashboard_failed")))
+		previous := w.view.GetText(false)
+		if _, rest, found := strings.Cut(previous, dashboardErrorEnd); found {
+			previous = rest
+		}
+		w.view.SetText(fmt.Sprintf("[red]%s[white]%s%s", tview.Escape(err.Error()), dashboardErrorEnd, previous))
+		return
+	}
+
+	_, _, width, _ := w.view.GetInnerRect()
+	text, hits, renderErr := t.renderDashboardWidget(w.widget, result, width)
+	if renderErr != nil {
+		text = fmt.Sprintf("[red]%s[white]\n%s", tview.Escape(fmt.Sprintf(i18n.GetMessage("error.render_failed"),
+			result.ContentType, renderErr)), tview.Escape(result.Output))
+	}
+
+	color := tcell.ColorWhite
+	status := time.Now().Format("15:04:05")
+	if w.widget.Type == client.WidgetWatch {
+		color = tcell.ColorGreen
+		if hits > 0 {
+			color = tcell.ColorRed
+			status = fmt.Sprintf(i18n.GetMessage("ui.dashboard_hits"), hits) + " · " + status
+		}
+	}
+	w.view.SetBorderColor(color)
+	w.view.SetTitle(fmt.Sprintf(" %s · %s ", tview.Escape(title), status))
+	w.view.SetText(text)
+}
+
+// dashboardErrorEnd separates the error of a failed refresh from the previous content
+const dashboardErrorEnd = "\n[gray]────[white]\n"
+
+// renderDashboardWidget renders a result by the type of the widget; for
+// watch tiles it also returns the number of matching lines
+func (t *TUI) renderDashboardWidget(widget *client.DashboardWidget, result *client.DashboardResult, width int) (string, int, error) {
+	switch widget.Type {
+	case client.WidgetTable:
+		if result.Table != nil && len(result.Table.Columns) > 0 {
+			// Rows of the server may have fewer cells than columns
+			records := [][]string{make([]string, len(result.Table.Columns))}
+			for i, column := range result.Table.Columns {
+				records[0][i] = column.Name
+			}
+			for _, row := range result.Table.Rows {
+				records = append(records, row.Cells)
+			}
+			return alignTable(recordsTable(records)), 0, nil
+		}
+		table, err := csvTable(result.Output)
+		if err != nil {
+			return "", 0, err
+		}
+		return alignTable(table), 0, nil
+
+	case client.WidgetChart:
+		text, err := t.renderChart(plugin.Content{Type: "chart", Command: widget.Command, Body: result.Output, Width: width})
+		return text, 0, err
+
+	case client.WidgetWatch:
+		var hits []string
+		for _, line := range strings.Split(strings.TrimRight(result.Output, "\n"), "\n") {
+			if widget.Matcher().MatchString(line) {
+				hits = append(hits, tview.Escape(line))
+			}
+		}
+		if len(hits) == 0 {
+			return "[green]" + i18n.GetMessage("ui.dashboard_no_hits"), 0, nil
+		}
+		return strings.Join(hits, "\n"), len(hits), nil
+	}
+
+	// Panels show the output like the output area; tables are aligned in
+	// place instead of opening the table view
+	switch result.ContentType {
+	case "table", "text/csv":
+		table, err := csvTable(result.Output)
+		if err != nil {
+			return "", 0, err
+		}
+		return alignTable(table), 0, nil
+	case plugin.ContentTypePlain:
+		return tview.Escape(result.Output), 0, nil
+	}
+	text, err := t.renderContent(plugin.Content{Type: result.ContentType, Command: widget.Command, Body: result.Output, Width: width})
+	return text, 0, err
+}
@@ -0,0 +1,79 @@
+// density.go
+/**
+ * Nexuflex Client - Spacing Density
+ *
+ * This file contains the spacing density of the user interface, chosen
+ * with `density` for readability with very large or very small terminal
+ * fonts. "compact" fits the most into few cells: the status information is
+ * always abbreviated and the key hints are left out. "normal" is the
+ * default layout. "spacious" adds padding inside the output, log and jobs
+ * panes and a blank line between command blocks.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import "strings"
+
+// Spacing densities of the user interface
+const (
+	densityCompact  = "compact"
+	densityNormal   = "normal"
+	densitySpacious = "spacious"
+)
+
+// densities are the selectable densities, from the tightest
+var densities = []string{densityCompact, densityNormal, densitySpacious}
+
+// density returns the configured spacing density
+func (t *TUI) density() string {
+	if cfg := t.client.GetConfig(); cfg != nil {
+		switch density := strings.ToLower(strings.TrimSpace(cfg.UI.Density)); density {
+		case densityCompact, densitySpacious:
+			return density
+		}
+	}
+	return densityNormal
+}
+
+// applyDensity sets the paddings of the panes for the configured density
+// and redraws the status information
+func (t *TUI) applyDensity() {
+	top, bottom, left, right := 0, 0, 0, 0
+	if t.density() == densitySpacious {
+		top, bottom, left, right = 1, 1, 2, 2
+	}
+	t.output.SetBorderPadding(top, bottom, left, right)
+	t.logView.SetBorderPadding(top, bottom, left, right)
+	t.jobsPanel.SetBorderPadding(top, bottom, left, right)
+
+	// The compact density shows no key hints
+	if t.density() == densityCompact && t.statusMessage == "" {
+		t.statusText.SetText("")
+	}
+	t.renderStatus()
+}
+
+// blockSeparator returns the lines written between two command blocks
+func (t *TUI) blockSeparator() string {
+	if t.density() == densitySpacious {
+		return "\n"
+	}
+	return ""
+}
+
+// densitySegments abbreviates all status segments in the compact density
+func (t *TUI) densitySegments(segments []statusSegment) []statusSegment {
+	if t.density() != densityCompact {
+		return segments
+	}
+	for i, segment := range segments {
+		if segment.short != "" {
+			segments[i].text = segment.short
+		}
+	}
+	return segments
+}
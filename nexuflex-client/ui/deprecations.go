@@ -0,0 +1,153 @@
+// deprecations.go
+/**
+ * Nexuflex Client - Deprecation Warnings
+ *
+ * This file contains the warnings about deprecated server commands: a
+ * yellow warning with the replacement when such a command is completed or
+ * executed, and the "deprecations" client command, which lists the
+ * deprecated commands the user still relies on in the command history,
+ * the aliases and the remembered parameter values.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// deprecatedUse is a deprecated command with the places it is used in
+type deprecatedUse struct {
+	deprecation *proto.Deprecation
+	history     int      // Entries of the command history
+	aliases     []string // Aliases expanding to the command
+	defaults    bool     // Remembered parameter values
+}
+
+// deprecationText describes the deprecation of a command
+func deprecationText(command string, deprecation *proto.Deprecation) string {
+	parts := []string{fmt.Sprintf(i18n.GetMessage("commands.deprecated"), command)}
+	if deprecation.Since != "" {
+		parts[0] += " " + fmt.Sprintf(i18n.GetMessage("commands.deprecated_since"), deprecation.Since)
+	}
+	if sunset, ok := client.DeprecationSunset(deprecation); ok {
+		if time.Now().Before(sunset) {
+			parts = append(parts, fmt.Sprintf(i18n.GetMessage("commands.deprecated_sunset"), deprecation.SunsetDate))
+		} else {
+			parts = append(parts, fmt.Sprintf(i18n.GetMessage("commands.deprecated_sunset_passed"), deprecation.SunsetDate))
+		}
+	}
+	if deprecation.Replacement != "" {
+		parts = append(parts, fmt.Sprintf(i18n.GetMessage("commands.deprecated_replacement"), deprecation.Replacement))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// warnDeprecated writes a warning to the output if a command is deprecated
+func (t *TUI) warnDeprecated(command string) {
+	canonical, deprecation := t.client.CommandDeprecation(command)
+	if deprecation == nil {
+		return
+	}
+	t.output.Write([]byte(fmt.Sprintf("[yellow]⚠ %s[white]\n", tview.Escape(deprecationText(canonical, deprecation)))))
+}
+
+// showDeprecationHint shows in the status bar that a completed command is deprecated
+func (t *TUI) showDeprecationHint(command string) {
+	canonical, deprecation := t.client.CommandDeprecation(command)
+	if deprecation == nil {
+		return
+	}
+	text := deprecationText(canonical, deprecation)
+	t.showStatusMessage(fmt.Sprintf("[yellow]%s[white]", tview.Escape(text)), text, 5*time.Second)
+}
+
+// deprecationNote returns the note appended to a deprecated completion candidate
+func (t *TUI) deprecationNote(suggestion string) string {
+	_, deprecation := t.client.CommandDeprecation(suggestion)
+	if deprecation == nil {
+		return ""
+	}
+	note := i18n.GetMessage("ui.deprecated_note")
+	if deprecation.Replacement != "" {
+		note += " → " + deprecation.Replacement
+	}
+	return fmt.Sprintf("  [yellow](%s)[white]", tview.Escape(note))
+}
+
+// handleDeprecationsCommand processes the "deprecations" client command
+func (t *TUI) handleDeprecationsCommand() {
+	if len(t.client.GetCachedServices()) == 0 {
+		t.ShowError(i18n.GetMessage("error.no_metadata"))
+		return
+	}
+
+	uses := make(map[string]*deprecatedUse)
+	use := func(commandLine string) *deprecatedUse {
+		canonical, deprecation := t.client.CommandDeprecation(commandLine)
+		if deprecation == nil {
+			return nil
+		}
+		if uses[canonical] == nil {
+			uses[canonical] = &deprecatedUse{deprecation: deprecation}
+		}
+		return uses[canonical]
+	}
+
+	for _, entry := range t.commandHistory.GetEntries() {
+		if found := use(entry); found != nil {
+			found.history++
+		}
+	}
+	for alias, expansion := range t.aliasManager.GetAllAliases() {
+		if found := use(expansion); found != nil {
+			found.aliases = append(found.aliases, alias)
+		}
+	}
+	for _, command := range t.client.RememberedParameters() {
+		if found := use(command); found != nil {
+			found.defaults = true
+		}
+	}
+
+	if len(uses) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.deprecations_none"))
+		return
+	}
+
+	commands := make([]string, 0, len(uses))
+	for command := range uses {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+
+	t.output.Write([]byte(fmt.Sprintf("%s\n", fmt.Sprintf(i18n.GetMessage("commands.deprecations_title"), len(commands)))))
+	for _, command := range commands {
+		found := uses[command]
+		t.output.Write([]byte(fmt.Sprintf("  [yellow]%s[white]\n", tview.Escape(deprecationText(command, found.deprecation)))))
+
+		var usedIn []string
+		if found.history > 0 {
+			usedIn = append(usedIn, fmt.Sprintf(i18n.GetMessage("commands.deprecations_history"), found.history))
+		}
+		if len(found.aliases) > 0 {
+			sort.Strings(found.aliases)
+			usedIn = append(usedIn, fmt.Sprintf(i18n.GetMessage("commands.deprecations_aliases"), strings.Join(found.aliases, ", ")))
+		}
+		if found.defaults {
+			usedIn = append(usedIn, i18n.GetMessage("commands.deprecations_defaults"))
+		}
+		t.output.Write([]byte(fmt.Sprintf("    [gray]%s[white]\n", tview.Escape(strings.Join(usedIn, ", ")))))
+	}
+}
@@ -0,0 +1,202 @@
+// e2e_test.go
+/**
+ * Nexuflex Client - End-to-End Tests of the User Interface
+ *
+ * This file contains tests driving the TUI the way a user does, on a
+ * simulation screen with the scripted driver of internal/uitest, against
+ * the mock server of internal/mockserver: connecting and logging in with
+ * the login dialog, running a command, completing a command name with
+ * Tab and the error flows of a failed login and a failing command.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/internal/mockserver"
+	"github.com/msto63/nexuflex/nexuflex-client/internal/uitest"
+	"github.com/msto63/nexuflex/nexuflex-client/ui"
+)
+
+// waitTimeout limits the waits for the screen
+const waitTimeout = 5 * time.Second
+
+// TestMain loads the English messages from the lang directory of the client
+// and keeps the state of the client in a temporary directory
+func TestMain(m *testing.M) {
+	if err := os.Chdir(".."); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := i18n.LoadLanguage("en"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	home, err := os.MkdirTemp("", "nexuflex-e2e")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, name := range []string{"HOME", "XDG_CONFIG_HOME", "APPDATA"} {
+		os.Setenv(name, home)
+	}
+
+	code := m.Run()
+	os.RemoveAll(home)
+	os.Exit(code)
+}
+
+// discardLog drops the log of the client, whose background work may still
+// log after a test has ended
+func discardLog(format string, args ...interface{}) {}
+
+// startTUI starts a mock server and the TUI on a simulation screen and
+// connects to the server with the connect command
+func startTUI(t *testing.T) (*uitest.Driver, *mockserver.Server) {
+	t.Helper()
+	server, err := mockserver.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	server.AddUser("alice", "secret")
+	server.AddCommand("Inventory.Show.Item", "Stock: 42")
+	server.AddCommand("Inventory.Show.List", "3 items")
+	server.AddFailingCommand("Inventory.Delete.Item", "item is locked")
+
+	// The keystrokes of the driver arrive faster than anyone types and
+	// would be taken for a paste
+	cfg := config.GetDefaultConfig()
+	cfg.UI.PastePreview = false
+	c := client.NewClient(&cfg, discardLog)
+	c.SetErrorLocalizer(i18n.GetErrorMessage)
+	t.Cleanup(func() { c.Close() })
+
+	d, err := uitest.Start(ui.NewTUI(c), 100, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := d.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	if err := d.WaitForText("Welcome to nexuflex Terminal!", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	d.Submit(fmt.Sprintf("connect %s %d", server.Address(), server.Port()))
+	if err := d.WaitForText(fmt.Sprintf("Connected to %s:%d", server.Address(), server.Port()), waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	return d, server
+}
+
+// login fills in the login dialog and presses its Login button
+func login(t *testing.T, d *uitest.Driver, username, password string) {
+	t.Helper()
+	d.Submit("login")
+	if err := d.WaitForText("Store in keyring", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	d.Type(username)
+	d.Press(tcell.KeyTab)
+	d.Type(password)
+	d.Press(tcell.KeyTab) // Store in keyring
+	d.Press(tcell.KeyTab) // Login button
+	d.Press(tcell.KeyEnter)
+}
+
+func TestLogin(t *testing.T) {
+	d, _ := startTUI(t)
+
+	login(t, d, "alice", "secret")
+	if err := d.WaitForText("Welcome, alice! You are now logged in.", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.WaitForGone("Remember", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoginWithWrongPassword(t *testing.T) {
+	d, _ := startTUI(t)
+
+	login(t, d, "alice", "wrong")
+	if err := d.WaitForText("invalid user name or password", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExecuteCommand(t *testing.T) {
+	d, server := startTUI(t)
+	login(t, d, "alice", "secret")
+	if err := d.WaitForText("You are now logged in.", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	d.Submit("Inventory.Show.Item 4711")
+	if err := d.WaitForText("Stock: 42", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	if received := server.Received(); len(received) != 1 || received[0] != "Inventory.Show.Item 4711" {
+		t.Errorf("the server received %q", received)
+	}
+}
+
+func TestExecuteFailingCommand(t *testing.T) {
+	d, _ := startTUI(t)
+	login(t, d, "alice", "secret")
+	if err := d.WaitForText("You are now logged in.", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	d.Submit("Inventory.Delete.Item 4711")
+	if err := d.WaitForText("item is locked", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	d, _ := startTUI(t)
+	login(t, d, "alice", "secret")
+	if err := d.WaitForText("You are now logged in.", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	// The only command starting with the input is completed directly
+	d.Type("Inventory.Show.I")
+	d.Press(tcell.KeyTab)
+	if err := d.WaitForText("Inventory.Show.Item", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	d.Press(tcell.KeyEnter)
+	if err := d.WaitForText("Stock: 42", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCommandWithoutLogin(t *testing.T) {
+	d, server := startTUI(t)
+
+	d.Submit("Inventory.Show.Item 4711")
+	if err := d.WaitForText("not logged in", waitTimeout); err != nil {
+		t.Fatal(err)
+	}
+	if received := server.Received(); len(received) != 0 {
+		t.Errorf("the server ran %q without a login", received)
+	}
+}
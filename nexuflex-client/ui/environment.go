@@ -0,0 +1,38 @@
+// environment.go
+/**
+ * Nexuflex Client - Production Safeguards
+ *
+ * This file contains the extra confirmation of commands that change data
+ * in an environment whose policy requires it, by default prod. The dialog
+ * names the environment and the command, so that a command meant for a
+ * test system is not sent to production by accident.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// confirmForEnvironment asks before a command changes data in a guarded
+// environment and calls run once it is confirmed; it reports whether the
+// command waits for the confirmation
+func (t *TUI) confirmForEnvironment(command string, run func()) bool {
+	if !t.client.NeedsEnvironmentConfirmation(command) {
+		return false
+	}
+
+	environment := strings.ToUpper(t.client.Environment())
+	t.showChoice(fmt.Sprintf(i18n.GetMessage("ui.confirm_environment"), environment, tview.Escape(command)), run, func() {
+		t.ShowInfo(i18n.GetMessage("commands.environment_cancelled"))
+	})
+	return true
+}
@@ -0,0 +1,94 @@
+// expansion.go
+/**
+ * Nexuflex Client - Confirmation of Resolved Commands
+ *
+ * This file contains the echo of the fully resolved form of a command
+ * before it is sent: aliases and snippets expanded, the command name
+ * resolved and the service context applied. With `expansion_confirm =
+ * substantial` the resolved form is echoed whenever it differs from what
+ * was typed, and a form that differs substantially, e.g. an alias adding
+ * arguments or running another command, is only sent once the user
+ * presses Enter on the empty input. With `changed` every difference needs
+ * the Enter. Escape or typing another command drops the pending command.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// confirmExpansion echoes the resolved form of a command typed as typed
+// and holds it back until Enter if it needs a confirmation; it reports
+// whether the command waits for the confirmation
+func (t *TUI) confirmExpansion(typed, command string, send func()) bool {
+	mode := t.client.ExpansionConfirmMode()
+	if mode == client.ExpansionConfirmOff {
+		return false
+	}
+
+	resolved := t.client.QualifyCommand(command)
+	changed, substantial := client.ExpansionDiffers(typed, resolved)
+	if !changed {
+		return false
+	}
+	if !substantial && mode == client.ExpansionConfirmSubstantial {
+		// The command itself has been echoed already
+		if resolved != strings.TrimSpace(command) {
+			t.output.Write([]byte(fmt.Sprintf("[gray]→ %s[-]\n", tview.Escape(resolved))))
+		}
+		return false
+	}
+
+	t.cancelExpansion()
+	t.output.Write([]byte(fmt.Sprintf("[yellow]→ %s[-] [gray]%s[-]\n", tview.Escape(resolved),
+		tview.Escape(i18n.GetMessage("commands.expansion_confirm")))))
+	t.pendingExpansion = send
+	return true
+}
+
+// sendPendingExpansion sends the command waiting for confirmation if the
+// user pressed Enter on the empty input; other input drops it. It reports
+// whether the input was consumed.
+func (t *TUI) sendPendingExpansion(input string) bool {
+	if t.pendingExpansion == nil {
+		return false
+	}
+	if strings.TrimSpace(input) != "" {
+		t.cancelExpansion()
+		return false
+	}
+
+	send := t.pendingExpansion
+	t.pendingExpansion = nil
+	send()
+	return true
+}
+
+// cancelExpansion drops the command waiting for confirmation
+func (t *TUI) cancelExpansion() {
+	if t.pendingExpansion == nil {
+		return
+	}
+	t.pendingExpansion = nil
+	t.ShowInfo(i18n.GetMessage("commands.expansion_cancelled"))
+}
+
+// handleExpansionKeys drops the command waiting for confirmation on Escape
+func (t *TUI) handleExpansionKeys(event *tcell.EventKey) bool {
+	if t.pendingExpansion == nil || event.Key() != tcell.KeyEscape {
+		return false
+	}
+	t.cancelExpansion()
+	return true
+}
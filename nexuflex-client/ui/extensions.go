@@ -0,0 +1,64 @@
+// extensions.go
+/**
+ * Nexuflex Client - Extension Host
+ *
+ * This file connects the compiled-in extensions of the plugin registry
+ * with the user interface: the TUI acts as plugin.Host, dispatches the
+ * extension commands and lists them in the help.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+)
+
+// ExecuteCommand sends a command to the server on behalf of an extension
+func (t *TUI) ExecuteCommand(command string) error {
+	return t.client.ExecuteCommand(command)
+}
+
+// WriteOutput appends text to the output area on behalf of an extension
+func (t *TUI) WriteOutput(text string) {
+	t.output.Write([]byte(text + "\n"))
+}
+
+// handleExtensionCommand runs an extension command; returns false if no extension provides it
+func (t *TUI) handleExtensionCommand(name, args string) bool {
+	cmd, ok := plugin.FindCommand(name)
+	if !ok {
+		return false
+	}
+
+	if err := plugin.RunCommand(cmd, t, strings.TrimSpace(args)); err != nil {
+		t.ShowError(err.Error())
+	}
+	return true
+}
+
+// extensionHelpText returns the help section for the extension commands, or "" if there are none
+func extensionHelpText() string {
+	commands := plugin.Commands()
+	if len(commands) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	writeHelpSection(&sb, i18n.GetMessage("help.extensions"))
+	for _, cmd := range commands {
+		usage := cmd.Usage
+		if usage == "" {
+			usage = cmd.Name
+		}
+		writeHelpEntry(&sb, usage, cmd.Description)
+	}
+	sb.WriteString(" \n")
+	return sb.String()
+}
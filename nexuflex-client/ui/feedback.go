@@ -0,0 +1,61 @@
+// feedback.go
+/**
+ * Nexuflex Client - Feedback Command
+ *
+ * This file contains the "feedback" client command, which sends a short
+ * report of the user to the administrators of the connected server, e.g.
+ * about a business command that did not do what was expected. With
+ * --screen the text of the output area is attached, so the administrators
+ * see what the user saw.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"google.golang.org/grpc/codes"
+)
+
+// feedbackUsage is the syntax of the feedback command
+const feedbackUsage = "feedback [--screen] <text>"
+
+// handleFeedbackCommand processes the "feedback [--screen] <text>" client command
+func (t *TUI) handleFeedbackCommand(args string) {
+	text := strings.TrimSpace(args)
+	withScreen := false
+	if rest, ok := strings.CutPrefix(text, "--screen"); ok && (rest == "" || rest[0] == ' ') {
+		withScreen = true
+		text = strings.TrimSpace(rest)
+	}
+	if text == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), feedbackUsage))
+		return
+	}
+
+	screen := ""
+	if withScreen {
+		screen = t.output.GetText(true)
+	}
+
+	reference, err := t.client.SendFeedback(text, screen)
+	var rpcErr *client.RPCError
+	switch {
+	case errors.As(err, &rpcErr) && rpcErr.Code == codes.Unimplemented:
+		t.ShowError(i18n.GetMessage("error.feedback_unsupported"))
+	case err != nil:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.feedback"), err))
+	case reference != "":
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.feedback_sent_reference"), reference))
+	default:
+		t.ShowInfo(i18n.GetMessage("success.feedback_sent"))
+	}
+}
@@ -0,0 +1,128 @@
+// fkeys.go
+/**
+ * Nexuflex Client - Function Key Macros
+ *
+ * This file contains the function keys bound to commands in the [fkeys]
+ * section of the configuration, e.g. "F5 = Monitor.Show.Dashboard". A key
+ * runs its command as if it had been typed, so client commands such as
+ * "flow run <name>" work as well. The bound keys are shown in a row below
+ * the status bar, where they can also be clicked.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// functionKeyLabelWidth is the maximum width of the label of a function key in the row
+const functionKeyLabelWidth = 16
+
+// initFunctionKeys reads the function keys bound to commands and creates the row showing them
+func (t *TUI) initFunctionKeys() {
+	t.functionKeys = make(map[tcell.Key]string)
+	if cfg := t.client.GetConfig(); cfg != nil {
+		for name, command := range cfg.FunctionKeys {
+			number, ok := config.FunctionKeyNumber(name)
+			if command = strings.TrimSpace(command); ok && command != "" {
+				t.functionKeys[tcell.KeyF1+tcell.Key(number-1)] = command
+			}
+		}
+	}
+
+	t.functionKeyBar = tview.NewTextView().
+		SetDynamicColors(true).
+		SetRegions(true).
+		SetWrap(false)
+	t.functionKeyBar.SetHighlightedFunc(func(added, removed, remaining []string) {
+		if len(added) == 0 {
+			return
+		}
+		// A click runs the key; the highlight is not kept
+		t.functionKeyBar.Highlight()
+		var number int
+		if _, err := fmt.Sscanf(added[0], "fkey-%d", &number); err == nil {
+			t.runFunctionKey(tcell.KeyF1 + tcell.Key(number-1))
+		}
+	})
+	t.functionKeyBar.SetText(t.functionKeyRow())
+}
+
+// functionKeyBarHeight returns the height of the row of the function keys:
+// hidden without bound keys and on low terminals
+func (t *TUI) functionKeyBarHeight() int {
+	if len(t.functionKeys) == 0 || t.shortLayout {
+		return 0
+	}
+	return 1
+}
+
+// functionKeyRow lists the bound function keys with the labels of their commands
+func (t *TUI) functionKeyRow() string {
+	keys := t.boundFunctionKeys()
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		number := int(key-tcell.KeyF1) + 1
+		entries = append(entries, fmt.Sprintf(`["fkey-%d"][black:aqua]F%d[-:-] %s[""]`,
+			number, number, tview.Escape(functionKeyLabel(t.functionKeys[key]))))
+	}
+	return strings.Join(entries, "  ")
+}
+
+// boundFunctionKeys returns the bound function keys in ascending order
+func (t *TUI) boundFunctionKeys() []tcell.Key {
+	keys := make([]tcell.Key, 0, len(t.functionKeys))
+	for key := range t.functionKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// functionKeyLabel shortens a command to a label: the last part of a
+// service command name, otherwise the command itself
+func functionKeyLabel(command string) string {
+	label := command
+	if name := strings.Fields(command)[0]; strings.Contains(name, ".") {
+		label = name[strings.LastIndex(name, ".")+1:]
+	}
+	if runes := []rune(label); len(runes) > functionKeyLabelWidth {
+		label = string(runes[:functionKeyLabelWidth-1]) + "…"
+	}
+	return label
+}
+
+// runFunctionKey runs the command bound to a function key; keys are only
+// active in the main view, not in dialogs
+func (t *TUI) runFunctionKey(key tcell.Key) bool {
+	command, ok := t.functionKeys[key]
+	if !ok {
+		return false
+	}
+	if name, _ := t.pages.GetFrontPage(); name != "main" {
+		return false
+	}
+	t.submitCommand(command)
+	return true
+}
+
+// addFunctionKeyHelp lists the bound function keys on the help page
+func (t *TUI) addFunctionKeyHelp(kb *KeyBindings) {
+	for _, key := range t.boundFunctionKeys() {
+		key := key
+		kb.AddGlobalHandler(key, func() bool {
+			return t.runFunctionKey(key)
+		}, fmt.Sprintf(i18n.GetMessage("help.function_key"), t.functionKeys[key]))
+	}
+}
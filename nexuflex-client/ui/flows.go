@@ -0,0 +1,210 @@
+// flows.go
+/**
+ * Nexuflex Client - Flow Commands
+ *
+ * This file contains the "flow" client command for recording command
+ * flows into YAML files, showing them and running them again. Before a
+ * flow with variables runs, a dialog asks for their values.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// flowDialogWidth is the width of the variables dialog
+const flowDialogWidth = 60
+
+// flowUsage is the syntax of the flow command
+const flowUsage = "flow record <name> | save <file> | show <file> | run <file>"
+
+// handleFlowCommand processes the "flow" client command
+func (t *TUI) handleFlowCommand(args string) {
+	action, arg, _ := strings.Cut(strings.TrimSpace(args), " ")
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), flowUsage))
+		return
+	}
+
+	switch strings.ToLower(action) {
+	case "record":
+		if err := t.client.StartFlowRecording(arg); err != nil {
+			t.ShowError(err.Error())
+			return
+		}
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.flow_recording"), arg))
+		t.renderStatus()
+
+	case "save":
+		t.saveFlow(arg)
+
+	case "show":
+		flow, err := client.LoadFlow(arg)
+		if err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.flow"), err))
+			return
+		}
+		t.showFlow(flow)
+
+	case "run":
+		flow, err := client.LoadFlow(arg)
+		if err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.flow"), err))
+			return
+		}
+		if !t.client.IsLoggedIn() {
+			t.ShowError(i18n.GetMessage("error.not_logged_in"))
+			return
+		}
+		t.askFlowVariables(flow)
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), flowUsage))
+	}
+}
+
+// saveFlow ends the recording and writes the recorded flow to a file
+func (t *TUI) saveFlow(path string) {
+	flow := t.client.StopFlowRecording()
+	t.renderStatus()
+	if flow == nil {
+		t.ShowError(i18n.GetMessage("error.flow_not_recording"))
+		return
+	}
+	if len(flow.Steps) == 0 {
+		t.ShowError(i18n.GetMessage("error.flow_empty"))
+		return
+	}
+
+	if err := client.SaveFlow(flow, path); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.flow"), err))
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.flow_saved"), flow.Name, len(flow.Steps), path))
+}
+
+// showFlow writes the steps of a flow to the output, with the service context
+// each step is sent in and the variables it uses
+func (t *TUI) showFlow(flow *client.Flow) {
+	t.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n", tview.Escape(flow.Name))))
+	if flow.Description != "" {
+		t.output.Write([]byte(tview.Escape(flow.Description) + "\n"))
+	}
+
+	for _, variable := range flow.AllVariables() {
+		line := "  ${" + variable.Name + "}"
+		if variable.Prompt != "" {
+			line += "  " + variable.Prompt
+		}
+		if variable.Default != "" {
+			line += fmt.Sprintf(" (%s)", variable.Default)
+		}
+		if variable.Secret {
+			line += "  " + i18n.GetMessage("commands.flow_secret")
+		}
+		t.output.Write([]byte(tview.Escape(line) + "\n"))
+	}
+
+	for i, step := range flow.Steps {
+		if i > 0 {
+			t.output.Write([]byte("   [gray]↓[white]\n"))
+		}
+		context := step.Context
+		if context == "" {
+			context = "-"
+		}
+		line := fmt.Sprintf("%2d [gray]%-12s[white] %s", i+1, tview.Escape(context), tview.Escape(step.Command))
+		if variables := step.StepVariables(); len(variables) > 0 {
+			line += fmt.Sprintf("  [gray]%s[white]",
+				fmt.Sprintf(i18n.GetMessage("commands.flow_uses"), strings.Join(variables, ", ")))
+		}
+		t.output.Write([]byte(line + "\n"))
+	}
+}
+
+// askFlowVariables asks for the variable values of a flow and runs it
+func (t *TUI) askFlowVariables(flow *client.Flow) {
+	variables := flow.AllVariables()
+	if len(variables) == 0 {
+		t.runFlow(flow, nil)
+		return
+	}
+
+	form := tview.NewForm()
+	for _, variable := range variables {
+		label := variable.Prompt
+		if label == "" {
+			label = variable.Name
+		}
+		if variable.Secret {
+			form.AddPasswordField(label, variable.Default, 30, '*', nil)
+		} else {
+			form.AddInputField(label, variable.Default, 30, nil, nil)
+		}
+	}
+
+	closeDialog := func() {
+		t.pages.RemovePage("flow")
+		t.app.SetFocus(t.input)
+	}
+
+	run := func() {
+		values := make(map[string]string, len(variables))
+		for i, variable := range variables {
+			values[variable.Name] = strings.TrimSpace(form.GetFormItem(i).(*tview.InputField).GetText())
+		}
+		closeDialog()
+		t.runFlow(flow, values)
+	}
+
+	form.
+		AddButton(i18n.GetMessage("ui.run_button"), run).
+		AddButton(i18n.GetMessage("ui.cancel_button"), closeDialog).
+		SetCancelFunc(closeDialog)
+	form.SetBorder(true).
+		SetTitle(fmt.Sprintf(i18n.GetMessage("ui.flow_title"), flow.Name)).
+		SetTitleAlign(tview.AlignCenter)
+	form.SetBackgroundColor(tcell.ColorBlack)
+
+	t.pages.AddPage("flow", centeredFlex(form, flowDialogWidth, 2*form.GetFormItemCount()+5), true, true)
+	t.app.SetFocus(form)
+}
+
+// runFlow executes the steps of a flow, echoing each command like a typed one
+func (t *TUI) runFlow(flow *client.Flow, values map[string]string) {
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.flow_running"), tview.Escape(flow.Name), len(flow.Steps)))))
+	t.lastResult.Reset()
+
+	err := t.client.RunFlow(flow, values, func(step int, command string) {
+		t.output.Write([]byte(fmt.Sprintf("%s> [gray]%d/%d[white] [yellow]%s[white]\n",
+			t.timestamp(), step, len(flow.Steps), tview.Escape(t.client.MaskCommand(command)))))
+		t.outputService = t.commandService(command)
+	})
+	t.outputService = ""
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.flow_stopped"), err))
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.flow_completed"), flow.Name))
+}
+
+// flowBadge returns the status bar segment shown while a flow is being recorded
+func (t *TUI) flowBadge() string {
+	if !t.client.IsRecordingFlow() {
+		return ""
+	}
+	return "[red]● " + i18n.GetMessage("status.recording_flow") + "[white]"
+}
@@ -0,0 +1,189 @@
+// fold.go
+/**
+ * Nexuflex Client - Folding of Long Command Output
+ *
+ * This file contains the summarization of long command results. If a
+ * result has more lines than configured, only its first and last lines
+ * are written to the output area with a placeholder for the hidden part
+ * in between; the complete result is kept in memory. The placeholder is
+ * selected like a reference: Enter (or a click) expands it in place, "e"
+ * exports the complete result to a file.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// maxFolds is the number of folded results that can still be expanded
+const maxFolds = 20
+
+// foldedOutput is a long result of which only the start and the end are shown
+type foldedOutput struct {
+	lines       []string // Complete result
+	keep        int      // Lines shown at the start and the end
+	placeholder string   // Text of the placeholder in the output area
+	gutter      string   // Service color gutter of the lines
+}
+
+// hiddenLines returns the lines replaced by the placeholder
+func (f *foldedOutput) hiddenLines() []string {
+	return f.lines[f.keep : len(f.lines)-f.keep]
+}
+
+// foldLimits returns the line count above which results are folded and the
+// lines kept at the start and the end; 0 disables folding
+func (t *TUI) foldLimits() (int, int) {
+	cfg := t.client.GetConfig()
+	if cfg == nil || cfg.UI.FoldOutputLines <= 0 {
+		return 0, 0
+	}
+
+	keep := cfg.UI.FoldKeepLines
+	if keep <= 0 || 2*keep >= cfg.UI.FoldOutputLines {
+		keep = cfg.UI.FoldOutputLines / 4
+	}
+	return cfg.UI.FoldOutputLines, keep
+}
+
+// foldOutput shortens a long result to its start, a placeholder and its end;
+// other results are returned unchanged. The gutter is put in front of the
+// hidden lines when they are expanded.
+func (t *TUI) foldOutput(output, gutter string) (string, bool) {
+	threshold, keep := t.foldLimits()
+	if threshold == 0 {
+		return output, false
+	}
+
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(lines) <= threshold {
+		return output, false
+	}
+
+	fold := &foldedOutput{lines: lines, keep: keep, gutter: gutter}
+	id := t.addFold(fold, fmt.Sprintf(i18n.GetMessage("ui.output_folded"), groupDigits(len(fold.hiddenLines()))))
+
+	// The newest placeholder is selected, so Enter expands it right away
+	t.selectedReference = id
+
+	return t.decorateOutput(strings.Join(lines[:keep], "\n")) + "\n" +
+		fold.placeholder + "\n" +
+		t.decorateOutput(strings.Join(lines[len(lines)-keep:], "\n")), true
+}
+
+// addFold keeps a folded result and sets its placeholder with a label,
+// which must not contain color tags; returns the ID of the placeholder
+func (t *TUI) addFold(fold *foldedOutput, label string) string {
+	t.foldCounter++
+	id := fmt.Sprintf("fold-%d", t.foldCounter)
+	fold.placeholder = fmt.Sprintf(`["%s"][gray::r]%s[-::-][""]`, id, label)
+
+	t.folds[id] = fold
+	t.referenceOrder = append(t.referenceOrder, id)
+	t.foldOrder = append(t.foldOrder, id)
+	if len(t.foldOrder) > maxFolds {
+		delete(t.folds, t.foldOrder[0])
+		t.foldOrder = t.foldOrder[1:]
+	}
+	return id
+}
+
+// expandFold replaces a placeholder in the output area by the hidden lines
+func (t *TUI) expandFold(id string) {
+	fold, ok := t.folds[id]
+	if !ok {
+		t.ShowError(i18n.GetMessage("error.fold_expired"))
+		return
+	}
+
+	text := t.output.GetText(false)
+	if !strings.Contains(text, fold.placeholder) {
+		t.ShowError(i18n.GetMessage("error.fold_expired"))
+		return
+	}
+
+	row, col := t.output.GetScrollOffset()
+	// The gutter in front of the placeholder is kept for the first line
+	hidden := t.decorateOutput(strings.Join(fold.hiddenLines(), "\n"))
+	t.output.SetText(strings.Replace(text, fold.placeholder,
+		strings.TrimPrefix(withGutter(hidden, fold.gutter), fold.gutter), 1))
+	t.output.ScrollTo(row, col)
+
+	t.forgetFold(id)
+}
+
+// exportFold writes the complete result of a placeholder to a file in the working directory
+func (t *TUI) exportFold(id string) {
+	fold, ok := t.folds[id]
+	if !ok {
+		t.ShowError(i18n.GetMessage("error.fold_expired"))
+		return
+	}
+
+	path := fmt.Sprintf("nexuflex-output-%s.txt", time.Now().Format("20060102-150405"))
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(fold.lines, "\n")+"\n"), 0600); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.fold_export"), err))
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.fold_exported"), len(fold.lines), path))
+}
+
+// forgetFold removes a placeholder that was expanded
+func (t *TUI) forgetFold(id string) {
+	delete(t.folds, id)
+	for i, existing := range t.referenceOrder {
+		if existing == id {
+			t.referenceOrder = append(t.referenceOrder[:i], t.referenceOrder[i+1:]...)
+			break
+		}
+	}
+	if t.selectedReference == id {
+		t.selectedReference = ""
+	}
+}
+
+// isFoldSelected checks whether a placeholder is selected and the command line is empty
+func (t *TUI) isFoldSelected() bool {
+	_, ok := t.folds[t.selectedReference]
+	return ok && t.input.GetText() == ""
+}
+
+// handleFoldKeys processes the keys for a selected placeholder; returns true if the key was consumed
+func (t *TUI) handleFoldKeys(event *tcell.EventKey) bool {
+	if event.Key() != tcell.KeyRune || event.Rune() != 'e' || !t.isFoldSelected() {
+		return false
+	}
+	t.exportFold(t.selectedReference)
+	return true
+}
+
+// groupDigits formats a number with the thousands separator of the language
+func groupDigits(n int) string {
+	digits := strconv.Itoa(n)
+	separator := i18n.GetMessage("general.thousands_separator")
+
+	var sb strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteString(separator)
+		}
+		sb.WriteRune(digit)
+	}
+	return sb.String()
+}
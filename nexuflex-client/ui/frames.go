@@ -0,0 +1,97 @@
+// frames.go
+/**
+ * Nexuflex Client - Pinned Frames
+ *
+ * This file contains the panel below the output area in which the frames
+ * of refreshing displays are shown in place. The server sends only the
+ * changed lines of such a display; the panel shows the current frame of
+ * every running display instead of appending each refresh to the
+ * scrollback. When its stream ends, the last frame is written to the
+ * output area once and removed from the panel.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// initFramePanel creates the panel for the frames of refreshing displays
+func (t *TUI) initFramePanel() {
+	t.framePanel = tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(false)
+	t.framePanel.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.frames_title")).
+		SetBorderColor(tcell.ColorDarkCyan)
+	t.frames = make(map[string]client.Frame)
+}
+
+// handleFrameChanged is called by the client with a changed frame
+func (t *TUI) handleFrameChanged(frame client.Frame) {
+	t.app.QueueUpdateDraw(func() {
+		if !frame.Ended {
+			if _, known := t.frames[frame.Key]; !known {
+				t.frameOrder = append(t.frameOrder, frame.Key)
+			}
+			t.frames[frame.Key] = frame
+			t.refreshFramePanel()
+			return
+		}
+
+		// The last frame stays in the scrollback
+		if _, known := t.frames[frame.Key]; !known {
+			return
+		}
+		delete(t.frames, frame.Key)
+		for i, key := range t.frameOrder {
+			if key == frame.Key {
+				t.frameOrder = append(t.frameOrder[:i], t.frameOrder[i+1:]...)
+				break
+			}
+		}
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n%s\n",
+			fmt.Sprintf(i18n.GetMessage("commands.frame_final"), tview.Escape(frame.Command)),
+			strings.Join(frame.Lines, "\n"))))
+		t.refreshFramePanel()
+	})
+}
+
+// refreshFramePanel shows the current frames and adapts the height of the
+// panel, which takes at most half of the screen
+func (t *TUI) refreshFramePanel() {
+	if len(t.frameOrder) == 0 {
+		t.framePanel.SetText("")
+		t.layout.ResizeItem(t.framePanel, 0, 0)
+		return
+	}
+
+	var sb strings.Builder
+	lines := 0
+	for i, key := range t.frameOrder {
+		frame := t.frames[key]
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("[gray]%s[white]\n", tview.Escape(frame.Command)))
+		sb.WriteString(strings.Join(frame.Lines, "\n"))
+		lines += len(frame.Lines) + 1
+	}
+	t.framePanel.SetText(sb.String())
+
+	height := lines + 2
+	if limit := t.screenHeight / 2; limit > 2 && height > limit {
+		height = limit
+	}
+	t.layout.ResizeItem(t.framePanel, height, 0)
+}
@@ -0,0 +1,84 @@
+// handoff.go
+/**
+ * Nexuflex Client - Session Handoff Commands
+ *
+ * This file contains the "session" command for moving a session to
+ * another device: "session detach" hands the session over to the server
+ * and shows the one-time code, "session attach <code>" takes it over on
+ * the new device and starts the handed-over jobs again.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleSessionCommand processes the "session" client command
+func (t *TUI) handleSessionCommand(args string) {
+	fields := strings.Fields(args)
+	usage := fmt.Sprintf(i18n.GetMessage("commands.syntax"), "session detach|attach <code>")
+	if len(fields) == 0 {
+		t.ShowError(usage)
+		return
+	}
+
+	if !t.client.IsConnected() {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case "detach":
+		if !t.client.IsLoggedIn() {
+			t.ShowError(i18n.GetMessage("error.not_logged_in"))
+			return
+		}
+		t.showConfirmation(i18n.GetMessage("commands.session_detach_confirm"), t.detachSession)
+
+	case "attach":
+		if len(fields) != 2 {
+			t.ShowError(usage)
+			return
+		}
+		t.attachSession(fields[1])
+
+	default:
+		t.ShowError(usage)
+	}
+}
+
+// detachSession hands the session over and shows the code for the other device
+func (t *TUI) detachSession() {
+	code, expires, err := t.client.DetachSession()
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	t.output.Write([]byte(fmt.Sprintf("[green]%s[white]\n", i18n.GetMessage("commands.session_detached"))))
+	t.output.Write([]byte(fmt.Sprintf("  [yellow]session attach %s[white]\n", code)))
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.session_code_expires"), expires.Format("15:04:05")))))
+}
+
+// attachSession takes over a detached session and starts its jobs again
+func (t *TUI) attachSession(code string) {
+	commands, err := t.client.AttachSession(code)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	for _, command := range commands {
+		t.startJob(command, t.isLogStreamCommand(command))
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.session_attached"), t.client.GetUsername(), len(commands)))
+}
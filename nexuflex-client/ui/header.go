@@ -0,0 +1,259 @@
+// header.go
+/**
+ * Nexuflex Client - Header Widgets
+ *
+ * This file contains the header bar, a strip of widgets arranged with the
+ * "header_widgets" option: the title, a color coded environment tag, the
+ * server, the user, the running jobs, the unread notifications and a clock.
+ * Widgets before the "spacer" are aligned left, the ones after it right;
+ * on narrow terminals the least important widgets are dropped. Elevated
+ * sessions keep their red header so that they are never overlooked.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// headerRefreshInterval is the interval the live widgets are updated in
+const headerRefreshInterval = time.Second
+
+// headerSpacer is the pseudo widget separating the left from the right widgets
+const headerSpacer = "spacer"
+
+// headerWidgetPriority orders the widgets by importance, lower values are kept longer
+var headerWidgetPriority = map[string]int{
+	"env":           0,
+	"title":         1,
+	"server":        2,
+	"jobs":          3,
+	"notifications": 3,
+	"user":          4,
+	"clock":         5,
+}
+
+// environmentColors are the badge colors of the environment tag, "[fg:bg]"
+var environmentColors = map[string]string{
+	"prod":       "[white:red]",
+	"production": "[white:red]",
+	"staging":    "[black:yellow]",
+	"test":       "[black:yellow]",
+	"qa":         "[black:yellow]",
+	"dev":        "[black:green]",
+	"local":      "[black:green]",
+}
+
+// startHeaderWidgets subscribes to the notifications and keeps the clock current
+func (t *TUI) startHeaderWidgets() {
+	t.client.Events().Subscribe(func(event client.Event) {
+		if event.Type != client.EventNotification {
+			return
+		}
+		go t.app.QueueUpdateDraw(func() {
+			t.unreadNotifications++
+			t.updateSessionHeader()
+		})
+	})
+
+	go func() {
+		ticker := time.NewTicker(headerRefreshInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			// The clock waits while the user is typing
+			if t.isTyping() {
+				continue
+			}
+			t.app.QueueUpdateDraw(t.updateSessionHeader)
+		}
+	}()
+}
+
+// markNotificationsRead resets the unread notifications shown in the header
+// and sends the read receipt to the server
+func (t *TUI) markNotificationsRead() {
+	t.unreadNotifications = 0
+	t.updateSessionHeader()
+
+	// Without the receipt the server delivers the notifications again next time
+	go t.client.AcknowledgeNotifications()
+}
+
+// updateSessionHeader renders the header widgets; the header takes the
+// color of the environment and is red while the session is elevated
+func (t *TUI) updateSessionHeader() {
+	background := t.theme.header
+	if color := t.client.EnvironmentPolicy().HeaderColor; color != "" {
+		if environmentColor := tcell.GetColor(color); environmentColor != tcell.ColorDefault {
+			background = environmentColor
+		}
+	}
+	if t.client.IsElevated() {
+		background = tcell.ColorDarkRed
+	}
+	t.header.SetBackgroundColor(background)
+	t.header.SetText(t.renderHeader(t.screenWidth))
+}
+
+// headerWidgets returns the configured widgets
+func (t *TUI) headerWidgets() []string {
+	if cfg := t.client.GetConfig(); cfg != nil && len(cfg.UI.HeaderWidgets) > 0 {
+		return cfg.UI.HeaderWidgets
+	}
+	return []string{"title"}
+}
+
+// renderHeader arranges the texts of the widgets in the given width
+func (t *TUI) renderHeader(width int) string {
+	var left, right []statusSegment
+	spacer := false
+	for _, name := range t.headerWidgets() {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == headerSpacer {
+			spacer = true
+			continue
+		}
+		text := t.headerWidgetText(name)
+		if text == "" {
+			continue
+		}
+		priority, known := headerWidgetPriority[name]
+		if !known {
+			continue
+		}
+		if spacer {
+			right = append(right, statusSegment{text: text, priority: priority})
+		} else {
+			left = append(left, statusSegment{text: text, priority: priority})
+		}
+	}
+
+	join := func(segments []statusSegment) string {
+		texts := make([]string, len(segments))
+		for i, segment := range segments {
+			texts[i] = segment.text
+		}
+		return strings.Join(texts, "  ")
+	}
+
+	// Without a spacer the widgets are centered like the former header text
+	if !spacer {
+		t.header.SetTextAlign(tview.AlignCenter)
+		return join(fitHeaderWidgets(left, nil, width)[0])
+	}
+
+	t.header.SetTextAlign(tview.AlignLeft)
+	fitted := fitHeaderWidgets(left, right, width)
+	leftText, rightText := " "+join(fitted[0]), join(fitted[1])+" "
+	gap := width - tview.TaggedStringWidth(leftText) - tview.TaggedStringWidth(rightText)
+	if gap < 1 {
+		gap = 1
+	}
+	return leftText + strings.Repeat(" ", gap) + rightText
+}
+
+// fitHeaderWidgets drops the least important widgets of both groups until
+// they fit the width; a width of 0 (not drawn yet) keeps all widgets
+func fitHeaderWidgets(left, right []statusSegment, width int) [2][]statusSegment {
+	groups := [2][]statusSegment{
+		append([]statusSegment(nil), left...),
+		append([]statusSegment(nil), right...),
+	}
+
+	total := func() int {
+		sum := 2 // Margins
+		for _, group := range groups {
+			for _, segment := range group {
+				sum += tview.TaggedStringWidth(segment.text) + 2
+			}
+		}
+		return sum
+	}
+
+	for width > 0 && len(groups[0])+len(groups[1]) > 1 && total() > width {
+		// Remove the widget with the lowest priority (the last one on ties)
+		dropGroup, drop := -1, 0
+		for g, group := range groups {
+			for i, segment := range group {
+				if dropGroup < 0 || segment.priority >= groups[dropGroup][drop].priority {
+					dropGroup, drop = g, i
+				}
+			}
+		}
+		groups[dropGroup] = append(groups[dropGroup][:drop], groups[dropGroup][drop+1:]...)
+	}
+	return groups
+}
+
+// headerWidgetText returns the current text of a widget, empty to hide it
+func (t *TUI) headerWidgetText(name string) string {
+	switch name {
+	case "title":
+		return tview.Escape(t.headerTitle())
+
+	case "env":
+		environment := t.client.Environment()
+		if environment == "" {
+			return ""
+		}
+		color, ok := environmentColors[strings.ToLower(environment)]
+		if !ok {
+			color = "[white:gray]"
+		}
+		return fmt.Sprintf("%s %s [-:-]", color, tview.Escape(strings.ToUpper(environment)))
+
+	case "server":
+		if !t.client.IsConnected() {
+			return "[gray]" + i18n.GetMessage("status.offline") + "[white]"
+		}
+		if server := t.client.GetServerInfo(); server != nil {
+			return tview.Escape(server.ShortName)
+		}
+
+	case "user":
+		if t.client.IsLoggedIn() {
+			return tview.Escape(t.client.GetUsername())
+		}
+
+	case "jobs":
+		if running := t.client.CountRunningJobs(); running > 0 {
+			return fmt.Sprintf(i18n.GetMessage("ui.header_jobs"), running)
+		}
+
+	case "notifications":
+		if t.unreadNotifications > 0 {
+			return fmt.Sprintf("[yellow]%s[white]",
+				fmt.Sprintf(i18n.GetMessage("ui.header_notifications"), t.unreadNotifications))
+		}
+
+	case "clock":
+		return time.Now().Format("15:04")
+	}
+	return ""
+}
+
+// headerTitle returns the title, noting an elevated session and the read-only mode
+func (t *TUI) headerTitle() string {
+	text := i18n.GetMessage("ui.header")
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.UI.HeaderText != "" {
+		text = cfg.UI.HeaderText
+	}
+	if t.client.IsElevated() {
+		text = fmt.Sprintf(i18n.GetMessage("ui.header_elevated"), text, t.client.GetUsername())
+	}
+	if t.client.IsReadOnly() {
+		text = fmt.Sprintf(i18n.GetMessage("ui.header_read_only"), text)
+	}
+	return text
+}
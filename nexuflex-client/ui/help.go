@@ -0,0 +1,217 @@
+// help.go
+/**
+ * Nexuflex Client - Help Page
+ *
+ * This file contains the registry of the local client commands and the
+ * generation of the help page from it, from the commands of the compiled-in
+ * extensions, the user's aliases and the KeyBindings registry. All texts
+ * are taken from the language files, so the help page always matches the
+ * commands and keys that actually exist.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// helpUsageWidth is the width of the command column of the help page
+const helpUsageWidth = 22
+
+// localCommand is a command processed by the client itself
+type localCommand struct {
+	names   []string // Command name and its synonyms
+	usage   string   // Syntax shown in the help
+	helpKey string   // Message key of the description
+}
+
+// localCommandGroup is a section of local commands in the help
+type localCommandGroup struct {
+	titleKey string
+	commands []localCommand
+}
+
+// localCommandGroups lists the local commands in the order of the help page
+var localCommandGroups = []localCommandGroup{
+	{"help.general_commands", []localCommand{
+		{[]string{"help", "?"}, "help, ?", "help.help_command"},
+		{[]string{"help"}, "help <command>", "help.help_command_server"},
+		{[]string{"help"}, "help export <file>", "help.help_export_command"},
+		{[]string{"tutorial"}, tutorialUsage, "help.tutorial_command"},
+		{[]string{"exit", "quit"}, "exit, quit", "help.exit_command"},
+		{[]string{"clear", "cls"}, "clear, cls", "help.clear_command"},
+		{[]string{"clear"}, "clear [--keep-pinned] [--older-than <duration>] [--service <name>]", "help.clear_blocks_command"},
+		{[]string{"clear"}, "clear --restore", "help.clear_restore_command"},
+		{[]string{"pin", "unpin"}, "pin, unpin [n]", "help.pin_command"},
+		{[]string{"history"}, "history", "help.history_command"},
+		{[]string{"timeline"}, "timeline [type...] [#tag...] [@filter]", "help.timeline_command"},
+		{[]string{"dashboard"}, "dashboard [list|open <name>]", "help.dashboard_command"},
+		{[]string{"timeline"}, "timeline filter [add <name> <condition...>|remove <name>]", "help.timeline_filter_command"},
+		{[]string{"search"}, "search <terms>", "help.search_command"},
+		{[]string{"copy"}, "copy [n]", "help.copy_command"},
+		{[]string{"table"}, "table", "help.table_command"},
+		{[]string{"more"}, "more", "help.more_command"},
+		{[]string{"query"}, "query \"<select statement>\"", "help.query_command"},
+		{[]string{"query"}, "query save <name> | query tables", "help.query_save_command"},
+		{[]string{"report"}, "report <file.html>", "help.report_command"},
+		{[]string{"record"}, "record [start <file>|stop]", "help.record_command"},
+		{[]string{"support-bundle"}, "support-bundle [<file.zip>]", "help.support_bundle_command"},
+		{[]string{"feedback"}, feedbackUsage, "help.feedback_command"},
+		{[]string{"split"}, "split [on|off|<n>]", "help.split_command"},
+		{[]string{"wrap"}, "wrap [on|off]", "help.wrap_command"},
+		{[]string{"highlight"}, "highlight [add|remove]", "help.highlight_command"},
+		{[]string{"watch-pattern"}, "watch-pattern [add|remove|jump]", "help.watch_command"},
+		{[]string{"settings"}, "settings", "help.settings_command"},
+		{[]string{"sync"}, "sync [passphrase|forget]", "help.sync_command"},
+		{[]string{"jobs"}, "jobs [cancel|attach <id>]", "help.jobs_command"},
+		{[]string{"bg"}, "bg <command>", "help.bg_command"},
+		{[]string{"undo"}, "undo [list]", "help.undo_command"},
+		{[]string{"maintenance"}, "maintenance [cancel]", "help.maintenance_command"},
+		{[]string{"upload"}, "upload <file> <command>", "help.upload_command"},
+		{[]string{"flow"}, "flow record|save|show|run", "help.flow_command"},
+		{[]string{"params"}, "params <command>", "help.params_command"},
+		{[]string{"defaults"}, "defaults [clear [<command>]]", "help.defaults_command"},
+		{[]string{"deprecations"}, "deprecations", "help.deprecations_command"},
+		{[]string{"workspace"}, "workspace [save|load|delete <name>]", "help.workspace_command"},
+		{[]string{"state"}, "state [usage|prune]", "help.state_command"},
+		{[]string{"version"}, "version", "help.version_command"},
+		{[]string{"connection"}, "connection", "help.connection_command"},
+		{[]string{"actions"}, "actions [refresh]", "help.actions_command"},
+	}},
+	{"help.connection_management", []localCommand{
+		{[]string{"connect"}, "connect <host> [port]", "help.connect_command"},
+		{[]string{"recent"}, "recent [n]", "help.recent_command"},
+		{[]string{"disconnect"}, "disconnect", "help.disconnect_command"},
+	}},
+	{"help.authentication", []localCommand{
+		{[]string{"login"}, "login", "help.login_command"},
+		{[]string{"logout"}, "logout", "help.logout_command"},
+		{[]string{"credentials"}, "credentials [forget]", "help.credentials_command"},
+		{[]string{"whoami"}, "whoami", "help.whoami_command"},
+		{[]string{"session"}, "session detach|attach <code>", "help.session_command"},
+	}},
+	{"help.alias_management", []localCommand{
+		{[]string{"alias"}, "alias", "help.alias_list_command"},
+		{[]string{"alias"}, "alias <n>=<command>", "help.alias_create_command"},
+		{[]string{"unalias"}, "unalias <n>", "help.alias_delete_command"},
+		{[]string{"import"}, "import bash-history|zsh-aliases <file>", "help.import_command"},
+		{[]string{"snippet"}, "snippet [add <name> <text>|remove <name>]", "help.snippet_command"},
+		{[]string{"suggestions"}, "suggestions", "help.suggestions_command"},
+	}},
+	{"help.approvals", []localCommand{
+		{[]string{"approvals"}, "approvals [mine]", "help.approvals_command"},
+		{[]string{"approve"}, "approve <id> [comment]", "help.approve_command"},
+		{[]string{"reject"}, "reject <id> [comment]", "help.reject_command"},
+	}},
+	{"help.context", []localCommand{
+		{[]string{"use"}, "use <service>", "help.context_command"},
+		{[]string{"readonly"}, "readonly [on|off]", "help.readonly_command"},
+		{[]string{"set"}, setUsage, "help.set_command"},
+		{[]string{"telemetry"}, "telemetry [on|off]", "help.telemetry_command"},
+	}},
+}
+
+// reservedWords are reserved for the client without being a command of their own
+var reservedWords = []string{"status"}
+
+// isReservedKeyword checks if a word is a reserved keyword
+func isReservedKeyword(word string) bool {
+	word = strings.ToLower(word)
+	for _, group := range localCommandGroups {
+		for _, cmd := range group.commands {
+			for _, name := range cmd.names {
+				if name == word {
+					return true
+				}
+			}
+		}
+	}
+	for _, reserved := range reservedWords {
+		if reserved == word {
+			return true
+		}
+	}
+	return false
+}
+
+// writeHelpEntry writes a line with a highlighted command or key and its description
+func writeHelpEntry(sb *strings.Builder, usage, description string) {
+	usage = tview.Escape(usage)
+	padding := "  "
+	if width := tview.TaggedStringWidth(usage); width < helpUsageWidth {
+		padding = strings.Repeat(" ", helpUsageWidth-width+1)
+	}
+	sb.WriteString(fmt.Sprintf("   [yellow]%s[white]%s%s\n", usage, padding, description))
+}
+
+// writeHelpSection writes the title of a help section
+func writeHelpSection(sb *strings.Builder, title string) {
+	sb.WriteString(fmt.Sprintf(" [blue]%s:[white]\n", title))
+}
+
+// getHelpText returns the help text for the application
+func (t *TUI) getHelpText() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[yellow]%s[white]\n \n", i18n.GetMessage("help.title")))
+
+	// Local commands
+	for _, group := range localCommandGroups {
+		writeHelpSection(&sb, i18n.GetMessage(group.titleKey))
+		for _, cmd := range group.commands {
+			writeHelpEntry(&sb, cmd.usage, i18n.GetMessage(cmd.helpKey))
+		}
+		sb.WriteString(" \n")
+	}
+
+	// Commands of the extensions
+	sb.WriteString(extensionHelpText())
+
+	// The user's aliases
+	if t.aliasManager != nil {
+		if aliases := t.aliasManager.GetAllAliases(); len(aliases) > 0 {
+			names := make([]string, 0, len(aliases))
+			for name := range aliases {
+				names = append(names, name)
+			}
+			i18n.SortStrings(names)
+
+			writeHelpSection(&sb, i18n.GetMessage("help.user_aliases"))
+			for _, name := range names {
+				writeHelpEntry(&sb, name, tview.Escape(aliases[name]))
+			}
+			sb.WriteString(" \n")
+		}
+	}
+
+	// Keyboard shortcuts
+	if t.keyBindings != nil {
+		writeHelpSection(&sb, i18n.GetMessage("help.keyboard_shortcuts"))
+		for _, entry := range t.keyBindings.HelpEntries() {
+			writeHelpEntry(&sb, KeyLabel(entry.Key), entry.Text)
+		}
+		sb.WriteString(" \n")
+	}
+
+	// Command format
+	writeHelpSection(&sb, i18n.GetMessage("help.command_format"))
+	sb.WriteString("   [yellow]<Service>.<Action>.<SubAction> <Parameters>[white]\n \n")
+	sb.WriteString(fmt.Sprintf("   %s: [yellow]Finance.Create.Report Q4_2024 \"Profit and Loss\"[white]\n \n",
+		i18n.GetMessage("help.example")))
+	sb.WriteString(" " + i18n.GetMessage("help.return_hint"))
+
+	return sb.String()
+}
+
+// showHelp shows the help page with the current commands, aliases and keys
+func (t *TUI) showHelp() {
+	t.arrangeHelpPage()
+	t.pages.SwitchToPage("help")
+}
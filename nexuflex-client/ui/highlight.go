@@ -0,0 +1,215 @@
+// highlight.go
+/**
+ * Nexuflex Client - Output Highlighting
+ *
+ * This file contains the application of the highlight rules to incoming
+ * output lines, the "highlight" client command for adding and removing
+ * rules at runtime and the rules manager page. Rules added or removed at
+ * runtime are saved to the [highlight] section of the configuration file
+ * and apply to all output received afterwards.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// highlightEndTag resets the colors and styles after a highlighted line
+const highlightEndTag = "[-:-:-]"
+
+// initHighlights compiles the configured highlight rules
+func (t *TUI) initHighlights() {
+	if err := t.compileHighlights(); err != nil {
+		t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.highlight_rules"), err))))
+	}
+}
+
+// compileHighlights replaces the active rules with the ones of the configuration
+func (t *TUI) compileHighlights() error {
+	rules, err := client.ParseHighlightRules(t.client.GetConfig().Highlights, isValidColor)
+	t.highlightRules = rules
+	return err
+}
+
+// isValidColor checks whether a color is a color name or "#rrggbb"
+func isValidColor(color string) bool {
+	if strings.HasPrefix(color, "#") {
+		_, err := strconv.ParseUint(color[1:], 16, 32)
+		return len(color) == 7 && err == nil
+	}
+	_, ok := tcell.ColorNames[color]
+	return ok
+}
+
+// decorateOutput highlights the lines of command output and marks its references
+func (t *TUI) decorateOutput(text string) string {
+	return t.highlightLines(text, t.decorateReferences)
+}
+
+// highlightLines shows every line matching a rule in the rule's colors and lines
+// matching a watch pattern in the watch colors; decorate, if set, is applied to
+// each line first and gets the line without the rule's tags
+func (t *TUI) highlightLines(text string, decorate func(string) string) string {
+	if len(t.highlightRules) == 0 && len(t.watchPatterns) == 0 {
+		if decorate != nil {
+			return decorate(text)
+		}
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		decorated := line
+		if decorate != nil {
+			decorated = decorate(line)
+		}
+		if client.MatchWatch(t.watchPatterns, line) != nil {
+			decorated = watchTag + decorated + highlightEndTag
+		} else if rule := client.MatchHighlight(t.highlightRules, line); rule != nil {
+			decorated = rule.Tag() + decorated + highlightEndTag
+		}
+		lines[i] = decorated
+	}
+	return strings.Join(lines, "\n")
+}
+
+// handleHighlightCommand processes the "highlight [add|remove]" client command
+func (t *TUI) handleHighlightCommand(args string) {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	switch strings.ToLower(sub) {
+	case "", "list":
+		t.showHighlightRules()
+	case "add":
+		t.addHighlightRule(rest)
+	case "remove":
+		t.removeHighlightRule(strings.TrimSpace(rest))
+	default:
+		t.ShowError(i18n.GetMessage("error.highlight_usage"))
+	}
+}
+
+// addHighlightRule adds a rule given as `"<regex>" <color> [styles]` and saves it
+func (t *TUI) addHighlightRule(args string) {
+	pattern, style := splitQuotedArgument(args)
+	if pattern == "" {
+		t.ShowError(i18n.GetMessage("error.highlight_usage"))
+		return
+	}
+
+	cfg := t.client.GetConfig()
+	if cfg.Highlights == nil {
+		cfg.Highlights = make(map[string]string)
+	}
+	name := client.NextHighlightName(cfg.Highlights)
+	rule, err := client.NewHighlightRule(name, pattern, strings.Fields(style), isValidColor)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.highlight_invalid"), err))
+		return
+	}
+
+	cfg.Highlights[name] = rule.Definition()
+	t.saveHighlights()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.highlight_added"), name))
+}
+
+// removeHighlightRule deletes a rule by name and saves the remaining ones
+func (t *TUI) removeHighlightRule(name string) {
+	cfg := t.client.GetConfig()
+	if _, ok := cfg.Highlights[name]; !ok {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.highlight_not_found"), name))
+		return
+	}
+
+	delete(cfg.Highlights, name)
+	t.saveHighlights()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.highlight_removed"), name))
+}
+
+// saveHighlights activates the changed rules and writes them to the configuration file
+func (t *TUI) saveHighlights() {
+	// Errors of rules from the configuration file were reported at startup
+	_ = t.compileHighlights()
+
+	if err := config.SaveConfig(*t.client.GetConfig(), ""); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+	}
+}
+
+// splitQuotedArgument splits off the first argument, which may be enclosed in double quotes
+func splitQuotedArgument(args string) (string, string) {
+	args = strings.TrimSpace(args)
+	if strings.HasPrefix(args, `"`) {
+		if end := strings.Index(args[1:], `"`); end >= 0 {
+			return args[1 : end+1], args[end+2:]
+		}
+	}
+	first, rest, _ := strings.Cut(args, " ")
+	return first, rest
+}
+
+// showHighlightRules shows the rules manager, where Delete removes the selected rule
+func (t *TUI) showHighlightRules() {
+	if len(t.highlightRules) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.highlight_none"))
+		return
+	}
+
+	list := tview.NewList().
+		ShowSecondaryText(true).
+		SetSecondaryTextColor(tcell.ColorDimGray)
+	list.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.highlight_title")).
+		SetTitleAlign(tview.AlignCenter)
+
+	closeRules := func() {
+		t.pages.RemovePage("highlights")
+		t.app.SetFocus(t.input)
+	}
+
+	fill := func() {
+		list.Clear()
+		for _, rule := range t.highlightRules {
+			list.AddItem(rule.Tag()+tview.Escape(rule.Pattern.String())+highlightEndTag,
+				tview.Escape(fmt.Sprintf("%s: %s", rule.Name, rule.Style())), 0, nil)
+		}
+	}
+	fill()
+
+	list.SetDoneFunc(closeRules)
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() != tcell.KeyDelete {
+			return event
+		}
+		index := list.GetCurrentItem()
+		if index >= 0 && index < len(t.highlightRules) {
+			t.removeHighlightRule(t.highlightRules[index].Name)
+			if len(t.highlightRules) == 0 {
+				closeRules()
+				return nil
+			}
+			fill()
+			if index >= list.GetItemCount() {
+				index = list.GetItemCount() - 1
+			}
+			list.SetCurrentItem(index)
+		}
+		return nil
+	})
+
+	t.pages.AddPage("highlights", centeredFlex(list, 70, 20), true, true)
+	t.app.SetFocus(list)
+}
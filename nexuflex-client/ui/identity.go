@@ -0,0 +1,72 @@
+// identity.go
+/**
+ * Nexuflex Client - Effective User Display
+ *
+ * This file contains the "whoami" command and the permanent display of
+ * the effective roles: elevated sessions get a badge in the status bar and
+ * a red header, so that administrative privileges are never overlooked.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleWhoamiCommand processes the "whoami" client command
+func (t *TUI) handleWhoamiCommand() {
+	info := t.client.GetUserInfo()
+	if info == nil {
+		t.ShowError(i18n.GetMessage("error.not_logged_in"))
+		return
+	}
+
+	name := info.Username
+	if info.DisplayName != "" && info.DisplayName != info.Username {
+		name = fmt.Sprintf("%s (%s)", info.Username, info.DisplayName)
+	}
+	roles := strings.Join(info.Roles, ", ")
+	if roles == "" {
+		roles = "-"
+	}
+	permissions := strings.Join(info.Permissions, ", ")
+	if permissions == "" {
+		permissions = "-"
+	}
+	session := i18n.GetMessage("commands.whoami_standard")
+	if t.client.IsElevated() {
+		session = "[red]" + i18n.GetMessage("commands.whoami_elevated") + "[white]"
+	}
+
+	t.output.Write([]byte(fmt.Sprintf("%s\n", i18n.GetMessage("commands.whoami_title"))))
+	t.output.Write([]byte(fmt.Sprintf("  %-14s %s\n", i18n.GetMessage("commands.whoami_user"), name)))
+	t.output.Write([]byte(fmt.Sprintf("  %-14s %s\n", i18n.GetMessage("commands.whoami_roles"), roles)))
+	t.output.Write([]byte(fmt.Sprintf("  %-14s %s\n", i18n.GetMessage("commands.whoami_permissions"), permissions)))
+	t.output.Write([]byte(fmt.Sprintf("  %-14s %s\n", i18n.GetMessage("commands.whoami_session"), session)))
+	if info.LastLoginTime != "" {
+		t.output.Write([]byte(fmt.Sprintf("  %-14s %s\n", i18n.GetMessage("commands.whoami_last_login"), info.LastLoginTime)))
+	}
+}
+
+// roleBadge returns the status bar badge of an elevated session, e.g. "ADMIN" in red
+func (t *TUI) roleBadge() string {
+	if cfg := t.client.GetConfig(); cfg != nil && !cfg.UI.RoleBadge {
+		return ""
+	}
+	if !t.client.IsElevated() {
+		return ""
+	}
+
+	label := i18n.GetMessage("status.elevated")
+	if roles := t.client.ElevatedRoles(); len(roles) > 0 {
+		label = strings.ToUpper(strings.Join(roles, "+"))
+	}
+	return fmt.Sprintf("[white:red] %s [-:-]", label)
+}
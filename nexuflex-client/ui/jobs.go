@@ -0,0 +1,383 @@
+// jobs.go
+/**
+ * Nexuflex Client - Jobs Panel
+ *
+ * This file contains the jobs panel docked at the bottom of the main view.
+ * It lists the streaming commands running in the background with their
+ * progress from the server's status updates and the elapsed time; a job
+ * can be attached to the log pane or cancelled from the panel or with the
+ * "jobs" command.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// Dimensions of the jobs panel
+const (
+	jobsPanelMaxRows    = 6 // Job rows shown without scrolling
+	jobsProgressWidth   = 20
+	jobsRefreshInterval = time.Second
+	jobHeartbeatGrace   = 2 * time.Second  // Delay of a heartbeat before the silence is shown
+	jobQuietShown       = 10 * time.Second // Silence shown for servers without heartbeats
+)
+
+// initJobsPanel creates the jobs panel; it is added to the layout with zero height
+func (t *TUI) initJobsPanel() {
+	t.jobsPanel = tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	t.jobsPanel.SetBorder(true).SetTitle(i18n.GetMessage("ui.jobs_title"))
+	t.jobsPanel.SetInputCapture(t.handleJobsPanelKeys)
+
+	t.jobStates = make(map[int]client.JobState)
+	t.attachedJobs = make(map[int]bool)
+}
+
+// toggleJobsPanel shows or hides the jobs panel
+func (t *TUI) toggleJobsPanel() {
+	t.showJobsPanel(!t.jobsVisible)
+}
+
+// showJobsPanel shows the panel with the focus on it, or hides it
+func (t *TUI) showJobsPanel(show bool) {
+	if show == t.jobsVisible {
+		if show {
+			t.app.SetFocus(t.jobsPanel)
+		}
+		return
+	}
+	t.jobsVisible = show
+
+	if show {
+		t.refreshJobsPanel()
+		t.app.SetFocus(t.jobsPanel)
+
+		// Keep the elapsed times current while the panel is visible
+		stop := make(chan struct{})
+		t.jobsStop = stop
+		go func() {
+			ticker := time.NewTicker(jobsRefreshInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-stop:
+					return
+				case <-ticker.C:
+					t.app.QueueUpdateDraw(t.refreshJobsPanel)
+				}
+			}
+		}()
+		return
+	}
+
+	close(t.jobsStop)
+	t.jobsStop = nil
+	t.layout.ResizeItem(t.jobsPanel, 0, 0)
+	t.app.SetFocus(t.input)
+}
+
+// refreshJobsPanel fills the panel with the current jobs and adapts its height
+func (t *TUI) refreshJobsPanel() {
+	if !t.jobsVisible {
+		return
+	}
+
+	jobs := t.client.GetJobs()
+	selected, _ := t.jobsPanel.GetSelection()
+
+	t.jobsPanel.Clear()
+	for col, header := range []string{"#", i18n.GetMessage("ui.jobs_command"), i18n.GetMessage("ui.jobs_progress"),
+		i18n.GetMessage("ui.jobs_elapsed"), i18n.GetMessage("ui.jobs_status")} {
+		t.jobsPanel.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetSelectable(false))
+	}
+
+	if len(jobs) == 0 {
+		t.jobsPanel.SetCell(1, 1, tview.NewTableCell(i18n.GetMessage("ui.jobs_none")).
+			SetTextColor(tcell.ColorGray).
+			SetSelectable(false))
+	}
+	for i, job := range jobs {
+		row := i + 1
+		t.jobsPanel.SetCell(row, 0, tview.NewTableCell(strconv.Itoa(job.ID)).SetReference(job.ID))
+		t.jobsPanel.SetCell(row, 1, tview.NewTableCell(job.Command).SetMaxWidth(40).SetExpansion(1))
+		t.jobsPanel.SetCell(row, 2, tview.NewTableCell(formatProgressBar(job.Progress, jobsProgressWidth)))
+		t.jobsPanel.SetCell(row, 3, tview.NewTableCell(formatElapsed(job.Elapsed())).SetAlign(tview.AlignRight))
+		t.jobsPanel.SetCell(row, 4, tview.NewTableCell(t.formatJobStatus(job)).SetExpansion(1))
+	}
+
+	// Keep the selection on a job row
+	if selected < 1 {
+		selected = 1
+	}
+	if selected > len(jobs) {
+		selected = len(jobs)
+	}
+	t.jobsPanel.Select(selected, 0)
+
+	rows := len(jobs)
+	if rows == 0 {
+		rows = 1
+	}
+	if rows > jobsPanelMaxRows {
+		rows = jobsPanelMaxRows
+	}
+	t.layout.ResizeItem(t.jobsPanel, rows+3, 0) // Rows, header and border
+}
+
+// selectedJob returns the ID of the job selected in the panel
+func (t *TUI) selectedJob() (int, bool) {
+	row, _ := t.jobsPanel.GetSelection()
+	if id, ok := t.jobsPanel.GetCell(row, 0).GetReference().(int); ok {
+		return id, true
+	}
+	return 0, false
+}
+
+// handleJobsPanelKeys processes the keys of the focused jobs panel
+func (t *TUI) handleJobsPanelKeys(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEnter:
+		if id, ok := t.selectedJob(); ok {
+			t.attachJob(id)
+		}
+		return nil
+
+	case tcell.KeyDelete:
+		if id, ok := t.selectedJob(); ok {
+			t.cancelJob(id)
+		}
+		return nil
+
+	case tcell.KeyEscape, tcell.KeyTab:
+		t.app.SetFocus(t.input)
+		return nil
+	}
+
+	return event
+}
+
+// startJob runs a streaming command as a background job; with toLogPane its
+// output is rendered into the log pane right away
+func (t *TUI) startJob(command string, toLogPane bool) {
+	var onOutput func(output string)
+	if toLogPane {
+		t.showLogPane(true)
+		onOutput = t.logPaneWriter(t.commandService(command))
+	}
+
+	id, err := t.client.StartJob(command, onOutput)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	if toLogPane {
+		t.attachedJobs[id] = true
+		t.logView.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("commands.log_stream_started"), command))))
+	} else {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.job_started"), id, command))
+	}
+}
+
+// logPaneWriter returns the function writing the output lines of a job of
+// a service into the log pane
+func (t *TUI) logPaneWriter(service string) func(output string) {
+	gutter := t.serviceGutter(service)
+	return func(output string) {
+		t.noticeWatchHits(t.logView, output, false)
+		t.logView.Write([]byte(withGutter(t.highlightLines(output, nil), gutter) + "\n"))
+	}
+}
+
+// attachJob shows the output of a job in the log pane, starting with what it has produced so far
+func (t *TUI) attachJob(id int) {
+	writeToLogPane := t.logPaneWriter("")
+	for _, job := range t.client.GetJobs() {
+		if job.ID == id {
+			writeToLogPane = t.logPaneWriter(t.commandService(job.Command))
+		}
+	}
+
+	output, err := t.client.AttachJob(id, writeToLogPane)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	t.attachedJobs[id] = true
+	t.showLogPane(true)
+	t.logView.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.job_attached"), id))))
+	for _, line := range output {
+		writeToLogPane(line)
+	}
+	t.logView.ScrollToEnd()
+}
+
+// cancelJob stops a running job
+func (t *TUI) cancelJob(id int) {
+	if err := t.client.CancelJob(id); err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.job_cancelling"), id))
+}
+
+// handleJobsChanged is called by the client when a job was started, updated or ended
+func (t *TUI) handleJobsChanged() {
+	t.app.QueueUpdateDraw(func() {
+		t.reportFinishedJobs()
+		t.refreshJobsPanel()
+		t.renderStatus()
+		t.updateSessionHeader()
+	})
+}
+
+// reportFinishedJobs reports jobs that ended since the last change
+func (t *TUI) reportFinishedJobs() {
+	for _, job := range t.client.GetJobs() {
+		previous, known := t.jobStates[job.ID]
+		t.jobStates[job.ID] = job.State
+		if job.State == client.JobRunning || (known && previous == job.State) {
+			continue
+		}
+
+		if t.attachedJobs[job.ID] {
+			t.logView.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.log_stream_ended"), job.Command))))
+			delete(t.attachedJobs, job.ID)
+		}
+
+		message := fmt.Sprintf(i18n.GetMessage("commands.job_ended"),
+			job.ID, job.Command, formatJobState(job.State), formatElapsed(job.Elapsed()))
+		if job.State == client.JobFailed {
+			t.output.Write([]byte(fmt.Sprintf("[red]%s: %s[white]\n", message, job.Status)))
+		} else {
+			t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", message)))
+		}
+	}
+}
+
+// handleJobsCommand processes the "jobs" client command
+func (t *TUI) handleJobsCommand(args string) {
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		t.showJobsPanel(true)
+		return
+	}
+
+	usage := fmt.Sprintf(i18n.GetMessage("commands.syntax"), "jobs [cancel|attach <id>]")
+	if len(fields) != 2 {
+		t.ShowError(usage)
+		return
+	}
+	id, err := strconv.Atoi(fields[1])
+	if err != nil {
+		t.ShowError(usage)
+		return
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case "cancel":
+		t.cancelJob(id)
+	case "attach":
+		t.attachJob(id)
+	default:
+		t.ShowError(usage)
+	}
+}
+
+// handleBackgroundCommand processes the "bg" client command
+func (t *TUI) handleBackgroundCommand(command string) {
+	command = strings.TrimSpace(command)
+	if command == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "bg <command>"))
+		return
+	}
+	if !t.client.IsConnected() {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+	start := func() {
+		if t.deferForMaintenance(command, true, func() { t.startJob(command, false) }) {
+			return
+		}
+		t.startJob(command, false)
+	}
+	if t.confirmForEnvironment(command, start) {
+		return
+	}
+	start()
+}
+
+// formatProgressBar renders a progress bar; unknown progress is shown as an empty gray bar
+func formatProgressBar(percent, width int) string {
+	if percent < 0 {
+		return "[gray]" + strings.Repeat("░", width) + "[white]"
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	filled := percent * width / 100
+	return fmt.Sprintf("[green]%s[gray]%s[white] %3d%%",
+		strings.Repeat("█", filled), strings.Repeat("░", width-filled), percent)
+}
+
+// formatElapsed formats a duration as m:ss or h:mm:ss
+func formatElapsed(d time.Duration) string {
+	seconds := int(d.Round(time.Second) / time.Second)
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// jobSilenceShown returns the silence of a job from which it is shown: a
+// heartbeat is overdue, or without heartbeats there was no output for a while
+func (t *TUI) jobSilenceShown() time.Duration {
+	if heartbeat := t.client.GetConfig().Server.StreamHeartbeatSeconds; heartbeat > 0 {
+		return time.Duration(heartbeat)*time.Second + jobHeartbeatGrace
+	}
+	return jobQuietShown
+}
+
+// formatJobStatus shows the last status message of a running job, with the
+// time the server has been silent if that is unusual, or its final state
+func (t *TUI) formatJobStatus(job client.JobInfo) string {
+	switch job.State {
+	case client.JobRunning:
+		if silence := job.Silence(); silence >= t.jobSilenceShown() {
+			return strings.TrimSpace(job.Status + " [gray]" +
+				fmt.Sprintf(i18n.GetMessage("ui.job_silent"), formatElapsed(silence)) + "[white]")
+		}
+		return job.Status
+	case client.JobFailed:
+		return "[red]" + formatJobState(job.State) + "[white]"
+	case client.JobCancelled:
+		return "[yellow]" + formatJobState(job.State) + "[white]"
+	}
+	return "[green]" + formatJobState(job.State) + "[white]"
+}
+
+// formatJobState returns the translated name of a job state
+func formatJobState(state client.JobState) string {
+	return i18n.GetMessage("ui.job_" + state.String())
+}
@@ -1,196 +1,367 @@
-// keybindings.go
-/**
-* Nexuflex Client - Key Bindings
-*
-* This file contains the definitions and processing of key bindings
-* for the user interface.
-*
-* @author msto63
-* @version 1.0.0
-* @date 2025-03-12
- */
-
-package ui
-
-import (
-	"time"
-
-	"github.com/gdamore/tcell/v2"
-)
-
-// KeyHandler is a type for keyboard handling functions
-type KeyHandler func() bool
-
-// KeyBindings manages the key bindings of the application
-type KeyBindings struct {
-	globalHandlers map[tcell.Key]KeyHandler
-	inputHandlers  map[tcell.Key]KeyHandler
-	outputHandlers map[tcell.Key]KeyHandler
-	helpText       map[tcell.Key]string
-}
-
-// NewKeyBindings creates a new instance of key binding management
-func NewKeyBindings() *KeyBindings {
-	return &KeyBindings{
-		globalHandlers: make(map[tcell.Key]KeyHandler),
-		inputHandlers:  make(map[tcell.Key]KeyHandler),
-		outputHandlers: make(map[tcell.Key]KeyHandler),
-		helpText:       make(map[tcell.Key]string),
-	}
-}
-
-// AddGlobalHandler adds a global keyboard handler
-func (kb *KeyBindings) AddGlobalHandler(key tcell.Key, handler KeyHandler, helpText string) {
-	kb.globalHandlers[key] = handler
-	if helpText != "" {
-		kb.helpText[key] = helpText
-	}
-}
-
-// AddInputHandler adds a keyboard handler for the input field
-func (kb *KeyBindings) AddInputHandler(key tcell.Key, handler KeyHandler, helpText string) {
-	kb.inputHandlers[key] = handler
-	if helpText != "" {
-		kb.helpText[key] = helpText
-	}
-}
-
-// AddOutputHandler adds a keyboard handler for the output field
-func (kb *KeyBindings) AddOutputHandler(key tcell.Key, handler KeyHandler, helpText string) {
-	kb.outputHandlers[key] = handler
-	if helpText != "" {
-		kb.helpText[key] = helpText
-	}
-}
-
-// HandleGlobalKey processes a keypress in the global context
-func (kb *KeyBindings) HandleGlobalKey(event *tcell.EventKey) *tcell.EventKey {
-	if handler, ok := kb.globalHandlers[event.Key()]; ok {
-		if handler() {
-			return nil // Key was processed
-		}
-	}
-
-	return event // Pass key on
-}
-
-// HandleInputKey processes a keypress in the input field
-func (kb *KeyBindings) HandleInputKey(event *tcell.EventKey) *tcell.EventKey {
-	if handler, ok := kb.inputHandlers[event.Key()]; ok {
-		if handler() {
-			return nil // Key was processed
-		}
-	}
-
-	return event // Pass key on
-}
-
-// HandleOutputKey processes a keypress in the output field
-func (kb *KeyBindings) HandleOutputKey(event *tcell.EventKey) *tcell.EventKey {
-	if handler, ok := kb.outputHandlers[event.Key()]; ok {
-		if handler() {
-			return nil // Key was processed
-		}
-	}
-
-	return event // Pass key on
-}
-
-// GetHelpText returns the help text for a key
-func (kb *KeyBindings) GetHelpText(key tcell.Key) string {
-	if text, ok := kb.helpText[key]; ok {
-		return text
-	}
-	return ""
-}
-
-// GetAllHelpTexts returns all help texts
-func (kb *KeyBindings) GetAllHelpTexts() map[tcell.Key]string {
-	return kb.helpText
-}
-
-// SetupDefaultKeyBindings configures the default key bindings for the application
-func SetupDefaultKeyBindings(tui *TUI) *KeyBindings {
-	kb := NewKeyBindings()
-
-	// Global key bindings
-	kb.AddGlobalHandler(tcell.KeyCtrlC, func() bool {
-		tui.app.Stop()
-		return true
-	}, "Exits the application")
-
-	kb.AddGlobalHandler(tcell.KeyCtrlL, func() bool {
-		tui.pages.SwitchToPage("login")
-		return true
-	}, "Opens the login dialog")
-
-	kb.AddGlobalHandler(tcell.KeyCtrlH, func() bool {
-		tui.pages.SwitchToPage("help")
-		return true
-	}, "Shows the help")
-
-	kb.AddGlobalHandler(tcell.KeyCtrlD, func() bool {
-		go func() {
-			err := tui.client.DiscoverServer(5 * time.Second)
-			if err != nil {
-				tui.app.QueueUpdateDraw(func() {
-					tui.ShowError(err.Error())
-				})
-			}
-		}()
-		return true
-	}, "Starts server discovery")
-
-	kb.AddGlobalHandler(tcell.KeyEscape, func() bool {
-		// If a modal dialog is active, close it
-		if tui.pages.HasPage("modal") {
-			tui.pages.RemovePage("modal")
-			return true
-		}
-		// Otherwise, if not on main page, return
-		if tui.pages.GetCurrentPage() != "main" {
-			tui.pages.SwitchToPage("main")
-			return true
-		}
-		return false
-	}, "Closes dialogs or returns to main view")
-
-	// Input field key bindings
-	kb.AddInputHandler(tcell.KeyUp, func() bool {
-		// Get previous command from history
-		return true
-	}, "Previous command from history")
-
-	kb.AddInputHandler(tcell.KeyDown, func() bool {
-		// Get next command from history
-		return true
-	}, "Next command from history")
-
-	kb.AddInputHandler(tcell.KeyTab, func() bool {
-		// Auto-completion
-		return true
-	}, "Command completion")
-
-	// Output field key bindings
-	kb.AddOutputHandler(tcell.KeyPgUp, func() bool {
-		// Scroll page up
-		return true
-	}, "Scroll page up")
-
-	kb.AddOutputHandler(tcell.KeyPgDn, func() bool {
-		// Scroll page down
-		return true
-	}, "Scroll page down")
-
-	kb.AddOutputHandler(tcell.KeyHome, func() bool {
-		// Scroll to start
-		return true
-	}, "Scroll to start of output")
-
-	kb.AddOutputHandler(tcell.KeyEnd, func() bool {
-		// Scroll to end
-		return true
-	}, "Scroll to end of output")
-
-	return kb
-}
+// keybindings.go
+/**
+* Nexuflex Client - Key Bindings
+*
+* This file contains the definitions and processing of key bindings
+* for the user interface.
+*
+* @author msto63
+* @version 1.0.0
+* @date 2025-03-12
+ */
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// KeyHandler is a type for keyboard handling functions
+type KeyHandler func() bool
+
+// KeyHint is a key shown with a short description in the status bar;
+// without a text, the help text of the key binding is used. Character keys
+// are given with Key set to tcell.KeyRune and the character in Rune.
+type KeyHint struct {
+	Key  tcell.Key
+	Rune rune
+	Text string
+}
+
+// KeyBindings manages the key bindings of the application
+type KeyBindings struct {
+	globalHandlers map[tcell.Key]KeyHandler
+	inputHandlers  map[tcell.Key]KeyHandler
+	outputHandlers map[tcell.Key]KeyHandler
+	helpText       map[tcell.Key]string
+	helpOrder      []tcell.Key
+	hints          map[string][]KeyHint
+}
+
+// KeyHelp is a key with its help text
+type KeyHelp struct {
+	Key  tcell.Key
+	Text string
+}
+
+// NewKeyBindings creates a new instance of key binding management
+func NewKeyBindings() *KeyBindings {
+	return &KeyBindings{
+		globalHandlers: make(map[tcell.Key]KeyHandler),
+		inputHandlers:  make(map[tcell.Key]KeyHandler),
+		outputHandlers: make(map[tcell.Key]KeyHandler),
+		helpText:       make(map[tcell.Key]string),
+		hints:          make(map[string][]KeyHint),
+	}
+}
+
+// AddGlobalHandler adds a global keyboard handler
+func (kb *KeyBindings) AddGlobalHandler(key tcell.Key, handler KeyHandler, helpText string) {
+	kb.globalHandlers[key] = handler
+	kb.setHelpText(key, helpText)
+}
+
+// AddInputHandler adds a keyboard handler for the input field
+func (kb *KeyBindings) AddInputHandler(key tcell.Key, handler KeyHandler, helpText string) {
+	kb.inputHandlers[key] = handler
+	kb.setHelpText(key, helpText)
+}
+
+// AddOutputHandler adds a keyboard handler for the output field
+func (kb *KeyBindings) AddOutputHandler(key tcell.Key, handler KeyHandler, helpText string) {
+	kb.outputHandlers[key] = handler
+	kb.setHelpText(key, helpText)
+}
+
+// HandleGlobalKey processes a keypress in the global context
+func (kb *KeyBindings) HandleGlobalKey(event *tcell.EventKey) *tcell.EventKey {
+	if handler, ok := kb.globalHandlers[event.Key()]; ok {
+		if handler() {
+			return nil // Key was processed
+		}
+	}
+
+	return event // Pass key on
+}
+
+// HandleInputKey processes a keypress in the input field
+func (kb *KeyBindings) HandleInputKey(event *tcell.EventKey) *tcell.EventKey {
+	if handler, ok := kb.inputHandlers[event.Key()]; ok {
+		if handler() {
+			return nil // Key was processed
+		}
+	}
+
+	return event // Pass key on
+}
+
+// HandleOutputKey processes a keypress in the output field
+func (kb *KeyBindings) HandleOutputKey(event *tcell.EventKey) *tcell.EventKey {
+	if handler, ok := kb.outputHandlers[event.Key()]; ok {
+		if handler() {
+			return nil // Key was processed
+		}
+	}
+
+	return event // Pass key on
+}
+
+// setHelpText stores the help text of a key, keeping the order of registration
+func (kb *KeyBindings) setHelpText(key tcell.Key, helpText string) {
+	if helpText == "" {
+		return
+	}
+	if _, ok := kb.helpText[key]; !ok {
+		kb.helpOrder = append(kb.helpOrder, key)
+	}
+	kb.helpText[key] = helpText
+}
+
+// HelpEntries returns the keys with a help text in the order they were registered
+func (kb *KeyBindings) HelpEntries() []KeyHelp {
+	entries := make([]KeyHelp, 0, len(kb.helpOrder))
+	for _, key := range kb.helpOrder {
+		entries = append(entries, KeyHelp{Key: key, Text: kb.helpText[key]})
+	}
+	return entries
+}
+
+// GetHelpText returns the help text for a key
+func (kb *KeyBindings) GetHelpText(key tcell.Key) string {
+	if text, ok := kb.helpText[key]; ok {
+		return text
+	}
+	return ""
+}
+
+// GetAllHelpTexts returns all help texts
+func (kb *KeyBindings) GetAllHelpTexts() map[tcell.Key]string {
+	return kb.helpText
+}
+
+// AddHints adds key hints shown in the status bar in a context (e.g. "prompt", "login")
+func (kb *KeyBindings) AddHints(context string, hints ...KeyHint) {
+	kb.hints[context] = append(kb.hints[context], hints...)
+}
+
+// GetHints returns the key hints of a context as a single line, e.g. "Tab: complete • Ctrl+H: help"
+func (kb *KeyBindings) GetHints(context string) string {
+	var parts []string
+	for _, hint := range kb.hints[context] {
+		text := hint.Text
+		if text == "" {
+			text = kb.GetHelpText(hint.Key)
+		}
+		label := KeyLabel(hint.Key)
+		if hint.Key == tcell.KeyRune {
+			label = string(hint.Rune)
+		}
+		parts = append(parts, label+": "+text)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// KeyLabel returns the display name of a key, e.g. "Ctrl+R" or "↑"
+func KeyLabel(key tcell.Key) string {
+	switch key {
+	case tcell.KeyUp:
+		return "↑"
+	case tcell.KeyDown:
+		return "↓"
+	case tcell.KeyLeft:
+		return "←"
+	case tcell.KeyRight:
+		return "→"
+	case tcell.KeyEscape:
+		return "Esc"
+	case tcell.KeyCtrlH:
+		return "Ctrl+H" // Same code as Backspace
+	}
+
+	if name, ok := tcell.KeyNames[key]; ok {
+		return strings.ReplaceAll(name, "Ctrl-", "Ctrl+")
+	}
+	return "?"
+}
+
+// SetupDefaultKeyBindings configures the default key bindings for the application
+func SetupDefaultKeyBindings(tui *TUI) *KeyBindings {
+	kb := NewKeyBindings()
+
+	// Global key bindings
+	kb.AddGlobalHandler(tcell.KeyCtrlC, func() bool {
+		tui.app.Stop()
+		return true
+	}, i18n.GetMessage("help.ctrl_c"))
+
+	kb.AddGlobalHandler(tcell.KeyCtrlL, func() bool {
+		tui.pages.SwitchToPage("login")
+		return true
+	}, i18n.GetMessage("help.ctrl_l"))
+
+	kb.AddGlobalHandler(tcell.KeyCtrlH, func() bool {
+		tui.showHelp()
+		return true
+	}, i18n.GetMessage("help.ctrl_h"))
+
+	kb.AddGlobalHandler(tcell.KeyCtrlD, func() bool {
+		tui.DiscoverServers()
+		return true
+	}, i18n.GetMessage("help.ctrl_d"))
+
+	kb.AddGlobalHandler(tcell.KeyCtrlR, func() bool {
+		tui.showRecentServers()
+		return true
+	}, i18n.GetMessage("help.ctrl_r"))
+
+	kb.AddGlobalHandler(tcell.KeyCtrlP, func() bool {
+		tui.openCommandPalette()
+		return true
+	}, i18n.GetMessage("help.ctrl_p"))
+
+	kb.AddGlobalHandler(tcell.KeyF4, func() bool {
+		tui.toggleJobsPanel()
+		return true
+	}, i18n.GetMessage("help.f4"))
+
+	tui.addFunctionKeyHelp(kb)
+
+	kb.AddGlobalHandler(tcell.KeyEscape, func() bool {
+		// If a modal dialog is active, close it
+		if tui.pages.HasPage("modal") {
+			tui.pages.RemovePage("modal")
+			return true
+		}
+		// Otherwise, if not on main page, return
+		if name, _ := tui.pages.GetFrontPage(); name != "main" {
+			tui.pages.SwitchToPage("main")
+			return true
+		}
+		return false
+	}, i18n.GetMessage("help.escape"))
+
+	// Input field key bindings
+	kb.AddInputHandler(tcell.KeyUp, func() bool {
+		// Get previous command from history
+		return true
+	}, i18n.GetMessage("help.history_previous"))
+
+	kb.AddInputHandler(tcell.KeyDown, func() bool {
+		// Get next command from history
+		return true
+	}, i18n.GetMessage("help.history_next"))
+
+	kb.AddInputHandler(tcell.KeyTab, func() bool {
+		// Auto-completion
+		return true
+	}, i18n.GetMessage("help.tab_key"))
+
+	kb.AddInputHandler(tcell.KeyCtrlSpace, func() bool {
+		tui.expandAliasInline()
+		return true
+	}, i18n.GetMessage("help.ctrl_space"))
+
+	kb.AddInputHandler(tcell.KeyCtrlG, func() bool {
+		tui.selectNextReference()
+		return true
+	}, i18n.GetMessage("help.ctrl_g"))
+
+	// Output field key bindings
+	kb.AddOutputHandler(tcell.KeyPgUp, func() bool {
+		// Scroll page up
+		return true
+	}, i18n.GetMessage("help.page_up"))
+
+	kb.AddOutputHandler(tcell.KeyPgDn, func() bool {
+		// Scroll page down
+		return true
+	}, i18n.GetMessage("help.page_down"))
+
+	kb.AddOutputHandler(tcell.KeyHome, func() bool {
+		// Scroll to start
+		return true
+	}, i18n.GetMessage("help.home_key"))
+
+	kb.AddOutputHandler(tcell.KeyEnd, func() bool {
+		// Scroll to end
+		return true
+	}, i18n.GetMessage("help.end_key"))
+
+	setupKeyHints(kb)
+
+	return kb
+}
+
+// setupKeyHints configures the key hints shown in the status bar per context
+func setupKeyHints(kb *KeyBindings) {
+	kb.AddHints("prompt",
+		KeyHint{Key: tcell.KeyTab, Text: i18n.GetMessage("hint.complete")},
+		KeyHint{Key: tcell.KeyUp, Text: i18n.GetMessage("hint.history")},
+		KeyHint{Key: tcell.KeyCtrlR, Text: i18n.GetMessage("hint.recent")},
+		KeyHint{Key: tcell.KeyCtrlH, Text: i18n.GetMessage("hint.help")})
+	kb.AddHints("alias",
+		KeyHint{Key: tcell.KeyTab, Text: i18n.GetMessage("hint.show_alias")},
+		KeyHint{Key: tcell.KeyCtrlSpace, Text: i18n.GetMessage("hint.expand_alias")},
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.run")})
+	kb.AddHints("reference",
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.open_reference")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'c', Text: i18n.GetMessage("hint.copy")},
+		KeyHint{Key: tcell.KeyCtrlG, Text: i18n.GetMessage("hint.next_reference")})
+	kb.AddHints("list",
+		KeyHint{Key: tcell.KeyUp, Text: i18n.GetMessage("hint.select")},
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.connect")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("login",
+		KeyHint{Key: tcell.KeyTab, Text: i18n.GetMessage("hint.next_field")},
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.confirm")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.cancel")})
+	kb.AddHints("paste",
+		KeyHint{Key: tcell.KeyTab, Text: i18n.GetMessage("hint.next_field")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.cancel")})
+	kb.AddHints("fold",
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.expand_output")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'e', Text: i18n.GetMessage("hint.export_output")},
+		KeyHint{Key: tcell.KeyCtrlG, Text: i18n.GetMessage("hint.next_reference")})
+	kb.AddHints("jobs",
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.attach_job")},
+		KeyHint{Key: tcell.KeyDelete, Text: i18n.GetMessage("hint.cancel_job")},
+		KeyHint{Key: tcell.KeyF4, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("rules",
+		KeyHint{Key: tcell.KeyUp, Text: i18n.GetMessage("hint.select")},
+		KeyHint{Key: tcell.KeyDelete, Text: i18n.GetMessage("hint.remove_rule")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("import",
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.toggle_entry")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'a', Text: i18n.GetMessage("hint.all_entries")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'i', Text: i18n.GetMessage("hint.import")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.cancel")})
+	kb.AddHints("suggestions",
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.create_alias")},
+		KeyHint{Key: tcell.KeyRune, Rune: 's', Text: i18n.GetMessage("hint.save_snippet")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'd', Text: i18n.GetMessage("hint.dismiss")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("table",
+		KeyHint{Key: tcell.KeyRune, Rune: '/', Text: i18n.GetMessage("hint.filter")},
+		KeyHint{Key: tcell.KeyRune, Rune: 's', Text: i18n.GetMessage("hint.sort")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'r', Text: i18n.GetMessage("hint.reset")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("timeline",
+		KeyHint{Key: tcell.KeyRune, Rune: '1', Text: i18n.GetMessage("hint.toggle_type")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'a', Text: i18n.GetMessage("hint.all_types")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'f', Text: i18n.GetMessage("hint.favorite_filter")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("dashboard",
+		KeyHint{Key: tcell.KeyTab, Text: i18n.GetMessage("hint.next_widget")},
+		KeyHint{Key: tcell.KeyRune, Rune: 'r', Text: i18n.GetMessage("hint.refresh")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("help",
+		KeyHint{Key: tcell.KeyPgDn, Text: i18n.GetMessage("hint.scroll")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.close")})
+	kb.AddHints("modal",
+		KeyHint{Key: tcell.KeyTab, Text: i18n.GetMessage("hint.choose")},
+		KeyHint{Key: tcell.KeyEnter, Text: i18n.GetMessage("hint.confirm")},
+		KeyHint{Key: tcell.KeyEscape, Text: i18n.GetMessage("hint.cancel")})
+}
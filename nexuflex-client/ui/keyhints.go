@@ -0,0 +1,113 @@
+// keyhints.go
+/**
+ * Nexuflex Client - Status Bar Key Hints
+ *
+ * This file contains the context-sensitive key hints in the status bar.
+ * The hints depend on the focused widget and the current state (prompt,
+ * alias in the input, selected reference, dialogs) and are taken from the
+ * KeyBindings registry; temporary messages take precedence over them.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"time"
+)
+
+// keyHintContext determines the hint context from the focused widget and the state;
+// it runs before every draw, with the application locked, and so asks the
+// widgets for their focus instead of the application
+func (t *TUI) keyHintContext() string {
+	if t.pages.HasPage("modal") {
+		return "modal"
+	}
+
+	switch name, _ := t.pages.GetFrontPage(); name {
+	case "login", "settings", "sync", "flow", "params":
+		return "login"
+	case "servers", "recent":
+		return "list"
+	case "help":
+		return "help"
+	case "paste":
+		return "paste"
+	case "highlights":
+		return "rules"
+	case "import":
+		return "import"
+	case "suggestions":
+		return "suggestions"
+	case "table":
+		return "table"
+	case "timeline":
+		return "timeline"
+	case "dashboard":
+		return "dashboard"
+	}
+
+	if t.jobsPanel.HasFocus() {
+		return "jobs"
+	}
+
+	if t.isFoldSelected() {
+		return "fold"
+	}
+
+	if t.selectedReference != "" && t.input.GetText() == "" {
+		return "reference"
+	}
+
+	if t.input.HasFocus() {
+		if t.inputIsAlias {
+			return "alias"
+		}
+		return "prompt"
+	}
+	return ""
+}
+
+// updateKeyHints shows the hints of the current context unless a message is displayed;
+// called before every draw, so it must not call Draw itself
+func (t *TUI) updateKeyHints() {
+	if t.statusMessage != "" || t.keyBindings == nil {
+		return
+	}
+	if cfg := t.client.GetConfig(); cfg != nil && !cfg.UI.KeyHints {
+		return
+	}
+	if t.density() == densityCompact {
+		return
+	}
+
+	text := ""
+	if hints := t.keyBindings.GetHints(t.keyHintContext()); hints != "" {
+		text = fmt.Sprintf("[gray]%s[white]", hints)
+	}
+	if t.statusText.GetText(false) != text {
+		t.statusText.SetText(text)
+	}
+}
+
+// showStatusMessage displays a temporary message in the status bar; afterwards the key hints return.
+// It only sets the text, which the next redraw shows; called on the UI goroutine or before Run,
+// other goroutines go through QueueUpdateDraw
+func (t *TUI) showStatusMessage(text, message string, duration time.Duration) {
+	t.statusMessage = message
+	t.statusText.SetText(text)
+
+	go func() {
+		time.Sleep(duration)
+		t.app.QueueUpdateDraw(func() {
+			// Only clear if the message is still the same
+			if t.statusMessage == message {
+				t.statusMessage = ""
+				t.statusText.SetText("")
+			}
+		})
+	}()
+}
@@ -0,0 +1,171 @@
+// latency.go
+/**
+ * Nexuflex Client - Input Latency
+ *
+ * This file keeps typing responsive on slow terminals and high-latency SSH
+ * sessions, where every redraw costs a round trip:
+ *
+ *   - Output written to the output and log pane requests a redraw instead
+ *     of drawing right away; the requests are coalesced to at most
+ *     `frame_rate` redraws per second, so streaming output does not queue
+ *     up full-screen updates in front of the keystrokes.
+ *   - While the user is typing, the cosmetic refreshes (clock, spinner) are
+ *     suppressed; they resume once the input pauses.
+ *   - The work that depends on the input text, such as recognizing an
+ *     alias for the key hints, runs once the input has been idle for
+ *     `input_debounce_ms` instead of on every keystroke and every draw.
+ *     It runs in the timer goroutine, not on the UI goroutine; only the
+ *     finished state is queued to the UI, and dropped if the input has
+ *     changed since.
+ *
+ * In low-bandwidth mode, where the terminal itself may run over the slow
+ * link, the redraws are limited to LowBandwidthFrameRate per second and the
+ * input waits at least LowBandwidthInputDebounceMs.
+ *
+ * With -debug, frames taking longer than slowFrameThreshold are logged.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Defaults of the latency settings
+const (
+	DefaultFrameRate       = 30
+	DefaultInputDebounceMs = 100
+)
+
+// Limits of the latency settings in low-bandwidth mode
+const (
+	LowBandwidthFrameRate       = 10
+	LowBandwidthInputDebounceMs = 400
+)
+
+// typingPause is the time after the last keystroke until the user no longer counts as typing
+const typingPause = 400 * time.Millisecond
+
+// slowFrameThreshold is the draw time from which a frame is logged as slow
+const slowFrameThreshold = 50 * time.Millisecond
+
+// initInputLatency sets up the debounced processing of the input text
+func (t *TUI) initInputLatency() {
+	t.input.SetChangedFunc(func(text string) {
+		t.lastKeystroke.Store(time.Now().UnixNano())
+		t.expandTypedSnippet(text)
+		t.debounceInput()
+	})
+}
+
+// frameInterval returns the minimum time between two coalesced redraws
+func (t *TUI) frameInterval() time.Duration {
+	rate := DefaultFrameRate
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.UI.FrameRate > 0 {
+		rate = cfg.UI.FrameRate
+	}
+	if t.client.IsLowBandwidth() {
+		rate = min(rate, LowBandwidthFrameRate)
+	}
+	return time.Second / time.Duration(rate)
+}
+
+// inputDebounce returns the idle time after which the input text is processed
+func (t *TUI) inputDebounce() time.Duration {
+	debounce := DefaultInputDebounceMs
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.UI.InputDebounceMs >= 0 {
+		debounce = cfg.UI.InputDebounceMs
+	}
+	if t.client.IsLowBandwidth() {
+		debounce = max(debounce, LowBandwidthInputDebounceMs)
+	}
+	return time.Duration(debounce) * time.Millisecond
+}
+
+// requestDraw schedules a redraw; requests arriving before it happens are
+// served by the same redraw. It can be called from any goroutine.
+func (t *TUI) requestDraw() {
+	if !t.drawPending.CompareAndSwap(false, true) {
+		return
+	}
+	time.AfterFunc(t.frameInterval(), func() {
+		t.drawPending.Store(false)
+		t.app.Draw()
+	})
+}
+
+// isTyping checks whether the user has typed into the command line just now
+func (t *TUI) isTyping() bool {
+	return time.Since(time.Unix(0, t.lastKeystroke.Load())) < typingPause
+}
+
+// inputState is the state derived from the input text
+type inputState struct {
+	isAlias bool
+}
+
+// debounceInput processes the input text once no further keystroke arrives
+// within the debounce time; called on the UI goroutine
+func (t *TUI) debounceInput() {
+	seq := t.inputSeq.Add(1)
+	text := t.input.GetText()
+	delay := t.inputDebounce()
+	if delay == 0 {
+		t.applyInputState(t.processInputText(text))
+		return
+	}
+	if t.inputTimer != nil {
+		t.inputTimer.Stop()
+	}
+	t.inputTimer = time.AfterFunc(delay, func() {
+		if t.inputSeq.Load() != seq {
+			return
+		}
+		state := t.processInputText(text)
+		t.app.QueueUpdateDraw(func() {
+			// The input has changed while the state was worked out
+			if t.inputSeq.Load() != seq {
+				return
+			}
+			t.applyInputState(state)
+		})
+	})
+}
+
+// processInputText works out the state derived from an input text; it can
+// be called from any goroutine
+func (t *TUI) processInputText(text string) inputState {
+	var state inputState
+	text = strings.TrimSpace(text)
+	if text != "" && !strings.Contains(text, " ") {
+		_, state.isAlias = t.lookupAlias(text)
+	}
+	return state
+}
+
+// applyInputState shows the state derived from the input text; called on
+// the UI goroutine
+func (t *TUI) applyInputState(state inputState) {
+	t.inputIsAlias = state.isAlias
+}
+
+// startFrameTimer notes the start of a frame; called before every draw
+func (t *TUI) startFrameTimer() {
+	t.frameStarted = time.Now()
+}
+
+// stopFrameTimer logs a slow frame; called after every draw
+func (t *TUI) stopFrameTimer(screen tcell.Screen) {
+	if elapsed := time.Since(t.frameStarted); elapsed > slowFrameThreshold {
+		width, height := screen.Size()
+		log.Printf("Slow frame: %v (%dx%d)", elapsed.Round(time.Millisecond), width, height)
+	}
+}
@@ -0,0 +1,81 @@
+// lowbandwidth.go
+/**
+ * Nexuflex Client - Low-Bandwidth Mode Display
+ *
+ * This file contains what the user sees of the low-bandwidth mode: the
+ * estimated traffic in the status bar while the mode is on, the note
+ * below a result that continues on a further page and the "more" client
+ * command fetching that page. The "connection" command shows the traffic
+ * in any mode.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// trafficSegment returns the status bar segment with the estimated
+// traffic, without text if the low-bandwidth mode is off
+func (t *TUI) trafficSegment() statusSegment {
+	if !t.client.IsLowBandwidth() {
+		return statusSegment{}
+	}
+	traffic := t.client.GetTraffic()
+	total := formatBytes(traffic.Sent + traffic.Received)
+	return statusSegment{
+		text:     fmt.Sprintf("[gray]%s[white]", fmt.Sprintf(i18n.GetMessage("status.traffic"), total)),
+		priority: 3,
+		short:    fmt.Sprintf("[gray]≈%s[white]", total),
+	}
+}
+
+// trafficLine returns the line of the "connection" command with the traffic
+func (t *TUI) trafficLine() string {
+	traffic := t.client.GetTraffic()
+	line := fmt.Sprintf(i18n.GetMessage("commands.connection_traffic"),
+		formatBytes(traffic.Sent), formatBytes(traffic.Received))
+	if traffic.Compressed {
+		line += " (" + i18n.GetMessage("commands.connection_compressed") + ")"
+	}
+	return line
+}
+
+// announceNextPage notes below a result that it continues on a further page
+func (t *TUI) announceNextPage() {
+	if t.client.NextPage() == "" {
+		return
+	}
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", i18n.GetMessage("commands.next_page"))))
+}
+
+// handleMoreCommand processes the "more" client command
+func (t *TUI) handleMoreCommand() {
+	command := t.client.NextPage()
+	if command == "" {
+		t.ShowError(i18n.GetMessage("commands.no_next_page"))
+		return
+	}
+	if !t.client.IsConnected() {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+
+	t.lastResult.Reset()
+	service := t.commandService(command)
+	t.outputService = service
+	seq := t.blockCounter
+	started := time.Now()
+	err := t.client.FetchNextPage()
+	t.outputService = ""
+	t.annotateResult(seq, command, service, started, err)
+	t.handleCommandError(err)
+	t.announceNextPage()
+}
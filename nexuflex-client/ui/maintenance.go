@@ -0,0 +1,248 @@
+// maintenance.go
+/**
+ * Nexuflex Client - Maintenance Banner
+ *
+ * This file contains the handling of maintenance windows announced by the
+ * server. While a window is announced, a banner below the header counts
+ * down to its start and, during the window, to its end. Commands that
+ * would run into the window, because it has begun or because they are
+ * expected to still run when it starts, are only started after a warning
+ * that offers to queue them instead; queued commands run in order once
+ * the window has ended. "maintenance" shows the window and the queue,
+ * "maintenance cancel" drops the queue.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// maintenanceSymbol marks the maintenance banner and notes
+const maintenanceSymbol = "⚠"
+
+// deferredCommand is a command queued until after a maintenance window
+type deferredCommand struct {
+	command    string
+	background bool // Started with "bg"
+}
+
+// initMaintenanceBanner creates the banner shown while maintenance is announced
+func (t *TUI) initMaintenanceBanner() {
+	t.maintenanceBanner = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetWrap(false)
+}
+
+// maintenanceBannerHeight returns the height of the banner: hidden without
+// an announced window and queued commands
+func (t *TUI) maintenanceBannerHeight() int {
+	if _, ok := t.client.UpcomingMaintenance(); ok || len(t.maintenanceQueue) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// handleMaintenanceChanged is called by the client when a window is announced, changed or withdrawn
+func (t *TUI) handleMaintenanceChanged(window *client.MaintenanceWindow) {
+	go t.app.QueueUpdateDraw(func() {
+		if window != nil {
+			description := formatMaintenanceWindow(*window)
+			if window.Message != "" {
+				description += ": " + tview.Escape(window.Message)
+			}
+			t.output.Write([]byte(fmt.Sprintf("[yellow]%s %s[white]\n", maintenanceSymbol,
+				fmt.Sprintf(i18n.GetMessage("commands.maintenance_announced"), description))))
+		} else {
+			t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", i18n.GetMessage("commands.maintenance_withdrawn"))))
+		}
+		t.updateMaintenanceBanner()
+	})
+}
+
+// startMaintenanceBanner refreshes the countdown every second and runs the
+// queued commands once the window has ended
+func (t *TUI) startMaintenanceBanner() {
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			t.app.QueueUpdateDraw(func() {
+				t.updateMaintenanceBanner()
+				t.runDeferredCommands()
+			})
+		}
+	}()
+}
+
+// updateMaintenanceBanner shows the countdown to the start or the end of the window
+func (t *TUI) updateMaintenanceBanner() {
+	height := t.maintenanceBannerHeight()
+	if height != t.maintenanceBannerShown {
+		t.maintenanceBannerShown = height
+		t.layout.ResizeItem(t.maintenanceBanner, height, 0)
+	}
+	if height == 0 {
+		return
+	}
+
+	var text string
+	now := time.Now()
+	if window, ok := t.client.UpcomingMaintenance(); ok {
+		if window.Active(now) {
+			text = fmt.Sprintf(i18n.GetMessage("ui.maintenance_active"), formatElapsed(window.End.Sub(now)),
+				formatMaintenanceTime(window.End))
+		} else {
+			text = fmt.Sprintf(i18n.GetMessage("ui.maintenance_upcoming"), formatElapsed(window.Start.Sub(now)),
+				formatMaintenanceWindow(window))
+		}
+		if window.Message != "" {
+			text += ": " + tview.Escape(window.Message)
+		}
+	}
+	if len(t.maintenanceQueue) > 0 {
+		if text != "" {
+			text += "  ·  "
+		}
+		text += fmt.Sprintf(i18n.GetMessage("ui.maintenance_queued"), len(t.maintenanceQueue))
+	}
+	t.maintenanceBanner.SetText(fmt.Sprintf("[black:yellow] %s %s [-:-]", maintenanceSymbol, text))
+}
+
+// deferForMaintenance checks a command against the maintenance window before
+// it is started; on a conflict it asks whether to queue the command until
+// after the window, run it anyway or drop it, and returns true
+func (t *TUI) deferForMaintenance(command string, background bool, run func()) bool {
+	assumed := time.Duration(0)
+	if cfg := t.client.GetConfig(); cfg != nil && background {
+		assumed = time.Duration(cfg.Commands.MaintenanceJobMinutes) * time.Minute
+	}
+	window, conflict := t.client.MaintenanceConflict(command, assumed)
+	if !conflict {
+		return false
+	}
+
+	message := fmt.Sprintf(i18n.GetMessage("ui.confirm_maintenance_overlap"), command, formatMaintenanceWindow(window))
+	if window.Active(time.Now()) {
+		message = fmt.Sprintf(i18n.GetMessage("ui.confirm_maintenance_active"), formatMaintenanceTime(window.End), command)
+	}
+
+	queue := i18n.GetMessage("ui.maintenance_queue_button")
+	runNow := i18n.GetMessage("ui.maintenance_run_button")
+	cancel := i18n.GetMessage("ui.cancel_button")
+	modal := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{queue, runNow, cancel}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			t.pages.RemovePage("modal")
+			t.app.SetFocus(t.input)
+			switch buttonLabel {
+			case queue:
+				t.maintenanceQueue = append(t.maintenanceQueue, deferredCommand{command: command, background: background})
+				t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.maintenance_deferred"), formatMaintenanceTime(window.End)))
+				t.updateMaintenanceBanner()
+			case runNow:
+				run()
+			}
+		})
+	t.pages.AddPage("modal", modal, true, true)
+	return true
+}
+
+// runDeferredCommands runs the queued commands in order once the window has
+// ended and the client is connected again
+func (t *TUI) runDeferredCommands() {
+	if len(t.maintenanceQueue) == 0 || !t.client.IsConnected() {
+		return
+	}
+	if window, ok := t.client.UpcomingMaintenance(); ok && window.Active(time.Now()) {
+		return
+	}
+
+	queued := t.maintenanceQueue
+	t.maintenanceQueue = nil
+	t.updateMaintenanceBanner()
+	t.output.Write([]byte(fmt.Sprintf("[yellow]%s %s[white]\n", maintenanceSymbol,
+		fmt.Sprintf(i18n.GetMessage("commands.maintenance_running_queue"), len(queued)))))
+	for _, deferred := range queued {
+		if deferred.background {
+			t.echoCommand("bg " + deferred.command)
+			t.startJob(deferred.command, false)
+		} else {
+			t.echoCommand(deferred.command)
+			t.executeCommand(deferred.command)
+		}
+	}
+}
+
+// handleMaintenanceCommand processes "maintenance [cancel]"
+func (t *TUI) handleMaintenanceCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+	case "cancel":
+		count := len(t.maintenanceQueue)
+		t.maintenanceQueue = nil
+		t.updateMaintenanceBanner()
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.maintenance_queue_dropped"), count))
+		return
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "maintenance [cancel]"))
+		return
+	}
+
+	var sb strings.Builder
+	if window, ok := t.client.UpcomingMaintenance(); ok {
+		sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.maintenance_window"), formatMaintenanceWindow(window)) + "\n")
+		if window.Message != "" {
+			sb.WriteString("  " + tview.Escape(window.Message) + "\n")
+		}
+	} else {
+		sb.WriteString(i18n.GetMessage("commands.maintenance_none") + "\n")
+	}
+	if len(t.maintenanceQueue) > 0 {
+		sb.WriteString(fmt.Sprintf(i18n.GetMessage("ui.maintenance_queued"), len(t.maintenanceQueue)) + "\n")
+		for _, deferred := range t.maintenanceQueue {
+			command := deferred.command
+			if deferred.background {
+				command = "bg " + command
+			}
+			sb.WriteString("  [yellow]" + tview.Escape(command) + "[white]\n")
+		}
+	}
+	t.output.Write([]byte(sb.String()))
+}
+
+// formatMaintenanceWindow formats the start and end of a window, e.g. "14:00–15:30"
+func formatMaintenanceWindow(window client.MaintenanceWindow) string {
+	end := window.End.Format("15:04")
+	if !sameDay(window.Start, window.End) {
+		end = formatMaintenanceTime(window.End)
+	}
+	return formatMaintenanceTime(window.Start) + "–" + end
+}
+
+// formatMaintenanceTime formats a time of the window, with the date unless it is today
+func formatMaintenanceTime(at time.Time) string {
+	if sameDay(at, time.Now()) {
+		return at.Format("15:04")
+	}
+	return at.Format("2006-01-02 15:04")
+}
+
+// sameDay checks whether two times fall on the same local day
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Local().Date()
+	by, bm, bd := b.Local().Date()
+	return ay == by && am == bm && ad == bd
+}
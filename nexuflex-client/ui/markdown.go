@@ -0,0 +1,151 @@
+// markdown.go
+/**
+ * Nexuflex Client - Markdown Renderer
+ *
+ * This file contains the renderer of results declared as text/markdown.
+ * Headings, bold and italic text, inline code, links, lists, block quotes,
+ * rules, fenced code blocks and simple pipe tables are shown with color
+ * tags instead of the raw asterisks and pipes. With `render_markdown =
+ * false` or "set markdown off" the source is shown as it is.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	"github.com/rivo/tview"
+)
+
+var (
+	markdownHeading   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	markdownBullet    = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	markdownNumbered  = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	markdownRule      = regexp.MustCompile(`^\s*([-*_])(\s*([-*_])){2,}\s*$`)
+	markdownSeparator = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	markdownCode      = regexp.MustCompile("`([^`]+)`")
+	markdownLink      = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownBold      = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	markdownItalic    = regexp.MustCompile(`\*([^*\s][^*]*)\*|\b_([^_]+)_\b`)
+)
+
+// renderMarkdown renders Markdown with color tags, or shows the source if
+// rendering is switched off
+func (t *TUI) renderMarkdown(content plugin.Content) (string, error) {
+	if cfg := t.client.GetConfig(); cfg != nil && !cfg.UI.RenderMarkdown {
+		return tview.Escape(content.Body), nil
+	}
+
+	lines := strings.Split(strings.ReplaceAll(strings.TrimRight(content.Body, "\n"), "\r\n", "\n"), "\n")
+	var out []string
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		// Fenced code block up to the closing fence
+		if fence := strings.TrimSpace(line); strings.HasPrefix(fence, "```") || strings.HasPrefix(fence, "~~~") {
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence[:3]); i++ {
+				out = append(out, "    [aqua]"+tview.Escape(lines[i])+"[-]")
+			}
+			continue
+		}
+
+		// Table: a heading row, a separator row and the rows up to the first line without a pipe
+		if strings.Contains(line, "|") && i+1 < len(lines) && markdownSeparator.MatchString(lines[i+1]) &&
+			strings.Contains(lines[i+1], "|") {
+			records := [][]string{markdownCells(line)}
+			for i += 2; i < len(lines) && strings.Contains(lines[i], "|"); i++ {
+				records = append(records, markdownCells(lines[i]))
+			}
+			i--
+			out = append(out, strings.Split(strings.TrimRight(alignTable(recordsTable(records)), "\n"), "\n")...)
+			continue
+		}
+
+		out = append(out, markdownLine(line, content.Width))
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+// markdownLine renders a line outside of code blocks and tables
+func markdownLine(line string, width int) string {
+	if match := markdownHeading.FindStringSubmatch(line); match != nil {
+		switch len(match[1]) {
+		case 1:
+			return "[yellow::bu]" + markdownInline(match[2]) + "[-::-]"
+		case 2:
+			return "[yellow::b]" + markdownInline(match[2]) + "[-::-]"
+		}
+		return "[::b]" + markdownInline(match[2]) + "[::-]"
+	}
+	if markdownRule.MatchString(line) {
+		if width <= 0 {
+			width = 40
+		}
+		return "[gray]" + strings.Repeat("─", width) + "[-]"
+	}
+	if match := markdownBullet.FindStringSubmatch(line); match != nil {
+		return match[1] + "  • " + markdownInline(match[2])
+	}
+	if match := markdownNumbered.FindStringSubmatch(line); match != nil {
+		return match[1] + "  [::b]" + match[2] + ".[::-] " + markdownInline(match[3])
+	}
+	if quote, ok := strings.CutPrefix(strings.TrimLeft(line, " "), ">"); ok {
+		return "[gray]│[-] [::i]" + markdownInline(strings.TrimPrefix(quote, " ")) + "[::-]"
+	}
+	return markdownInline(line)
+}
+
+// markdownCells splits a table row into its cells
+func markdownCells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+	cells := strings.Split(line, "|")
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
+
+// markdownInline renders the inline code of a text; the text between the
+// code spans gets its links and emphasis rendered
+func markdownInline(text string) string {
+	var sb strings.Builder
+	for {
+		loc := markdownCode.FindStringSubmatchIndex(text)
+		if loc == nil {
+			sb.WriteString(markdownLinks(text))
+			return sb.String()
+		}
+		sb.WriteString(markdownLinks(text[:loc[0]]))
+		sb.WriteString("[aqua]" + tview.Escape(text[loc[2]:loc[3]]) + "[-]")
+		text = text[loc[1]:]
+	}
+}
+
+// markdownLinks shows links as underlined text followed by the target in gray
+func markdownLinks(text string) string {
+	var sb strings.Builder
+	for {
+		loc := markdownLink.FindStringSubmatchIndex(text)
+		if loc == nil {
+			sb.WriteString(markdownEmphasis(tview.Escape(text)))
+			return sb.String()
+		}
+		sb.WriteString(markdownEmphasis(tview.Escape(text[:loc[0]])))
+		sb.WriteString("[::u]" + markdownEmphasis(tview.Escape(text[loc[2]:loc[3]])) + "[::-] [gray](" +
+			tview.Escape(text[loc[4]:loc[5]]) + ")[-]")
+		text = text[loc[1]:]
+	}
+}
+
+// markdownEmphasis renders bold and italic text of escaped text
+func markdownEmphasis(escaped string) string {
+	escaped = markdownBold.ReplaceAllString(escaped, "[::b]$1$2[::-]")
+	return markdownItalic.ReplaceAllString(escaped, "[::i]$1$2[::-]")
+}
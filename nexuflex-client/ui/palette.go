@@ -0,0 +1,270 @@
+// palette.go
+/**
+ * Nexuflex Client - Command Palette
+ *
+ * This file contains the command palette (Ctrl+P) with the quick actions
+ * contributed by the server, grouped by category. Typing filters the
+ * entries by label, category, command and description; Enter runs the
+ * selected action, asking for the values of its placeholders first. The
+ * "actions" client command lists the quick actions in the output area.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// Size of the command palette
+const (
+	paletteWidth  = 80
+	paletteHeight = 24
+)
+
+// quickActionIcons maps the icon hints of the server to symbols
+var quickActionIcons = map[string]string{
+	"run":      "▶",
+	"report":   "▤",
+	"search":   "⌕",
+	"list":     "☰",
+	"add":      "+",
+	"delete":   "✗",
+	"edit":     "✎",
+	"warning":  "⚠",
+	"user":     "☺",
+	"settings": "⚙",
+	"refresh":  "↻",
+	"download": "↓",
+	"upload":   "↑",
+	"star":     "★",
+	"time":     "◷",
+}
+
+// quickActionIcon returns the symbol of the icon hint of a quick action
+func quickActionIcon(action *proto.QuickAction) string {
+	if icon, ok := quickActionIcons[strings.ToLower(action.Icon)]; ok {
+		return icon
+	}
+	return "•"
+}
+
+// openCommandPalette retrieves the quick actions in the background and opens the palette
+func (t *TUI) openCommandPalette() {
+	if !t.client.IsLoggedIn() {
+		t.ShowError(i18n.GetMessage("error.not_logged_in"))
+		return
+	}
+
+	go func() {
+		actions, err := t.client.GetQuickActions(false)
+		t.app.QueueUpdateDraw(func() {
+			if err != nil {
+				t.ShowError(err.Error())
+				return
+			}
+			t.showCommandPalette(actions)
+		})
+	}()
+}
+
+// showCommandPalette shows the palette with the quick actions
+func (t *TUI) showCommandPalette(actions []*proto.QuickAction) {
+	list := tview.NewList().
+		ShowSecondaryText(true).
+		SetSecondaryTextColor(tcell.ColorDimGray)
+	filter := tview.NewInputField().
+		SetLabel("> ").
+		SetFieldBackgroundColor(tcell.ColorBlack)
+
+	closePalette := func() {
+		t.pages.RemovePage("palette")
+		t.app.SetFocus(t.input)
+	}
+
+	var shown []*proto.QuickAction
+	fill := func(text string) {
+		list.Clear()
+		shown = filterQuickActions(actions, text)
+		for _, action := range shown {
+			title := fmt.Sprintf("%s %s", quickActionIcon(action), tview.Escape(action.Label))
+			if action.Category != "" {
+				title = fmt.Sprintf("%s [gray]%s[-]", title, tview.Escape(action.Category))
+			}
+			details := action.CommandTemplate
+			if action.Description != "" {
+				details = action.Description + " · " + details
+			}
+			list.AddItem(title, tview.Escape(details), 0, nil)
+		}
+		if len(shown) == 0 {
+			list.AddItem(i18n.GetMessage("ui.palette_empty"), "", 0, nil)
+		}
+	}
+	fill("")
+
+	run := func() {
+		index := list.GetCurrentItem()
+		if index < 0 || index >= len(shown) {
+			return
+		}
+		closePalette()
+		t.runQuickAction(shown[index])
+	}
+
+	filter.SetChangedFunc(fill)
+	filter.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyUp, tcell.KeyDown, tcell.KeyPgUp, tcell.KeyPgDn:
+			// The selection moves while the filter keeps the focus
+			list.InputHandler()(event, nil)
+			return nil
+		case tcell.KeyEnter:
+			run()
+			return nil
+		case tcell.KeyEscape:
+			closePalette()
+			return nil
+		}
+		return event
+	})
+
+	frame := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(filter, 1, 0, true).
+		AddItem(list, 0, 1, false)
+	frame.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.palette_title")).
+		SetTitleAlign(tview.AlignCenter)
+
+	t.pages.AddPage("palette", centeredFlex(frame, paletteWidth, paletteHeight), true, true)
+	t.app.SetFocus(filter)
+}
+
+// filterQuickActions returns the quick actions matching all words of a filter
+func filterQuickActions(actions []*proto.QuickAction, filter string) []*proto.QuickAction {
+	words := strings.Fields(strings.ToLower(filter))
+	var matching []*proto.QuickAction
+	for _, action := range actions {
+		text := strings.ToLower(strings.Join([]string{action.Label, action.Category,
+			action.CommandTemplate, action.Description}, " "))
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(text, word) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			matching = append(matching, action)
+		}
+	}
+	return matching
+}
+
+// runQuickAction asks for the placeholder values of a quick action and
+// runs its command like a typed one
+func (t *TUI) runQuickAction(action *proto.QuickAction) {
+	variables := client.QuickActionVariables(action)
+	if len(variables) == 0 {
+		t.submitCommand(action.CommandTemplate)
+		return
+	}
+
+	form := tview.NewForm()
+	for _, variable := range variables {
+		form.AddInputField(variable, "", 30, nil, nil)
+	}
+
+	closeDialog := func() {
+		t.pages.RemovePage("quickaction")
+		t.app.SetFocus(t.input)
+	}
+
+	run := func() {
+		values := make(map[string]string, len(variables))
+		for i, variable := range variables {
+			values[variable] = strings.TrimSpace(form.GetFormItem(i).(*tview.InputField).GetText())
+		}
+		closeDialog()
+
+		command, err := client.ExpandQuickAction(action, values)
+		if err != nil {
+			t.ShowError(err.Error())
+			return
+		}
+		t.submitCommand(command)
+	}
+
+	form.
+		AddButton(i18n.GetMessage("ui.run_button"), run).
+		AddButton(i18n.GetMessage("ui.cancel_button"), closeDialog).
+		SetCancelFunc(closeDialog)
+	form.SetBorder(true).
+		SetTitle(" " + tview.Escape(action.Label) + " ").
+		SetTitleAlign(tview.AlignCenter)
+	form.SetBackgroundColor(tcell.ColorBlack)
+
+	t.pages.AddPage("quickaction", centeredFlex(form, flowDialogWidth, 2*form.GetFormItemCount()+5), true, true)
+	t.app.SetFocus(form)
+}
+
+// handleActionsCommand processes the "actions [refresh]" client command
+func (t *TUI) handleActionsCommand(args string) {
+	refresh := false
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+	case "refresh":
+		refresh = true
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "actions [refresh]"))
+		return
+	}
+	if !t.client.IsLoggedIn() {
+		t.ShowError(i18n.GetMessage("error.not_logged_in"))
+		return
+	}
+
+	actions, err := t.client.GetQuickActions(refresh)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+	if len(actions) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.actions_none"))
+		return
+	}
+
+	// Grouped by category in the order the server sent them
+	var categories []string
+	byCategory := make(map[string][]*proto.QuickAction)
+	for _, action := range actions {
+		if _, ok := byCategory[action.Category]; !ok {
+			categories = append(categories, action.Category)
+		}
+		byCategory[action.Category] = append(byCategory[action.Category], action)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.actions_title"), len(actions)) + "\n")
+	for _, category := range categories {
+		if category != "" {
+			sb.WriteString(fmt.Sprintf("  [::b]%s[::-]\n", tview.Escape(category)))
+		}
+		for _, action := range byCategory[category] {
+			sb.WriteString(fmt.Sprintf("    %s %s  [yellow]%s[white]\n", quickActionIcon(action),
+				tview.Escape(action.Label), tview.Escape(action.CommandTemplate)))
+		}
+	}
+	t.output.Write([]byte(sb.String()))
+}
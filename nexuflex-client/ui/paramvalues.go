@@ -0,0 +1,182 @@
+// paramvalues.go
+/**
+ * Nexuflex Client - Parameter Defaults
+ *
+ * This file contains the parameter form ("params <command>"), which asks
+ * for the parameters of a server command with the last used values as
+ * defaults, the completion of the next parameter with its last used value
+ * and the "defaults" command, which shows and clears the remembered values.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// paramsDialogWidth is the width of the parameter form
+const paramsDialogWidth = 70
+
+// defaultsUsage is the syntax of the defaults command
+const defaultsUsage = "defaults [clear [<command>]]"
+
+// completeParameterDefault fills in the default of the next parameter when
+// the input ends with a space after a known command; returns whether it did
+func (t *TUI) completeParameterDefault(text string) bool {
+	if !strings.HasSuffix(text, " ") {
+		return false
+	}
+	name, args, _ := strings.Cut(strings.TrimSpace(text), " ")
+	if isReservedKeyword(name) {
+		return false
+	}
+	if resolved, _, err := t.client.ResolveCommand(name); err == nil {
+		name = resolved
+	}
+
+	_, parameters := t.client.ParameterDefaults(name)
+	next := len(client.SplitArguments(args))
+	if next >= len(parameters) || parameters[next].Value == "" {
+		return false
+	}
+
+	parameter := parameters[next]
+	t.input.SetText(text + client.QuoteArgument(parameter.Value))
+	hint := fmt.Sprintf("%s: [%s]", parameter.Name, parameter.Value)
+	t.showStatusMessage("[green]"+tview.Escape(hint)+"[white]", hint, 3*time.Second)
+	return true
+}
+
+// handleParamsCommand opens the parameter form of a command
+func (t *TUI) handleParamsCommand(args string) {
+	name := strings.TrimSpace(args)
+	if name == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "params <command>"))
+		return
+	}
+	if resolved, _, err := t.client.ResolveCommand(name); err == nil {
+		name = resolved
+	}
+
+	command, parameters := t.client.ParameterDefaults(name)
+	if parameters == nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.params_unknown"), name))
+		return
+	}
+	if len(parameters) == 0 {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.params_none"), command))
+		return
+	}
+
+	form := tview.NewForm()
+	for _, parameter := range parameters {
+		label := parameter.Name
+		if parameter.Required {
+			label += "*"
+		}
+		form.AddInputField(label, parameter.Value, 40, nil, nil)
+		form.GetFormItem(form.GetFormItemCount() - 1).(*tview.InputField).SetPlaceholder(parameter.Description)
+	}
+
+	closeDialog := func() {
+		t.pages.RemovePage("params")
+		t.app.SetFocus(t.input)
+	}
+
+	run := func() {
+		values := make([]string, len(parameters))
+		last := -1
+		for i, parameter := range parameters {
+			values[i] = strings.TrimSpace(form.GetFormItem(i).(*tview.InputField).GetText())
+			if values[i] == "" && parameter.Required {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.params_required"), parameter.Name))
+				form.SetFocus(i)
+				t.app.SetFocus(form)
+				return
+			}
+			if values[i] != "" {
+				last = i
+			}
+		}
+
+		// Optional parameters at the end are left out, gaps are sent empty
+		line := command
+		for _, value := range values[:last+1] {
+			line += " " + client.QuoteArgument(value)
+		}
+		closeDialog()
+		t.submitCommand(line)
+	}
+
+	form.
+		AddButton(i18n.GetMessage("ui.run_button"), run).
+		AddButton(i18n.GetMessage("ui.cancel_button"), closeDialog).
+		SetCancelFunc(closeDialog)
+	form.SetBorder(true).
+		SetTitle(fmt.Sprintf(i18n.GetMessage("ui.params_title"), command)).
+		SetTitleAlign(tview.AlignCenter)
+	form.SetBackgroundColor(tcell.ColorBlack)
+
+	t.pages.AddPage("params", centeredFlex(form, paramsDialogWidth, 2*form.GetFormItemCount()+5), true, true)
+	t.app.SetFocus(form)
+}
+
+// handleDefaultsCommand processes the "defaults" client command
+func (t *TUI) handleDefaultsCommand(args string) {
+	action, command, _ := strings.Cut(strings.TrimSpace(args), " ")
+	switch strings.ToLower(action) {
+	case "":
+		t.showRememberedParameters()
+
+	case "clear":
+		removed, err := t.client.ClearParameterValues(strings.TrimSpace(command))
+		if err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.params_save"), err))
+			return
+		}
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.defaults_cleared"), removed))
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), defaultsUsage))
+	}
+}
+
+// showRememberedParameters writes the remembered values of the connected server to the output
+func (t *TUI) showRememberedParameters() {
+	commands := t.client.RememberedParameters()
+	if len(commands) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.defaults_none"))
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[yellow]%s[white]\n", i18n.GetMessage("commands.defaults_title")))
+	for _, command := range commands {
+		sb.WriteString(fmt.Sprintf("  %s\n", tview.Escape(command)))
+		values := t.client.RememberedValues(command)
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sb.WriteString(fmt.Sprintf("    [gray]%s:[white] %s\n", tview.Escape(name), tview.Escape("["+values[name]+"]")))
+		}
+	}
+	if cfg := t.client.GetConfig(); cfg != nil && !cfg.Commands.RememberParameters {
+		sb.WriteString(fmt.Sprintf("[gray]%s[white]\n", i18n.GetMessage("commands.defaults_disabled")))
+	}
+	t.output.Write([]byte(sb.String()))
+}
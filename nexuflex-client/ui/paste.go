@@ -0,0 +1,188 @@
+// paste.go
+/**
+ * Nexuflex Client - Multi-Line Paste Preview
+ *
+ * This file contains the handling of multi-line pastes into the command
+ * line. Bracketed pastes are intercepted at the root primitive; terminals
+ * without bracketed paste are detected by lines arriving faster than
+ * anyone can type. Instead of sending every line to the server at once,
+ * the text is shown in a preview where it can be edited and executed
+ * completely or line by line after confirmation.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// Timing of the paste detection for terminals without bracketed paste
+const (
+	pasteKeyInterval  = 5 * time.Millisecond  // Enter this soon after the previous key is pasted
+	pasteQuietPeriod  = 50 * time.Millisecond // The paste ends after this time without keys
+	pastePreviewWidth = 80
+)
+
+// pasteRoot wraps the root primitive to intercept bracketed pastes
+type pasteRoot struct {
+	tview.Primitive
+	tui *TUI
+}
+
+// PasteHandler routes multi-line pastes into the command line to the preview
+func (p *pasteRoot) PasteHandler() func(pastedText string, setFocus func(p tview.Primitive)) {
+	handler := p.Primitive.PasteHandler()
+	return func(pastedText string, setFocus func(p tview.Primitive)) {
+		if p.tui.isPastePreviewEnabled() && p.tui.app.GetFocus() == p.tui.input {
+			lines := splitPastedLines(p.tui.input.GetText() + pastedText)
+			if len(lines) > 1 {
+				p.tui.input.SetText("")
+				p.tui.showPastePreview(lines)
+				return
+			}
+		}
+		if handler != nil {
+			handler(pastedText, setFocus)
+		}
+	}
+}
+
+// isPastePreviewEnabled checks whether multi-line pastes are previewed
+func (t *TUI) isPastePreviewEnabled() bool {
+	cfg := t.client.GetConfig()
+	return cfg == nil || cfg.UI.PastePreview
+}
+
+// detectUnbracketedPaste collects lines that arrive faster than typing; returns true if the key was consumed
+func (t *TUI) detectUnbracketedPaste(event *tcell.EventKey) bool {
+	now := time.Now()
+	quick := now.Sub(t.lastKeyTime) < pasteKeyInterval
+	t.lastKeyTime = now
+
+	// Collecting a paste: every Enter ends a line
+	if t.pasteLines != nil {
+		t.pasteTimer.Reset(pasteQuietPeriod)
+		if event.Key() == tcell.KeyEnter {
+			t.pasteLines = append(t.pasteLines, t.input.GetText())
+			t.input.SetText("")
+			return true
+		}
+		return false
+	}
+
+	if event.Key() != tcell.KeyEnter || !quick || !t.isPastePreviewEnabled() || t.input.GetText() == "" {
+		return false
+	}
+
+	t.pasteLines = []string{t.input.GetText()}
+	t.input.SetText("")
+	t.pasteTimer = time.AfterFunc(pasteQuietPeriod, func() {
+		t.app.QueueUpdateDraw(t.finishUnbracketedPaste)
+	})
+	return true
+}
+
+// finishUnbracketedPaste shows the collected lines once no more keys arrive
+func (t *TUI) finishUnbracketedPaste() {
+	if t.pasteLines == nil {
+		return
+	}
+	lines := t.pasteLines
+	if rest := t.input.GetText(); rest != "" {
+		lines = append(lines, rest)
+	}
+	t.pasteLines = nil
+	t.input.SetText("")
+
+	lines = splitPastedLines(strings.Join(lines, "\n"))
+	if len(lines) == 1 {
+		// A single pasted line is not executed without pressing Enter
+		t.input.SetText(lines[0])
+		return
+	}
+	t.showPastePreview(lines)
+}
+
+// splitPastedLines splits pasted text into lines without empty lines
+func splitPastedLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// showPastePreview shows the pasted lines for editing and execution
+func (t *TUI) showPastePreview(lines []string) {
+	form := tview.NewForm().
+		AddTextArea("", strings.Join(lines, "\n"), 0, 0, 0, nil)
+	area := form.GetFormItem(0).(*tview.TextArea)
+
+	closePreview := func() {
+		t.pages.RemovePage("paste")
+		t.app.SetFocus(t.input)
+	}
+	editedLines := func() []string {
+		return splitPastedLines(area.GetText())
+	}
+
+	form.
+		AddButton(i18n.GetMessage("ui.paste_execute_all"), func() {
+			lines := editedLines()
+			closePreview()
+			for _, line := range lines {
+				t.submitCommand(line)
+			}
+		}).
+		AddButton(i18n.GetMessage("ui.paste_step"), func() {
+			lines := editedLines()
+			closePreview()
+			t.stepPastedLines(lines, 0)
+		}).
+		AddButton(i18n.GetMessage("ui.cancel_button"), closePreview).
+		SetCancelFunc(closePreview)
+	form.SetBorder(true).
+		SetTitle(fmt.Sprintf(i18n.GetMessage("ui.paste_title"), len(lines))).
+		SetTitleAlign(tview.AlignCenter)
+	form.SetBackgroundColor(tcell.ColorBlack)
+
+	height := len(lines) + 6
+	if height > 20 {
+		height = 20
+	}
+	t.pages.AddPage("paste", centeredFlex(form, pastePreviewWidth, height), true, true)
+	t.app.SetFocus(form)
+}
+
+// stepPastedLines asks for each line before executing it; declining skips the line,
+// closing the dialog with Escape stops the remaining lines
+func (t *TUI) stepPastedLines(lines []string, index int) {
+	if index >= len(lines) {
+		return
+	}
+
+	next := func() {
+		t.stepPastedLines(lines, index+1)
+	}
+	t.showChoice(fmt.Sprintf(i18n.GetMessage("ui.paste_confirm_line"), index+1, len(lines), lines[index]),
+		func() {
+			t.submitCommand(lines[index])
+			next()
+		},
+		next)
+}
@@ -0,0 +1,124 @@
+// query.go
+/**
+ * Nexuflex Client - Query Command
+ *
+ * This file contains the "query" client command, which evaluates a SQL-like
+ * query against structured results on the client, e.g.
+ * query "select region, sum(amount) from last group by region".
+ * "last" is the most recent table; "query save <name>" keeps it under a
+ * name for the session so later queries can refer to it. The result is
+ * written as a table and becomes the most recent table itself, so it can
+ * be opened with "table" or queried again.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// lastTableName refers to the most recent table in queries
+const lastTableName = "last"
+
+// queryTable is a table saved for queries with the command it came from
+type queryTable struct {
+	name    string
+	command string
+	table   *proto.TableResult
+}
+
+// handleQueryCommand processes "query <statement>", "query save <name>" and "query tables"
+func (t *TUI) handleQueryCommand(args string) {
+	args = strings.TrimSpace(args)
+	fields := strings.Fields(args)
+	switch {
+	case len(fields) == 0:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), `query "<select statement>" | query save <name> | query tables`))
+		return
+	case strings.EqualFold(fields[0], "save") && len(fields) == 2:
+		t.saveQueryTable(fields[1])
+		return
+	case strings.EqualFold(fields[0], "tables") && len(fields) == 1:
+		t.listQueryTables()
+		return
+	}
+
+	// The statement may be quoted as a whole
+	statement := args
+	if len(statement) >= 2 && strings.HasPrefix(statement, `"`) && strings.HasSuffix(statement, `"`) &&
+		len(strings.Fields(statement)) > 1 {
+		statement = statement[1 : len(statement)-1]
+	}
+
+	result, err := client.QueryTable(statement, t.lookupQueryTable)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.query"), tview.Escape(err.Error())))
+		return
+	}
+
+	t.lastTable = result
+	t.lastTableCommand = "query " + statement
+	t.output.Write([]byte(alignTable(result)))
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.query_result"), len(result.Rows), len(result.Columns)))))
+}
+
+// lookupQueryTable returns the table a query refers to by name
+func (t *TUI) lookupQueryTable(name string) (*proto.TableResult, bool) {
+	if strings.EqualFold(name, lastTableName) {
+		return t.lastTable, t.lastTable != nil
+	}
+	stored, ok := t.queryTables[strings.ToLower(name)]
+	return stored.table, ok
+}
+
+// saveQueryTable keeps the most recent table under a name
+func (t *TUI) saveQueryTable(name string) {
+	if t.lastTable == nil {
+		t.ShowError(i18n.GetMessage("error.table_none"))
+		return
+	}
+	if strings.EqualFold(name, lastTableName) || strings.ContainsAny(name, `"'`+"`") {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.query_table_name"), tview.Escape(name)))
+		return
+	}
+	if t.queryTables == nil {
+		t.queryTables = make(map[string]queryTable)
+	}
+	t.queryTables[strings.ToLower(name)] = queryTable{name: name, command: t.lastTableCommand, table: t.lastTable}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.query_saved"), tview.Escape(name), len(t.lastTable.Rows)))
+}
+
+// listQueryTables lists the tables saved for queries
+func (t *TUI) listQueryTables() {
+	if len(t.queryTables) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.query_no_tables"))
+		return
+	}
+
+	keys := make([]string, 0, len(t.queryTables))
+	for key := range t.queryTables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(i18n.GetMessage("commands.query_tables_title") + "\n")
+	for _, key := range keys {
+		stored := t.queryTables[key]
+		sb.WriteString(fmt.Sprintf("  [yellow]%s[white]  %s  [gray](%d × %d)[white]\n", tview.Escape(stored.name),
+			tview.Escape(stored.command), len(stored.table.Rows), len(stored.table.Columns)))
+	}
+	t.output.Write([]byte(sb.String()))
+}
@@ -0,0 +1,57 @@
+// readonly.go
+/**
+ * Nexuflex Client - Read-Only Mode Command
+ *
+ * This file contains the "readonly" client command and the marking of a
+ * read-only session: a yellow badge in the status bar and a note in the
+ * header, so that nobody wonders why a change is refused.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleReadOnlyCommand processes the "readonly [on|off]" client command
+func (t *TUI) handleReadOnlyCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		if t.client.IsReadOnly() {
+			t.ShowInfo(i18n.GetMessage("commands.readonly_on"))
+		} else {
+			t.ShowInfo(i18n.GetMessage("commands.readonly_off"))
+		}
+		return
+
+	case "on":
+		t.client.SetReadOnly(true)
+		t.ShowInfo(i18n.GetMessage("commands.readonly_on"))
+
+	case "off":
+		t.client.SetReadOnly(false)
+		t.ShowInfo(i18n.GetMessage("commands.readonly_off"))
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "readonly [on|off]"))
+		return
+	}
+
+	t.renderStatus()
+	t.updateSessionHeader()
+}
+
+// readOnlyBadge returns the status bar badge of a session in read-only mode
+func (t *TUI) readOnlyBadge() string {
+	if !t.client.IsReadOnly() {
+		return ""
+	}
+	return fmt.Sprintf("[black:yellow] %s [-:-]", i18n.GetMessage("status.read_only"))
+}
@@ -0,0 +1,79 @@
+// receipts.go
+/**
+ * Nexuflex Client - Outcome of Critical Commands
+ *
+ * This file contains the display of the outcome of critical commands that
+ * were sent before a network drop and reconciled after the next login.
+ * Commands the server never received can be sent again or discarded.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// handleCommandReconciled shows the outcome of a critical command; called from the background
+// work of the session, which Close waits for, so it does not wait for the UI
+func (t *TUI) handleCommandReconciled(pending client.PendingCommand, status *proto.CommandStatusResponse) {
+	go t.app.QueueUpdateDraw(func() {
+		command := tview.Escape(pending.Command)
+		sent := pending.SentAt.Format("2006-01-02 15:04:05")
+
+		switch status.Status {
+		case proto.CommandStatusResponse_COMPLETED:
+			t.output.Write([]byte(fmt.Sprintf("[green]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.receipt_completed"), command, sent))))
+			if status.Output != "" {
+				t.handleOutput(status.Output)
+			}
+		case proto.CommandStatusResponse_FAILED:
+			t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.receipt_failed"), command, sent, tview.Escape(status.Output)))))
+		case proto.CommandStatusResponse_RUNNING:
+			t.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.receipt_running"), command, sent))))
+		case proto.CommandStatusResponse_PENDING_APPROVAL:
+			t.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.receipt_pending_approval"), command, sent))))
+			t.updateStatus("", t.lastStatusInfo)
+		default:
+			t.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("commands.receipt_not_received"), command, sent))))
+			t.unsentCommands = append(t.unsentCommands, pending)
+			if !t.pages.HasPage("modal") {
+				t.askResendCommand()
+			}
+		}
+	})
+}
+
+// askResendCommand asks whether the next command the server never received should be
+// sent again; a command whose dialog is closed with Escape stays in the log for the next login
+func (t *TUI) askResendCommand() {
+	if len(t.unsentCommands) == 0 {
+		return
+	}
+	pending := t.unsentCommands[0]
+	t.unsentCommands = t.unsentCommands[1:]
+
+	t.showChoice(fmt.Sprintf(i18n.GetMessage("commands.confirm_resend"), pending.Command),
+		func() {
+			t.handleCommandError(t.client.ResendCommand(pending))
+			t.askResendCommand()
+		},
+		func() {
+			t.client.DiscardCommand(pending)
+			t.ShowInfo(i18n.GetMessage("commands.command_discarded"))
+			t.askResendCommand()
+		})
+}
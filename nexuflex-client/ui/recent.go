@@ -0,0 +1,108 @@
+// recent.go
+/**
+ * Nexuflex Client - Recent Servers Picker
+ *
+ * This file contains the picker for recently connected servers (Ctrl+R)
+ * and the "recent" client command, which reconnect to a server with a
+ * single keystroke and log in again with stored credentials.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// showRecentServers fills the picker with the recently used servers and shows it
+func (t *TUI) showRecentServers() {
+	servers := t.client.GetRecentServers()
+	if len(servers) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.recent_none"))
+		return
+	}
+
+	t.recentList.Clear()
+	for i, server := range servers {
+		title := fmt.Sprintf("%s (%s:%d)", server.Name, server.Address, server.Port)
+		if server.Name == "" {
+			title = fmt.Sprintf("%s:%d", server.Address, server.Port)
+		}
+
+		var shortcut rune
+		if i < 9 {
+			shortcut = rune('1' + i)
+		}
+
+		t.recentList.AddItem(tview.Escape(title), tview.Escape(formatRecentDetails(server)), shortcut, func(server client.RecentServer) func() {
+			return func() {
+				t.pages.SwitchToPage("main")
+				t.connectRecent(server)
+			}
+		}(server))
+	}
+
+	t.pages.SwitchToPage("recent")
+}
+
+// formatRecentDetails returns the secondary text of a recent server entry
+func formatRecentDetails(server client.RecentServer) string {
+	user := server.LastUser
+	if user == "" {
+		user = "-"
+	}
+	context := server.LastContext
+	if context == "" {
+		context = "-"
+	}
+	return fmt.Sprintf(i18n.GetMessage("commands.recent_details"),
+		user, context, server.LastUsed.Format("2006-01-02 15:04"))
+}
+
+// connectRecent reconnects to a recent server in the background
+func (t *TUI) connectRecent(server client.RecentServer) {
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.recent_connecting"), server.Address, server.Port))
+
+	go func() {
+		loggedIn, err := t.client.ConnectRecent(server)
+		t.app.QueueUpdateDraw(func() {
+			if err != nil {
+				t.ShowError(err.Error())
+				return
+			}
+
+			t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.connected"), server.Address, server.Port))
+			if !loggedIn {
+				// No stored credentials: continue with the login dialog
+				t.pages.SwitchToPage("login")
+			}
+		})
+	}()
+}
+
+// handleRecentCommand processes the "recent [n]" client command
+func (t *TUI) handleRecentCommand(args string) {
+	args = strings.TrimSpace(args)
+	if args == "" {
+		t.showRecentServers()
+		return
+	}
+
+	servers := t.client.GetRecentServers()
+	index, err := strconv.Atoi(args)
+	if err != nil || index < 1 || index > len(servers) {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.recent_index"), args))
+		return
+	}
+
+	t.connectRecent(servers[index-1])
+}
@@ -0,0 +1,84 @@
+// reconnect.go
+/**
+ * Nexuflex Client - Reconnect Boundaries
+ *
+ * This file contains the handling of lost connections and reconnects in
+ * the user interface. The output, the history position and the job list
+ * are kept across a reconnect to the same server; a marked line in the
+ * output shows where the connection was lost and where it was restored.
+ * With `keep_state_on_reconnect = false` the output is cleared instead.
+ * A failover to another node of the cluster is marked with both nodes.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// handleConnectionLost marks the lost connection in the output
+func (t *TUI) handleConnectionLost(server string, err error) {
+	t.app.QueueUpdateDraw(func() {
+		t.writeConnectionBoundary("red", fmt.Sprintf(i18n.GetMessage("commands.connection_lost"),
+			server, time.Now().Format("15:04:05"), err))
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.reconnecting"), server))
+	})
+}
+
+// handleReconnected marks the restored connection in the output, or reports
+// that reconnecting failed
+func (t *TUI) handleReconnected(server string, loggedIn bool, err error) {
+	t.app.QueueUpdateDraw(func() {
+		if err != nil {
+			t.writeConnectionBoundary("red", fmt.Sprintf(i18n.GetMessage("error.reconnect_failed"), server, err))
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.reconnect_failed"), server, err))
+			return
+		}
+
+		t.showRestoredConnection(fmt.Sprintf(i18n.GetMessage("commands.reconnected"), server,
+			time.Now().Format("15:04:05")), t.client.KeepsStateOnReconnect(), loggedIn)
+	})
+}
+
+// handleFailover marks the node of the cluster the client failed over to
+func (t *TUI) handleFailover(from, to string, loggedIn, sessionKept bool) {
+	t.app.QueueUpdateDraw(func() {
+		key := "commands.failed_over_login"
+		if sessionKept {
+			key = "commands.failed_over"
+		}
+		t.showRestoredConnection(fmt.Sprintf(i18n.GetMessage(key), from, to, time.Now().Format("15:04:05")),
+			t.client.KeepsStateOnReconnect(), loggedIn)
+	})
+}
+
+// showRestoredConnection marks the restored connection in the output,
+// clearing it first unless the state was kept
+func (t *TUI) showRestoredConnection(message string, keepOutput, loggedIn bool) {
+	if !keepOutput {
+		t.output.SetText("")
+		t.clearReferences()
+		t.forgetWatchHits(t.output)
+	}
+	t.writeConnectionBoundary("green", message)
+	t.ShowInfo(message)
+	t.renderStatus()
+
+	if !loggedIn {
+		// No stored credentials: continue with the login dialog
+		t.pages.SwitchToPage("login")
+	}
+}
+
+// writeConnectionBoundary writes a line marking a disconnect or reconnect into the output
+func (t *TUI) writeConnectionBoundary(color, text string) {
+	t.output.Write([]byte(fmt.Sprintf("[%s]──── %s ────[white]\n", color, tview.Escape(text))))
+}
@@ -0,0 +1,284 @@
+// recording.go
+/**
+ * Nexuflex Client - Session Recording Commands
+ *
+ * This file contains the "record" client command, which records the
+ * screen of the session into an asciicast v2 file that asciinema can
+ * replay. After every draw, the rows that changed since the last frame
+ * are taken from the screen and written as ANSI output, so the recording
+ * shows exactly what the operator saw, including dialogs and the status
+ * bar.
+ *
+ * Before a frame is written, a redaction pass blanks the secret fields of
+ * the login dialog (hiding even the length of the password) and masks
+ * credentials shown as "password=...", "token: ..." and the like anywhere
+ * on the screen.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// recordUsage is the syntax of the record command
+const recordUsage = "record start <file> | stop"
+
+// screenRecorder records the frames of the screen into a session recording
+type screenRecorder struct {
+	recording     *client.SessionRecording
+	width, height int
+	rows          []string // Rows of the last recorded frame, as written
+}
+
+// recordedCell is a screen cell of a frame being recorded
+type recordedCell struct {
+	text   string
+	style  tcell.Style
+	offset int // Byte offset of the cell in the plain text of the row
+}
+
+// handleRecordCommand processes the "record" client command
+func (t *TUI) handleRecordCommand(args string) {
+	action, path, _ := strings.Cut(strings.TrimSpace(args), " ")
+	path = strings.TrimSpace(path)
+
+	switch strings.ToLower(action) {
+	case "":
+		if t.recorder == nil {
+			t.ShowInfo(i18n.GetMessage("commands.record_off"))
+			return
+		}
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.record_on"), t.recorder.recording.Path(),
+			t.recorder.recording.Duration().Round(time.Second)))
+
+	case "start":
+		if path == "" {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), recordUsage))
+			return
+		}
+		t.startRecording(path)
+
+	case "stop":
+		t.stopRecording()
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), recordUsage))
+	}
+}
+
+// startRecording starts recording the screen into an asciicast file
+func (t *TUI) startRecording(path string) {
+	if t.recorder != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.record_running"), t.recorder.recording.Path()))
+		return
+	}
+	if t.screen == nil {
+		return
+	}
+
+	width, height := t.screen.Size()
+	recording, err := client.StartSessionRecording(path, width, height, strings.TrimSpace("nexuflex "+t.client.Environment()))
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.record"), err))
+		return
+	}
+	t.recorder = &screenRecorder{recording: recording}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.record_started"), path))
+	t.renderStatus()
+}
+
+// stopRecording ends the recording of the screen
+func (t *TUI) stopRecording() {
+	if t.recorder == nil {
+		t.ShowError(i18n.GetMessage("commands.record_off"))
+		return
+	}
+
+	recording := t.recorder.recording
+	t.recorder = nil
+	t.renderStatus()
+	if err := recording.Close(); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.record"), err))
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.record_stopped"), recording.Path(),
+		recording.Duration().Round(time.Second)))
+}
+
+// recordingBadge returns the status bar segment shown while the screen is recorded
+func (t *TUI) recordingBadge() string {
+	if t.recorder == nil {
+		return ""
+	}
+	return "[red]● " + i18n.GetMessage("status.recording_session") + "[white]"
+}
+
+// recordFrame writes the rows of the screen that changed since the last
+// frame to the recording; called after every draw
+func (t *TUI) recordFrame(screen tcell.Screen) {
+	r := t.recorder
+	if r == nil {
+		return
+	}
+
+	var sb strings.Builder
+	width, height := screen.Size()
+	if r.rows == nil || width != r.width || height != r.height {
+		if r.rows != nil {
+			r.recording.Resize(width, height)
+		}
+		r.width, r.height = width, height
+		r.rows = make([]string, height)
+		sb.WriteString("\x1b[?25l\x1b[2J")
+	}
+
+	secret := t.secretFieldRects()
+	for y := 0; y < height; y++ {
+		row := renderRecordedRow(recordedRow(screen, y, width, secret))
+		if row == r.rows[y] {
+			continue
+		}
+		r.rows[y] = row
+		sb.WriteString("\x1b[" + strconv.Itoa(y+1) + ";1H" + row)
+	}
+	if sb.Len() == 0 {
+		return
+	}
+
+	if err := r.recording.Output(sb.String()); err != nil {
+		// Stop instead of failing on every frame, e.g. when the disk is full
+		t.recorder = nil
+		r.recording.Close()
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.record"), err))
+	}
+}
+
+// secretFieldRects returns the areas of the secret fields shown on the screen
+func (t *TUI) secretFieldRects() [][4]int {
+	if name, _ := t.pages.GetFrontPage(); name != "login" || t.loginForm == nil {
+		return nil
+	}
+
+	var rects [][4]int
+	for i, field := range t.client.GetAuthProvider().Fields() {
+		if !field.Secret || i >= t.loginForm.GetFormItemCount() {
+			continue
+		}
+		x, y, width, height := t.loginForm.GetFormItem(i).(*tview.InputField).GetRect()
+		rects = append(rects, [4]int{x, y, width, height})
+	}
+	return rects
+}
+
+// recordedRow reads a row of the screen and applies the redaction pass
+func recordedRow(screen tcell.Screen, y, width int, secret [][4]int) []recordedCell {
+	cells := make([]recordedCell, 0, width)
+	var plain strings.Builder
+	for x := 0; x < width; x++ {
+		mainc, combc, style, cellWidth := screen.GetContent(x, y)
+		text := string(mainc) + string(combc)
+		if mainc == 0 {
+			text = " "
+		}
+		// The mask characters of a secret field reveal the length of the secret
+		if mainc == '*' && insideRects(x, y, secret) {
+			text = " "
+		}
+		cells = append(cells, recordedCell{text: text, style: style, offset: plain.Len()})
+		plain.WriteString(text)
+		if cellWidth > 1 {
+			x += cellWidth - 1
+		}
+	}
+
+	// Mask credentials like "password=..." in the text of the row
+	for _, secretRange := range client.SecretRanges(plain.String()) {
+		for i := range cells {
+			if cells[i].offset >= secretRange[0] && cells[i].offset < secretRange[1] {
+				cells[i].text = "*"
+			}
+		}
+	}
+	return cells
+}
+
+// renderRecordedRow renders the cells of a row as text with ANSI colors
+func renderRecordedRow(cells []recordedCell) string {
+	var sb strings.Builder
+	var current tcell.Style
+	first := true
+	for _, cell := range cells {
+		if first || cell.style != current {
+			sb.WriteString(ansiStyle(cell.style))
+			current, first = cell.style, false
+		}
+		sb.WriteString(cell.text)
+	}
+	sb.WriteString("\x1b[0m")
+	return sb.String()
+}
+
+// ansiStyle returns the SGR escape sequence of a style
+func ansiStyle(style tcell.Style) string {
+	fg, bg, attrs := style.Decompose()
+	codes := []string{"0"}
+	for _, attr := range []struct {
+		mask tcell.AttrMask
+		code string
+	}{
+		{tcell.AttrBold, "1"},
+		{tcell.AttrDim, "2"},
+		{tcell.AttrItalic, "3"},
+		{tcell.AttrUnderline, "4"},
+		{tcell.AttrBlink, "5"},
+		{tcell.AttrReverse, "7"},
+		{tcell.AttrStrikeThrough, "9"},
+	} {
+		if attrs&attr.mask != 0 {
+			codes = append(codes, attr.code)
+		}
+	}
+	if code := ansiColor(fg, "38"); code != "" {
+		codes = append(codes, code)
+	}
+	if code := ansiColor(bg, "48"); code != "" {
+		codes = append(codes, code)
+	}
+	return "\x1b[" + strings.Join(codes, ";") + "m"
+}
+
+// ansiColor returns the SGR parameters of a color, empty for the default color
+func ansiColor(color tcell.Color, prefix string) string {
+	switch {
+	case !color.Valid():
+		return ""
+	case color.IsRGB():
+		r, g, b := color.RGB()
+		return fmt.Sprintf("%s;2;%d;%d;%d", prefix, r, g, b)
+	default:
+		return fmt.Sprintf("%s;5;%d", prefix, color-tcell.ColorValid)
+	}
+}
+
+// insideRects checks whether a cell lies in one of the areas
+func insideRects(x, y int, rects [][4]int) bool {
+	for _, rect := range rects {
+		if x >= rect[0] && x < rect[0]+rect[2] && y >= rect[1] && y < rect[1]+rect[3] {
+			return true
+		}
+	}
+	return false
+}
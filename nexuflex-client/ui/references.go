@@ -0,0 +1,205 @@
+// references.go
+/**
+ * Nexuflex Client - Actionable References in the Output
+ *
+ * This file contains the rendering of configured references (ticket or
+ * document IDs, etc.) as highlighted regions in the output area. If the
+ * terminal supports it, URL targets are additionally emitted as OSC 8
+ * hyperlinks. A reference is activated with a mouse click or by selecting
+ * it with Ctrl+G and pressing Enter on an empty command line.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// maxReferences is the number of rendered references that remain actionable
+const maxReferences = 500
+
+// initReferences compiles the configured reference rules and enables regions in the output
+func (t *TUI) initReferences() {
+	t.references = make(map[string]client.Reference)
+	t.folds = make(map[string]*foldedOutput)
+
+	rules, err := client.ParseReferenceRules(t.client.GetConfig().References)
+	t.referenceRules = rules
+	if err != nil {
+		t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.references"), err))))
+	}
+
+	t.output.SetRegions(true).
+		SetHighlightedFunc(t.handleReferenceHighlight)
+}
+
+// decorateReferences marks all references in a text as regions (and hyperlinks)
+func (t *TUI) decorateReferences(text string) string {
+	if len(t.referenceRules) == 0 {
+		return text
+	}
+
+	refs := client.FindReferences(t.referenceRules, text)
+	if len(refs) == 0 {
+		return text
+	}
+
+	hyperlinks := t.client.GetConfig().UI.Hyperlinks
+
+	var sb strings.Builder
+	last := 0
+	for _, ref := range refs {
+		id := t.registerReference(ref)
+
+		sb.WriteString(text[last:ref.Start])
+		if hyperlinks && ref.IsURL() && !strings.ContainsAny(ref.Target, "[]") {
+			sb.WriteString(fmt.Sprintf(`["%s"][aqua::u:%s]%s[-::-:-][""]`, id, ref.Target, ref.Text))
+		} else {
+			sb.WriteString(fmt.Sprintf(`["%s"][aqua::u]%s[-::-][""]`, id, ref.Text))
+		}
+		last = ref.End
+	}
+	sb.WriteString(text[last:])
+
+	return sb.String()
+}
+
+// registerReference stores a reference under a new region ID
+func (t *TUI) registerReference(ref client.Reference) string {
+	t.referenceCounter++
+	id := fmt.Sprintf("ref-%d", t.referenceCounter)
+
+	t.references[id] = ref
+	t.referenceOrder = append(t.referenceOrder, id)
+
+	// Forget the oldest references
+	if len(t.referenceOrder) > maxReferences {
+		delete(t.references, t.referenceOrder[0])
+		t.referenceOrder = t.referenceOrder[1:]
+	}
+
+	return id
+}
+
+// clearReferences forgets all rendered references (e.g. when the output is cleared)
+func (t *TUI) clearReferences() {
+	t.references = make(map[string]client.Reference)
+	t.referenceOrder = nil
+	t.selectedReference = ""
+	t.folds = make(map[string]*foldedOutput)
+	t.foldOrder = nil
+}
+
+// handleReferenceHighlight activates a reference clicked with the mouse
+func (t *TUI) handleReferenceHighlight(added, removed, remaining []string) {
+	if t.selectingReference || len(added) == 0 {
+		return
+	}
+
+	ref, ok := t.references[added[0]]
+	t.output.Highlight()
+	t.app.SetFocus(t.input)
+	if _, isFold := t.folds[added[0]]; isFold {
+		t.expandFold(added[0])
+		return
+	}
+	if ok {
+		t.activateReference(ref)
+	}
+}
+
+// selectNextReference highlights the previous visible reference, starting with the newest one
+func (t *TUI) selectNextReference() {
+	if len(t.referenceOrder) == 0 {
+		return
+	}
+
+	// Walk backwards from the current selection, wrapping around
+	index := len(t.referenceOrder) - 1
+	for i, id := range t.referenceOrder {
+		if id == t.selectedReference {
+			index = (i - 1 + len(t.referenceOrder)) % len(t.referenceOrder)
+			break
+		}
+	}
+
+	t.selectedReference = t.referenceOrder[index]
+
+	t.selectingReference = true
+	t.output.Highlight(t.selectedReference).ScrollToHighlight()
+	t.selectingReference = false
+
+	if fold, ok := t.folds[t.selectedReference]; ok {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.fold_selected"), groupDigits(len(fold.hiddenLines()))))
+		return
+	}
+	ref := t.references[t.selectedReference]
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.reference_selected"), ref.Text, ref.Target))
+}
+
+// activateSelectedReference activates the reference selected with the keyboard
+func (t *TUI) activateSelectedReference() bool {
+	if t.selectedReference == "" {
+		return false
+	}
+
+	id := t.selectedReference
+	ref, ok := t.references[id]
+	t.selectedReference = ""
+	t.selectingReference = true
+	t.output.Highlight()
+	t.selectingReference = false
+
+	if _, isFold := t.folds[id]; isFold {
+		t.expandFold(id)
+		return true
+	}
+	if ok {
+		t.activateReference(ref)
+	}
+	return ok
+}
+
+// activateReference opens the URL or runs the drill-down command of a reference
+func (t *TUI) activateReference(ref client.Reference) {
+	t.client.GetTelemetry().RecordFeature("reference")
+
+	if ref.IsURL() {
+		if err := openURL(ref.Target); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.open_url"), ref.Target, err))
+			return
+		}
+		t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.reference_opened"), ref.Target) + "\n"))
+		return
+	}
+
+	// Run the drill-down command as if it had been typed
+	t.input.SetText(ref.Target)
+	t.handleCommand(tcell.KeyEnter)
+}
+
+// openURL opens a URL in the default browser of the platform
+func openURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	case "darwin":
+		cmd = exec.Command("open", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
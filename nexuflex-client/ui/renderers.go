@@ -0,0 +1,291 @@
+// renderers.go
+/**
+ * Nexuflex Client - Output Renderers
+ *
+ * This file contains the rendering of command results by their declared
+ * content type. The renderer of a type is looked up among the renderers
+ * of the extensions first and then in builtinRenderers, the one place new
+ * formats are added: Markdown is formatted (markdown.go), JSON is
+ * indented and colored, CSV is aligned into columns and can be opened in
+ * the table view, "table" opens the table view right away and "chart"
+ * draws a bar chart. Results of other types, and results a renderer fails
+ * on, are shown as plain text.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+	"github.com/rivo/uniseg"
+)
+
+// builtinRenderer renders content of a type for the output area
+type builtinRenderer func(t *TUI, content plugin.Content) (string, error)
+
+// builtinRenderers maps the content types to the renderers of the client
+var builtinRenderers = map[string]builtinRenderer{
+	"application/json": (*TUI).renderJSON,
+	"text/markdown":    (*TUI).renderMarkdown,
+	"text/csv":         (*TUI).renderCSV,
+	"table":            (*TUI).renderTable,
+	"chart":            (*TUI).renderChart,
+}
+
+// Colors of the JSON renderer
+const (
+	jsonKeyColor     = "aqua"
+	jsonStringColor  = "green"
+	jsonNumberColor  = "yellow"
+	jsonLiteralColor = "fuchsia"
+)
+
+// chartBar is the character of the bars of a chart
+const chartBar = "█"
+
+// handleContent is called by the client with a result of a declared content type
+func (t *TUI) handleContent(command, contentType, content string) {
+	// Copying the result copies what the server sent
+	t.recordResult(content)
+
+	_, _, width, _ := t.output.GetInnerRect()
+	rendered, err := t.renderContent(plugin.Content{
+		Type:    contentType,
+		Command: command,
+		Body:    content,
+		Width:   width,
+	})
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.render_failed"), contentType, err))
+		rendered = content
+	}
+	t.showOutput(rendered)
+}
+
+// renderContent renders content with the renderer of its type; content
+// without a renderer is returned unchanged
+func (t *TUI) renderContent(content plugin.Content) (string, error) {
+	if renderer, ok := plugin.FindRenderer(content.Type); ok {
+		return plugin.Render(renderer, t, content)
+	}
+	if renderer, ok := builtinRenderers[content.Type]; ok {
+		return renderer(t, content)
+	}
+	return content.Body, nil
+}
+
+// renderJSON indents a JSON document and colors its tokens
+func (t *TUI) renderJSON(content plugin.Content) (string, error) {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(strings.TrimSpace(content.Body)), "", "  "); err != nil {
+		return "", err
+	}
+	return colorizeJSON(indented.String()), nil
+}
+
+// colorizeJSON colors the keys, strings, numbers and literals of indented JSON
+func colorizeJSON(text string) string {
+	var sb strings.Builder
+	for i := 0; i < len(text); {
+		ch := text[i]
+		end := i + 1
+		color := ""
+
+		switch {
+		case ch == '"':
+			for end < len(text) && text[end] != '"' {
+				if text[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end+1, len(text))
+			color = jsonStringColor
+			if strings.HasPrefix(strings.TrimLeft(text[end:], " "), ":") {
+				color = jsonKeyColor
+			}
+		case ch == '-' || (ch >= '0' && ch <= '9'):
+			for end < len(text) && strings.IndexByte("+-.0123456789eE", text[end]) >= 0 {
+				end++
+			}
+			color = jsonNumberColor
+		case ch >= 'a' && ch <= 'z':
+			for end < len(text) && text[end] >= 'a' && text[end] <= 'z' {
+				end++
+			}
+			color = jsonLiteralColor
+		}
+
+		if color == "" {
+			sb.WriteByte(ch)
+		} else {
+			sb.WriteString("[" + color + "]" + tview.Escape(text[i:end]) + "[-]")
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+// renderCSV aligns CSV data into columns; the data can also be opened in the table view
+func (t *TUI) renderCSV(content plugin.Content) (string, error) {
+	table, err := csvTable(content.Body)
+	if err != nil {
+		return "", err
+	}
+	t.lastTable = table
+	t.lastTableCommand = content.Command
+
+	return alignTable(table) + fmt.Sprintf("[gray]%s[white]",
+		fmt.Sprintf(i18n.GetMessage("commands.table_received"), len(table.Rows), len(table.Columns))), nil
+}
+
+// renderTable opens CSV data in the table view
+func (t *TUI) renderTable(content plugin.Content) (string, error) {
+	table, err := csvTable(content.Body)
+	if err != nil {
+		return "", err
+	}
+	t.lastTable = table
+	t.lastTableCommand = content.Command
+	t.showResultTable(content.Command, table)
+
+	return fmt.Sprintf("[gray]%s[white]",
+		fmt.Sprintf(i18n.GetMessage("commands.table_received"), len(table.Rows), len(table.Columns))), nil
+}
+
+// renderChart draws a horizontal bar chart of "label,value" lines; a first
+// line whose value is not a number is the heading
+func (t *TUI) renderChart(content plugin.Content) (string, error) {
+	records, err := csv.NewReader(strings.NewReader(content.Body)).ReadAll()
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if len(records) > 0 && len(records[0]) >= 2 {
+		if _, ok := parseResultNumber(records[0][1]); !ok {
+			sb.WriteString(fmt.Sprintf("[::b]%s[::-]\n", tview.Escape(strings.Join(records[0], " / "))))
+			records = records[1:]
+		}
+	}
+
+	labels := make([]string, 0, len(records))
+	values := make([]float64, 0, len(records))
+	labelWidth, valueWidth, largest := 0, 0, 0.0
+	for _, record := range records {
+		if len(record) < 2 {
+			return "", fmt.Errorf("line %q has no value", strings.Join(record, ","))
+		}
+		value, ok := parseResultNumber(record[1])
+		if !ok {
+			return "", fmt.Errorf("value %q is not a number", record[1])
+		}
+		labels = append(labels, record[0])
+		values = append(values, value)
+		labelWidth = max(labelWidth, uniseg.StringWidth(record[0]))
+		valueWidth = max(valueWidth, len(formatResultNumber(value)))
+		largest = max(largest, math.Abs(value))
+	}
+
+	// The bars take the width left of the labels and the values
+	barWidth := 40
+	if content.Width > 0 {
+		barWidth = max(10, content.Width-labelWidth-valueWidth-4)
+	}
+	for i, label := range labels {
+		length := 0
+		if largest > 0 {
+			length = int(math.Round(math.Abs(values[i]) / largest * float64(barWidth)))
+		}
+		sb.WriteString(fmt.Sprintf("%s%s [aqua]%s[white]%s %s\n",
+			tview.Escape(label), strings.Repeat(" ", labelWidth-uniseg.StringWidth(label)),
+			strings.Repeat(chartBar, length), strings.Repeat(" ", barWidth-length),
+			formatResultNumber(values[i])))
+	}
+	return strings.TrimRight(sb.String(), "\n"), nil
+}
+
+// csvTable parses CSV data with a heading line into a structured result;
+// columns whose values are all numbers are numeric
+func csvTable(body string) (*proto.TableResult, error) {
+	reader := csv.NewReader(strings.NewReader(body))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no heading line")
+	}
+	return recordsTable(records), nil
+}
+
+// recordsTable turns records with a heading record into a structured result
+func recordsTable(records [][]string) *proto.TableResult {
+	table := &proto.TableResult{}
+	for i, name := range records[0] {
+		numeric := len(records) > 1
+		for _, record := range records[1:] {
+			if i < len(record) && record[i] != "" {
+				if _, ok := parseResultNumber(record[i]); !ok {
+					numeric = false
+					break
+				}
+			}
+		}
+		table.Columns = append(table.Columns, &proto.TableColumn{Name: name, Numeric: numeric})
+	}
+	for _, record := range records[1:] {
+		cells := make([]string, len(table.Columns))
+		copy(cells, record)
+		table.Rows = append(table.Rows, &proto.TableRow{Cells: cells})
+	}
+	return table
+}
+
+// alignTable formats a structured result as text with aligned columns and a bold heading
+func alignTable(table *proto.TableResult) string {
+	widths := make([]int, len(table.Columns))
+	for i, column := range table.Columns {
+		widths[i] = uniseg.StringWidth(column.Name)
+		for _, row := range table.Rows {
+			widths[i] = max(widths[i], uniseg.StringWidth(row.Cells[i]))
+		}
+	}
+
+	cell := func(i int, text string) string {
+		padding := strings.Repeat(" ", widths[i]-uniseg.StringWidth(text))
+		if table.Columns[i].Numeric {
+			return padding + tview.Escape(text)
+		}
+		return tview.Escape(text) + padding
+	}
+
+	var sb strings.Builder
+	heading := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		heading[i] = cell(i, column.Name)
+	}
+	sb.WriteString("[::b]" + strings.Join(heading, "  ") + "[::-]\n")
+	for _, row := range table.Rows {
+		cells := make([]string, len(table.Columns))
+		for i := range table.Columns {
+			cells[i] = cell(i, row.Cells[i])
+		}
+		sb.WriteString(strings.TrimRight(strings.Join(cells, "  "), " ") + "\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,41 @@
+// report.go
+/**
+ * Nexuflex Client - Report Command
+ *
+ * This file contains the client command that exports the transcript of
+ * the current session as an HTML report.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleReportCommand processes the "report <file.html>" client command
+func (t *TUI) handleReportCommand(path string) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "report <file.html>"))
+		return
+	}
+	if filepath.Ext(path) == "" {
+		path += ".html"
+	}
+
+	count, err := t.client.SaveHTMLReport(path)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.report"), err))
+		return
+	}
+
+	t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.report_saved"), count, path) + "\n"))
+}
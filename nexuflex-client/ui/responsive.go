@@ -0,0 +1,265 @@
+// responsive.go
+/**
+ * Nexuflex Client - Responsive Layout
+ *
+ * This file contains the adaptation of the layout to the terminal size.
+ * Below the configured width or height the log pane is collapsed, the
+ * header is hidden, status segments are abbreviated, truncated and
+ * dropped by priority and the help switches to a compact layout, so that
+ * small terminals stay usable.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Size of the help window in the normal layout
+const (
+	helpWidth  = 70
+	helpHeight = 20
+)
+
+// statusMinTruncated is the width down to which an abbreviated status segment is truncated
+const statusMinTruncated = 6
+
+// statusSeparator separates the status segments
+const statusSeparator = " | "
+
+// statusSegment is a part of the status information; lower priority values are kept longer
+type statusSegment struct {
+	text     string
+	priority int
+	short    string // Abbreviation used when the space is short, e.g. only the server name; empty if none
+}
+
+// fittedSegment is a status segment as shown, abbreviated or truncated to fit
+type fittedSegment struct {
+	statusSegment
+	shown string
+}
+
+// colorTagPrefix matches a color tag at the start of a text, e.g. "[green]" or "[black:yellow]"
+var colorTagPrefix = regexp.MustCompile(`^\[[a-zA-Z0-9#:,_-]*\]`)
+
+// helpEntryPattern matches a help line consisting of a highlighted command and its description
+var helpEntryPattern = regexp.MustCompile(`(?m)^(\s+)(\[yellow\].*?\[white\])\s{2,}(.*)$`)
+
+// handleResize is called before every draw and adapts the layout when the screen size changed
+func (t *TUI) handleResize(screen tcell.Screen) bool {
+	width, height := screen.Size()
+	if width == t.screenWidth && height == t.screenHeight {
+		return false
+	}
+	t.screenWidth, t.screenHeight = width, height
+
+	narrow, short := false, false
+	if cfg := t.client.GetConfig(); cfg != nil {
+		narrow = cfg.UI.CompactWidth > 0 && width < cfg.UI.CompactWidth
+		short = cfg.UI.CompactHeight > 0 && height < cfg.UI.CompactHeight
+	}
+	if narrow != t.narrowLayout || short != t.shortLayout {
+		t.narrowLayout, t.shortLayout = narrow, short
+		t.applyResponsiveLayout()
+	}
+
+	// The space for the status segments and header widgets depends on the width in any case.
+	// No Draw() here: we are already inside the draw cycle.
+	t.renderStatus()
+	t.updateSessionHeader()
+	return false
+}
+
+// isCompactLayout reports whether the terminal is below one of the size thresholds
+func (t *TUI) isCompactLayout() bool {
+	return t.narrowLayout || t.shortLayout
+}
+
+// applyResponsiveLayout arranges the components for the current layout mode
+func (t *TUI) applyResponsiveLayout() {
+	// Header
+	headerHeight := 1
+	if t.shortLayout {
+		headerHeight = 0
+	}
+	t.layout.ResizeItem(t.header, headerHeight, 0)
+
+	// Row of the function keys
+	t.layout.ResizeItem(t.functionKeyBar, t.functionKeyBarHeight(), 0)
+
+	// Log pane
+	t.arrangeOutputArea()
+
+	// Status bar: the status information gets more room on narrow screens
+	textProportion, infoProportion := t.statusProportions()
+	t.statusBar.ResizeItem(t.statusText, 0, textProportion)
+	t.statusBar.ResizeItem(t.statusInfo, 0, infoProportion)
+
+	// Help
+	t.arrangeHelpPage()
+}
+
+// statusProportions returns the proportions of the status message and the status information
+func (t *TUI) statusProportions() (int, int) {
+	if t.narrowLayout {
+		return 1, 2
+	}
+	return 3, 1
+}
+
+// statusInfoWidth estimates the number of columns available for the status information
+func (t *TUI) statusInfoWidth() int {
+	if t.screenWidth == 0 {
+		return 0 // Not drawn yet
+	}
+	textProportion, infoProportion := t.statusProportions()
+	return t.screenWidth * infoProportion / (textProportion + infoProportion)
+}
+
+// arrangeHelpPage (re)builds the help page: centered window or full screen in the compact layout
+func (t *TUI) arrangeHelpPage() {
+	t.helpPage.Clear()
+	if t.isCompactLayout() {
+		t.helpText.SetText(compactHelpText(t.getHelpText()))
+		t.helpPage.AddItem(t.helpText, 0, 1, true)
+		return
+	}
+
+	t.helpText.SetText(t.getHelpText())
+	t.helpPage.
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(t.helpText, helpHeight, 1, true).
+			AddItem(nil, 0, 1, false),
+			helpWidth, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// compactHelpText puts the descriptions of the help entries below the commands
+func compactHelpText(text string) string {
+	return helpEntryPattern.ReplaceAllString(text, "$1$2\n$1  $3")
+}
+
+// fitStatusSegments fits the segments into the width: the least important
+// segments are abbreviated first, then their abbreviations are truncated
+// with an ellipsis, and only if that is not enough, segments are dropped;
+// a width of 0 (not drawn yet) keeps all segments in full
+func fitStatusSegments(segments []statusSegment, width int) []fittedSegment {
+	visible := make([]statusSegment, len(segments))
+	copy(visible, segments)
+
+	for {
+		fitted := shortenStatusSegments(visible, width)
+		if width <= 0 || len(visible) <= 1 || statusWidth(fitted) <= width {
+			return fitted
+		}
+
+		// Remove the segment with the lowest priority (the last one on ties)
+		drop := 0
+		for i, segment := range visible {
+			if segment.priority >= visible[drop].priority {
+				drop = i
+			}
+		}
+		visible = append(visible[:drop], visible[drop+1:]...)
+	}
+}
+
+// shortenStatusSegments abbreviates and truncates the least important
+// segments until they fit the width or cannot be shortened any further
+func shortenStatusSegments(segments []statusSegment, width int) []fittedSegment {
+	fitted := make([]fittedSegment, len(segments))
+	for i, segment := range segments {
+		fitted[i] = fittedSegment{statusSegment: segment, shown: segment.text}
+	}
+	if width <= 0 {
+		return fitted
+	}
+
+	// Least important first, the last one on ties
+	order := make([]int, len(segments))
+	for i := range order {
+		order[i] = len(segments) - 1 - i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return segments[order[a]].priority > segments[order[b]].priority
+	})
+
+	for _, i := range order {
+		if statusWidth(fitted) <= width {
+			return fitted
+		}
+		if segments[i].short != "" {
+			fitted[i].shown = segments[i].short
+		}
+	}
+
+	for _, i := range order {
+		excess := statusWidth(fitted) - width
+		if excess <= 0 {
+			break
+		}
+		if segments[i].short == "" {
+			continue
+		}
+		shownWidth := tview.TaggedStringWidth(fitted[i].shown)
+		if target := max(statusMinTruncated, shownWidth-excess); target < shownWidth {
+			fitted[i].shown = truncateTagged(fitted[i].shown, target)
+		}
+	}
+	return fitted
+}
+
+// joinStatusSegments joins the shown texts of the segments
+func joinStatusSegments(fitted []fittedSegment) string {
+	texts := make([]string, len(fitted))
+	for i, segment := range fitted {
+		texts[i] = segment.shown
+	}
+	return strings.Join(texts, statusSeparator)
+}
+
+// statusWidth returns the width of the joined segments
+func statusWidth(fitted []fittedSegment) int {
+	return tview.TaggedStringWidth(joinStatusSegments(fitted))
+}
+
+// truncateTagged shortens a text with color tags to a width, ending it with
+// an ellipsis; the tags after the cut are kept so that the colors are reset
+func truncateTagged(text string, width int) string {
+	if tview.TaggedStringWidth(text) <= width {
+		return text
+	}
+
+	var sb strings.Builder
+	used := 0
+	for text != "" {
+		if tag := colorTagPrefix.FindString(text); tag != "" {
+			sb.WriteString(tag)
+			text = text[len(tag):]
+			continue
+		}
+		r := []rune(text)[0]
+		text = text[len(string(r)):]
+		if runeWidth := tview.TaggedStringWidth(string(r)); used+runeWidth < width {
+			sb.WriteRune(r)
+			used += runeWidth
+		} else if used < width {
+			sb.WriteString("…")
+			used = width
+		}
+	}
+	return sb.String()
+}
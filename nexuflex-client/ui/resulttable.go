@@ -0,0 +1,534 @@
+// resulttable.go
+/**
+ * Nexuflex Client - Result Table
+ *
+ * This file contains ResultTable, the component showing structured command
+ * results. The header row stays in place while the rows scroll; below it,
+ * every column has a filter (a case-insensitive text or, for numeric
+ * columns, a comparison such as ">100"). Rows can be sorted by several
+ * columns, in the order the columns were chosen. The footer shows the
+ * count of every column and the sum and average of the numeric columns,
+ * computed from the rows passing the filters.
+ *
+ * Keys: "/" edits the filter of the selected column, "s" sorts by it
+ * (ascending, descending, off), "r" resets filters and sorting, Escape
+ * closes the table.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// resultTableMaxColumnWidth limits the width of a column; longer cells are truncated
+const resultTableMaxColumnWidth = 40
+
+// resultTableHeaderRows are the fixed rows above the data: the header and the filters
+const resultTableHeaderRows = 2
+
+// ResultColumn describes a column of a result table
+type ResultColumn struct {
+	Name    string
+	Numeric bool // Compared as numbers and aggregated in the footer
+}
+
+// resultSortKey is a column the rows are sorted by
+type resultSortKey struct {
+	column     int
+	descending bool
+}
+
+// ResultTable shows rows of a structured result with column filters,
+// multi-column sorting and footer aggregates
+type ResultTable struct {
+	*tview.Box
+
+	table       *tview.Table
+	footer      *tview.Table
+	filterInput *tview.InputField
+
+	columns  []ResultColumn
+	rows     [][]string
+	widths   []int
+	filters  []string
+	sortKeys []resultSortKey
+	visible  []int // Indexes of the rows passing the filters, in display order
+	editing  int   // Column whose filter is being edited, -1 if none
+
+	done func()
+}
+
+// NewResultTable creates an empty result table
+func NewResultTable() *ResultTable {
+	r := &ResultTable{
+		Box:         tview.NewBox(),
+		table:       tview.NewTable().SetFixed(resultTableHeaderRows, 0).SetSelectable(true, true),
+		footer:      tview.NewTable(),
+		filterInput: tview.NewInputField().SetFieldBackgroundColor(tcell.ColorBlack),
+		editing:     -1,
+	}
+	r.SetBorder(true).SetTitleAlign(tview.AlignCenter)
+	return r
+}
+
+// SetData replaces the columns and rows; numeric columns are also detected
+// from the values, rows are padded or cut to the number of columns
+func (r *ResultTable) SetData(columns []ResultColumn, rows [][]string) *ResultTable {
+	r.columns = append([]ResultColumn(nil), columns...)
+	r.rows = make([][]string, len(rows))
+	for i, row := range rows {
+		cells := make([]string, len(columns))
+		copy(cells, row)
+		r.rows[i] = cells
+	}
+
+	for col := range r.columns {
+		if !r.columns[col].Numeric {
+			r.columns[col].Numeric = r.isNumericColumn(col)
+		}
+	}
+
+	r.filters = make([]string, len(columns))
+	r.sortKeys = nil
+	r.refresh()
+	r.table.Select(resultTableHeaderRows, 0)
+	return r
+}
+
+// SetDoneFunc sets the function called when the table is closed
+func (r *ResultTable) SetDoneFunc(done func()) *ResultTable {
+	r.done = done
+	return r
+}
+
+// VisibleRows returns the number of rows passing the filters
+func (r *ResultTable) VisibleRows() int {
+	return len(r.visible)
+}
+
+// isNumericColumn checks whether all non-empty values of a column are numbers
+func (r *ResultTable) isNumericColumn(col int) bool {
+	found := false
+	for _, row := range r.rows {
+		if strings.TrimSpace(row[col]) == "" {
+			continue
+		}
+		if _, ok := parseResultNumber(row[col]); !ok {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// refresh applies the filters and the sorting and fills the tables
+func (r *ResultTable) refresh() {
+	r.visible = r.visible[:0]
+	for i, row := range r.rows {
+		if r.matchesFilters(row) {
+			r.visible = append(r.visible, i)
+		}
+	}
+	sort.SliceStable(r.visible, func(a, b int) bool {
+		return r.lessRows(r.rows[r.visible[a]], r.rows[r.visible[b]])
+	})
+
+	aggregates := r.aggregates()
+	r.computeWidths(aggregates)
+	r.fillTable()
+	r.fillFooter(aggregates)
+}
+
+// matchesFilters checks a row against the filters of all columns
+func (r *ResultTable) matchesFilters(row []string) bool {
+	for col, filter := range r.filters {
+		if filter != "" && !r.matchesFilter(col, row[col], filter) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesFilter checks a cell against a filter: a comparison for numeric
+// columns ("<", "<=", ">", ">=", "=", "!=" and a number), otherwise a
+// case-insensitive text contained in the cell
+func (r *ResultTable) matchesFilter(col int, cell, filter string) bool {
+	if r.columns[col].Numeric {
+		for _, op := range []string{"<=", ">=", "!=", "<", ">", "="} {
+			if !strings.HasPrefix(filter, op) {
+				continue
+			}
+			limit, ok := parseResultNumber(filter[len(op):])
+			value, valid := parseResultNumber(cell)
+			if !ok || !valid {
+				return false
+			}
+			switch op {
+			case "<=":
+				return value <= limit
+			case ">=":
+				return value >= limit
+			case "!=":
+				return value != limit
+			case "<":
+				return value < limit
+			case ">":
+				return value > limit
+			}
+			return value == limit
+		}
+	}
+	return strings.Contains(strings.ToLower(cell), strings.ToLower(filter))
+}
+
+// lessRows compares two rows by the sort keys
+func (r *ResultTable) lessRows(a, b []string) bool {
+	for _, key := range r.sortKeys {
+		cmp := r.compareCells(key.column, a[key.column], b[key.column])
+		if cmp == 0 {
+			continue
+		}
+		if key.descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	}
+	return false
+}
+
+// compareCells compares two values of a column; numbers before other values in numeric columns
+func (r *ResultTable) compareCells(col int, a, b string) int {
+	if r.columns[col].Numeric {
+		x, okX := parseResultNumber(a)
+		y, okY := parseResultNumber(b)
+		switch {
+		case okX && okY:
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		case okX:
+			return -1
+		case okY:
+			return 1
+		}
+	}
+	return i18n.Compare(a, b)
+}
+
+// resultAggregates are the footer values of the visible rows per column
+type resultAggregates struct {
+	counts  []int // Non-empty cells
+	numbers []int // Cells with a number
+	sums    []float64
+}
+
+// aggregates computes the count of every column and the sums of the numeric columns
+func (r *ResultTable) aggregates() resultAggregates {
+	agg := resultAggregates{
+		counts:  make([]int, len(r.columns)),
+		numbers: make([]int, len(r.columns)),
+		sums:    make([]float64, len(r.columns)),
+	}
+	for _, index := range r.visible {
+		for col, cell := range r.rows[index] {
+			if strings.TrimSpace(cell) == "" {
+				continue
+			}
+			agg.counts[col]++
+			if value, ok := parseResultNumber(cell); ok && r.columns[col].Numeric {
+				agg.numbers[col]++
+				agg.sums[col] += value
+			}
+		}
+	}
+	return agg
+}
+
+// footerCells returns the footer rows of a column: count, sum and average
+func (r *ResultTable) footerCells(col int, agg resultAggregates) []string {
+	cells := []string{fmt.Sprintf("# %d", agg.counts[col]), "", ""}
+	if r.columns[col].Numeric && agg.numbers[col] > 0 {
+		cells[1] = "Σ " + formatResultNumber(agg.sums[col])
+		cells[2] = "Ø " + formatResultNumber(agg.sums[col]/float64(agg.numbers[col]))
+	}
+	return cells
+}
+
+// hasNumericColumns checks whether the footer shows sums and averages
+func (r *ResultTable) hasNumericColumns() bool {
+	for _, column := range r.columns {
+		if column.Numeric {
+			return true
+		}
+	}
+	return false
+}
+
+// computeWidths determines the column widths shared by the table and the footer
+func (r *ResultTable) computeWidths(agg resultAggregates) {
+	r.widths = make([]int, len(r.columns))
+	for col := range r.columns {
+		width := tview.TaggedStringWidth(tview.Escape(r.headerText(col)))
+		if w := tview.TaggedStringWidth(tview.Escape(r.filterText(col))); w > width {
+			width = w
+		}
+		for _, index := range r.visible {
+			if w := tview.TaggedStringWidth(tview.Escape(r.rows[index][col])); w > width {
+				width = w
+			}
+		}
+		for _, cell := range r.footerCells(col, agg) {
+			if w := tview.TaggedStringWidth(cell); w > width {
+				width = w
+			}
+		}
+		if width > resultTableMaxColumnWidth {
+			width = resultTableMaxColumnWidth
+		}
+		r.widths[col] = width
+	}
+}
+
+// headerText returns the header of a column with its sort direction and priority
+func (r *ResultTable) headerText(col int) string {
+	text := r.columns[col].Name
+	for priority, key := range r.sortKeys {
+		if key.column != col {
+			continue
+		}
+		arrow := "▲"
+		if key.descending {
+			arrow = "▼"
+		}
+		text += fmt.Sprintf(" %s%d", arrow, priority+1)
+	}
+	return text
+}
+
+// filterText returns the filter row entry of a column
+func (r *ResultTable) filterText(col int) string {
+	if r.filters[col] == "" {
+		return ""
+	}
+	return "⌕ " + r.filters[col]
+}
+
+// cell creates a table cell padded to the width of its column
+func (r *ResultTable) cell(col int, text string, color tcell.Color) *tview.TableCell {
+	text = tview.Escape(text)
+	if pad := r.widths[col] - tview.TaggedStringWidth(text); pad > 0 {
+		if r.columns[col].Numeric {
+			text = strings.Repeat(" ", pad) + text
+		} else {
+			text += strings.Repeat(" ", pad)
+		}
+	}
+	return tview.NewTableCell(text).SetTextColor(color).SetMaxWidth(r.widths[col])
+}
+
+// fillTable writes the header, the filters and the visible rows into the table
+func (r *ResultTable) fillTable() {
+	r.table.Clear()
+	for col := range r.columns {
+		r.table.SetCell(0, col, r.cell(col, r.headerText(col), tcell.ColorYellow).
+			SetAttributes(tcell.AttrBold).SetSelectable(false))
+		r.table.SetCell(1, col, r.cell(col, r.filterText(col), tcell.ColorGray).SetSelectable(false))
+	}
+	for row, index := range r.visible {
+		for col, value := range r.rows[index] {
+			r.table.SetCell(row+resultTableHeaderRows, col, r.cell(col, value, tcell.ColorWhite))
+		}
+	}
+
+	// Keep the selection within the rows
+	row, col := r.table.GetSelection()
+	if last := len(r.visible) + resultTableHeaderRows - 1; row > last {
+		r.table.Select(max(last, resultTableHeaderRows), col)
+	}
+}
+
+// fillFooter writes the aggregates of the visible rows into the footer
+func (r *ResultTable) fillFooter(agg resultAggregates) {
+	r.footer.Clear()
+	for col := range r.columns {
+		for row, text := range r.footerCells(col, agg)[:r.footerHeight()] {
+			r.footer.SetCell(row, col, r.cell(col, text, tcell.ColorAqua))
+		}
+	}
+}
+
+// footerHeight returns the number of footer rows
+func (r *ResultTable) footerHeight() int {
+	if r.hasNumericColumns() {
+		return 3
+	}
+	return 1
+}
+
+// selectedColumn returns the column of the selected cell
+func (r *ResultTable) selectedColumn() int {
+	_, col := r.table.GetSelection()
+	if col < 0 || col >= len(r.columns) {
+		return 0
+	}
+	return col
+}
+
+// toggleSort cycles the sorting by a column through ascending, descending and off;
+// a newly sorted column is added after the columns already sorted by
+func (r *ResultTable) toggleSort(col int) {
+	for i, key := range r.sortKeys {
+		if key.column != col {
+			continue
+		}
+		if !key.descending {
+			r.sortKeys[i].descending = true
+		} else {
+			r.sortKeys = append(r.sortKeys[:i], r.sortKeys[i+1:]...)
+		}
+		r.refresh()
+		return
+	}
+	r.sortKeys = append(r.sortKeys, resultSortKey{column: col})
+	r.refresh()
+}
+
+// startFilter opens the input of the filter of a column
+func (r *ResultTable) startFilter(col int) {
+	r.editing = col
+	r.filterInput.
+		SetLabel(fmt.Sprintf(i18n.GetMessage("ui.table_filter"), r.columns[col].Name) + " ").
+		SetText(r.filters[col])
+	r.filterInput.Focus(nil)
+}
+
+// endFilter closes the filter input, applying the entered filter if apply is set
+func (r *ResultTable) endFilter(apply bool) {
+	if apply {
+		r.filters[r.editing] = strings.TrimSpace(r.filterInput.GetText())
+		r.refresh()
+	}
+	r.editing = -1
+	r.filterInput.Blur()
+}
+
+// reset removes all filters and the sorting
+func (r *ResultTable) reset() {
+	r.filters = make([]string, len(r.columns))
+	r.sortKeys = nil
+	r.refresh()
+}
+
+// Draw draws the table, the footer and the filter input or the row count
+func (r *ResultTable) Draw(screen tcell.Screen) {
+	r.Box.DrawForSubclass(screen, r)
+	x, y, width, height := r.GetInnerRect()
+
+	footerHeight := r.footerHeight()
+	tableHeight := height - footerHeight - 2
+	if tableHeight < resultTableHeaderRows+1 {
+		tableHeight = resultTableHeaderRows + 1
+	}
+
+	r.table.SetRect(x, y, width, tableHeight)
+	r.table.Draw(screen)
+
+	// The footer scrolls horizontally with the table
+	_, columnOffset := r.table.GetOffset()
+	r.footer.SetOffset(0, columnOffset)
+	for col := 0; col < width; col++ {
+		screen.SetContent(x+col, y+tableHeight, tview.BoxDrawingsLightHorizontal, nil,
+			tcell.StyleDefault.Foreground(tcell.ColorGray).Background(r.GetBackgroundColor()))
+	}
+	r.footer.SetRect(x, y+tableHeight+1, width, footerHeight)
+	r.footer.Draw(screen)
+
+	lastLine := y + tableHeight + 1 + footerHeight
+	if r.editing >= 0 {
+		r.filterInput.SetRect(x, lastLine, width, 1)
+		r.filterInput.Draw(screen)
+		return
+	}
+	tview.Print(screen, fmt.Sprintf("[gray]%s", tview.Escape(
+		fmt.Sprintf(i18n.GetMessage("ui.table_rows"), len(r.visible), len(r.rows)))),
+		x, lastLine, width, tview.AlignLeft, tcell.ColorGray)
+}
+
+// InputHandler handles the keys of the table and of the filter input
+func (r *ResultTable) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return r.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		if r.editing >= 0 {
+			switch event.Key() {
+			case tcell.KeyEnter:
+				r.endFilter(true)
+			case tcell.KeyEscape:
+				r.endFilter(false)
+			default:
+				r.filterInput.InputHandler()(event, setFocus)
+			}
+			return
+		}
+
+		switch {
+		case event.Key() == tcell.KeyEscape,
+			event.Key() == tcell.KeyRune && event.Rune() == 'q':
+			if r.done != nil {
+				r.done()
+			}
+		case event.Key() == tcell.KeyRune && event.Rune() == '/':
+			r.startFilter(r.selectedColumn())
+		case event.Key() == tcell.KeyRune && event.Rune() == 's':
+			r.toggleSort(r.selectedColumn())
+		case event.Key() == tcell.KeyRune && event.Rune() == 'r':
+			r.reset()
+		default:
+			r.table.InputHandler()(event, setFocus)
+		}
+	})
+}
+
+// MouseHandler selects cells and scrolls the rows with the mouse
+func (r *ResultTable) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (bool, tview.Primitive) {
+	return r.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (bool, tview.Primitive) {
+		if !r.InRect(event.Position()) {
+			return false, nil
+		}
+		setFocus(r)
+		consumed, _ := r.table.MouseHandler()(action, event, func(tview.Primitive) {})
+		return consumed, nil
+	})
+}
+
+// parseResultNumber parses a numeric cell; blanks and thousands separators
+// ("1,234.5" or "1 234.5") are ignored
+func parseResultNumber(text string) (float64, bool) {
+	text = strings.TrimSpace(text)
+	text = strings.NewReplacer(",", "", " ", "", "\u00a0", "").Replace(text)
+	if text == "" {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	return value, err == nil && !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// formatResultNumber formats an aggregate with up to two decimals
+func formatResultNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,137 @@
+// rpcerrors.go
+/**
+ * Nexuflex Client - Command Error Handling
+ *
+ * This file contains the handling of errors returned for a command. Failed
+ * server calls are shown with a message for their gRPC status code and
+ * the hints of the server, followed by the fitting way out: a reconnect
+ * is offered when the server was unavailable, the login dialog opens when
+ * the session was not accepted, and a command that timed out can be
+ * retried with a longer timeout.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+	"google.golang.org/grpc/codes"
+)
+
+// retryTimeoutFactor multiplies the timeout of a command that is retried after it timed out
+const retryTimeoutFactor = 4
+
+// rpcErrorKeys maps gRPC status codes to the message keys describing them
+var rpcErrorKeys = map[codes.Code]string{
+	codes.Unavailable:       "error.rpc_unavailable",
+	codes.Unauthenticated:   "error.rpc_unauthenticated",
+	codes.PermissionDenied:  "error.rpc_permission_denied",
+	codes.NotFound:          "error.rpc_not_found",
+	codes.InvalidArgument:   "error.rpc_invalid_argument",
+	codes.ResourceExhausted: "error.rpc_resource_exhausted",
+	codes.Unimplemented:     "error.rpc_unimplemented",
+	codes.Canceled:          "error.rpc_canceled",
+}
+
+// handleCommandError shows the error of a command and offers a way to recover from it
+func (t *TUI) handleCommandError(err error) {
+	var interrupted *client.InterruptedCommandError
+	var readOnly *client.ReadOnlyError
+	var rpcErr *client.RPCError
+	var conflict *client.ConflictError
+	var pending *client.ApprovalPendingError
+
+	switch {
+	case err == nil:
+	case errors.As(err, &interrupted):
+		// Session was renewed automatically, replay the command
+		t.replayInterruptedCommand(interrupted.Command)
+	case errors.As(err, &readOnly):
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.read_only"), readOnly.Command))
+	case errors.As(err, &conflict):
+		t.handleConflict(conflict)
+	case errors.As(err, &pending):
+		t.handleApprovalPending(pending)
+	case errors.As(err, &rpcErr):
+		t.handleRPCError(rpcErr)
+	default:
+		t.ShowError(err.Error())
+	}
+}
+
+// handleRPCError shows a failed server call with the hints of the server and
+// continues with the recovery for its status code
+func (t *TUI) handleRPCError(err *client.RPCError) {
+	message := rpcErrorMessage(err)
+	t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n", tview.Escape(message))))
+	if err.RetryAfter > 0 {
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.rpc_retry_after"), err.RetryAfter.Round(time.Second)))))
+	}
+	if err.HelpURL != "" {
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.rpc_help"), tview.Escape(err.HelpURL)))))
+	}
+	if err.CommandID != "" {
+		t.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.rpc_outcome_unknown"), err.CommandID))))
+	}
+	t.ShowError(message)
+
+	switch err.Action() {
+	case client.RecoverReconnect:
+		t.showConfirmation(i18n.GetMessage("commands.confirm_reconnect"), t.reconnect)
+
+	case client.RecoverLogin:
+		t.pages.SwitchToPage("login")
+
+	case client.RecoverRetry:
+		if err.Code != codes.DeadlineExceeded || err.Command == "" || err.Timeout <= 0 {
+			return
+		}
+		timeout := err.Timeout * retryTimeoutFactor
+		question := fmt.Sprintf(i18n.GetMessage("commands.confirm_retry_timeout"), err.Timeout, timeout)
+		if !client.IsIdempotentCommand(err.Command) {
+			question += "\n" + i18n.GetMessage("commands.retry_not_idempotent")
+		}
+		command := err.Command
+		t.showConfirmation(question, func() {
+			t.handleCommandError(t.client.ExecuteCommandWithTimeout(command, timeout))
+		})
+	}
+}
+
+// rpcErrorMessage returns the localized description of a failed server call
+func rpcErrorMessage(err *client.RPCError) string {
+	if err.Code == codes.DeadlineExceeded && err.Timeout > 0 {
+		return fmt.Sprintf(i18n.GetMessage("error.rpc_deadline_exceeded"), err.Timeout)
+	}
+	if key, ok := rpcErrorKeys[err.Code]; ok {
+		return fmt.Sprintf(i18n.GetMessage(key), err.Message)
+	}
+	return fmt.Sprintf(i18n.GetMessage("error.rpc_failed"), err.Code, err.Message)
+}
+
+// reconnect connects to the current server again in the background
+func (t *TUI) reconnect() {
+	serverInfo := t.client.GetServerInfo()
+	if serverInfo == nil {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.recent_connecting"), serverInfo.Address, serverInfo.Port))
+
+	go func() {
+		loggedIn, err := t.client.Reconnect()
+		t.handleReconnected(serverInfo.ShortName, loggedIn, err)
+	}()
+}
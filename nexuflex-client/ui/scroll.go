@@ -0,0 +1,204 @@
+// scroll.go
+/**
+ * Nexuflex Client - Mouse Scrolling
+ *
+ * This file contains the mouse handling of the output and log pane. The
+ * wheel scrolls by `scroll_lines` rows per tick; ticks following each other
+ * quickly in the same direction, as sent by touchpads and free-spinning
+ * wheels, scroll faster the longer the movement lasts. Scrolling down to
+ * the end follows new output again. With wrapping turned off ("wrap off"),
+ * Shift+wheel and horizontal wheels scroll sideways. A click into the right
+ * border jumps to the corresponding position of the scrollback.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// DefaultScrollLines is the number of rows scrolled per wheel tick
+const DefaultScrollLines = 3
+
+// Momentum of wheel scrolling
+const (
+	momentumInterval = 40 * time.Millisecond // Ticks closer than this continue a movement
+	maxMomentum      = 4                     // Maximum factor applied to the rows per tick
+	momentumTicks    = 3                     // Ticks per increase of the factor
+)
+
+// horizontalScrollColumns is the number of columns scrolled per horizontal wheel tick
+const horizontalScrollColumns = 8
+
+// wheelMomentum tracks a continuous wheel movement
+type wheelMomentum struct {
+	last      time.Time
+	direction int
+	ticks     int
+}
+
+// factor registers a wheel tick and returns the factor for its rows
+func (m *wheelMomentum) factor(direction int, now time.Time) int {
+	if direction != m.direction || now.Sub(m.last) > momentumInterval {
+		m.ticks = 0
+	}
+	m.direction = direction
+	m.last = now
+	m.ticks++
+
+	factor := 1 + (m.ticks-1)/momentumTicks
+	if factor > maxMomentum {
+		factor = maxMomentum
+	}
+	return factor
+}
+
+// initMouseScrolling installs the mouse handling of the output and log pane
+func (t *TUI) initMouseScrolling() {
+	t.wrapOutput = true
+	if cfg := t.client.GetConfig(); cfg != nil {
+		t.wrapOutput = cfg.UI.WrapOutput
+	}
+	t.output.SetWrap(t.wrapOutput)
+	t.logView.SetWrap(t.wrapOutput)
+
+	t.output.SetMouseCapture(t.mouseScrollCapture(t.output))
+	t.logView.SetMouseCapture(t.mouseScrollCapture(t.logView))
+}
+
+// scrollLines returns the configured number of rows per wheel tick
+func (t *TUI) scrollLines() int {
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.UI.ScrollLines > 0 {
+		return cfg.UI.ScrollLines
+	}
+	return DefaultScrollLines
+}
+
+// mouseScrollCapture returns the mouse capture of a scrollable text view
+func (t *TUI) mouseScrollCapture(view *tview.TextView) func(tview.MouseAction, *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	var momentum wheelMomentum
+	return func(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+		shift := event.Modifiers()&tcell.ModShift != 0
+
+		switch action {
+		case tview.MouseScrollUp, tview.MouseScrollDown:
+			direction := 1
+			if action == tview.MouseScrollUp {
+				direction = -1
+			}
+			if shift && !t.wrapOutput {
+				scrollColumns(view, direction*horizontalScrollColumns)
+			} else {
+				scrollRows(view, direction*t.scrollLines()*momentum.factor(direction, time.Now()))
+			}
+			return tview.MouseConsumed, nil
+
+		case tview.MouseScrollLeft, tview.MouseScrollRight:
+			if t.wrapOutput {
+				return tview.MouseConsumed, nil
+			}
+			direction := 1
+			if action == tview.MouseScrollLeft {
+				direction = -1
+			}
+			scrollColumns(view, direction*horizontalScrollColumns)
+			return tview.MouseConsumed, nil
+
+		case tview.MouseLeftDown, tview.MouseLeftClick:
+			if jumpToScrollPosition(view, event) {
+				return tview.MouseConsumed, nil
+			}
+		}
+		return action, event
+	}
+}
+
+// scrollRows scrolls a text view by a number of rows; reaching the end
+// follows new output again
+func scrollRows(view *tview.TextView, rows int) {
+	row, column := view.GetScrollOffset()
+	_, _, _, height := view.GetInnerRect()
+	last := view.GetWrappedLineCount() - height
+
+	row += rows
+	if rows > 0 && row >= last {
+		view.ScrollToEnd()
+		return
+	}
+	if row < 0 {
+		row = 0
+	}
+	view.ScrollTo(row, column)
+}
+
+// scrollColumns scrolls a text view without wrapping sideways
+func scrollColumns(view *tview.TextView, columns int) {
+	row, column := view.GetScrollOffset()
+	column += columns
+	if column < 0 {
+		column = 0
+	}
+	view.ScrollTo(row, column)
+}
+
+// jumpToScrollPosition scrolls a text view to the position of a click into
+// its right border, the top of the border being the start of the
+// scrollback and the bottom its end; it reports whether the click hit the border
+func jumpToScrollPosition(view *tview.TextView, event *tcell.EventMouse) bool {
+	x, _, width, _ := view.GetRect()
+	innerX, innerY, innerWidth, innerHeight := view.GetInnerRect()
+	mouseX, mouseY := event.Position()
+	if innerX+innerWidth >= x+width || mouseX != x+width-1 || mouseY < innerY || mouseY >= innerY+innerHeight {
+		return false
+	}
+
+	last := view.GetWrappedLineCount() - innerHeight
+	if last <= 0 {
+		return true // Everything is visible
+	}
+	if innerHeight == 1 || mouseY == innerY+innerHeight-1 {
+		view.ScrollToEnd()
+		return true
+	}
+	_, column := view.GetScrollOffset()
+	view.ScrollTo((mouseY-innerY)*last/(innerHeight-1), column)
+	return true
+}
+
+// handleWrapCommand turns wrapping of the output and log pane on or off
+func (t *TUI) handleWrapCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		t.setWrapOutput(!t.wrapOutput)
+	case "on":
+		t.setWrapOutput(true)
+	case "off":
+		t.setWrapOutput(false)
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "wrap [on|off]"))
+		return
+	}
+
+	if t.wrapOutput {
+		t.ShowInfo(i18n.GetMessage("commands.wrap_on"))
+	} else {
+		t.ShowInfo(i18n.GetMessage("commands.wrap_off"))
+	}
+}
+
+// setWrapOutput sets whether long lines of the output and log pane are wrapped
+func (t *TUI) setWrapOutput(wrap bool) {
+	t.wrapOutput = wrap
+	t.output.SetWrap(wrap)
+	t.logView.SetWrap(wrap)
+}
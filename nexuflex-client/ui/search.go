@@ -0,0 +1,56 @@
+// search.go
+/**
+ * Nexuflex Client - Search Command
+ *
+ * This file contains the client command for searching the transcripts of
+ * past sessions and the command history.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// maxSearchResults is the maximum number of search results shown
+const maxSearchResults = 20
+
+// handleSearchCommand processes the "search" client command
+func (t *TUI) handleSearchCommand(query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "search <terms>"))
+		return
+	}
+
+	index := client.BuildSearchIndex(t.client.GetTranscript(), t.commandHistory.GetEntries())
+	results := index.Search(query, maxSearchResults)
+
+	if len(results) == 0 {
+		t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.search_no_results"), query) + "\n"))
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.search_results"), len(results), index.Size()) + "\n")
+	for _, result := range results {
+		timestamp := "-"
+		if !result.Document.Time.IsZero() {
+			timestamp = result.Document.Time.Format("2006-01-02 15:04")
+		}
+
+		sb.WriteString(fmt.Sprintf("  [gray]%s[white] [blue]%-8s[white] %s\n",
+			timestamp, result.Document.Kind, tview.Escape(result.Snippet)))
+	}
+
+	t.output.Write([]byte(sb.String()))
+}
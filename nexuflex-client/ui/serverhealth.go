@@ -0,0 +1,85 @@
+// serverhealth.go
+/**
+ * Nexuflex Client - Server Health Badges
+ *
+ * This file contains the health, load and maintenance badges of the
+ * server selection dialog and the check before connecting to a selected
+ * server: servers in maintenance are refused if configured, and servers
+ * in maintenance or reported unhealthy are only connected after a warning.
+ * A refused server ends the selection with the error shown; declining the
+ * warning returns to the list to choose another server or cancel.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// serverBadges returns the colored badges of a server entry
+func serverBadges(server *proto.ServerInfo) string {
+	var badges string
+	switch server.Health {
+	case proto.ServerInfo_HEALTHY:
+		badges = "[green]● " + i18n.GetMessage("ui.server_healthy")
+	case proto.ServerInfo_DEGRADED:
+		badges = "[yellow]● " + i18n.GetMessage("ui.server_degraded")
+	case proto.ServerInfo_UNHEALTHY:
+		badges = "[red]● " + i18n.GetMessage("ui.server_unhealthy")
+	}
+	if server.Health != proto.ServerInfo_HEALTH_UNKNOWN {
+		badges += fmt.Sprintf(" [white]"+i18n.GetMessage("ui.server_load"), server.LoadPercent)
+	}
+	if server.Maintenance {
+		badges += " [black:yellow] " + i18n.GetMessage("ui.server_maintenance") + " [-:-]"
+	}
+	return badges + "[white]"
+}
+
+// selectServer chooses a server in the selection dialog after checking its
+// maintenance and health information
+func (t *TUI) selectServer(server *proto.ServerInfo) {
+	if err := t.client.CheckServerAvailability(server); err != nil {
+		// End the waiting discovery; the error is shown on the main page
+		t.pages.SwitchToPage("main")
+		t.chooseServer(nil)
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.server_maintenance"), err))
+		return
+	}
+
+	connect := func() {
+		t.pages.SwitchToPage("main")
+		t.chooseServer(server)
+	}
+	if warning := serverWarning(server); warning != "" {
+		t.showConfirmationOrCancel(warning, connect, func() {
+			t.app.SetFocus(t.serverList)
+		})
+		return
+	}
+	connect()
+}
+
+// serverWarning returns the question shown before connecting to a server in
+// maintenance or reported unhealthy, "" for any other server
+func serverWarning(server *proto.ServerInfo) string {
+	name := tview.Escape(server.ShortName)
+	switch {
+	case server.Maintenance && server.MaintenanceMessage != "":
+		return fmt.Sprintf(i18n.GetMessage("commands.confirm_server_maintenance_message"),
+			name, tview.Escape(server.MaintenanceMessage))
+	case server.Maintenance:
+		return fmt.Sprintf(i18n.GetMessage("commands.confirm_server_maintenance"), name)
+	case server.Health == proto.ServerInfo_UNHEALTHY:
+		return fmt.Sprintf(i18n.GetMessage("commands.confirm_server_unhealthy"), name)
+	}
+	return ""
+}
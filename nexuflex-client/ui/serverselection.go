@@ -0,0 +1,95 @@
+// serverselection.go
+/**
+ * Nexuflex Client - Server Selection
+ *
+ * This file contains the server selection dialog of the discovery. The
+ * client hands over the discovered servers with a continuation; the list is
+ * shown from the event loop and the choice is passed on when the user
+ * presses Enter, or nil when the list is closed or the chosen server is
+ * refused, so that the discovery waiting for it in the background connects
+ * to the server or ends. A new discovery ends the selection of the
+ * previous one.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// DiscoverServers discovers the servers in the background and connects to
+// the one the user chooses
+func (t *TUI) DiscoverServers() {
+	go func() {
+		err := t.client.DiscoverServer(t.discoverTimeout())
+		t.app.QueueUpdateDraw(func() {
+			switch {
+			case errors.Is(err, client.ErrNoServerSelected):
+				// The user closed the list
+			case err != nil:
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.discovery"), err))
+			default:
+				server := t.client.GetServerInfo()
+				t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.connected"), server.Address, server.Port))
+				t.pages.SwitchToPage("login")
+			}
+		})
+	}()
+}
+
+// handleServerSelection is called by the client with the discovered
+// servers; choose receives the server the user selects
+func (t *TUI) handleServerSelection(servers []*proto.ServerInfo, choose func(server *proto.ServerInfo)) {
+	t.app.QueueUpdateDraw(func() {
+		t.chooseServer(nil)
+		t.serverChoice = choose
+		t.showServerList(servers)
+	})
+}
+
+// chooseServer passes the choice of the user to the waiting discovery
+func (t *TUI) chooseServer(server *proto.ServerInfo) {
+	if t.serverChoice != nil {
+		choose := t.serverChoice
+		t.serverChoice = nil
+		choose(server)
+	}
+}
+
+// showServerList shows the discovered servers for selection
+func (t *TUI) showServerList(servers []*proto.ServerInfo) {
+	// Clear list
+	t.serverList.Clear()
+
+	// Sort the servers by name in the order of the user's language
+	servers = slices.Clone(servers)
+	slices.SortStableFunc(servers, func(a, b *proto.ServerInfo) int {
+		return i18n.Compare(a.ShortName, b.ShortName)
+	})
+
+	// Add servers to list with their health badges
+	for i, server := range servers {
+		title := fmt.Sprintf("%s (%s) %s", tview.Escape(server.ShortName), tview.Escape(server.Address), serverBadges(server))
+		secondary := fmt.Sprintf("Version: %s, TLS: %v", server.Version, server.TlsEnabled)
+
+		t.serverList.AddItem(title, secondary, rune('1'+i), func(server *proto.ServerInfo) func() {
+			return func() {
+				t.selectServer(server)
+			}
+		}(server))
+	}
+
+	// Show list
+	t.pages.SwitchToPage("servers")
+}
@@ -0,0 +1,74 @@
+// servicecolors.go
+/**
+ * Nexuflex Client - Service Colors
+ *
+ * This file contains the color coding of output by service: every line of
+ * a result gets a gutter in the color of the service that produced it, so
+ * that interleaved output of different services can be told apart. The
+ * service is taken from the prefix of the command or the current context;
+ * its color comes from the [service_colors] section or, for other
+ * services, from the `service_palette` by a hash of the service name.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"hash/fnv"
+	"strings"
+)
+
+// serviceGutterMark is drawn in front of every line of a result
+const serviceGutterMark = "▎"
+
+// commandService returns the service a command is sent to: its prefix if
+// it has one, otherwise the current service context
+func (t *TUI) commandService(command string) string {
+	name := strings.SplitN(strings.TrimSpace(command), " ", 2)[0]
+	if service, _, found := strings.Cut(name, "."); found && service != "" {
+		return service
+	}
+	return t.client.GetLastServiceUsed()
+}
+
+// serviceColor returns the color of a service, "" if service colors are disabled
+func (t *TUI) serviceColor(service string) string {
+	cfg := t.client.GetConfig()
+	if cfg == nil || !cfg.UI.ServiceColors || service == "" {
+		return ""
+	}
+
+	for name, color := range cfg.ServiceColors {
+		if strings.EqualFold(name, service) {
+			return strings.TrimSpace(color)
+		}
+	}
+	if len(cfg.UI.ServicePalette) == 0 {
+		return ""
+	}
+
+	// The same service always gets the same color
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.ToLower(service)))
+	return strings.TrimSpace(cfg.UI.ServicePalette[hash.Sum32()%uint32(len(cfg.UI.ServicePalette))])
+}
+
+// serviceGutter returns the colored gutter of the output of a service
+func (t *TUI) serviceGutter(service string) string {
+	color := t.serviceColor(service)
+	if color == "" {
+		return ""
+	}
+	return "[" + color + "]" + serviceGutterMark + "[-] "
+}
+
+// withGutter puts a gutter in front of every line of a text
+func withGutter(text, gutter string) string {
+	if gutter == "" {
+		return text
+	}
+	return gutter + strings.ReplaceAll(text, "\n", "\n"+gutter)
+}
@@ -0,0 +1,197 @@
+// settings.go
+/**
+ * Nexuflex Client - Settings Page
+ *
+ * This file contains the "settings" page, a form for the most common
+ * options of the configuration file. Saving writes the options back to the
+ * loaded configuration file and applies them to the running client: the
+ * theme, the language, the density, the history size, the timestamps and
+ * the completion take effect immediately, the keep-alive interval with the
+ * next login.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// settingsWidth is the width of the settings form
+const settingsWidth = 56
+
+// timestamp returns the time prefix of echoed commands if timestamps are enabled
+func (t *TUI) timestamp() string {
+	if cfg := t.client.GetConfig(); cfg == nil || !cfg.UI.ShowTimestamps {
+		return ""
+	}
+	return fmt.Sprintf("[gray]%s[white] ", time.Now().Format("15:04:05"))
+}
+
+// isAutoCompleteEnabled checks whether Tab completes commands
+func (t *TUI) isAutoCompleteEnabled() bool {
+	cfg := t.client.GetConfig()
+	return cfg == nil || cfg.UI.AutoCompleteEnabled
+}
+
+// settingsLanguages returns the selectable languages, including the current one
+func settingsLanguages() []string {
+	seen := map[string]bool{i18n.GetCurrentLanguage(): true}
+	available, _ := i18n.GetAvailableLanguages()
+	for _, lang := range available {
+		seen[lang] = true
+	}
+	delete(seen, "")
+
+	languages := make([]string, 0, len(seen))
+	for lang := range seen {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// indexOf returns the position of a value in a list, 0 if it is missing
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return 0
+}
+
+// showSettings shows the settings form with the current configuration
+func (t *TUI) showSettings() {
+	cfg := t.client.GetConfig()
+	if cfg == nil {
+		return
+	}
+
+	themes := themeNames()
+	languages := settingsLanguages()
+
+	form := tview.NewForm().
+		AddDropDown(i18n.GetMessage("ui.settings_theme"), themes, indexOf(themes, cfg.UI.ColorScheme), nil).
+		AddDropDown(i18n.GetMessage("ui.settings_language"), languages, indexOf(languages, i18n.GetCurrentLanguage()), nil).
+		AddDropDown(i18n.GetMessage("ui.settings_density"), densities, indexOf(densities, t.density()), nil).
+		AddCheckbox(i18n.GetMessage("ui.settings_timestamps"), cfg.UI.ShowTimestamps, nil).
+		AddCheckbox(i18n.GetMessage("ui.settings_auto_complete"), cfg.UI.AutoCompleteEnabled, nil).
+		AddCheckbox(i18n.GetMessage("ui.settings_remember_parameters"), cfg.Commands.RememberParameters, nil).
+		AddInputField(i18n.GetMessage("ui.settings_history_size"),
+			strconv.Itoa(cfg.UI.MaxHistoryEntries), 6, tview.InputFieldInteger, nil).
+		AddInputField(i18n.GetMessage("ui.settings_keep_alive"),
+			strconv.Itoa(cfg.Server.KeepAliveSeconds), 6, tview.InputFieldInteger, nil).
+		AddInputField(i18n.GetMessage("ui.settings_discover_timeout"),
+			strconv.Itoa(cfg.Server.DiscoverTimeoutSeconds), 6, tview.InputFieldInteger, nil)
+
+	themeField := form.GetFormItem(0).(*tview.DropDown)
+	languageField := form.GetFormItem(1).(*tview.DropDown)
+	densityField := form.GetFormItem(2).(*tview.DropDown)
+	timestampsField := form.GetFormItem(3).(*tview.Checkbox)
+	autoCompleteField := form.GetFormItem(4).(*tview.Checkbox)
+	rememberParametersField := form.GetFormItem(5).(*tview.Checkbox)
+	numberFields := []*tview.InputField{
+		form.GetFormItem(6).(*tview.InputField),
+		form.GetFormItem(7).(*tview.InputField),
+		form.GetFormItem(8).(*tview.InputField),
+	}
+
+	closeSettings := func() {
+		t.pages.RemovePage("settings")
+		t.app.SetFocus(t.input)
+	}
+
+	save := func() {
+		// All numbers must be positive before anything is changed
+		numbers := make([]int, len(numberFields))
+		for i, field := range numberFields {
+			n, err := strconv.Atoi(strings.TrimSpace(field.GetText()))
+			if err != nil || n <= 0 {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.settings_invalid_number"),
+					strings.TrimSpace(field.GetLabel())))
+				form.SetFocus(6 + i)
+				t.app.SetFocus(form)
+				return
+			}
+			numbers[i] = n
+		}
+
+		_, theme := themeField.GetCurrentOption()
+		_, language := languageField.GetCurrentOption()
+		_, density := densityField.GetCurrentOption()
+		languageChanged := language != i18n.GetCurrentLanguage()
+
+		cfg.UI.ColorScheme = theme
+		cfg.UI.Language = language
+		cfg.UI.Density = density
+		cfg.UI.ShowTimestamps = timestampsField.IsChecked()
+		cfg.UI.AutoCompleteEnabled = autoCompleteField.IsChecked()
+		cfg.Commands.RememberParameters = rememberParametersField.IsChecked()
+		cfg.UI.MaxHistoryEntries = numbers[0]
+		cfg.Server.KeepAliveSeconds = numbers[1]
+		cfg.Server.DiscoverTimeoutSeconds = numbers[2]
+		closeSettings()
+
+		// Apply the changes to the running client
+		t.applyTheme()
+		t.applyDensity()
+		t.commandHistory.SetMaxEntries(cfg.UI.MaxHistoryEntries)
+		if languageChanged {
+			if err := i18n.LoadLanguage(language); err != nil {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.language_load"), err))
+			} else {
+				t.refreshTexts()
+			}
+		}
+
+		if err := config.SaveConfig(*cfg, ""); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+			return
+		}
+		t.ShowInfo(i18n.GetMessage("success.settings_saved"))
+	}
+
+	form.
+		AddButton(i18n.GetMessage("ui.save_button"), save).
+		AddButton(i18n.GetMessage("ui.cancel_button"), closeSettings).
+		SetCancelFunc(closeSettings)
+	form.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.settings_title")).
+		SetTitleAlign(tview.AlignCenter)
+	form.SetBackgroundColor(tcell.ColorBlack)
+
+	t.pages.AddPage("settings", centeredFlex(form, settingsWidth, 2*form.GetFormItemCount()+5), true, true)
+	t.app.SetFocus(form)
+}
+
+// refreshTexts shows the texts of the main view and the dialogs in the current language
+func (t *TUI) refreshTexts() {
+	t.output.SetTitle(i18n.GetMessage("ui.output_title"))
+	t.logView.SetTitle(i18n.GetMessage("ui.log_title"))
+	t.jobsPanel.SetTitle(i18n.GetMessage("ui.jobs_title"))
+	t.serverList.SetTitle(i18n.GetMessage("ui.available_servers"))
+	t.recentList.SetTitle(i18n.GetMessage("ui.recent_servers"))
+	t.helpText.SetTitle(i18n.GetMessage("ui.help_title"))
+	t.input.SetLabel(i18n.GetMessage("ui.command_prompt"))
+	t.updateSessionHeader()
+	t.initLoginForm()
+
+	// Key help texts are looked up when the bindings are registered
+	t.keyBindings = SetupDefaultKeyBindings(t)
+	if t.lastStatusInfo != nil {
+		t.updateStatus("", t.lastStatusInfo)
+	}
+}
@@ -0,0 +1,186 @@
+// settingssync.go
+/**
+ * Nexuflex Client - Settings Sync
+ *
+ * This file contains the "sync" client command and the sync of the
+ * personal settings after login. "sync passphrase" asks for the passphrase
+ * encrypting the settings on the server and stores it in the keyring; the
+ * same passphrase has to be entered once on every workstation. From then
+ * on the aliases, snippets, timeline filters, theme and density are synced
+ * after each login with a server supporting it, and "sync" syncs them at
+ * once. Settings pulled from the server replace the local ones and apply
+ * immediately.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// syncUsage is the syntax of the "sync" command
+const syncUsage = "sync [passphrase|forget]"
+
+// handleSyncCommand processes the "sync" client command
+func (t *TUI) handleSyncCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		if !t.client.IsLoggedIn() {
+			t.ShowError(i18n.GetMessage("error.not_logged_in"))
+			return
+		}
+		t.syncSettings(true)
+	case "passphrase":
+		if !t.client.IsLoggedIn() {
+			t.ShowError(i18n.GetMessage("error.not_logged_in"))
+			return
+		}
+		t.showSyncPassphrase()
+	case "forget":
+		if err := t.client.SetSyncPassphrase(""); err != nil {
+			t.ShowError(err.Error())
+			return
+		}
+		t.ShowInfo(i18n.GetMessage("commands.sync_passphrase_removed"))
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), syncUsage))
+	}
+}
+
+// handleSettingsSync is called by the client after login when the settings can be synced
+func (t *TUI) handleSettingsSync() {
+	t.app.QueueUpdateDraw(func() {
+		t.syncSettings(false)
+	})
+}
+
+// syncSettings syncs the settings in the background and applies pulled
+// ones; only a sync started by the user reports that nothing changed
+func (t *TUI) syncSettings(manual bool) {
+	local := t.localSettings()
+	go func() {
+		settings, outcome, err := t.client.SyncSettings(local)
+		t.app.QueueUpdateDraw(func() {
+			switch {
+			case errors.Is(err, client.ErrNoSyncPassphrase):
+				t.ShowError(i18n.GetMessage("commands.sync_no_passphrase"))
+			case err != nil:
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.sync_failed"), err))
+			case outcome == client.SyncPulled:
+				if skipped := t.applySyncedSettings(settings); len(skipped) > 0 {
+					t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.sync_aliases_skipped"), strings.Join(skipped, ", ")))
+				} else {
+					t.ShowInfo(i18n.GetMessage("commands.sync_pulled"))
+				}
+			case outcome == client.SyncPushed:
+				t.ShowInfo(i18n.GetMessage("commands.sync_pushed"))
+			case manual:
+				t.ShowInfo(i18n.GetMessage("commands.sync_unchanged"))
+			}
+		})
+	}()
+}
+
+// localSettings collects the settings synced through the server
+func (t *TUI) localSettings() client.SyncedSettings {
+	cfg := t.client.GetConfig()
+	return client.SyncedSettings{
+		Aliases:         t.aliasManager.GetAllAliases(),
+		Snippets:        maps.Clone(cfg.Snippets),
+		TimelineFilters: maps.Clone(cfg.TimelineFilters),
+		ColorScheme:     cfg.UI.ColorScheme,
+		Density:         cfg.UI.Density,
+	}
+}
+
+// applySyncedSettings replaces the local settings with the synced ones and
+// saves them; it returns the aliases that could not be created
+func (t *TUI) applySyncedSettings(settings client.SyncedSettings) []string {
+	var skipped []string
+	for name := range t.aliasManager.GetAllAliases() {
+		_ = t.aliasManager.RemoveAlias(name)
+	}
+	for name, command := range settings.Aliases {
+		if err := t.aliasManager.AddAlias(name, command); err != nil {
+			skipped = append(skipped, name)
+		}
+	}
+	if err := t.aliasManager.SaveAliases(); err != nil {
+		t.ShowError(err.Error())
+	}
+
+	cfg := t.client.GetConfig()
+	cfg.Snippets = make(map[string]string)
+	maps.Copy(cfg.Snippets, settings.Snippets)
+	cfg.TimelineFilters = make(map[string]string)
+	maps.Copy(cfg.TimelineFilters, settings.TimelineFilters)
+	if settings.ColorScheme != "" {
+		cfg.UI.ColorScheme = settings.ColorScheme
+	}
+	if settings.Density != "" {
+		cfg.UI.Density = settings.Density
+	}
+	t.applyTheme()
+	t.applyDensity()
+
+	if err := config.SaveConfig(*cfg, ""); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+	}
+	sort.Strings(skipped)
+	return skipped
+}
+
+// showSyncPassphrase asks for the passphrase encrypting the synced settings
+func (t *TUI) showSyncPassphrase() {
+	form := tview.NewForm().
+		AddPasswordField(i18n.GetMessage("ui.sync_passphrase"), "", 30, '*', nil).
+		AddPasswordField(i18n.GetMessage("ui.sync_passphrase_repeat"), "", 30, '*', nil)
+	passphraseField := form.GetFormItem(0).(*tview.InputField)
+	repeatField := form.GetFormItem(1).(*tview.InputField)
+
+	closePassphrase := func() {
+		t.pages.RemovePage("sync")
+		t.app.SetFocus(t.input)
+	}
+
+	save := func() {
+		passphrase := passphraseField.GetText()
+		if passphrase == "" || passphrase != repeatField.GetText() {
+			t.ShowError(i18n.GetMessage("error.sync_passphrase_mismatch"))
+			return
+		}
+		closePassphrase()
+		if err := t.client.SetSyncPassphrase(passphrase); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.sync_failed"), err))
+			return
+		}
+		t.ShowInfo(i18n.GetMessage("commands.sync_passphrase_set"))
+		t.syncSettings(true)
+	}
+
+	form.
+		AddButton(i18n.GetMessage("ui.save_button"), save).
+		AddButton(i18n.GetMessage("ui.cancel_button"), closePassphrase).
+		SetCancelFunc(closePassphrase)
+	form.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.sync_title")).
+		SetTitleAlign(tview.AlignCenter)
+	form.SetBackgroundColor(tcell.ColorBlack)
+
+	t.pages.AddPage("sync", centeredFlex(form, settingsWidth, 9), true, true)
+	t.app.SetFocus(form)
+}
@@ -0,0 +1,206 @@
+// shellimport.go
+/**
+ * Nexuflex Client - Shell Import Commands
+ *
+ * This file contains the "import" client command, which takes over the
+ * client invocations of a bash or zsh history file as history entries and
+ * the shell aliases running the client as nexuflex aliases. The converted
+ * entries are shown for review first: Enter selects or deselects an entry,
+ * "a" all of them, and "i" imports the selected ones. Entries already in
+ * the history and aliases whose name is taken start deselected; selecting
+ * such an alias replaces the existing one.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	"github.com/rivo/tview"
+)
+
+// importUsage is the syntax of the import command
+const importUsage = "import bash-history <file> | zsh-aliases <file>"
+
+// importCandidate is an entry of the import review
+type importCandidate struct {
+	client.ImportCandidate
+	selected bool
+	conflict string // Why the entry starts deselected, empty if it does not
+}
+
+// handleImportCommand processes the "import" client command
+func (t *TUI) handleImportCommand(args string) {
+	source, path, _ := strings.Cut(strings.TrimSpace(args), " ")
+	path = strings.TrimSpace(path)
+	if path == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), importUsage))
+		return
+	}
+
+	var converted []client.ImportCandidate
+	var err error
+	switch strings.ToLower(source) {
+	case "bash-history", "zsh-history":
+		converted, err = client.ImportShellHistory(path)
+	case "zsh-aliases", "bash-aliases":
+		converted, err = client.ImportShellAliases(path)
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), importUsage))
+		return
+	}
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.import"), err))
+		return
+	}
+	if len(converted) == 0 {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.import_none"), path))
+		return
+	}
+
+	t.showImportReview(filepath.Base(path), t.reviewImportCandidates(converted))
+}
+
+// reviewImportCandidates preselects the converted entries that can be imported
+func (t *TUI) reviewImportCandidates(converted []client.ImportCandidate) []*importCandidate {
+	history := make(map[string]bool)
+	for _, entry := range t.commandHistory.GetEntries() {
+		history[entry] = true
+	}
+
+	candidates := make([]*importCandidate, len(converted))
+	for i, c := range converted {
+		candidate := &importCandidate{ImportCandidate: c}
+		switch {
+		case c.Kind == client.ImportHistory && history[c.Command]:
+			candidate.conflict = i18n.GetMessage("ui.import_in_history")
+		case c.Kind == client.ImportAlias && (isReservedKeyword(c.Name) || plugin.IsCommand(c.Name)):
+			candidate.conflict = fmt.Sprintf(i18n.GetMessage("error.reserved_keyword"), c.Name)
+		case c.Kind == client.ImportAlias:
+			if _, exists := t.aliasManager.GetAlias(c.Name); exists {
+				candidate.conflict = fmt.Sprintf(i18n.GetMessage("ui.import_alias_replaced"), c.Name)
+			}
+		}
+		candidate.selected = candidate.conflict == ""
+		candidates[i] = candidate
+	}
+	return candidates
+}
+
+// showImportReview shows the converted entries for selecting the ones to import
+func (t *TUI) showImportReview(name string, candidates []*importCandidate) {
+	list := tview.NewList().
+		ShowSecondaryText(true).
+		SetSecondaryTextColor(tcell.ColorDimGray)
+	list.SetBorder(true).
+		SetTitle(fmt.Sprintf(i18n.GetMessage("ui.import_title"), len(candidates), name)).
+		SetTitleAlign(tview.AlignCenter)
+
+	closeReview := func() {
+		t.pages.RemovePage("import")
+		t.app.SetFocus(t.input)
+	}
+
+	fill := func() {
+		current := list.GetCurrentItem()
+		list.Clear()
+		for _, candidate := range candidates {
+			mark := "[ ] "
+			if candidate.selected {
+				mark = "[x] "
+			}
+			text := candidate.Command
+			if candidate.Kind == client.ImportAlias {
+				text = candidate.Name + " = " + candidate.Command
+			}
+			source := fmt.Sprintf("%d: %s", candidate.Line, candidate.Source)
+			if candidate.conflict != "" {
+				source = candidate.conflict + " - " + source
+			}
+			list.AddItem(tview.Escape(mark+text), tview.Escape(source), 0, nil)
+		}
+		list.SetCurrentItem(current)
+	}
+	fill()
+
+	list.SetSelectedFunc(func(index int, _, _ string, _ rune) {
+		candidates[index].selected = !candidates[index].selected
+		fill()
+	})
+	list.SetDoneFunc(closeReview)
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() != tcell.KeyRune {
+			return event
+		}
+		switch event.Rune() {
+		case 'a':
+			// Select all entries, or deselect them if all are selected
+			all := true
+			for _, candidate := range candidates {
+				all = all && candidate.selected
+			}
+			for _, candidate := range candidates {
+				candidate.selected = !all
+			}
+			fill()
+		case 'i':
+			closeReview()
+			t.importCandidates(candidates)
+		}
+		return nil
+	})
+
+	t.pages.AddPage("import", centeredFlex(list, 80, 24), true, true)
+	t.app.SetFocus(list)
+}
+
+// importCandidates adds the selected entries to the history and the aliases
+func (t *TUI) importCandidates(candidates []*importCandidate) {
+	entries, aliases, skipped := 0, 0, 0
+	for _, candidate := range candidates {
+		if !candidate.selected {
+			continue
+		}
+		switch candidate.Kind {
+		case client.ImportHistory:
+			t.commandHistory.Add(candidate.Command)
+			entries++
+		case client.ImportAlias:
+			if isReservedKeyword(candidate.Name) || plugin.IsCommand(candidate.Name) {
+				skipped++
+				continue
+			}
+			// Selecting an alias that already exists replaces it
+			if _, exists := t.aliasManager.GetAlias(candidate.Name); exists {
+				t.aliasManager.RemoveAlias(candidate.Name)
+			}
+			if err := t.aliasManager.AddAlias(candidate.Name, candidate.Command); err != nil {
+				skipped++
+				continue
+			}
+			aliases++
+		}
+	}
+
+	if entries > 0 {
+		if err := t.commandHistory.Save(); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.import"), err))
+		}
+	}
+	if aliases > 0 {
+		if err := t.aliasManager.SaveAliases(); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.import"), err))
+		}
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.import_done"), entries, aliases, skipped))
+}
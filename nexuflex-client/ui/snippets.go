@@ -0,0 +1,132 @@
+// snippets.go
+/**
+ * Nexuflex Client - Snippet Commands
+ *
+ * This file contains the inline expansion of text snippets while typing
+ * and the "snippet" client command for adding, listing and removing them.
+ * A snippet is expanded when a space is typed at the end of the input
+ * right after its trigger (prefix and name), and when the line is sent
+ * with the trigger as its last word. Snippets added or removed at runtime
+ * are saved to the [snippets] section of the configuration file.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// snippetUsage is the syntax of the snippet command
+const snippetUsage = "snippet [list] | snippet add <name> <text> | snippet remove <name>"
+
+// snippetPrefix returns the prefix that starts a snippet trigger
+func (t *TUI) snippetPrefix() string {
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.Commands.SnippetPrefix != "" {
+		return cfg.Commands.SnippetPrefix
+	}
+	return client.DefaultSnippetPrefix
+}
+
+// expandTypedSnippet expands the snippet before a space typed at the end of
+// the input; called with the text after every change of the input
+func (t *TUI) expandTypedSnippet(text string) {
+	previous := t.previousInput
+	t.previousInput = text
+	if text != previous+" " {
+		return
+	}
+
+	expanded, ok := client.ExpandLastSnippet(previous, t.client.GetConfig().Snippets, t.snippetPrefix())
+	if !ok {
+		return
+	}
+	// The input field is still processing the keystroke; replace the text afterwards
+	go t.app.QueueUpdateDraw(func() {
+		if t.input.GetText() == text {
+			t.input.SetText(expanded + " ")
+		}
+	})
+}
+
+// handleSnippetCommand processes the "snippet [add|remove]" client command
+func (t *TUI) handleSnippetCommand(args string) {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	switch strings.ToLower(sub) {
+	case "", "list":
+		t.showSnippets()
+	case "add":
+		name, text, _ := strings.Cut(strings.TrimSpace(rest), " ")
+		t.addSnippet(strings.TrimPrefix(name, t.snippetPrefix()), strings.TrimSpace(text))
+	case "remove":
+		t.removeSnippet(strings.TrimPrefix(strings.TrimSpace(rest), t.snippetPrefix()))
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), snippetUsage))
+	}
+}
+
+// addSnippet adds or replaces a snippet and saves it
+func (t *TUI) addSnippet(name, text string) {
+	if err := client.ValidateSnippet(name, text); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.snippet_invalid"), err))
+		return
+	}
+
+	cfg := t.client.GetConfig()
+	if cfg.Snippets == nil {
+		cfg.Snippets = make(map[string]string)
+	}
+	cfg.Snippets[name] = text
+	t.saveSnippets()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.snippet_added"), t.snippetPrefix()+name, text))
+}
+
+// removeSnippet deletes a snippet by name and saves the remaining ones
+func (t *TUI) removeSnippet(name string) {
+	cfg := t.client.GetConfig()
+	if _, ok := cfg.Snippets[name]; !ok {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.snippet_not_found"), name))
+		return
+	}
+
+	delete(cfg.Snippets, name)
+	t.saveSnippets()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.snippet_removed"), t.snippetPrefix()+name))
+}
+
+// saveSnippets writes the snippets to the configuration file
+func (t *TUI) saveSnippets() {
+	if err := config.SaveConfig(*t.client.GetConfig(), ""); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+	}
+}
+
+// showSnippets lists the snippets with their triggers
+func (t *TUI) showSnippets() {
+	snippets := t.client.GetConfig().Snippets
+	if len(snippets) == 0 {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.snippets_none"), snippetUsage))
+		return
+	}
+
+	names := make([]string, 0, len(snippets))
+	for name := range snippets {
+		names = append(names, name)
+	}
+	i18n.SortStrings(names)
+
+	prefix := t.snippetPrefix()
+	t.output.Write([]byte(i18n.GetMessage("commands.snippets_title") + "\n"))
+	for _, name := range names {
+		t.output.Write([]byte(fmt.Sprintf("  [yellow]%s[white] → %s\n", tview.Escape(prefix+name), tview.Escape(snippets[name]))))
+	}
+}
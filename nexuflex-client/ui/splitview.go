@@ -0,0 +1,179 @@
+// splitview.go
+/**
+ * Nexuflex Client - Split Output View
+ *
+ * This file contains the two-region output layout in which normal command
+ * output is shown in the upper pane and a designated streaming command
+ * (e.g. a live application log) is rendered into the lower pane.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// Limits for the split ratio (percentage of the height used by the upper pane)
+const (
+	minSplitRatio     = 10
+	maxSplitRatio     = 90
+	defaultSplitRatio = 70
+	splitRatioStep    = 5
+)
+
+// initSplitView creates the lower log pane and the container holding both panes
+func (t *TUI) initSplitView() {
+	t.logView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetChangedFunc(func() {
+			t.logWrap.invalidate()
+			t.requestDraw()
+		})
+	t.logView.SetBorder(true).SetTitle(i18n.GetMessage("ui.log_title"))
+
+	t.splitRatio = defaultSplitRatio
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.UI.SplitRatio > 0 {
+		t.splitRatio = clampSplitRatio(cfg.UI.SplitRatio)
+	}
+
+	t.outputArea = tview.NewFlex().SetDirection(tview.FlexRow)
+	t.arrangeOutputArea()
+}
+
+// arrangeOutputArea (re)builds the output container according to the split state;
+// the log pane is collapsed in the compact layout and keeps receiving output
+func (t *TUI) arrangeOutputArea() {
+	t.outputArea.Clear()
+	if !t.splitActive || t.isCompactLayout() {
+		t.outputArea.AddItem(t.output, 0, 1, false)
+		return
+	}
+
+	t.outputArea.
+		AddItem(t.output, 0, t.splitRatio, false).
+		AddItem(t.logView, 0, 100-t.splitRatio, false)
+}
+
+// showLogPane shows or hides the lower log pane
+func (t *TUI) showLogPane(show bool) {
+	if t.splitActive == show {
+		return
+	}
+	t.splitActive = show
+	t.arrangeOutputArea()
+}
+
+// setSplitRatio sets the percentage of the output height used by the upper pane
+func (t *TUI) setSplitRatio(ratio int) {
+	t.splitRatio = clampSplitRatio(ratio)
+	t.arrangeOutputArea()
+}
+
+// isLogStreamCommand checks whether a command is configured to render into the log pane
+func (t *TUI) isLogStreamCommand(command string) bool {
+	cfg := t.client.GetConfig()
+	if cfg == nil {
+		return false
+	}
+
+	name := strings.SplitN(strings.TrimSpace(command), " ", 2)[0]
+	for _, streamCommand := range cfg.UI.LogStreamCommands {
+		if strings.EqualFold(strings.TrimSpace(streamCommand), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// runLogStream executes a streaming command as a job whose output goes to the log pane
+func (t *TUI) runLogStream(command string) {
+	t.startJob(command, true)
+}
+
+// handleSplitCommand processes the "split" client command
+func (t *TUI) handleSplitCommand(args string) {
+	args = strings.ToLower(strings.TrimSpace(args))
+
+	switch args {
+	case "":
+		t.showLogPane(!t.splitActive)
+	case "on":
+		t.showLogPane(true)
+	case "off":
+		t.showLogPane(false)
+	case "clear":
+		t.logView.SetText("")
+	default:
+		ratio, err := strconv.Atoi(strings.TrimSuffix(args, "%"))
+		if err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "split [on|off|clear|<percent>]"))
+			return
+		}
+		t.showLogPane(true)
+		t.setSplitRatio(ratio)
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.split_ratio_set"), t.splitRatio))
+	}
+}
+
+// handleSplitKeys processes the keys for resizing and scrolling the split panes
+func (t *TUI) handleSplitKeys(event *tcell.EventKey) bool {
+	if !t.splitActive {
+		return false
+	}
+
+	alt := event.Modifiers()&tcell.ModAlt != 0
+	shift := event.Modifiers()&tcell.ModShift != 0
+
+	switch {
+	case alt && event.Key() == tcell.KeyUp:
+		t.setSplitRatio(t.splitRatio - splitRatioStep)
+		return true
+
+	case alt && event.Key() == tcell.KeyDown:
+		t.setSplitRatio(t.splitRatio + splitRatioStep)
+		return true
+
+	case shift && event.Key() == tcell.KeyPgUp:
+		scrollTextView(t.logView, -1)
+		return true
+
+	case shift && event.Key() == tcell.KeyPgDn:
+		scrollTextView(t.logView, 1)
+		return true
+	}
+
+	return false
+}
+
+// scrollTextView scrolls a text view by the given number of pages
+func scrollTextView(view *tview.TextView, pages int) {
+	row, _ := view.GetScrollOffset()
+	_, _, _, height := view.GetInnerRect()
+	row += pages * height
+	if row < 0 {
+		row = 0
+	}
+	view.ScrollTo(row, 0)
+}
+
+// clampSplitRatio limits a split ratio to the allowed range
+func clampSplitRatio(ratio int) int {
+	if ratio < minSplitRatio {
+		return minSplitRatio
+	}
+	if ratio > maxSplitRatio {
+		return maxSplitRatio
+	}
+	return ratio
+}
@@ -0,0 +1,52 @@
+// startup.go
+/**
+ * Nexuflex Client - Startup Commands
+ *
+ * This file contains the execution of the commands configured with
+ * "on_connect" in the [session] section of the profile. They run after
+ * every login as if they had been typed; a failing command is reported
+ * in the output and the remaining commands are still executed.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleSessionStarted is called by the client after a login with the startup commands;
+// the login may run on the UI goroutine, so the updates are queued without waiting
+func (t *TUI) handleSessionStarted(commands []string) {
+	// Jobs of a loaded workspace were waiting for the session
+	go t.app.QueueUpdateDraw(func() {
+		if len(t.workspaceJobs) > 0 {
+			t.startWorkspaceJobs()
+		}
+		t.advanceTutorial()
+	})
+
+	var startup []string
+	for _, command := range commands {
+		if command = strings.TrimSpace(command); command != "" {
+			startup = append(startup, command)
+		}
+	}
+	if len(startup) == 0 {
+		return
+	}
+
+	go t.app.QueueUpdateDraw(func() {
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("commands.startup_commands"), len(startup)))))
+		for _, command := range startup {
+			t.submitCommand(command)
+		}
+	})
+}
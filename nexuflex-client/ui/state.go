@@ -0,0 +1,81 @@
+// state.go
+/**
+ * Nexuflex Client - Local State Command
+ *
+ * This file contains the "state" client command, which shows how much disk
+ * space the files in the configuration directory take per category and
+ * applies the retention settings on demand.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+)
+
+// handleStateCommand processes the "state usage|prune" client command
+func (t *TUI) handleStateCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "", "usage":
+		t.showStateUsage()
+
+	case "prune":
+		cfg := t.client.GetConfig()
+		if cfg == nil {
+			return
+		}
+		result := state.Maintain(cfg.Retention, func(format string, args ...interface{}) {})
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.state_pruned"),
+			result.Compressed, result.Deleted, formatBytes(result.Freed)))
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "state [usage|prune]"))
+	}
+}
+
+// showStateUsage writes the disk consumption per category to the output
+func (t *TUI) showStateUsage() {
+	usage, err := state.Usage()
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.state_usage"), err))
+		return
+	}
+
+	dir, _ := state.Dir()
+	t.output.Write([]byte(fmt.Sprintf("%s\n", fmt.Sprintf(i18n.GetMessage("commands.state_title"), dir))))
+
+	var files int
+	var total int64
+	for _, category := range usage {
+		t.output.Write([]byte(fmt.Sprintf("  %-14s %10s  %s\n",
+			i18n.GetMessage("commands.state_"+category.Category), formatBytes(category.Bytes),
+			fmt.Sprintf(i18n.GetMessage("commands.state_files"), category.Files))))
+		files += category.Files
+		total += category.Bytes
+	}
+	t.output.Write([]byte(fmt.Sprintf("  %-14s %10s  %s\n",
+		i18n.GetMessage("commands.state_total"), formatBytes(total),
+		fmt.Sprintf(i18n.GetMessage("commands.state_files"), files))))
+}
+
+// formatBytes formats a size in bytes with a binary unit, e.g. "1.5 MiB"
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,77 @@
+// statusdetails.go
+/**
+ * Nexuflex Client - Status Details
+ *
+ * This file contains the popup with the full status information. On
+ * narrow terminals the status segments are abbreviated, truncated or
+ * dropped; clicking the status information shows all segments in full,
+ * with the clicked one marked, so nothing is lost by fitting them.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// statusClickCapture shows the status details when the status information is clicked
+func (t *TUI) statusClickCapture(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	if action != tview.MouseLeftClick || len(t.statusAll) == 0 {
+		return action, event
+	}
+	x, _ := event.Position()
+	t.showStatusDetails(t.statusSegmentAt(x))
+	return tview.MouseConsumed, nil
+}
+
+// statusSegmentAt returns the index of the shown segment at a screen column, -1 if there is none
+func (t *TUI) statusSegmentAt(x int) int {
+	innerX, _, innerWidth, _ := t.statusInfo.GetInnerRect()
+
+	// The status information is right-aligned
+	column := innerX + max(0, innerWidth-statusWidth(t.statusShown))
+	separator := tview.TaggedStringWidth(statusSeparator)
+	for i, segment := range t.statusShown {
+		width := tview.TaggedStringWidth(segment.shown)
+		if x >= column && x < column+width {
+			return i
+		}
+		column += width + separator
+	}
+	return -1
+}
+
+// showStatusDetails shows all status segments in full; the shown segment
+// with the given index is marked
+func (t *TUI) showStatusDetails(index int) {
+	clicked := ""
+	if index >= 0 && index < len(t.statusShown) {
+		clicked = t.statusShown[index].text
+	}
+
+	lines := make([]string, len(t.statusAll))
+	for i, segment := range t.statusAll {
+		mark := "  "
+		if segment.text == clicked {
+			mark = "▸ "
+		}
+		lines[i] = mark + segment.text
+	}
+
+	modal := tview.NewModal().
+		SetText(i18n.GetMessage("ui.status_details_title") + "\n\n" + strings.Join(lines, "\n")).
+		AddButtons([]string{i18n.GetMessage("ui.close_button")}).
+		SetDoneFunc(func(int, string) {
+			t.pages.RemovePage("modal")
+			t.app.SetFocus(t.input)
+		})
+	t.pages.AddPage("modal", modal, true, true)
+}
@@ -0,0 +1,164 @@
+// suggestions.go
+/**
+ * Nexuflex Client - Alias Suggestions
+ *
+ * This file contains the hints at long commands the user types again and
+ * again and the "suggestions" client command. Every few minutes the
+ * history is analyzed; a newly found command is mentioned once in the
+ * status bar, e.g. 'You typed "Inventory.Show.Item 4711" 14 times'. The
+ * suggestions page lists all of them with the proposed alias name: Enter
+ * creates the alias, "s" saves the command as a snippet instead and "d"
+ * dismisses the suggestion for the rest of the session.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// suggestionInterval is the time between two analyses of the history
+const suggestionInterval = 10 * time.Minute
+
+// startUsageSuggestions analyzes the history periodically and hints at new suggestions
+func (t *TUI) startUsageSuggestions() {
+	go func() {
+		ticker := time.NewTicker(suggestionInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			// The hint would replace what the user is reading in the status bar
+			if t.isTyping() {
+				continue
+			}
+			t.app.QueueUpdateDraw(t.hintUsageSuggestion)
+		}
+	}()
+}
+
+// usageSuggestions returns the current suggestions that were not dismissed
+func (t *TUI) usageSuggestions() []client.UsageSuggestion {
+	cfg := t.client.GetConfig()
+	if !cfg.Commands.SuggestAliases {
+		return nil
+	}
+
+	suggestions := client.SuggestAliases(t.commandHistory.GetEntries(), t.aliasManager.GetAllAliases(),
+		cfg.Snippets, cfg.Commands.SuggestMinCount, cfg.Commands.SuggestMinLength, func(name string) bool {
+			_, snippet := cfg.Snippets[name]
+			return snippet || isReservedKeyword(name)
+		})
+	var remaining []client.UsageSuggestion
+	for _, suggestion := range suggestions {
+		if !t.suggestionsDismissed[suggestion.Command] {
+			remaining = append(remaining, suggestion)
+		}
+	}
+	return remaining
+}
+
+// hintUsageSuggestion mentions the first suggestion not hinted at before in the status bar
+func (t *TUI) hintUsageSuggestion() {
+	for _, suggestion := range t.usageSuggestions() {
+		if t.suggestionsHinted[suggestion.Command] {
+			continue
+		}
+		if t.suggestionsHinted == nil {
+			t.suggestionsHinted = make(map[string]bool)
+		}
+		t.suggestionsHinted[suggestion.Command] = true
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("ui.suggestion_hint"), suggestion.Command, suggestion.Count))
+		return
+	}
+}
+
+// handleSuggestionsCommand processes the "suggestions" client command
+func (t *TUI) handleSuggestionsCommand() {
+	suggestions := t.usageSuggestions()
+	if len(suggestions) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.suggestions_none"))
+		return
+	}
+	t.showSuggestions(suggestions)
+}
+
+// showSuggestions shows the suggestions for creating aliases with one key
+func (t *TUI) showSuggestions(suggestions []client.UsageSuggestion) {
+	list := tview.NewList().
+		ShowSecondaryText(true).
+		SetSecondaryTextColor(tcell.ColorDimGray)
+	list.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.suggestions_title")).
+		SetTitleAlign(tview.AlignCenter)
+
+	closeSuggestions := func() {
+		t.pages.RemovePage("suggestions")
+		t.app.SetFocus(t.input)
+	}
+
+	for _, suggestion := range suggestions {
+		list.AddItem(tview.Escape(suggestion.Command),
+			tview.Escape(fmt.Sprintf(i18n.GetMessage("ui.suggestion_details"), suggestion.Count, suggestion.Alias)), 0, nil)
+	}
+
+	// done removes a handled suggestion and closes the page after the last one
+	done := func(index int) {
+		suggestions = append(suggestions[:index], suggestions[index+1:]...)
+		list.RemoveItem(index)
+		if len(suggestions) == 0 {
+			closeSuggestions()
+		}
+	}
+
+	list.SetSelectedFunc(func(index int, _, _ string, _ rune) {
+		if t.acceptAliasSuggestion(suggestions[index]) {
+			done(index)
+		}
+	})
+	list.SetDoneFunc(closeSuggestions)
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() != tcell.KeyRune || len(suggestions) == 0 {
+			return event
+		}
+		index := list.GetCurrentItem()
+		switch event.Rune() {
+		case 's':
+			t.addSnippet(suggestions[index].Alias, suggestions[index].Command)
+			done(index)
+		case 'd':
+			if t.suggestionsDismissed == nil {
+				t.suggestionsDismissed = make(map[string]bool)
+			}
+			t.suggestionsDismissed[suggestions[index].Command] = true
+			done(index)
+		default:
+			return event
+		}
+		return nil
+	})
+
+	t.pages.AddPage("suggestions", centeredFlex(list, 80, 20), true, true)
+	t.app.SetFocus(list)
+}
+
+// acceptAliasSuggestion creates the proposed alias of a suggestion
+func (t *TUI) acceptAliasSuggestion(suggestion client.UsageSuggestion) bool {
+	if err := t.aliasManager.AddAlias(suggestion.Alias, suggestion.Command); err != nil {
+		t.ShowError(err.Error())
+		return false
+	}
+	if err := t.aliasManager.SaveAliases(); err != nil {
+		t.ShowError(err.Error())
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.alias_created"), suggestion.Alias, suggestion.Command))
+	return true
+}
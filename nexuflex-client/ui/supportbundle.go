@@ -0,0 +1,63 @@
+// supportbundle.go
+/**
+ * Nexuflex Client - Support Bundle Command
+ *
+ * This file contains the "support-bundle" client command, which writes a
+ * zip file for bug reports with the version information, the configuration,
+ * the debug log, the terminal capabilities and the protocol traces of the
+ * last commands. The capabilities of the terminal are only known to the
+ * user interface and are added here.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// supportBundleCommands is the number of last commands whose protocol traces are included
+const supportBundleCommands = 20
+
+// handleSupportBundleCommand processes the "support-bundle [<file.zip>]" client command
+func (t *TUI) handleSupportBundleCommand(path string) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = "nexuflex-support-" + time.Now().Format("20060102-150405") + ".zip"
+	}
+	if filepath.Ext(path) == "" {
+		path += ".zip"
+	}
+
+	files, err := t.client.WriteSupportBundle(path, t.terminalCapabilities(), supportBundleCommands)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.support_bundle"), err))
+		return
+	}
+
+	t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.support_bundle_saved"),
+		path, strings.Join(files, ", ")) + "\n"))
+}
+
+// terminalCapabilities describes the screen as tcell sees it
+func (t *TUI) terminalCapabilities() []string {
+	if t.screen == nil {
+		return []string{"screen: not initialized"}
+	}
+	width, height := t.screen.Size()
+	return []string{
+		fmt.Sprintf("size: %dx%d", width, height),
+		fmt.Sprintf("colors: %d", t.screen.Colors()),
+		fmt.Sprintf("character set: %s", t.screen.CharacterSet()),
+		fmt.Sprintf("mouse: %t", t.screen.HasMouse()),
+		fmt.Sprintf("compact layout: %t", t.isCompactLayout()),
+	}
+}
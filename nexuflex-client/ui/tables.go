@@ -0,0 +1,62 @@
+// tables.go
+/**
+ * Nexuflex Client - Structured Results
+ *
+ * This file contains the handling of structured command results. A
+ * command answering with a table is announced in the output area; the
+ * "table" client command opens the last table in a ResultTable.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// handleTable remembers the structured result of a command
+func (t *TUI) handleTable(command string, table *proto.TableResult) {
+	t.lastTable = table
+	t.lastTableCommand = command
+	t.resultTable = table
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.table_received"), len(table.Rows), len(table.Columns)))))
+}
+
+// handleTableCommand processes the "table" client command
+func (t *TUI) handleTableCommand() {
+	if t.lastTable == nil {
+		t.ShowError(i18n.GetMessage("error.table_none"))
+		return
+	}
+	t.showResultTable(t.lastTableCommand, t.lastTable)
+}
+
+// showResultTable opens a structured result in the table view
+func (t *TUI) showResultTable(command string, table *proto.TableResult) {
+	columns := make([]ResultColumn, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = ResultColumn{Name: column.Name, Numeric: column.Numeric}
+	}
+	rows := make([][]string, len(table.Rows))
+	for i, row := range table.Rows {
+		rows[i] = row.Cells
+	}
+
+	resultTable := NewResultTable().SetData(columns, rows)
+	resultTable.SetTitle(" " + tview.Escape(command) + " ")
+	resultTable.SetDoneFunc(func() {
+		t.pages.RemovePage("table")
+		t.app.SetFocus(t.input)
+	})
+
+	t.pages.AddPage("table", resultTable, true, true)
+	t.app.SetFocus(resultTable)
+}
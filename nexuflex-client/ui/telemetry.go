@@ -0,0 +1,78 @@
+// telemetry.go
+/**
+ * Nexuflex Client - Telemetry Command
+ *
+ * This file contains the client command for showing and changing the
+ * opt-in telemetry setting, including what exactly is sent to the server.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleTelemetryCommand processes the "telemetry" client command
+func (t *TUI) handleTelemetryCommand(args string) {
+	telemetry := t.client.GetTelemetry()
+
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "", "status":
+		t.showTelemetryStatus()
+
+	case "on":
+		telemetry.SetEnabled(true)
+		t.saveTelemetrySetting(true)
+		t.ShowInfo(i18n.GetMessage("commands.telemetry_enabled"))
+		t.showTelemetryStatus()
+
+		// Send the report right away if a session exists
+		if t.client.IsLoggedIn() {
+			go t.client.ReportClientInfo()
+		}
+
+	case "off":
+		telemetry.SetEnabled(false)
+		t.saveTelemetrySetting(false)
+		t.ShowInfo(i18n.GetMessage("commands.telemetry_disabled"))
+
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "telemetry [status|on|off]"))
+	}
+}
+
+// showTelemetryStatus writes the telemetry state and the report contents to the output
+func (t *TUI) showTelemetryStatus() {
+	telemetry := t.client.GetTelemetry()
+
+	if !telemetry.IsEnabled() {
+		t.output.Write([]byte(i18n.GetMessage("commands.telemetry_status_off") + "\n"))
+		return
+	}
+
+	t.output.Write([]byte(i18n.GetMessage("commands.telemetry_status_on") + "\n"))
+	for _, line := range telemetry.DescribeReport() {
+		t.output.Write([]byte(fmt.Sprintf("  %s\n", line)))
+	}
+}
+
+// saveTelemetrySetting persists the telemetry setting in the configuration file
+func (t *TUI) saveTelemetrySetting(enabled bool) {
+	cfg := t.client.GetConfig()
+	if cfg == nil {
+		return
+	}
+
+	cfg.Telemetry.Enabled = enabled
+	if err := config.SaveConfig(*cfg, ""); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+	}
+}
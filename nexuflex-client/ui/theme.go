@@ -0,0 +1,73 @@
+// theme.go
+/**
+ * Nexuflex Client - Color Themes
+ *
+ * This file contains the color themes selectable with the "color_scheme"
+ * option. A theme sets the background colors of the header, the command
+ * line and the status bar; it can be changed at runtime on the settings
+ * page.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// colorTheme contains the colors of the main view
+type colorTheme struct {
+	header    tcell.Color // Header background; elevated sessions always get a red header
+	field     tcell.Color // Background of the command line
+	statusBar tcell.Color
+}
+
+// colorThemes are the available themes by name
+var colorThemes = map[string]colorTheme{
+	"default": {
+		header:    tcell.ColorBlue,
+		field:     tcell.ColorBlack,
+		statusBar: tcell.ColorDarkGray,
+	},
+	"dark": {
+		header:    tcell.ColorDarkSlateGray,
+		field:     tcell.ColorBlack,
+		statusBar: tcell.ColorBlack,
+	},
+	"contrast": {
+		header:    tcell.ColorNavy,
+		field:     tcell.ColorDarkBlue,
+		statusBar: tcell.ColorBlack,
+	},
+}
+
+// themeNames returns the names of the available themes
+func themeNames() []string {
+	names := make([]string, 0, len(colorThemes))
+	for name := range colorThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyTheme colors the main view with the configured theme; unknown names fall back to "default"
+func (t *TUI) applyTheme() {
+	t.theme = colorThemes["default"]
+	if cfg := t.client.GetConfig(); cfg != nil {
+		if theme, ok := colorThemes[cfg.UI.ColorScheme]; ok {
+			t.theme = theme
+		}
+	}
+
+	t.input.SetFieldBackgroundColor(t.theme.field)
+	t.statusBar.SetBackgroundColor(t.theme.statusBar)
+	t.statusText.SetBackgroundColor(t.theme.statusBar)
+	t.statusInfo.SetBackgroundColor(t.theme.statusBar)
+	t.updateSessionHeader()
+}
@@ -0,0 +1,97 @@
+// thresholds.go
+/**
+ * Nexuflex Client - Result Thresholds
+ *
+ * This file contains the check of finished commands against the configured
+ * thresholds. The rows of a result are the rows of the table it returned,
+ * otherwise its lines of output. A breached threshold is shown as a badge
+ * in the header of the block of the command, e.g. "WARNING open_items:
+ * rows 12408 > 10000", so that it stays visible above a long result, and
+ * recorded in the timeline like an annotation.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	"github.com/rivo/tview"
+)
+
+// initThresholds compiles the configured threshold rules
+func (t *TUI) initThresholds() {
+	rules, err := client.ParseThresholdRules(t.client.GetConfig().Thresholds)
+	t.thresholdRules = rules
+	if err != nil {
+		t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.threshold_rules"), err))))
+	}
+}
+
+// checkThresholds marks the header of block seq with the thresholds the
+// result of its command exceeds
+func (t *TUI) checkThresholds(seq int64, result plugin.Result) {
+	table := t.resultTable
+	t.resultTable = nil
+	if len(t.thresholdRules) == 0 {
+		return
+	}
+
+	metrics := client.ResultMetrics{Duration: result.Duration, Bytes: len(result.Output)}
+	if table != nil {
+		metrics.Rows = len(table.Rows)
+		for _, row := range table.Rows {
+			for _, cell := range row.Cells {
+				metrics.Bytes += len(cell)
+			}
+		}
+	} else {
+		for _, line := range strings.Split(result.Output, "\n") {
+			if strings.TrimSpace(line) != "" {
+				metrics.Rows++
+			}
+		}
+	}
+
+	breaches := client.CheckThresholds(t.thresholdRules, result.Command, metrics)
+	if len(breaches) == 0 {
+		return
+	}
+
+	var mark strings.Builder
+	for _, breach := range breaches {
+		mark.WriteString(fmt.Sprintf(" %s %s %s [-:-]", annotationBadges[breach.Severity],
+			strings.ToUpper(breach.Severity), tview.Escape(breach.Text)))
+		t.client.RecordAnnotation(result.Command, breach)
+	}
+	t.markBlockHeader(seq, mark.String())
+}
+
+// markBlockHeader appends a mark to the first line of a block, usually the
+// echo of its command
+func (t *TUI) markBlockHeader(seq int64, mark string) {
+	text := t.output.GetText(false)
+	tag := blockTag(seq)
+	start := strings.Index(text, tag)
+	if start < 0 {
+		return
+	}
+	end := strings.IndexByte(text[start:], '\n')
+	if end < 0 {
+		end = len(text)
+	} else {
+		end += start
+	}
+
+	row, col := t.output.GetScrollOffset()
+	t.output.SetText(text[:end] + mark + text[end:])
+	t.output.ScrollTo(row, col)
+}
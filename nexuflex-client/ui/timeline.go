@@ -0,0 +1,274 @@
+// timeline.go
+/**
+ * Nexuflex Client - Session Timeline
+ *
+ * This file contains the timeline page, a chronological list of the
+ * events of the session (connects, logins, context switches, commands,
+ * errors, notifications and annotations) with icons and timestamps, fed by
+ * the event bus of the client. The number keys show or hide the event
+ * types, "a" shows all of them again; "#<tag>" arguments restrict the page
+ * to events with one of the tags, e.g. annotations of a severity; events arriving while the page is open are
+ * added at the bottom. "@<name>" applies a saved filter, and "f" cycles
+ * through the favorite filters (see timelinefilters.go). The page helps
+ * to reconstruct what happened after an incident.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// timelineIcons are the icons and colors of the event types
+var timelineIcons = map[client.EventType]struct {
+	icon  string
+	color string
+}{
+	client.EventConnect:      {"⇄", "aqua"},
+	client.EventDisconnect:   {"⨯", "aqua"},
+	client.EventLogin:        {"→", "green"},
+	client.EventLogout:       {"←", "green"},
+	client.EventContext:      {"◆", "blue"},
+	client.EventCommand:      {"›", "white"},
+	client.EventError:        {"✖", "red"},
+	client.EventNotification: {"✉", "yellow"},
+	client.EventAnnotation:   {"◈", "fuchsia"},
+}
+
+// timelinePage is the state of the open timeline page
+type timelinePage struct {
+	table       *tview.Table
+	filterBar   *tview.TextView
+	hidden      map[client.EventType]bool
+	tags        []string                 // Only events with one of these tags are shown, if set
+	filter      *client.TimelineFilter   // Saved filter applied, nil if none
+	favorites   []*client.TimelineFilter // Favorite filters cycled with "f"
+	unsubscribe func()
+}
+
+// handleTimelineCommand processes the "timeline [type...] [#tag...] [@filter]"
+// client command; given types restrict the page to these types, given tags
+// to the events with one of them, a given filter to the events it matches
+func (t *TUI) handleTimelineCommand(args string) {
+	if sub, rest, _ := strings.Cut(strings.TrimSpace(args), " "); strings.EqualFold(sub, "filter") {
+		t.handleTimelineFilterCommand(rest)
+		return
+	}
+
+	filters := t.timelineFilters()
+	hidden := make(map[client.EventType]bool)
+	var types, tags []string
+	var filter *client.TimelineFilter
+	for _, word := range strings.Fields(args) {
+		switch {
+		case strings.HasPrefix(word, "#") && len(word) > 1:
+			tags = append(tags, strings.ToLower(word[1:]))
+		case strings.HasPrefix(word, "@") && len(word) > 1:
+			if filter = findTimelineFilter(filters, word[1:]); filter == nil {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.timeline_filter_not_found"), word[1:]))
+				return
+			}
+		default:
+			types = append(types, strings.ToLower(word))
+		}
+	}
+	if len(types) > 0 {
+		for _, eventType := range client.EventTypes {
+			hidden[eventType] = true
+		}
+		for _, name := range types {
+			if _, ok := timelineIcons[client.EventType(name)]; !ok {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.timeline_type"), name, timelineTypeNames()))
+				return
+			}
+			hidden[client.EventType(name)] = false
+		}
+	}
+	t.showTimeline(hidden, tags, filter, filters)
+}
+
+// showTimeline opens the timeline page with the given event types hidden,
+// restricted to events with one of the tags if any are given and to the
+// events matching the filter if it is set
+func (t *TUI) showTimeline(hidden map[client.EventType]bool, tags []string,
+	filter *client.TimelineFilter, filters []*client.TimelineFilter) {
+	page := &timelinePage{
+		table:     tview.NewTable().SetSelectable(true, false),
+		filterBar: tview.NewTextView().SetDynamicColors(true),
+		hidden:    hidden,
+		tags:      tags,
+		filter:    filter,
+	}
+	for _, f := range filters {
+		if f.Favorite {
+			page.favorites = append(page.favorites, f)
+		}
+	}
+	page.table.SetBorder(true).
+		SetTitle(i18n.GetMessage("ui.timeline_title")).
+		SetTitleAlign(tview.AlignCenter)
+
+	// The notifications are read once they were shown in the timeline
+	t.markNotificationsRead()
+	closeTimeline := func() {
+		page.unsubscribe()
+		t.pages.RemovePage("timeline")
+		t.app.SetFocus(t.input)
+		t.markNotificationsRead()
+	}
+
+	page.table.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			closeTimeline()
+		}
+	})
+	page.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() != tcell.KeyRune {
+			return event
+		}
+		switch r := event.Rune(); {
+		case r == 'a':
+			page.hidden = make(map[client.EventType]bool)
+		case r == 'f':
+			page.filter = nextFavoriteFilter(page.favorites, page.filter)
+		case r >= '1' && int(r-'1') < len(client.EventTypes):
+			eventType := client.EventTypes[r-'1']
+			page.hidden[eventType] = !page.hidden[eventType]
+		default:
+			return event
+		}
+		t.fillTimeline(page)
+		return nil
+	})
+
+	// Events of the running session are added while the page is open
+	page.unsubscribe = t.client.Events().Subscribe(func(event client.Event) {
+		go t.app.QueueUpdateDraw(func() {
+			if t.pages.HasPage("timeline") {
+				t.fillTimeline(page)
+			}
+		})
+	})
+
+	t.fillTimeline(page)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(page.filterBar, 1, 0, false).
+		AddItem(page.table, 0, 1, true)
+	t.pages.AddPage("timeline", layout, true, true)
+	t.app.SetFocus(page.table)
+}
+
+// fillTimeline shows the filter bar and the events of the visible types
+func (t *TUI) fillTimeline(page *timelinePage) {
+	var bar strings.Builder
+	for i, eventType := range client.EventTypes {
+		color := "white"
+		if page.hidden[eventType] {
+			color = "gray"
+		}
+		bar.WriteString(fmt.Sprintf(" [yellow]%d[%s] %s %s ", i+1, color,
+			timelineIcons[eventType].icon, eventType))
+	}
+	bar.WriteString("[gray]│ a " + i18n.GetMessage("ui.timeline_all"))
+	if len(page.tags) > 0 {
+		bar.WriteString(" │ [fuchsia]#" + tview.Escape(strings.Join(page.tags, " #")))
+	}
+	if page.filter != nil {
+		bar.WriteString(" │ [aqua]@" + tview.Escape(page.filter.Name))
+	} else if len(page.favorites) > 0 {
+		bar.WriteString(" │ [gray]f " + i18n.GetMessage("ui.timeline_favorites"))
+	}
+	page.filterBar.SetText(bar.String())
+
+	// Follow new events unless an older one is selected
+	selected, _ := page.table.GetSelection()
+	follow := selected >= page.table.GetRowCount()-1
+
+	page.table.Clear()
+	row := 0
+	now := time.Now()
+	for _, event := range t.client.Events().History() {
+		if page.hidden[event.Type] || !hasAnyTag(event.Tags, page.tags) {
+			continue
+		}
+		if page.filter != nil && !page.filter.Matches(event, now) {
+			continue
+		}
+		style := timelineIcons[event.Type]
+		page.table.SetCell(row, 0, tview.NewTableCell(event.Time.Format("2006-01-02 15:04:05")).
+			SetTextColor(tcell.ColorGray))
+		page.table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("[%s]%s", style.color, style.icon)))
+		page.table.SetCell(row, 2, tview.NewTableCell(string(event.Type)).SetTextColor(tcell.ColorGray))
+		text := tview.Escape(event.Text)
+		if len(event.Tags) > 0 {
+			text += " [gray]#" + tview.Escape(strings.Join(event.Tags, " #"))
+		}
+		page.table.SetCell(row, 3, tview.NewTableCell(text).SetExpansion(1))
+		page.table.SetCell(row, 4, tview.NewTableCell(tview.Escape(timelineOrigin(event))).
+			SetTextColor(tcell.ColorGray))
+		row++
+	}
+
+	if row == 0 {
+		page.table.SetCell(0, 3, tview.NewTableCell(i18n.GetMessage("ui.timeline_empty")).
+			SetTextColor(tcell.ColorGray).SetSelectable(false))
+		return
+	}
+	if follow || selected >= row {
+		selected = row - 1
+	}
+	page.table.Select(selected, 0)
+}
+
+// timelineOrigin returns where an event happened: user, server and context
+func timelineOrigin(event client.Event) string {
+	var parts []string
+	if event.User != "" {
+		parts = append(parts, event.User)
+	}
+	if event.Server != "" {
+		parts = append(parts, "@"+event.Server)
+	}
+	origin := strings.Join(parts, "")
+	if event.Context != "" {
+		origin = strings.TrimSpace(origin + " " + event.Context)
+	}
+	return origin
+}
+
+// hasAnyTag checks whether an event has one of the wanted tags; without
+// wanted tags every event qualifies
+func hasAnyTag(tags, wanted []string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		for _, want := range wanted {
+			if strings.EqualFold(tag, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// timelineTypeNames returns the event type names for error messages
+func timelineTypeNames() string {
+	names := make([]string, len(client.EventTypes))
+	for i, eventType := range client.EventTypes {
+		names[i] = string(eventType)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,138 @@
+// timelinefilters.go
+/**
+ * Nexuflex Client - Timeline Filter Commands
+ *
+ * This file contains the "timeline filter" client command for adding,
+ * listing and removing the saved filters of the timeline page, and the
+ * cycling through the favorite filters on the page. Filters added or
+ * removed at runtime are saved to the [timeline_filter] section of the
+ * configuration file.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// timelineFilterUsage is the syntax of the timeline filter command
+const timelineFilterUsage = "timeline filter [list] | timeline filter add <name> [*] <condition...> | timeline filter remove <name>"
+
+// timelineFilters parses the saved filters, reporting invalid ones
+func (t *TUI) timelineFilters() []*client.TimelineFilter {
+	filters, err := client.ParseTimelineFilters(t.client.GetConfig().TimelineFilters)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.timeline_filters"), err))
+	}
+	return filters
+}
+
+// findTimelineFilter returns the filter with a name, nil if there is none
+func findTimelineFilter(filters []*client.TimelineFilter, name string) *client.TimelineFilter {
+	for _, filter := range filters {
+		if strings.EqualFold(filter.Name, name) {
+			return filter
+		}
+	}
+	return nil
+}
+
+// nextFavoriteFilter returns the favorite after the current filter; after
+// the last one, and for a filter that is no favorite, the timeline is
+// shown unfiltered again, then the first favorite
+func nextFavoriteFilter(favorites []*client.TimelineFilter, current *client.TimelineFilter) *client.TimelineFilter {
+	if current == nil {
+		if len(favorites) == 0 {
+			return nil
+		}
+		return favorites[0]
+	}
+	for i, favorite := range favorites {
+		if favorite == current && i+1 < len(favorites) {
+			return favorites[i+1]
+		}
+	}
+	return nil
+}
+
+// handleTimelineFilterCommand processes the "timeline filter [add|remove]" client command
+func (t *TUI) handleTimelineFilterCommand(args string) {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	switch strings.ToLower(sub) {
+	case "", "list":
+		t.showTimelineFilters()
+	case "add":
+		name, definition, _ := strings.Cut(strings.TrimSpace(rest), " ")
+		t.addTimelineFilter(name, strings.TrimSpace(definition))
+	case "remove":
+		t.removeTimelineFilter(strings.TrimSpace(rest))
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), timelineFilterUsage))
+	}
+}
+
+// addTimelineFilter adds or replaces a filter and saves it
+func (t *TUI) addTimelineFilter(name, definition string) {
+	if _, err := client.ParseTimelineFilter(name, definition); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.timeline_filter_invalid"), err))
+		return
+	}
+
+	cfg := t.client.GetConfig()
+	if cfg.TimelineFilters == nil {
+		cfg.TimelineFilters = make(map[string]string)
+	}
+	cfg.TimelineFilters[name] = definition
+	t.saveTimelineFilters()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.timeline_filter_added"), name))
+}
+
+// removeTimelineFilter deletes a filter by name and saves the remaining ones
+func (t *TUI) removeTimelineFilter(name string) {
+	cfg := t.client.GetConfig()
+	if _, ok := cfg.TimelineFilters[name]; !ok {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.timeline_filter_not_found"), name))
+		return
+	}
+
+	delete(cfg.TimelineFilters, name)
+	t.saveTimelineFilters()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.timeline_filter_removed"), name))
+}
+
+// saveTimelineFilters writes the filters to the configuration file
+func (t *TUI) saveTimelineFilters() {
+	if err := config.SaveConfig(*t.client.GetConfig(), ""); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+	}
+}
+
+// showTimelineFilters lists the saved filters with their conditions
+func (t *TUI) showTimelineFilters() {
+	definitions := t.client.GetConfig().TimelineFilters
+	if len(definitions) == 0 {
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.timeline_filters_none"), timelineFilterUsage))
+		return
+	}
+
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	i18n.SortStrings(names)
+
+	t.output.Write([]byte(i18n.GetMessage("commands.timeline_filters_title") + "\n"))
+	for _, name := range names {
+		t.output.Write([]byte(fmt.Sprintf("  [aqua]@%s[white] %s\n", tview.Escape(name), tview.Escape(definitions[name]))))
+	}
+}
@@ -1,766 +1,1664 @@
-// tui.go
-/**
- * Nexuflex Client - Text User Interface Main Class
- *
- * This file contains the main class for the text-based user interface (TUI)
- * of the nexuflex client.
- *
- * @author msto63
- * @version 1.0.0
- * @date 2025-03-12
- */
-
-package ui
-
-import (
-	"fmt"
-	"strings"
-	"time"
-
-	"github.com/gdamore/tcell/v2"
-	"github.com/msto63/nexuflex/nexuflex-client/core"
-	"github.com/msto63/nexuflex/nexuflex-client/i18n"
-	"github.com/msto63/nexuflex/shared/proto"
-	"github.com/rivo/tview"
-)
-
-// TUI represents the complete text-based user interface
-type TUI struct {
-	// Main components
-	app        *tview.Application
-	pages      *tview.Pages
-	layout     *tview.Flex
-	header     *tview.TextView
-	output     *tview.TextView
-	input      *tview.InputField
-	statusBar  *tview.Flex
-	statusText *tview.TextView
-	statusInfo *tview.TextView
-
-	// Dialogs
-	loginForm  *tview.Form
-	serverList *tview.List
-	helpText   *tview.TextView
-
-	// Client and other components
-	client         *core.Client
-	commandHistory *core.CommandHistory
-	aliasManager   *core.AliasManager
-
-	// Status
-	lastCommand   string
-	statusMessage string
-}
-
-// NewTUI creates a new TUI instance
-func NewTUI(client *core.Client) *TUI {
-	// Create new TUI instance
-	tui := &TUI{
-		app:            tview.NewApplication(),
-		pages:          tview.NewPages(),
-		client:         client,
-		commandHistory: core.NewCommandHistory(100), // 100 entries in history
-		aliasManager:   core.NewAliasManager(50),    // 50 aliases maximum
-	}
-
-	// Initialize user interface
-	tui.initUI()
-
-	// Set callbacks for the client
-	client.SetCallbacks(
-		tui.handleStatusChanged,
-		tui.handleServerList,
-		tui.handleOutput,
-	)
-
-	// Load command history and aliases
-	tui.commandHistory.Load()
-	tui.aliasManager.LoadAliases()
-
-	return tui
-}
-
-// initUI initializes the user interface
-func (t *TUI) initUI() {
-	// Create header
-	t.header = tview.NewTextView().
-		SetTextAlign(tview.AlignCenter).
-		SetText(i18n.GetMessage("ui.header")).
-		SetTextColor(tcell.ColorWhite).
-		SetBackgroundColor(tcell.ColorBlue)
-
-	// Create output area
-	t.output = tview.NewTextView().
-		SetDynamicColors(true).
-		SetChangedFunc(func() {
-			t.app.Draw()
-		})
-	t.output.SetBorder(true).SetTitle(i18n.GetMessage("ui.output_title"))
-
-	// Create input field
-	t.input = tview.NewInputField().
-		SetLabel(i18n.GetMessage("ui.command_prompt")).
-		SetFieldWidth(0).
-		SetFieldBackgroundColor(tcell.ColorBlack).
-		SetDoneFunc(t.handleCommand)
-
-	// Create status bar
-	t.statusText = tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextColor(tcell.ColorWhite)
-	t.statusInfo = tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignRight).
-		SetTextColor(tcell.ColorWhite)
-
-	t.statusBar = tview.NewFlex().
-		SetDirection(tview.FlexColumn).
-		AddItem(t.statusText, 0, 3, false).
-		AddItem(t.statusInfo, 0, 1, false)
-	t.statusBar.SetBackgroundColor(tcell.ColorDarkGray)
-
-	// Create layout
-	t.layout = tview.NewFlex().
-		SetDirection(tview.FlexRow).
-		AddItem(t.header, 1, 0, false).
-		AddItem(t.output, 0, 1, false).
-		AddItem(t.input, 1, 0, true).
-		AddItem(t.statusBar, 1, 0, false)
-
-	// Create login form
-	t.loginForm = tview.NewForm().
-		AddInputField(i18n.GetMessage("ui.username"), "", 20, nil, nil).
-		AddPasswordField(i18n.GetMessage("ui.password"), "", 20, '*', nil).
-		AddButton(i18n.GetMessage("ui.login_button"), t.handleLogin).
-		AddButton(i18n.GetMessage("ui.cancel_button"), func() {
-			t.pages.SwitchToPage("main")
-		})
-	t.loginForm.SetBorder(true).SetTitle(i18n.GetMessage("ui.login_title")).SetTitleAlign(tview.AlignCenter)
-	t.loginForm.SetBackgroundColor(tcell.ColorBlack)
-
-	// Create server list
-	t.serverList = tview.NewList().
-		ShowSecondaryText(true).
-		SetSecondaryTextColor(tcell.ColorDimGray)
-	t.serverList.SetBorder(true).SetTitle(i18n.GetMessage("ui.available_servers")).SetTitleAlign(tview.AlignCenter)
-	t.serverList.SetDoneFunc(func() {
-		t.pages.SwitchToPage("main")
-	})
-
-	// Create help text
-	t.helpText = tview.NewTextView().
-		SetDynamicColors(true).
-		SetScrollable(true).
-		SetText(getHelpText())
-	t.helpText.SetBorder(true).SetTitle(i18n.GetMessage("ui.help_title")).SetTitleAlign(tview.AlignCenter)
-	t.helpText.SetDoneFunc(func(key tcell.Key) {
-		t.pages.SwitchToPage("main")
-	})
-
-	// Add pages
-	t.pages.AddPage("main", t.layout, true, true)
-	t.pages.AddPage("login", centeredFlex(t.loginForm, 40, 10), true, false)
-	t.pages.AddPage("servers", centeredFlex(t.serverList, 60, 20), true, false)
-	t.pages.AddPage("help", centeredFlex(t.helpText, 70, 20), true, false)
-
-	// Keyboard shortcuts
-	t.app.SetInputCapture(t.handleGlobalKeys)
-	t.input.SetInputCapture(t.handleInputKeys)
-}
-
-// Run starts the user interface
-func (t *TUI) Run() error {
-	// Set status
-	t.updateStatus(i18n.GetMessage("general.ready"), &proto.StatusInfo{
-		ConnectionStatus: proto.StatusInfo_OFFLINE,
-		SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
-	})
-
-	// Display initial text
-	t.output.SetText(i18n.GetMessage("general.welcome_message"))
-
-	// Start the application
-	return t.app.SetRoot(t.pages, true).EnableMouse(true).Run()
-}
-
-// ShowError displays an error message in the status bar
-func (t *TUI) ShowError(message string) {
-	t.statusText.SetText(fmt.Sprintf("[red]%s[white]", message))
-	t.app.Draw()
-
-	// Clear message after 5 seconds
-	go func() {
-		time.Sleep(5 * time.Second)
-		t.app.QueueUpdateDraw(func() {
-			// Only clear if the text is still the same
-			if strings.Contains(t.statusText.GetText(true), message) {
-				t.statusText.SetText("")
-			}
-		})
-	}()
-}
-
-// ShowInfo displays an information message in the status bar
-func (t *TUI) ShowInfo(message string) {
-	t.statusText.SetText(fmt.Sprintf("[green]%s[white]", message))
-	t.app.Draw()
-
-	// Clear message after 3 seconds
-	go func() {
-		time.Sleep(3 * time.Second)
-		t.app.QueueUpdateDraw(func() {
-			// Only clear if the text is still the same
-			if strings.Contains(t.statusText.GetText(true), message) {
-				t.statusText.SetText("")
-			}
-		})
-	}()
-}
-
-// handleCommand processes the entered command line
-func (t *TUI) handleCommand(key tcell.Key) {
-	// Get command
-	command := t.input.GetText()
-
-	// Ignore empty command
-	if strings.TrimSpace(command) == "" {
-		return
-	}
-
-	// Resolve aliases
-	command = t.aliasManager.ExpandCommand(command)
-
-	// Add command to history
-	t.commandHistory.Add(command)
-
-	// Clear input field
-	t.input.SetText("")
-
-	// Display output in terminal
-	t.output.Write([]byte(fmt.Sprintf("> [yellow]%s[white]\n", command)))
-
-	// Process special client commands
-	if t.handleSpecialCommand(command) {
-		return
-	}
-
-	// Send command to server
-	if t.client.IsConnected() {
-		err := t.client.ExecuteCommand(command)
-		if err != nil {
-			t.ShowError(err.Error())
-		}
-	} else {
-		t.ShowError(i18n.GetMessage("error.not_connected"))
-	}
-}
-
-// handleSpecialCommand processes special client-side commands
-func (t *TUI) handleSpecialCommand(command string) bool {
-	command = strings.TrimSpace(command)
-	parts := strings.SplitN(command, " ", 2)
-	cmd := strings.ToLower(parts[0])
-
-	switch cmd {
-	case "help", "?":
-		// Show help
-		t.pages.SwitchToPage("help")
-		return true
-
-	case "exit", "quit":
-		// Exit application
-		t.app.Stop()
-		return true
-
-	case "clear", "cls":
-		// Clear output
-		t.output.SetText("")
-		return true
-
-	case "connect":
-		// Connect to server
-		if len(parts) < 2 {
-			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "connect <host> [port]"))
-			return true
-		}
-
-		connectParts := strings.Split(parts[1], " ")
-		host := connectParts[0]
-		port := 50051 // Default port
-
-		if len(connectParts) > 1 {
-			if _, err := fmt.Sscanf(connectParts[1], "%d", &port); err != nil {
-				t.ShowError(fmt.Sprintf("Invalid port: %s", connectParts[1]))
-				return true
-			}
-		}
-
-		err := t.client.Connect(host, port, false)
-		if err != nil {
-			t.ShowError(err.Error())
-		} else {
-			t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.connected"), host, port))
-		}
-		return true
-
-	case "disconnect":
-		// Disconnect from server
-		t.client.Close()
-		t.updateStatus(i18n.GetMessage("success.disconnected"), &proto.StatusInfo{
-			ConnectionStatus: proto.StatusInfo_OFFLINE,
-			SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
-		})
-		return true
-
-	case "login":
-		// Show login dialog
-		t.pages.SwitchToPage("login")
-		return true
-
-	case "logout":
-		// Log out
-		if !t.client.IsConnected() {
-			t.ShowError(i18n.GetMessage("error.not_connected"))
-			return true
-		}
-
-		if !t.client.IsLoggedIn() {
-			t.ShowError(i18n.GetMessage("error.not_logged_in"))
-			return true
-		}
-
-		err := t.client.Logout()
-		if err != nil {
-			t.ShowError(err.Error())
-		} else {
-			t.ShowInfo(i18n.GetMessage("success.logged_out"))
-		}
-		return true
-
-	case "alias":
-		// Define or show aliases
-		if len(parts) < 2 {
-			// Show aliases
-			aliases := t.aliasManager.GetAllAliases()
-			if len(aliases) == 0 {
-				t.output.Write([]byte(i18n.GetMessage("commands.no_aliases") + "\n"))
-			} else {
-				t.output.Write([]byte(i18n.GetMessage("commands.local_aliases") + "\n"))
-				for alias, command := range aliases {
-					t.output.Write([]byte(fmt.Sprintf("  %s = %s\n", alias, command)))
-				}
-			}
-		} else {
-			// Define alias
-			aliasParts := strings.SplitN(parts[1], "=", 2)
-			if len(aliasParts) != 2 {
-				t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "alias <name>=<command>"))
-				return true
-			}
-
-			alias := strings.TrimSpace(aliasParts[0])
-			command := strings.TrimSpace(aliasParts[1])
-
-			if alias == "" {
-				t.ShowError(i18n.GetMessage("error.empty_alias"))
-				return true
-			}
-
-			if command == "" {
-				t.ShowError(i18n.GetMessage("error.empty_command"))
-				return true
-			}
-
-			if isReservedKeyword(alias) {
-				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.reserved_keyword"), alias))
-				return true
-			}
-
-			err := t.aliasManager.AddAlias(alias, command)
-			if err != nil {
-				t.ShowError(err.Error())
-			} else {
-				t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.alias_created"), alias, command))
-				t.aliasManager.SaveAliases()
-			}
-		}
-		return true
-
-	case "unalias":
-		// Delete alias
-		if len(parts) < 2 {
-			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "unalias <name>"))
-			return true
-		}
-
-		alias := strings.TrimSpace(parts[1])
-		err := t.aliasManager.RemoveAlias(alias)
-		if err != nil {
-			t.ShowError(err.Error())
-		} else {
-			t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.alias_deleted"), alias))
-			t.aliasManager.SaveAliases()
-		}
-		return true
-
-	case "history":
-		// Show history
-		entries := t.commandHistory.GetEntries()
-		if len(entries) == 0 {
-			t.output.Write([]byte(i18n.GetMessage("commands.no_history") + "\n"))
-		} else {
-			t.output.Write([]byte(i18n.GetMessage("commands.command_history") + "\n"))
-			for i, entry := range entries {
-				t.output.Write([]byte(fmt.Sprintf("  %d: %s\n", i+1, entry)))
-			}
-		}
-		return true
-
-	case "use":
-		// Set service context
-		if len(parts) < 2 {
-			t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.current_context"),
-				t.client.GetLastServiceUsed())))
-			return true
-		}
-
-		service := strings.TrimSpace(parts[1])
-		t.client.SetLastServiceUsed(service)
-		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.context_set"), service))
-		return true
-	}
-
-	return false
-}
-
-// handleLogin processes the login
-func (t *TUI) handleLogin() {
-	username := t.loginForm.GetFormItem(0).(*tview.InputField).GetText()
-	password := t.loginForm.GetFormItem(1).(*tview.InputField).GetText()
-
-	// Reset form
-	t.loginForm.GetFormItem(1).(*tview.InputField).SetText("")
-
-	// Return to main page
-	t.pages.SwitchToPage("main")
-
-	// Check if connected to server
-	if !t.client.IsConnected() {
-		t.ShowError(i18n.GetMessage("error.not_connected"))
-		return
-	}
-
-	// Login
-	err := t.client.Login(username, password)
-	if err != nil {
-		t.ShowError(err.Error())
-	}
-}
-
-// handleServerList processes the server list
-func (t *TUI) handleServerList(servers []*proto.ServerInfo) (int, error) {
-	// Clear list
-	t.serverList.Clear()
-
-	// Add servers to list
-	for i, server := range servers {
-		title := fmt.Sprintf("%s (%s)", server.ShortName, server.Address)
-		secondary := fmt.Sprintf("Version: %s, TLS: %v", server.Version, server.TlsEnabled)
-
-		t.serverList.AddItem(title, secondary, rune('1'+i), func(index int) func() {
-			return func() {
-				t.pages.SwitchToPage("main")
-				// Return selected index
-				// (processed later)
-			}
-		}(i))
-	}
-
-	// Show list
-	t.pages.SwitchToPage("servers")
-
-	// Wait for selection
-	selectedIndex := -1
-
-	// Since we need a return value, we have to wait here
-	// In a real implementation, we would probably use a channel
-	// or perform discovery asynchronously in the background
-
-	return selectedIndex, nil
-}
-
-// handleOutput processes output from the server
-func (t *TUI) handleOutput(output string) {
-	t.output.Write([]byte(output + "\n"))
-}
-
-// handleStatusChanged processes status changes
-func (t *TUI) handleStatusChanged(statusInfo *proto.StatusInfo) {
-	t.updateStatus("", statusInfo)
-}
-
-// updateStatus updates the status display
-func (t *TUI) updateStatus(message string, statusInfo *proto.StatusInfo) {
-	if message != "" {
-		t.statusText.SetText(message)
-	}
-
-	if statusInfo == nil {
-		return
-	}
-
-	// Create status text
-	var statusText strings.Builder
-
-	// Connection status
-	switch statusInfo.ConnectionStatus {
-	case proto.StatusInfo_OFFLINE:
-		statusText.WriteString("[red]" + i18n.GetMessage("status.offline") + "[white]")
-	case proto.StatusInfo_CONNECTING:
-		statusText.WriteString("[yellow]" + i18n.GetMessage("status.connecting") + "[white]")
-	case proto.StatusInfo_CONNECTED:
-		if statusInfo.ServerName != "" {
-			statusText.WriteString(fmt.Sprintf("[green]%s[white]",
-				fmt.Sprintf(i18n.GetMessage("status.connected"), statusInfo.ServerName)))
-		} else {
-			statusText.WriteString("[green]" + i18n.GetMessage("status.connected") + "[white]")
-		}
-	case proto.StatusInfo_CONNECTION_ERROR:
-		statusText.WriteString("[red]" + i18n.GetMessage("status.connection_error") + "[white]")
-	}
-
-	// Separator
-	statusText.WriteString(" | ")
-
-	// Session status
-	switch statusInfo.SessionStatus {
-	case proto.StatusInfo_NOT_LOGGED_IN:
-		statusText.WriteString("[yellow]" + i18n.GetMessage("status.not_logged_in") + "[white]")
-	case proto.StatusInfo_AUTHENTICATED:
-		if statusInfo.Username != "" {
-			statusText.WriteString(fmt.Sprintf("[green]%s[white]",
-				fmt.Sprintf(i18n.GetMessage("status.logged_in"), statusInfo.Username)))
-		} else {
-			statusText.WriteString("[green]" + i18n.GetMessage("status.logged_in") + "[white]")
-		}
-	case proto.StatusInfo_LOGIN_REQUIRED:
-		statusText.WriteString("[yellow]" + i18n.GetMessage("status.login_required") + "[white]")
-	case proto.StatusInfo_SESSION_EXPIRING:
-		remaining := statusInfo.SessionRemainingMinutes
-		statusText.WriteString(fmt.Sprintf("[yellow]%s[white]",
-			fmt.Sprintf(i18n.GetMessage("status.session_expiring"), remaining)))
-	case proto.StatusInfo_SESSION_EXPIRED:
-		statusText.WriteString("[red]" + i18n.GetMessage("status.session_expired") + "[white]")
-	}
-
-	// Service context
-	if statusInfo.CurrentService != "" {
-		statusText.WriteString(fmt.Sprintf(" | %s",
-			fmt.Sprintf(i18n.GetMessage("status.service_context"), statusInfo.CurrentService)))
-	}
-
-	// Update status display
-	t.statusInfo.SetText(statusText.String())
-	t.app.Draw()
-}
-
-// handleGlobalKeys processes global keyboard shortcuts
-func (t *TUI) handleGlobalKeys(event *tcell.EventKey) *tcell.EventKey {
-	// If a modal dialog is active, only process Escape
-	if t.pages.HasPage("modal") {
-		if event.Key() == tcell.KeyEscape {
-			t.pages.RemovePage("modal")
-			return nil
-		}
-		return event
-	}
-
-	// Global keyboard shortcuts
-	switch event.Key() {
-	case tcell.KeyCtrlC:
-		// Exit application
-		t.app.Stop()
-		return nil
-
-	case tcell.KeyCtrlL:
-		// Show login dialog
-		if t.pages.HasPage("login") {
-			t.pages.SwitchToPage("login")
-			return nil
-		}
-
-	case tcell.KeyCtrlH:
-		// Show help
-		if t.pages.HasPage("help") {
-			t.pages.SwitchToPage("help")
-			return nil
-		}
-
-	case tcell.KeyCtrlD:
-		// Start server discovery
-		go func() {
-			err := t.client.DiscoverServer(5 * time.Second)
-			if err != nil {
-				t.app.QueueUpdateDraw(func() {
-					t.ShowError(fmt.Sprintf(i18n.GetMessage("error.discovery"), err))
-				})
-			}
-		}()
-		return nil
-	}
-
-	return event
-}
-
-// handleInputKeys processes keyboard shortcuts in the input field
-func (t *TUI) handleInputKeys(event *tcell.EventKey) *tcell.EventKey {
-	// History navigation
-	switch event.Key() {
-	case tcell.KeyUp:
-		// Previous command
-		if cmd, ok := t.commandHistory.Previous(); ok {
-			t.input.SetText(cmd)
-		}
-		return nil
-
-	case tcell.KeyDown:
-		// Next command
-		if cmd, ok := t.commandHistory.Next(); ok {
-			t.input.SetText(cmd)
-		}
-		return nil
-
-	case tcell.KeyTab:
-		// Auto-completion
-		currentText := t.input.GetText()
-		if t.client.IsConnected() {
-			suggestions, commonPrefix, err := t.client.AutoComplete(currentText, len(currentText))
-			if err == nil && len(suggestions) > 0 {
-				if len(suggestions) == 1 {
-					// Only one suggestion - complete directly
-					t.input.SetText(suggestions[0])
-				} else if commonPrefix != "" && commonPrefix != currentText {
-					// Complete common prefix
-					t.input.SetText(commonPrefix)
-				} else {
-					// Multiple suggestions - show them
-					t.output.Write([]byte("Possible completions:\n"))
-					for _, suggestion := range suggestions {
-						t.output.Write([]byte(fmt.Sprintf("  %s\n", suggestion)))
-					}
-				}
-			}
-		}
-		return nil
-	}
-
-	return event
-}
-
-// centeredFlex centers a flex element on the screen
-func centeredFlex(p tview.Primitive, width, height int) tview.Primitive {
-	return tview.NewFlex().
-		AddItem(nil, 0, 1, false).
-		AddItem(
-			tview.NewFlex().
-				SetDirection(tview.FlexRow).
-				AddItem(nil, 0, 1, false).
-				AddItem(p, height, 1, true).
-				AddItem(nil, 0, 1, false),
-			width, 1, true).
-		AddItem(nil, 0, 1, false)
-}
-
-// getHelpText returns the help text for the application
-func getHelpText() string {
-	return fmt.Sprintf(`[yellow]%s[white]
- 
- [blue]%s:[white]
-   [yellow]help[white] or [yellow]?[white]          %s
-   [yellow]exit[white] or [yellow]quit[white]       %s
-   [yellow]clear[white] or [yellow]cls[white]       %s
-   [yellow]history[white]               %s
- 
- [blue]%s:[white]
-   [yellow]connect <host> [port][white]  %s
-   [yellow]disconnect[white]             %s
- 
- [blue]%s:[white]
-   [yellow]login[white]                  %s
-   [yellow]logout[white]                 %s
- 
- [blue]%s:[white]
-   [yellow]alias[white]                  %s
-   [yellow]alias <n>=<command>[white]    %s
-   [yellow]unalias <n>[white]            %s
- 
- [blue]%s:[white]
-   [yellow]use <service>[white]          %s
- 
- [blue]%s:[white]
-   [yellow]Ctrl+H[white]                 %s
-   [yellow]Ctrl+L[white]                 %s
-   [yellow]Ctrl+D[white]                 %s
-   [yellow]Ctrl+C[white]                 %s
-   [yellow]↑/↓[white]                    %s
-   [yellow]Tab[white]                    %s
- 
- [blue]%s:[white]
-   [yellow]<Service>.<Action>.<SubAction> <Parameters>[white]
- 
-   %s: [yellow]Finance.Create.Report Q4_2024 "Profit and Loss"[white]
- 
- %s`,
-		i18n.GetMessage("help.title"),
-		i18n.GetMessage("help.general_commands"),
-		i18n.GetMessage("help.help_command"),
-		i18n.GetMessage("help.exit_command"),
-		i18n.GetMessage("help.clear_command"),
-		i18n.GetMessage("help.history_command"),
-		i18n.GetMessage("help.connection_management"),
-		i18n.GetMessage("help.connect_command"),
-		i18n.GetMessage("help.disconnect_command"),
-		i18n.GetMessage("help.authentication"),
-		i18n.GetMessage("help.login_command"),
-		i18n.GetMessage("help.logout_command"),
-		i18n.GetMessage("help.alias_management"),
-		i18n.GetMessage("help.alias_list_command"),
-		i18n.GetMessage("help.alias_create_command"),
-		i18n.GetMessage("help.alias_delete_command"),
-		i18n.GetMessage("help.context"),
-		i18n.GetMessage("help.context_command"),
-		i18n.GetMessage("help.keyboard_shortcuts"),
-		i18n.GetMessage("help.ctrl_h"),
-		i18n.GetMessage("help.ctrl_l"),
-		i18n.GetMessage("help.ctrl_d"),
-		i18n.GetMessage("help.ctrl_c"),
-		i18n.GetMessage("help.arrow_keys"),
-		i18n.GetMessage("help.tab_key"),
-		i18n.GetMessage("help.command_format"),
-		"Example",
-		"Press any key to return to the main application.")
-}
-
-// isReservedKeyword checks if a word is a reserved keyword
-func isReservedKeyword(word string) bool {
-	// List of reserved keywords
-	reservedKeywords := map[string]bool{
-		"help":       true,
-		"?":          true,
-		"login":      true,
-		"logout":     true,
-		"alias":      true,
-		"unalias":    true,
-		"exit":       true,
-		"quit":       true,
-		"clear":      true,
-		"cls":        true,
-		"history":    true,
-		"use":        true,
-		"connect":    true,
-		"disconnect": true,
-		"status":     true,
-	}
-
-	return reservedKeywords[strings.ToLower(word)]
-}
+// tui.go
+/**
+ * Nexuflex Client - Text User Interface Main Class
+ *
+ * This file contains the main class for the text-based user interface (TUI)
+ * of the nexuflex client.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2025-03-12
+ */
+
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/msto63/nexuflex/nexuflex-client/plugin"
+	"github.com/msto63/nexuflex/nexuflex-client/state"
+	proto "github.com/msto63/nexuflex/shared/proto/nexuflex/v1"
+	"github.com/rivo/tview"
+)
+
+// TUI represents the complete text-based user interface
+type TUI struct {
+	// Main components
+	app        *tview.Application
+	pages      *tview.Pages
+	layout     *tview.Flex
+	header     *tview.TextView
+	output     *tview.TextView
+	input      *tview.InputField
+	completer  *AutoCompleter
+	statusBar  *tview.Flex
+	statusText *tview.TextView
+	statusInfo *tview.TextView
+
+	// Split output view
+	outputArea  *tview.Flex
+	logView     *tview.TextView
+	splitActive bool
+	splitRatio  int
+
+	// Color theme of the main view
+	theme colorTheme
+
+	// Screen of the last draw, for the clipboard
+	screen tcell.Screen
+
+	// Continuation markers of wrapped rows
+	outputWrap wrapLayout
+	logWrap    wrapLayout
+	wrapOutput bool // Long lines are wrapped instead of scrolled sideways
+
+	// Coalesced redraws and debounced processing of the input
+	drawPending   atomic.Bool
+	lastKeystroke atomic.Int64 // Unix nanoseconds of the last change of the input
+	inputTimer    *time.Timer
+	inputSeq      atomic.Uint64 // Number of the last change of the input; older results are dropped
+	inputIsAlias  bool          // The input is an alias, as of the last processing
+	frameStarted  time.Time
+	previousInput string // Input text before the last change, to detect a space typed at the end
+
+	// Recording of the screen into an asciicast file, nil if none is running
+	recorder *screenRecorder
+
+	// Command waiting for the confirmation of its resolved form, nil if none
+	pendingExpansion func()
+
+	// Tutorial with its sandbox, nil if none is running
+	tutorial *tutorialRun
+
+	// Output of the last command sent to the server, for copying
+	lastResult strings.Builder
+
+	// Jobs panel
+	jobsPanel    *tview.Table
+	jobsVisible  bool
+	jobsStop     chan struct{}
+	jobStates    map[int]client.JobState
+	attachedJobs map[int]bool
+
+	// Responsive layout
+	screenWidth  int
+	screenHeight int
+	narrowLayout bool
+	shortLayout  bool
+
+	// Actionable references in the output
+	referenceRules     []*client.ReferenceRule
+	references         map[string]client.Reference
+	referenceOrder     []string
+	referenceCounter   int
+	selectedReference  string
+	selectingReference bool
+
+	// Highlight rules for output lines
+	highlightRules []*client.HighlightRule
+
+	// Annotation rules for the output of finished commands
+	annotationRules []*client.AnnotationRule
+
+	// Threshold rules for the results of finished commands and the table
+	// received by the running command
+	thresholdRules []*client.ThresholdRule
+	resultTable    *proto.TableResult
+
+	// Watch patterns and their remembered hits
+	watchPatterns []*client.WatchPattern
+	watchHits     []watchHit
+	watchJump     int // Index of the hit jumped to last
+	watchUnseen   int // Hits not jumped to yet
+
+	// Command blocks of the output and the blocks removed by the last "clear"
+	blocks        map[int64]*outputBlock
+	blockCounter  int64
+	clearedBlocks []blockSegment
+
+	// Numbered completion candidates shown last
+	completions completionList
+
+	// Last structured result, opened with the "table" command
+	lastTable        *proto.TableResult
+	lastTableCommand string
+	queryTables      map[string]queryTable // Tables saved with "query save"
+
+	// Folded long results
+	folds       map[string]*foldedOutput
+	foldOrder   []string
+	foldCounter int
+
+	// Dialogs
+	loginForm    *tview.Form
+	serverList   *tview.List
+	serverChoice func(server *proto.ServerInfo) // Continuation of the discovery waiting for the selection
+	recentList   *tview.List
+	helpText     *tview.TextView
+	helpPage     *tview.Flex
+
+	// Client and other components
+	client         *client.Client
+	commandHistory *client.CommandHistory
+	aliasManager   *client.AliasManager
+	keyBindings    *KeyBindings
+
+	// Status
+	lastStatusInfo *proto.StatusInfo
+	statusAll      []statusSegment // All segments of the status information
+	statusShown    []fittedSegment // The segments shown, fitted to the width
+	lastCommand    string
+	statusMessage  string
+
+	// Unread notifications shown in the header until the timeline is opened
+	unreadNotifications int
+
+	// Repeated commands already hinted or dismissed as alias suggestions
+	suggestionsHinted    map[string]bool
+	suggestionsDismissed map[string]bool
+
+	// Current frame of the activity spinner
+	spinnerFrame int
+
+	// Service of the command whose output is expected, for its color
+	outputService string
+
+	// Jobs of a loaded workspace, started once its session is running
+	workspaceJobs []client.WorkspaceJob
+
+	// Panel with the current frames of refreshing displays by key, in the
+	// order they appeared
+	framePanel *tview.TextView
+	frames     map[string]client.Frame
+	frameOrder []string
+
+	// Critical commands the server never received, waiting for a decision
+	unsentCommands []client.PendingCommand
+
+	// Function keys bound to commands and the row showing them
+	functionKeys   map[tcell.Key]string
+	functionKeyBar *tview.TextView
+
+	// Banner announcing maintenance and the commands queued until after it
+	maintenanceBanner      *tview.TextView
+	maintenanceBannerShown int // Current height of the banner
+	maintenanceQueue       []deferredCommand
+
+	// Detection of pastes without bracketed paste
+	lastKeyTime time.Time
+	pasteLines  []string
+	pasteTimer  *time.Timer
+}
+
+// NewTUI creates a new TUI instance
+func NewTUI(c *client.Client) *TUI {
+	// Create new TUI instance
+	tui := &TUI{
+		app:            tview.NewApplication(),
+		pages:          tview.NewPages(),
+		client:         c,
+		commandHistory: client.NewCommandHistory(historySize(c)),
+		aliasManager:   client.NewAliasManager(50), // 50 aliases maximum
+	}
+
+	if cfg := c.GetConfig(); cfg != nil {
+		tui.aliasManager.SetMaxDepth(cfg.Commands.MaxAliasDepth)
+	}
+
+	// Initialize user interface
+	tui.initUI()
+	tui.keyBindings = SetupDefaultKeyBindings(tui)
+
+	// Complete through the cache of server answers
+	tui.completer = NewAutoCompleter(tui.output, func(text string) ([]string, string, error) {
+		return c.CompleteInContext(text, len(text))
+	})
+	tui.completer.SetCacheScope(c.CompletionScope)
+	if cfg := c.GetConfig(); cfg != nil {
+		ttl := DefaultCompletionCacheTTL
+		if cfg.UI.CompletionCacheTTL > 0 {
+			ttl = time.Duration(cfg.UI.CompletionCacheTTL) * time.Second
+		}
+		tui.completer.SetCacheLimits(cfg.UI.CompletionCacheSize, ttl)
+	}
+
+	// Set callbacks for the client
+	c.SetCallbacks(
+		tui.handleStatusChanged,
+		nil,
+		tui.handleOutput,
+	)
+	c.SetServerSelectionCallback(tui.handleServerSelection)
+	c.SetApprovalCallback(tui.handleApprovalDecided)
+	c.SetCommandReconciledCallback(tui.handleCommandReconciled)
+	c.SetJobsChangedCallback(tui.handleJobsChanged)
+	c.SetSessionStartedCallback(tui.handleSessionStarted)
+	c.SetTableCallback(tui.handleTable)
+	c.SetContentCallback(tui.handleContent)
+	c.SetFrameCallback(tui.handleFrameChanged)
+	c.SetRPCTraceCallback(tui.handleRPCTrace)
+	c.SetConnectionQualityCallback(tui.handleQualityChanged)
+	c.SetConnectionLostCallback(tui.handleConnectionLost)
+	c.SetReconnectedCallback(tui.handleReconnected)
+	c.SetFailoverCallback(tui.handleFailover)
+	c.SetSettingsSyncCallback(tui.handleSettingsSync)
+	c.SetUndoCallback(tui.handleUndoAvailable)
+	c.SetMaintenanceCallback(tui.handleMaintenanceChanged)
+
+	// Keep the live widgets of the header current
+	tui.startHeaderWidgets()
+
+	// Show a spinner while commands are in flight
+	tui.startActivityIndicator()
+
+	// Count down the time an undo is offered
+	tui.startUndoIndicator()
+
+	// Count down to announced maintenance and run the commands queued until after it
+	tui.startMaintenanceBanner()
+
+	// Hint at repeated commands worth an alias
+	tui.startUsageSuggestions()
+
+	// Password commands like pinentry-curses prompt on the terminal
+	client.SetInteractiveRunner(func(run func()) {
+		ran := false
+		tui.app.Suspend(func() {
+			ran = true
+			run()
+		})
+		if !ran {
+			run()
+		}
+	})
+	// The refresh runs as background work of the session, which Close waits
+	// for, so it does not wait for the UI
+	c.SetMetadataRefreshedCallback(func() {
+		go tui.app.QueueUpdateDraw(func() {
+			tui.ShowInfo(i18n.GetMessage("commands.metadata_refreshed"))
+		})
+	})
+
+	// Load command history and aliases; report quarantined lines
+	for _, err := range []error{tui.commandHistory.Load(), tui.aliasManager.LoadAliases()} {
+		var corrupt *state.CorruptLinesError
+		if errors.As(err, &corrupt) {
+			tui.output.Write([]byte(fmt.Sprintf("[yellow]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("error.corrupt_lines"), corrupt.Count, corrupt.Path, corrupt.QuarantinePath))))
+		}
+	}
+
+	return tui
+}
+
+// initUI initializes the user interface
+func (t *TUI) initUI() {
+	// Create header
+	t.header = tview.NewTextView().
+		SetTextAlign(tview.AlignCenter).
+		SetText(i18n.GetMessage("ui.header")).
+		SetTextColor(tcell.ColorWhite)
+
+	t.header.SetDynamicColors(true).
+		SetBackgroundColor(tcell.ColorBlue)
+
+	// Create output area
+	t.output = tview.NewTextView().
+		SetDynamicColors(true).
+		SetChangedFunc(func() {
+			t.outputWrap.invalidate()
+			t.requestDraw()
+		})
+	t.output.SetBorder(true).SetTitle(i18n.GetMessage("ui.output_title"))
+
+	// Create split view with the log pane below the output area
+	t.initSplitView()
+
+	// Scroll the output and log pane with the mouse wheel
+	t.initMouseScrolling()
+
+	// Create the jobs panel docked below the output area
+	t.initJobsPanel()
+
+	// Create the panel showing refreshing displays in place
+	t.initFramePanel()
+
+	// Render configured references as actionable regions
+	t.initReferences()
+
+	// Compile the rules highlighting important output lines
+	t.initHighlights()
+
+	// Compile the rules annotating the output of finished commands
+	t.initAnnotations()
+
+	// Compile the thresholds marking results of finished commands
+	t.initThresholds()
+
+	// Compile the patterns raising alerts for watched output
+	t.initWatches()
+
+	// Create input field
+	t.input = tview.NewInputField().
+		SetLabel(i18n.GetMessage("ui.command_prompt")).
+		SetFieldWidth(0).
+		SetFieldBackgroundColor(tcell.ColorBlack).
+		SetDoneFunc(t.handleCommand)
+
+	// Process the input text once typing pauses
+	t.initInputLatency()
+
+	// Create status bar
+	t.statusText = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextColor(tcell.ColorWhite)
+	t.statusInfo = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignRight).
+		SetTextColor(tcell.ColorWhite)
+	t.statusInfo.SetMouseCapture(t.statusClickCapture)
+
+	t.statusBar = tview.NewFlex().
+		SetDirection(tview.FlexColumn).
+		AddItem(t.statusText, 0, 3, false).
+		AddItem(t.statusInfo, 0, 1, false)
+	t.applyTheme()
+	t.applyDensity()
+
+	// Create the row of the function keys bound to commands
+	t.initFunctionKeys()
+
+	// Create the banner announcing maintenance
+	t.initMaintenanceBanner()
+
+	// Create layout
+	t.layout = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(t.header, 1, 0, false).
+		AddItem(t.maintenanceBanner, 0, 0, false).
+		AddItem(t.outputArea, 0, 1, false).
+		AddItem(t.framePanel, 0, 0, false).
+		AddItem(t.jobsPanel, 0, 0, false).
+		AddItem(t.input, 1, 0, true).
+		AddItem(t.statusBar, 1, 0, false).
+		AddItem(t.functionKeyBar, t.functionKeyBarHeight(), 0, false)
+
+	// Create login form from the fields of the authentication provider
+	t.initLoginForm()
+
+	// Create server list
+	t.serverList = tview.NewList().
+		ShowSecondaryText(true).
+		SetSecondaryTextColor(tcell.ColorDimGray)
+	t.serverList.SetBorder(true).SetTitle(i18n.GetMessage("ui.available_servers")).SetTitleAlign(tview.AlignCenter)
+	t.serverList.SetDoneFunc(func() {
+		t.pages.SwitchToPage("main")
+		t.chooseServer(nil)
+	})
+
+	// Create list of recently used servers
+	t.recentList = tview.NewList().
+		ShowSecondaryText(true).
+		SetSecondaryTextColor(tcell.ColorDimGray)
+	t.recentList.SetBorder(true).SetTitle(i18n.GetMessage("ui.recent_servers")).SetTitleAlign(tview.AlignCenter)
+	t.recentList.SetDoneFunc(func() {
+		t.pages.SwitchToPage("main")
+	})
+
+	// Create help text
+	t.helpText = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true)
+	t.helpText.SetBorder(true).SetTitle(i18n.GetMessage("ui.help_title")).SetTitleAlign(tview.AlignCenter)
+	t.helpText.SetDoneFunc(func(key tcell.Key) {
+		t.pages.SwitchToPage("main")
+	})
+	t.helpPage = tview.NewFlex()
+	t.arrangeHelpPage()
+
+	// Add pages
+	t.pages.AddPage("main", t.layout, true, true)
+	t.pages.AddPage("servers", centeredFlex(t.serverList, 60, 20), true, false)
+	t.pages.AddPage("recent", centeredFlex(t.recentList, 70, 24), true, false)
+	t.pages.AddPage("help", t.helpPage, true, false)
+
+	// Keyboard shortcuts
+	t.app.SetInputCapture(t.handleGlobalKeys)
+	t.input.SetInputCapture(t.handleInputKeys)
+
+	// Adapt the layout and the key hints before every draw
+	t.app.SetBeforeDrawFunc(t.beforeDraw)
+	t.app.SetAfterDrawFunc(t.afterDraw)
+}
+
+// initLoginForm creates the login form from the fields of the authentication
+// provider; adding it again replaces the previous page
+func (t *TUI) initLoginForm() {
+	t.loginForm = tview.NewForm()
+	loginFields := t.client.GetAuthProvider().Fields()
+	for _, field := range loginFields {
+		if field.Secret {
+			t.loginForm.AddPasswordField(i18n.GetMessage(field.Label), field.Default, 20, '*', nil)
+		} else {
+			t.loginForm.AddInputField(i18n.GetMessage(field.Label), field.Default, 20, nil, nil)
+		}
+	}
+	t.loginForm.
+		AddCheckbox(i18n.GetMessage("ui.remember_credentials"), t.client.GetConfig().Auth.RememberCredentials, nil).
+		AddButton(i18n.GetMessage("ui.login_button"), t.handleLogin).
+		AddButton(i18n.GetMessage("ui.cancel_button"), func() {
+			t.pages.SwitchToPage("main")
+		})
+	t.loginForm.SetCancelFunc(func() {
+		t.pages.SwitchToPage("main")
+	})
+	t.loginForm.SetBorder(true).SetTitle(i18n.GetMessage("ui.login_title")).SetTitleAlign(tview.AlignCenter)
+	t.loginForm.SetBackgroundColor(tcell.ColorBlack)
+	t.pages.AddPage("login", centeredFlex(t.loginForm, 40, 2*len(loginFields)+8), true, false)
+}
+
+// beforeDraw adapts the layout to the screen size and the key hints to the current state
+func (t *TUI) beforeDraw(screen tcell.Screen) bool {
+	t.startFrameTimer()
+	t.screen = screen
+	t.handleResize(screen)
+	t.updateKeyHints()
+	return false
+}
+
+// Run starts the user interface
+func (t *TUI) Run() error {
+	// Set status
+	t.updateStatus(i18n.GetMessage("general.ready"), &proto.StatusInfo{
+		ConnectionStatus: proto.StatusInfo_OFFLINE,
+		SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+	})
+
+	// Display initial text
+	t.output.SetText(i18n.GetMessage("general.welcome_message"))
+	if !client.LoadTutorialProgress().Started() {
+		t.output.Write([]byte("\n" + i18n.GetMessage("general.tutorial_hint")))
+	}
+
+	// Report what the configuration file contains that the client ignores
+	t.showConfigDiagnostics()
+
+	// Continue with the setup of the last workspace
+	t.restoreLastWorkspace()
+
+	// Start the application
+	err := t.app.SetRoot(&pasteRoot{Primitive: t.pages, tui: t}, true).EnableMouse(true).EnablePaste(true).Run()
+
+	// Finish a recording still running
+	if t.recorder != nil {
+		t.recorder.recording.Close()
+	}
+	t.stopTutorial()
+
+	// Let the extensions clean up
+	plugin.NotifyShutdown()
+	return err
+}
+
+// SetScreen makes Run use the given screen instead of the terminal, e.g. the
+// simulation screen of an end-to-end test; called before Run
+func (t *TUI) SetScreen(screen tcell.Screen) {
+	t.app.SetScreen(screen)
+}
+
+// Stop ends Run as the exit command does
+func (t *TUI) Stop() {
+	t.app.Stop()
+}
+
+// showConfigDiagnostics lists the problems found in the configuration file
+func (t *TUI) showConfigDiagnostics() {
+	diagnostics := config.LoadDiagnostics()
+	if len(diagnostics) == 0 {
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\n[yellow]%s[white]\n", fmt.Sprintf(i18n.GetMessage("error.config_problems"), len(diagnostics))))
+	for _, diagnostic := range diagnostics {
+		sb.WriteString("  [yellow]" + tview.Escape(diagnostic.String()) + "[white]\n")
+	}
+	t.output.Write([]byte(sb.String()))
+}
+
+// ShowError displays an error message in the status bar
+func (t *TUI) ShowError(message string) {
+	// Clear message after 5 seconds
+	t.showStatusMessage(fmt.Sprintf("[red]%s[white]", message), message, 5*time.Second)
+}
+
+// ShowInfo displays an information message in the status bar
+func (t *TUI) ShowInfo(message string) {
+	// Clear message after 3 seconds
+	t.showStatusMessage(fmt.Sprintf("[green]%s[white]", message), message, 3*time.Second)
+}
+
+// ExecuteForwarded runs a command forwarded by another invocation of the client
+// as if it had been entered in this session
+func (t *TUI) ExecuteForwarded(command string) error {
+	t.app.QueueUpdateDraw(func() {
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", i18n.GetMessage("commands.forwarded_command"))))
+		t.submitCommand(command)
+	})
+	return nil
+}
+
+// handleCommand processes the entered command line
+func (t *TUI) handleCommand(key tcell.Key) {
+	// Get command
+	command := t.input.GetText()
+
+	// Enter on the empty input sends a command waiting for confirmation
+	if t.sendPendingExpansion(command) {
+		t.advanceTutorial()
+		return
+	}
+
+	// An empty command activates the selected reference, otherwise it is ignored
+	if strings.TrimSpace(command) == "" {
+		t.activateSelectedReference()
+		return
+	}
+
+	// ":<n>" picks a numbered completion candidate instead of running
+	if t.handleCompletionInput(command) {
+		return
+	}
+
+	// A snippet at the end of the line has not been expanded by a space yet
+	command, _ = client.ExpandLastSnippet(command, t.client.GetConfig().Snippets, t.snippetPrefix())
+
+	// Clear input field
+	t.input.SetText("")
+
+	t.submitCommand(command)
+}
+
+// submitCommand processes a command line entered or pasted by the user
+func (t *TUI) submitCommand(command string) {
+	// The command may complete a step of the tutorial
+	defer t.advanceTutorial()
+
+	// Resolve aliases, also aliases expanding to other aliases
+	typed := strings.TrimSpace(command)
+	command, chain, err := t.aliasManager.ExpandAliases(command)
+	if err != nil {
+		// A cycle or a too long chain is not sent half expanded
+		t.echoCommand(typed)
+		t.commandHistory.Add(typed)
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.alias_expansion"), err))
+		return
+	}
+
+	// Display output in terminal, with the aliases the command was expanded from
+	t.echoCommand(command)
+	if len(chain) > 0 {
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n", tview.Escape(fmt.Sprintf(i18n.GetMessage("commands.alias_expanded"), typed, strings.Join(chain, " → "))))))
+	}
+
+	// Prefix the service context and resolve case-insensitive and
+	// abbreviated command names; the effective command is shown once
+	if name := strings.SplitN(strings.TrimSpace(command), " ", 2)[0]; !isReservedKeyword(name) && !plugin.IsCommand(name) {
+		prefixed, changed := t.client.ApplyServicePrefix(command)
+		resolved, resolvedChanged, err := t.client.ResolveCommand(prefixed)
+		if err != nil {
+			t.commandHistory.Add(command)
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.ambiguous_command"), err))
+			return
+		}
+		if changed || resolvedChanged {
+			t.output.Write([]byte(fmt.Sprintf("[gray]= %s[white]\n", tview.Escape(resolved))))
+			command = resolved
+		}
+	}
+
+	// Add command to history; the completion candidates belonged to the previous input
+	t.commandHistory.Add(command)
+	t.completions.clear()
+
+	// Process special client commands
+	if t.handleSpecialCommand(command) {
+		return
+	}
+
+	// Send command to server
+	t.lastResult.Reset()
+	if t.client.IsConnected() {
+		// A command resolving to something else than typed may need a confirmation
+		if t.confirmExpansion(typed, command, func() { t.sendCommand(command) }) {
+			return
+		}
+		t.sendCommand(command)
+	} else {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+	}
+}
+
+// sendCommand sends a resolved command to the server
+func (t *TUI) sendCommand(command string) {
+	t.warnDeprecated(command)
+
+	// Designated streaming commands render into the log pane
+	if t.isLogStreamCommand(command) {
+		t.client.GetTelemetry().RecordFeature("log_stream")
+		t.runLogStream(command)
+		return
+	}
+
+	send := func() {
+		// Commands running into announced maintenance can be queued until after it
+		if t.deferForMaintenance(command, false, func() { t.executeCommand(command) }) {
+			return
+		}
+		t.executeCommand(command)
+	}
+
+	// Changes in production are confirmed first
+	if t.confirmForEnvironment(command, send) {
+		return
+	}
+	send()
+}
+
+// handleSpecialCommand processes special client-side commands
+func (t *TUI) handleSpecialCommand(command string) bool {
+	command = strings.TrimSpace(command)
+	parts := strings.SplitN(command, " ", 2)
+	cmd := strings.ToLower(parts[0])
+
+	// Count usage of client commands (only collected if telemetry is enabled)
+	if isReservedKeyword(cmd) {
+		t.client.GetTelemetry().RecordFeature(cmd)
+	}
+
+	switch cmd {
+	case "help", "?":
+		// Show help, the help of a server command or export the command reference
+		if len(parts) < 2 {
+			t.showHelp()
+		} else {
+			t.handleHelpCommand(parts[1])
+		}
+		return true
+
+	case "exit", "quit":
+		// Exit application
+		t.app.Stop()
+		return true
+
+	case "copy":
+		// Copy output as logical lines to the clipboard
+		if len(parts) < 2 {
+			t.handleCopyCommand("")
+		} else {
+			t.handleCopyCommand(parts[1])
+		}
+		return true
+
+	case "upload":
+		// Stream a file into an upload command
+		if len(parts) < 2 {
+			t.handleUploadCommand("")
+		} else {
+			t.handleUploadCommand(parts[1])
+		}
+		return true
+
+	case "more":
+		// Fetch the further page of the last result
+		t.handleMoreCommand()
+		return true
+
+	case "suggestions":
+		// Offer aliases for repeated commands
+		t.handleSuggestionsCommand()
+		return true
+
+	case "tutorial":
+		// Guided tour in the tutorial sandbox
+		if len(parts) < 2 {
+			t.handleTutorialCommand("")
+		} else {
+			t.handleTutorialCommand(parts[1])
+		}
+		return true
+
+	case "sync":
+		// Sync the personal settings through the server
+		if len(parts) < 2 {
+			t.handleSyncCommand("")
+		} else {
+			t.handleSyncCommand(parts[1])
+		}
+		return true
+
+	case "snippet":
+		// Manage the text snippets
+		if len(parts) < 2 {
+			t.handleSnippetCommand("")
+		} else {
+			t.handleSnippetCommand(parts[1])
+		}
+		return true
+
+	case "highlight":
+		// Manage the highlight rules
+		if len(parts) < 2 {
+			t.handleHighlightCommand("")
+		} else {
+			t.handleHighlightCommand(parts[1])
+		}
+		return true
+
+	case "watch-pattern":
+		// Manage the watch patterns and jump to their hits
+		if len(parts) < 2 {
+			t.handleWatchCommand("")
+		} else {
+			t.handleWatchCommand(parts[1])
+		}
+		return true
+
+	case "timeline":
+		// Show the events of the session
+		if len(parts) < 2 {
+			t.handleTimelineCommand("")
+		} else {
+			t.handleTimelineCommand(parts[1])
+		}
+		return true
+
+	case "params":
+		// Ask for the parameters of a command
+		if len(parts) < 2 {
+			t.handleParamsCommand("")
+		} else {
+			t.handleParamsCommand(parts[1])
+		}
+		return true
+
+	case "deprecations":
+		// List the deprecated commands still in use
+		t.handleDeprecationsCommand()
+		return true
+
+	case "defaults":
+		// Show or clear the remembered parameter values
+		if len(parts) < 2 {
+			t.handleDefaultsCommand("")
+		} else {
+			t.handleDefaultsCommand(parts[1])
+		}
+		return true
+
+	case "query":
+		if len(parts) < 2 {
+			t.handleQueryCommand("")
+		} else {
+			t.handleQueryCommand(parts[1])
+		}
+		return true
+
+	case "table":
+		// Open the last structured result
+		t.handleTableCommand()
+		return true
+
+	case "settings":
+		// Edit the most common options
+		t.showSettings()
+		return true
+
+	case "clear", "cls":
+		// Clear output
+		if len(parts) < 2 {
+			t.handleClearCommand("")
+		} else {
+			t.handleClearCommand(parts[1])
+		}
+		return true
+
+	case "pin", "unpin":
+		if len(parts) < 2 {
+			t.handlePinCommand("", cmd == "pin")
+		} else {
+			t.handlePinCommand(parts[1], cmd == "pin")
+		}
+		return true
+
+	case "wrap":
+		// Turn wrapping of long lines on or off
+		if len(parts) < 2 {
+			t.handleWrapCommand("")
+		} else {
+			t.handleWrapCommand(parts[1])
+		}
+		return true
+
+	case "split":
+		// Show, hide or resize the log pane
+		if len(parts) < 2 {
+			t.handleSplitCommand("")
+		} else {
+			t.handleSplitCommand(parts[1])
+		}
+		return true
+
+	case "connect":
+		// Connect to server
+		if len(parts) < 2 {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "connect <host> [port]"))
+			return true
+		}
+
+		connectParts := strings.Split(parts[1], " ")
+		host := connectParts[0]
+		port := 50051 // Default port
+
+		if len(connectParts) > 1 {
+			if _, err := fmt.Sscanf(connectParts[1], "%d", &port); err != nil {
+				t.ShowError(fmt.Sprintf("Invalid port: %s", connectParts[1]))
+				return true
+			}
+		}
+
+		err := t.client.Connect(host, port, false)
+		if err != nil {
+			t.ShowError(err.Error())
+		} else {
+			t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.connected"), host, port))
+		}
+		return true
+
+	case "recent":
+		// Reconnect to a recently used server
+		if len(parts) < 2 {
+			t.handleRecentCommand("")
+		} else {
+			t.handleRecentCommand(parts[1])
+		}
+		return true
+
+	case "jobs":
+		// Show, cancel or attach background jobs
+		if len(parts) < 2 {
+			t.handleJobsCommand("")
+		} else {
+			t.handleJobsCommand(parts[1])
+		}
+		return true
+
+	case "maintenance":
+		if len(parts) < 2 {
+			t.handleMaintenanceCommand("")
+		} else {
+			t.handleMaintenanceCommand(parts[1])
+		}
+		return true
+
+	case "bg":
+		// Run a streaming command in the background
+		if len(parts) < 2 {
+			t.handleBackgroundCommand("")
+		} else {
+			t.handleBackgroundCommand(parts[1])
+		}
+		return true
+
+	case "disconnect":
+		// Disconnect from server
+		t.client.Close()
+		t.updateStatus(i18n.GetMessage("success.disconnected"), &proto.StatusInfo{
+			ConnectionStatus: proto.StatusInfo_OFFLINE,
+			SessionStatus:    proto.StatusInfo_NOT_LOGGED_IN,
+		})
+		return true
+
+	case "login":
+		// Show login dialog
+		t.pages.SwitchToPage("login")
+		return true
+
+	case "logout":
+		// Log out
+		if !t.client.IsConnected() {
+			t.ShowError(i18n.GetMessage("error.not_connected"))
+			return true
+		}
+
+		if !t.client.IsLoggedIn() {
+			t.ShowError(i18n.GetMessage("error.not_logged_in"))
+			return true
+		}
+
+		err := t.client.Logout()
+		if err != nil {
+			t.ShowError(err.Error())
+		} else {
+			t.ShowInfo(i18n.GetMessage("success.logged_out"))
+		}
+		return true
+
+	case "alias":
+		// Define or show aliases
+		if len(parts) < 2 {
+			// Show aliases
+			aliases := t.aliasManager.GetAllAliases()
+			if len(aliases) == 0 {
+				t.output.Write([]byte(i18n.GetMessage("commands.no_aliases") + "\n"))
+			} else {
+				t.output.Write([]byte(i18n.GetMessage("commands.local_aliases") + "\n"))
+				names := make([]string, 0, len(aliases))
+				for alias := range aliases {
+					names = append(names, alias)
+				}
+				i18n.SortStrings(names)
+				for _, alias := range names {
+					t.output.Write([]byte(fmt.Sprintf("  %s = %s\n", alias, aliases[alias])))
+				}
+			}
+
+			// Show server aliases with their expected parameters
+			if names := t.client.ServerAliasNames(); len(names) > 0 {
+				i18n.SortStrings(names)
+				t.output.Write([]byte(i18n.GetMessage("commands.server_aliases") + "\n"))
+				for _, name := range names {
+					hint, _ := t.lookupServerAlias(name)
+					t.output.Write([]byte("  " + formatAliasHint(name, hint) + "\n"))
+				}
+			}
+		} else {
+			// Define alias
+			aliasParts := strings.SplitN(parts[1], "=", 2)
+			if len(aliasParts) != 2 {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "alias <name>=<command>"))
+				return true
+			}
+
+			alias := strings.TrimSpace(aliasParts[0])
+			command := strings.TrimSpace(aliasParts[1])
+
+			if alias == "" {
+				t.ShowError(i18n.GetMessage("error.empty_alias"))
+				return true
+			}
+
+			if command == "" {
+				t.ShowError(i18n.GetMessage("error.empty_command"))
+				return true
+			}
+
+			if isReservedKeyword(alias) || plugin.IsCommand(alias) {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.reserved_keyword"), alias))
+				return true
+			}
+
+			err := t.aliasManager.AddAlias(alias, command)
+			if err != nil {
+				t.ShowError(err.Error())
+			} else {
+				t.aliasManager.SaveAliases()
+
+				// The alias is kept, but cannot be used until the chain is fixed
+				if _, _, err := t.aliasManager.ExpandAliases(alias); err != nil {
+					t.ShowError(fmt.Sprintf(i18n.GetMessage("error.alias_created_unusable"), alias, err))
+				} else {
+					t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.alias_created"), alias, command))
+				}
+			}
+		}
+		return true
+
+	case "unalias":
+		// Delete alias
+		if len(parts) < 2 {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "unalias <name>"))
+			return true
+		}
+
+		alias := strings.TrimSpace(parts[1])
+		err := t.aliasManager.RemoveAlias(alias)
+		if err != nil {
+			t.ShowError(err.Error())
+		} else {
+			t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.alias_deleted"), alias))
+			t.aliasManager.SaveAliases()
+		}
+		return true
+
+	case "history":
+		// Show history
+		entries := t.commandHistory.GetEntries()
+		if len(entries) == 0 {
+			t.output.Write([]byte(i18n.GetMessage("commands.no_history") + "\n"))
+		} else {
+			t.output.Write([]byte(i18n.GetMessage("commands.command_history") + "\n"))
+			for i, entry := range entries {
+				t.output.Write([]byte(fmt.Sprintf("  %d: %s\n", i+1, entry)))
+			}
+		}
+		return true
+
+	case "record":
+		// Record the screen into an asciicast file
+		if len(parts) < 2 {
+			t.handleRecordCommand("")
+		} else {
+			t.handleRecordCommand(parts[1])
+		}
+		return true
+
+	case "import":
+		// Import history entries or aliases from shell files
+		if len(parts) < 2 {
+			t.handleImportCommand("")
+		} else {
+			t.handleImportCommand(parts[1])
+		}
+		return true
+
+	case "search":
+		// Search transcripts and history
+		if len(parts) < 2 {
+			t.handleSearchCommand("")
+		} else {
+			t.handleSearchCommand(parts[1])
+		}
+		return true
+
+	case "approvals":
+		// List approval requests
+		if len(parts) < 2 {
+			t.handleApprovalsCommand("")
+		} else {
+			t.handleApprovalsCommand(parts[1])
+		}
+		return true
+
+	case "approve", "reject":
+		// Decide on an approval request
+		args := ""
+		if len(parts) > 1 {
+			args = parts[1]
+		}
+		t.handleApproveCommand(args, cmd == "approve")
+		return true
+
+	case "report":
+		// Export the session transcript as HTML
+		if len(parts) < 2 {
+			t.handleReportCommand("")
+		} else {
+			t.handleReportCommand(parts[1])
+		}
+		return true
+
+	case "undo":
+		// Execute the compensating action of the last command
+		if len(parts) < 2 {
+			t.handleUndoCommand("")
+		} else {
+			t.handleUndoCommand(parts[1])
+		}
+		return true
+
+	case "dashboard":
+		// Open a dashboard of refreshing widgets
+		if len(parts) < 2 {
+			t.handleDashboardCommand("")
+		} else {
+			t.handleDashboardCommand(parts[1])
+		}
+		return true
+
+	case "feedback":
+		// Send a report to the administrators of the server
+		if len(parts) < 2 {
+			t.handleFeedbackCommand("")
+		} else {
+			t.handleFeedbackCommand(parts[1])
+		}
+		return true
+
+	case "support-bundle":
+		// Write a zip file for bug reports
+		if len(parts) < 2 {
+			t.handleSupportBundleCommand("")
+		} else {
+			t.handleSupportBundleCommand(parts[1])
+		}
+		return true
+
+	case "use":
+		// Set service context
+		if len(parts) < 2 {
+			t.output.Write([]byte(fmt.Sprintf(i18n.GetMessage("commands.current_context"),
+				t.client.GetLastServiceUsed())))
+			return true
+		}
+
+		service := strings.TrimSpace(parts[1])
+		t.client.SetLastServiceUsed(service)
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.context_set"), service))
+		return true
+
+	case "whoami":
+		// Show the effective user and roles
+		t.handleWhoamiCommand()
+		return true
+
+	case "actions":
+		// List or reload the quick actions of the server
+		if len(parts) < 2 {
+			t.handleActionsCommand("")
+		} else {
+			t.handleActionsCommand(parts[1])
+		}
+		return true
+
+	case "connection":
+		// Show the quality of the connection
+		t.handleConnectionCommand()
+		return true
+
+	case "version":
+		// Show client and server versions
+		t.handleVersionCommand()
+		return true
+
+	case "session":
+		// Move the session to another device
+		if len(parts) < 2 {
+			t.handleSessionCommand("")
+		} else {
+			t.handleSessionCommand(parts[1])
+		}
+		return true
+
+	case "credentials":
+		// Show or delete the credentials stored in the keyring
+		if len(parts) < 2 {
+			t.handleCredentialsCommand("")
+		} else {
+			t.handleCredentialsCommand(parts[1])
+		}
+		return true
+
+	case "workspace":
+		// Save, load or delete a workspace
+		if len(parts) < 2 {
+			t.handleWorkspaceCommand("")
+		} else {
+			t.handleWorkspaceCommand(parts[1])
+		}
+		return true
+
+	case "flow":
+		// Record, show or run a command flow
+		if len(parts) < 2 {
+			t.handleFlowCommand("")
+		} else {
+			t.handleFlowCommand(parts[1])
+		}
+		return true
+
+	case "state":
+		// Show or prune the local state in the configuration directory
+		if len(parts) < 2 {
+			t.handleStateCommand("")
+		} else {
+			t.handleStateCommand(parts[1])
+		}
+		return true
+
+	case "readonly":
+		// Show or switch the read-only mode
+		if len(parts) < 2 {
+			t.handleReadOnlyCommand("")
+		} else {
+			t.handleReadOnlyCommand(parts[1])
+		}
+		return true
+
+	case "set":
+		// Show or switch the options of the session
+		if len(parts) < 2 {
+			t.handleSetCommand("")
+		} else {
+			t.handleSetCommand(parts[1])
+		}
+		return true
+
+	case "telemetry":
+		// Show or change the opt-in telemetry setting
+		if len(parts) < 2 {
+			t.handleTelemetryCommand("")
+		} else {
+			t.handleTelemetryCommand(parts[1])
+		}
+		return true
+	}
+
+	// Commands of compiled-in extensions
+	args := ""
+	if len(parts) > 1 {
+		args = parts[1]
+	}
+	return t.handleExtensionCommand(cmd, args)
+}
+
+// handleLogin processes the login
+func (t *TUI) handleLogin() {
+	// Collect the values of the provider fields
+	fields := t.client.GetAuthProvider().Fields()
+	values := make(map[string]string, len(fields))
+	for i, field := range fields {
+		input := t.loginForm.GetFormItem(i).(*tview.InputField)
+		values[field.Key] = input.GetText()
+
+		// Reset secret fields
+		if field.Secret {
+			input.SetText("")
+		}
+	}
+	remember := t.loginForm.GetFormItem(len(fields)).(*tview.Checkbox).IsChecked()
+
+	// Return to main page
+	t.pages.SwitchToPage("main")
+
+	// Check if connected to server
+	if !t.client.IsConnected() {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+
+	// Login
+	err := t.client.LoginWith(values)
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+
+	// Store credentials for automatic re-login
+	if remember && len(fields) > 0 {
+		t.storeLoginCredentials(values)
+	}
+}
+
+// handleOutput processes output from the server
+func (t *TUI) handleOutput(output string) {
+	t.recordResult(output)
+	t.showOutput(output)
+}
+
+// showOutput writes a result to the output area
+func (t *TUI) showOutput(output string) {
+	output = plugin.DecorateOutput(output)
+
+	// Results are marked with the color of the service that produced them
+	gutter := t.serviceGutter(t.outputService)
+
+	// Long results are shown folded with the placeholder selected
+	if folded, ok := t.foldOutput(output, gutter); ok {
+		t.noticeWatchHits(t.output, output, true)
+		t.output.Write([]byte(withGutter(folded, gutter) + "\n"))
+		t.selectingReference = true
+		t.output.Highlight(t.selectedReference)
+		t.selectingReference = false
+		return
+	}
+
+	t.noticeWatchHits(t.output, output, false)
+	t.output.Write([]byte(withGutter(t.decorateOutput(output), gutter) + "\n"))
+}
+
+// handleStatusChanged processes status changes; the client reports them on the
+// UI goroutine as well as from its own goroutines
+func (t *TUI) handleStatusChanged(statusInfo *proto.StatusInfo) {
+	go t.app.QueueUpdateDraw(func() {
+		t.updateStatus("", statusInfo)
+	})
+}
+
+// updateStatus updates the status display, which the next redraw shows;
+// called on the UI goroutine or before Run
+func (t *TUI) updateStatus(message string, statusInfo *proto.StatusInfo) {
+	if message != "" {
+		t.showStatusMessage(message, message, 3*time.Second)
+	}
+
+	if statusInfo == nil {
+		return
+	}
+	t.lastStatusInfo = statusInfo
+
+	// Update status display and mark elevated sessions
+	t.renderStatus()
+	t.updateSessionHeader()
+}
+
+// renderStatus shows the last status information, fitted to the available width
+func (t *TUI) renderStatus() {
+	if t.lastStatusInfo == nil {
+		return
+	}
+	t.statusAll = t.densitySegments(t.statusSegments(t.lastStatusInfo))
+	t.statusShown = fitStatusSegments(t.statusAll, t.statusInfoWidth())
+	t.statusInfo.SetText(joinStatusSegments(t.statusShown))
+}
+
+// statusSegments creates the segments of the status information in display order
+func (t *TUI) statusSegments(statusInfo *proto.StatusInfo) []statusSegment {
+	var segments []statusSegment
+
+	// Badges of an elevated or read-only session, never dropped
+	if badge := t.roleBadge(); badge != "" {
+		segments = append(segments, statusSegment{text: badge, priority: 0})
+	}
+	if badge := t.readOnlyBadge(); badge != "" {
+		segments = append(segments, statusSegment{text: badge, priority: 0})
+	}
+
+	// Commands in flight and queued
+	if activity := t.activitySegment(); activity != "" {
+		segments = append(segments, statusSegment{text: activity, priority: 1})
+	}
+
+	// Connection status
+	switch statusInfo.ConnectionStatus {
+	case proto.StatusInfo_OFFLINE:
+		segments = append(segments, statusSegment{text: "[red]" + i18n.GetMessage("status.offline") + "[white]", priority: 0})
+	case proto.StatusInfo_CONNECTING:
+		segments = append(segments, statusSegment{text: "[yellow]" + i18n.GetMessage("status.connecting") + "[white]", priority: 0})
+	case proto.StatusInfo_CONNECTED:
+		if statusInfo.ServerName != "" {
+			segments = append(segments, statusSegment{text: fmt.Sprintf("[green]%s[white]",
+				fmt.Sprintf(i18n.GetMessage("status.connected"), statusInfo.ServerName)), priority: 0,
+				short: "[green]" + statusInfo.ServerName + "[white]"})
+		} else {
+			segments = append(segments, statusSegment{text: "[green]" + i18n.GetMessage("status.connected") + "[white]", priority: 0})
+		}
+		if gauge := t.qualitySegment(); gauge != "" {
+			segments = append(segments, statusSegment{text: gauge, priority: 2})
+		}
+		if traffic := t.trafficSegment(); traffic.text != "" {
+			segments = append(segments, traffic)
+		}
+	case proto.StatusInfo_CONNECTION_ERROR:
+		segments = append(segments, statusSegment{text: "[red]" + i18n.GetMessage("status.connection_error") + "[white]", priority: 0})
+	}
+
+	// Session status
+	switch statusInfo.SessionStatus {
+	case proto.StatusInfo_NOT_LOGGED_IN:
+		segments = append(segments, statusSegment{text: "[yellow]" + i18n.GetMessage("status.not_logged_in") + "[white]", priority: 1})
+	case proto.StatusInfo_AUTHENTICATED:
+		if statusInfo.Username != "" {
+			segments = append(segments, statusSegment{text: fmt.Sprintf("[green]%s[white]",
+				fmt.Sprintf(i18n.GetMessage("status.logged_in"), statusInfo.Username)), priority: 3,
+				short: "[green]" + statusInfo.Username + "[white]"})
+		} else {
+			segments = append(segments, statusSegment{text: "[green]" + i18n.GetMessage("status.logged_in") + "[white]", priority: 3})
+		}
+	case proto.StatusInfo_LOGIN_REQUIRED:
+		segments = append(segments, statusSegment{text: "[yellow]" + i18n.GetMessage("status.login_required") + "[white]", priority: 1})
+	case proto.StatusInfo_SESSION_EXPIRING:
+		remaining := statusInfo.SessionRemainingMinutes
+		segments = append(segments, statusSegment{text: fmt.Sprintf("[yellow]%s[white]",
+			fmt.Sprintf(i18n.GetMessage("status.session_expiring"), remaining)), priority: 1,
+			short: fmt.Sprintf("[yellow]%s[white]", fmt.Sprintf(i18n.GetMessage("status.session_expiring_short"), remaining))})
+	case proto.StatusInfo_SESSION_EXPIRED:
+		segments = append(segments, statusSegment{text: "[red]" + i18n.GetMessage("status.session_expired") + "[white]", priority: 1})
+	}
+
+	// Service context
+	if statusInfo.CurrentService != "" {
+		segments = append(segments, statusSegment{
+			text: fmt.Sprintf(i18n.GetMessage("status.service_context"), statusInfo.CurrentService), priority: 4,
+			short: statusInfo.CurrentService})
+	}
+
+	// Running background jobs
+	if running := t.client.CountRunningJobs(); running > 0 {
+		segments = append(segments, statusSegment{
+			text: fmt.Sprintf(i18n.GetMessage("status.running_jobs"), running), priority: 2})
+	}
+
+	// Own commands waiting for approval
+	if pending := len(t.client.GetPendingApprovals()); pending > 0 {
+		segments = append(segments, statusSegment{text: fmt.Sprintf("[yellow]%s[white]",
+			fmt.Sprintf(i18n.GetMessage("status.pending_approvals"), pending)), priority: 2})
+	}
+
+	// Flow being recorded
+	if badge := t.flowBadge(); badge != "" {
+		segments = append(segments, statusSegment{text: badge, priority: 1})
+	}
+
+	// Screen being recorded
+	if badge := t.recordingBadge(); badge != "" {
+		segments = append(segments, statusSegment{text: badge, priority: 0})
+	}
+
+	// Watch hits not jumped to yet
+	if badge := t.watchBadge(); badge != "" {
+		segments = append(segments, statusSegment{text: badge, priority: 1})
+	}
+
+	// Undo of the last command still offered
+	if segment := t.undoSegment(); segment != "" {
+		segments = append(segments, statusSegment{text: segment, priority: 1})
+	}
+
+	return segments
+}
+
+// handleGlobalKeys processes global keyboard shortcuts
+func (t *TUI) handleGlobalKeys(event *tcell.EventKey) *tcell.EventKey {
+	// If a modal dialog is active, only process Escape
+	if t.pages.HasPage("modal") {
+		if event.Key() == tcell.KeyEscape {
+			t.pages.RemovePage("modal")
+			return nil
+		}
+		return event
+	}
+
+	// Function keys bound to commands
+	if t.runFunctionKey(event.Key()) {
+		return nil
+	}
+
+	// Global keyboard shortcuts
+	switch event.Key() {
+	case tcell.KeyCtrlC:
+		// Exit application
+		t.app.Stop()
+		return nil
+
+	case tcell.KeyCtrlL:
+		// Show login dialog
+		if t.pages.HasPage("login") {
+			t.pages.SwitchToPage("login")
+			return nil
+		}
+
+	case tcell.KeyCtrlH:
+		// Show help
+		if t.pages.HasPage("help") {
+			t.showHelp()
+			return nil
+		}
+
+	case tcell.KeyCtrlR:
+		// Show recently used servers
+		t.showRecentServers()
+		return nil
+
+	case tcell.KeyCtrlP:
+		// Open the command palette with the quick actions of the server
+		t.openCommandPalette()
+		return nil
+
+	case tcell.KeyF4:
+		// Show or hide the jobs panel
+		t.toggleJobsPanel()
+		return nil
+
+	case tcell.KeyCtrlD:
+		// Start server discovery
+		t.DiscoverServers()
+		return nil
+	}
+
+	return event
+}
+
+// handleInputKeys processes keyboard shortcuts in the input field
+func (t *TUI) handleInputKeys(event *tcell.EventKey) *tcell.EventKey {
+	// Lines pasted without bracketed paste go to the paste preview
+	if t.detectUnbracketedPaste(event) {
+		return nil
+	}
+
+	// Dropping of a command waiting for confirmation
+	if t.handleExpansionKeys(event) {
+		return nil
+	}
+
+	// Export of a selected folded result
+	if t.handleFoldKeys(event) {
+		return nil
+	}
+
+	// Copying of a selected reference
+	if t.handleCopyKeys(event) {
+		return nil
+	}
+
+	// Picking of a numbered completion candidate
+	if t.handleCompletionKeys(event) {
+		return nil
+	}
+
+	// Resizing and scrolling of the split panes
+	if t.handleSplitKeys(event) {
+		return nil
+	}
+
+	// History navigation
+	switch event.Key() {
+	case tcell.KeyUp:
+		// Previous command
+		if cmd, ok := t.commandHistory.Previous(); ok {
+			t.input.SetText(cmd)
+		}
+		return nil
+
+	case tcell.KeyDown:
+		// Next command
+		if cmd, ok := t.commandHistory.Next(); ok {
+			t.input.SetText(cmd)
+		}
+		return nil
+
+	case tcell.KeyPgUp:
+		// Scroll output page up
+		scrollTextView(t.output, -1)
+		return nil
+
+	case tcell.KeyPgDn:
+		// Scroll output page down
+		scrollTextView(t.output, 1)
+		return nil
+
+	case tcell.KeyCtrlSpace:
+		// Expand the alias at the start of the input
+		t.expandAliasInline()
+		return nil
+
+	case tcell.KeyCtrlG:
+		// Select the next reference in the output
+		t.selectNextReference()
+		return nil
+
+	case tcell.KeyTab:
+		// Auto-completion
+		currentText := t.input.GetText()
+
+		// Show what an entered alias will run instead of completing it
+		if t.showAliasHint(currentText) {
+			return nil
+		}
+
+		// Offer the last used value of the next parameter
+		if t.isAutoCompleteEnabled() && t.completeParameterDefault(currentText) {
+			return nil
+		}
+
+		if t.client.IsConnected() && t.isAutoCompleteEnabled() {
+			t.client.GetTelemetry().RecordFeature("completion")
+			suggestions, commonPrefix, err := t.completer.CompleteFromServer(currentText)
+			if err == nil && len(suggestions) > 0 {
+				if len(suggestions) == 1 {
+					// Only one suggestion - complete directly
+					t.input.SetText(suggestions[0])
+					t.showDeprecationHint(suggestions[0])
+				} else if commonPrefix != "" && commonPrefix != currentText {
+					// Complete common prefix
+					t.input.SetText(commonPrefix)
+				} else {
+					// Multiple suggestions - show them numbered for picking
+					t.showCompletions(suggestions)
+				}
+			}
+			t.advanceTutorial()
+		}
+		return nil
+	}
+
+	return event
+}
+
+// discoverTimeout returns the configured timeout of the server discovery
+func (t *TUI) discoverTimeout() time.Duration {
+	if cfg := t.client.GetConfig(); cfg != nil && cfg.Server.DiscoverTimeoutSeconds > 0 {
+		return time.Duration(cfg.Server.DiscoverTimeoutSeconds) * time.Second
+	}
+	return 5 * time.Second
+}
+
+// historySize returns the configured number of history entries
+func historySize(c *client.Client) int {
+	if cfg := c.GetConfig(); cfg != nil && cfg.UI.MaxHistoryEntries > 0 {
+		return cfg.UI.MaxHistoryEntries
+	}
+	return 100
+}
+
+// centeredFlex centers a flex element on the screen
+func centeredFlex(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(
+			tview.NewFlex().
+				SetDirection(tview.FlexRow).
+				AddItem(nil, 0, 1, false).
+				AddItem(p, height, 1, true).
+				AddItem(nil, 0, 1, false),
+			width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
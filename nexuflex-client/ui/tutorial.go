@@ -0,0 +1,215 @@
+// tutorial.go
+/**
+ * Nexuflex Client - Tutorial
+ *
+ * This file contains the "tutorial" client command, a guided walkthrough
+ * for new users. It starts the tutorial sandbox, a small server inside the
+ * client, and leads through connecting to it, logging in, running a
+ * command, completing a command name with Tab and creating an alias. Every
+ * step is verified by what the user actually did: the connection and the
+ * session of the client, the commands and completions the sandbox served
+ * and the aliases created. Completed steps are recorded per user, and
+ * "tutorial status" shows the progress.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// tutorialUsage is the syntax of the "tutorial" command
+const tutorialUsage = "tutorial [status|stop|reset]"
+
+// tutorialRun is a running tutorial
+type tutorialRun struct {
+	sandbox  *client.Sandbox
+	progress *client.TutorialProgress
+	step     int               // Index of the current step in client.TutorialSteps
+	aliases  map[string]string // Local aliases when the tutorial started
+}
+
+// handleTutorialCommand processes the "tutorial" client command
+func (t *TUI) handleTutorialCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		t.startTutorial()
+	case "status":
+		t.showTutorialStatus()
+	case "stop":
+		if t.tutorial == nil {
+			t.ShowError(i18n.GetMessage("commands.tutorial_not_running"))
+			return
+		}
+		t.stopTutorial()
+		t.ShowInfo(i18n.GetMessage("commands.tutorial_stopped"))
+	case "reset":
+		if err := client.LoadTutorialProgress().Reset(); err != nil {
+			t.ShowError(err.Error())
+			return
+		}
+		if t.tutorial != nil {
+			t.tutorial.progress = client.LoadTutorialProgress()
+		}
+		t.ShowInfo(i18n.GetMessage("commands.tutorial_reset"))
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), tutorialUsage))
+	}
+}
+
+// startTutorial starts the sandbox and shows the first step; a running
+// tutorial shows its current step again
+func (t *TUI) startTutorial() {
+	if t.tutorial != nil {
+		t.showTutorialStep()
+		return
+	}
+
+	sandbox, err := client.StartSandbox()
+	if err != nil {
+		t.ShowError(err.Error())
+		return
+	}
+	t.tutorial = &tutorialRun{
+		sandbox:  sandbox,
+		progress: client.LoadTutorialProgress(),
+		aliases:  t.aliasManager.GetAllAliases(),
+	}
+
+	t.writeTutorial(i18n.GetMessage("commands.tutorial_intro"))
+	if progress := t.tutorial.progress; progress.Finished != nil {
+		t.writeTutorial(i18n.GetMessage("commands.tutorial_again"))
+	} else if progress.Started() {
+		t.writeTutorial(fmt.Sprintf(i18n.GetMessage("commands.tutorial_resumed"), len(progress.Completed), len(client.TutorialSteps)))
+	}
+	t.showTutorialStep()
+}
+
+// stopTutorial ends the tutorial and the sandbox, and the connection to it
+func (t *TUI) stopTutorial() {
+	if t.tutorial == nil {
+		return
+	}
+	if t.connectedToSandbox() {
+		t.client.Close()
+	}
+	t.tutorial.sandbox.Close()
+	t.tutorial = nil
+}
+
+// advanceTutorial verifies the current step and moves on as long as steps
+// are completed; it is called after everything that can complete a step
+func (t *TUI) advanceTutorial() {
+	run := t.tutorial
+	if run == nil || run.step >= len(client.TutorialSteps) {
+		return
+	}
+
+	advanced := false
+	for run.step < len(client.TutorialSteps) && t.tutorialStepDone(client.TutorialSteps[run.step]) {
+		step := client.TutorialSteps[run.step]
+		if err := run.progress.Complete(step); err != nil {
+			t.ShowError(err.Error())
+		}
+		t.writeTutorial(fmt.Sprintf("[green]✓[-] %s", fmt.Sprintf(i18n.GetMessage("commands.tutorial_step_done"), i18n.GetMessage("commands.tutorial_title_"+step))))
+		run.step++
+		advanced = true
+	}
+	if !advanced {
+		return
+	}
+
+	if run.step < len(client.TutorialSteps) {
+		t.showTutorialStep()
+		return
+	}
+	t.writeTutorial(i18n.GetMessage("commands.tutorial_finished"))
+}
+
+// tutorialStepDone verifies whether the user has completed a step
+func (t *TUI) tutorialStepDone(step string) bool {
+	run := t.tutorial
+	switch step {
+	case client.TutorialConnect:
+		return t.connectedToSandbox()
+	case client.TutorialLogin:
+		return t.connectedToSandbox() && t.client.IsLoggedIn()
+	case client.TutorialCommand:
+		return len(run.sandbox.Commands()) > 0
+	case client.TutorialCompletion:
+		return run.sandbox.Completions() > 0
+	case client.TutorialAlias:
+		for name, command := range t.aliasManager.GetAllAliases() {
+			if previous, ok := run.aliases[name]; !ok || previous != command {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// connectedToSandbox returns whether the client is connected to the tutorial sandbox
+func (t *TUI) connectedToSandbox() bool {
+	if t.tutorial == nil || !t.client.IsConnected() {
+		return false
+	}
+	server := t.client.GetServerInfo()
+	return server != nil && t.tutorial.sandbox.Serves(server.Address, int(server.Port))
+}
+
+// showTutorialStep writes the instructions of the current step
+func (t *TUI) showTutorialStep() {
+	run := t.tutorial
+	if run.step >= len(client.TutorialSteps) {
+		t.writeTutorial(i18n.GetMessage("commands.tutorial_finished"))
+		return
+	}
+
+	step := client.TutorialSteps[run.step]
+	var instructions string
+	switch step {
+	case client.TutorialConnect:
+		instructions = fmt.Sprintf(i18n.GetMessage("commands.tutorial_step_connect"), run.sandbox.Address(), run.sandbox.Port())
+	case client.TutorialLogin:
+		instructions = fmt.Sprintf(i18n.GetMessage("commands.tutorial_step_login"), client.SandboxPassword)
+	default:
+		instructions = i18n.GetMessage("commands.tutorial_step_" + step)
+	}
+	t.writeTutorial(fmt.Sprintf("[yellow]%s[-] %s", fmt.Sprintf(i18n.GetMessage("commands.tutorial_step"), run.step+1, len(client.TutorialSteps)), instructions))
+}
+
+// showTutorialStatus lists the steps with the ones the user has completed
+func (t *TUI) showTutorialStatus() {
+	progress := client.LoadTutorialProgress()
+	if t.tutorial != nil {
+		progress = t.tutorial.progress
+	}
+
+	var sb strings.Builder
+	sb.WriteString(i18n.GetMessage("commands.tutorial_status") + "\n")
+	for i, step := range client.TutorialSteps {
+		mark := "[gray]·[-]"
+		if completed, ok := progress.Completed[step]; ok {
+			mark = fmt.Sprintf("[green]✓[-] [gray](%s)[-]", completed.Format("2006-01-02 15:04"))
+		}
+		sb.WriteString(fmt.Sprintf("  %d. %s %s\n", i+1, i18n.GetMessage("commands.tutorial_title_"+step), mark))
+	}
+	if progress.Finished != nil {
+		sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.tutorial_finished_at"), progress.Finished.Format("2006-01-02 15:04")) + "\n")
+	}
+	t.output.Write([]byte(sb.String()))
+}
+
+// writeTutorial writes a message of the tutorial to the output area
+func (t *TUI) writeTutorial(message string) {
+	t.output.Write([]byte(fmt.Sprintf("[aqua]%s[-] %s\n", tview.Escape(i18n.GetMessage("commands.tutorial_prefix")), message)))
+}
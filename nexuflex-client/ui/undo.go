@@ -0,0 +1,133 @@
+// undo.go
+/**
+ * Nexuflex Client - Undo Command
+ *
+ * This file contains the "undo" client command and its indicator. When a
+ * command can be undone, a note below its output says so and the status
+ * bar shows how long the undo is still offered. "undo" asks for
+ * confirmation and executes the compensating action of the most recent
+ * command; "undo list" shows all commands that can still be undone.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// undoSymbol marks commands that can be undone
+const undoSymbol = "↶"
+
+// handleUndoAvailable is called by the client when a command can be undone
+func (t *TUI) handleUndoAvailable(entry client.UndoEntry) {
+	go t.app.QueueUpdateDraw(func() {
+		description := entry.Description
+		if description == "" {
+			description = entry.UndoCommand
+		}
+		t.output.Write([]byte(fmt.Sprintf("[gray]%s %s[white]\n", undoSymbol,
+			fmt.Sprintf(i18n.GetMessage("commands.undo_available"),
+				formatUndoRemaining(time.Until(entry.Expires)), tview.Escape(description)))))
+		t.renderStatus()
+	})
+}
+
+// startUndoIndicator refreshes the remaining time of the undo in the status
+// bar every second while an undo is offered
+func (t *TUI) startUndoIndicator() {
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		shown := false
+		for range ticker.C {
+			_, available := t.client.LastUndo()
+			if !available && !shown {
+				continue
+			}
+			shown = available
+			t.app.QueueUpdateDraw(t.renderStatus)
+		}
+	}()
+}
+
+// undoSegment returns the status bar segment offering the undo, empty if there is none
+func (t *TUI) undoSegment() string {
+	entry, ok := t.client.LastUndo()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("[aqua]%s %s[white]", undoSymbol,
+		fmt.Sprintf(i18n.GetMessage("status.undo"), formatUndoRemaining(time.Until(entry.Expires))))
+}
+
+// handleUndoCommand processes the "undo [list]" client command
+func (t *TUI) handleUndoCommand(args string) {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+	case "list":
+		t.showUndoList()
+		return
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "undo [list]"))
+		return
+	}
+
+	if !t.client.IsConnected() {
+		t.ShowError(i18n.GetMessage("error.not_connected"))
+		return
+	}
+	entry, ok := t.client.LastUndo()
+	if !ok {
+		t.ShowInfo(i18n.GetMessage("commands.undo_none"))
+		return
+	}
+
+	t.showConfirmation(fmt.Sprintf(i18n.GetMessage("ui.confirm_undo"), entry.Command, entry.UndoCommand), func() {
+		entry, err := t.client.TakeUndo()
+		if err != nil {
+			t.ShowError(i18n.GetMessage("commands.undo_expired"))
+			return
+		}
+		t.startBlock(entry.UndoCommand)
+		t.output.Write([]byte(fmt.Sprintf("%s> [yellow]%s[white]\n", t.timestamp(), tview.Escape(entry.UndoCommand))))
+		t.lastResult.Reset()
+		t.executeCommand(entry.UndoCommand)
+		t.renderStatus()
+	})
+}
+
+// showUndoList lists the commands that can still be undone, the newest first
+func (t *TUI) showUndoList() {
+	entries := t.client.UndoEntries()
+	if len(entries) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.undo_none"))
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(i18n.GetMessage("commands.undo_title") + "\n")
+	for _, entry := range entries {
+		sb.WriteString(fmt.Sprintf("  [yellow]%s[white]  %s %s  [gray](%s)[white]\n", tview.Escape(entry.Command),
+			undoSymbol, tview.Escape(entry.UndoCommand), formatUndoRemaining(time.Until(entry.Expires))))
+		if entry.Description != "" {
+			sb.WriteString("    " + tview.Escape(entry.Description) + "\n")
+		}
+	}
+	t.output.Write([]byte(sb.String()))
+}
+
+// formatUndoRemaining formats the remaining time of an undo as m:ss
+func formatUndoRemaining(remaining time.Duration) string {
+	seconds := max(0, int(remaining.Round(time.Second)/time.Second))
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
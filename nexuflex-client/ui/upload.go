@@ -0,0 +1,94 @@
+// upload.go
+/**
+ * Nexuflex Client - Upload Command
+ *
+ * This file contains the "upload <file> <command>" client command, which
+ * streams a local file into an upload command in the background. Reading
+ * from standard input ("upload - <command>") is only possible in batch
+ * mode, where stdin is not the terminal. It also contains the upload of
+ * the files referenced by "@file:" parameters before their command runs.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleUploadCommand processes the "upload" client command
+func (t *TUI) handleUploadCommand(args string) {
+	source, command, err := client.ParseUploadArgs(args)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), "upload <file> <command>"))
+		return
+	}
+
+	if !t.client.IsLoggedIn() {
+		t.ShowError(i18n.GetMessage("error.not_logged_in"))
+		return
+	}
+
+	data, err := client.OpenUploadSource(source, nil)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.upload"), err))
+		return
+	}
+
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.upload_started"), source, command))
+
+	go func() {
+		defer data.Close()
+		output, err := t.client.UploadData(context.Background(), command, data, nil)
+		t.app.QueueUpdateDraw(func() {
+			if err != nil {
+				t.ShowError(err.Error())
+				return
+			}
+			t.output.Write([]byte(fmt.Sprintf("[green]%s[white]\n",
+				fmt.Sprintf(i18n.GetMessage("success.uploaded"), source, command))))
+			if output != "" {
+				t.handleOutput(output)
+			}
+		})
+	}()
+}
+
+// stageFilesAndExecute uploads the files referenced with @file: in the
+// background, showing the progress in the status bar, and then executes the
+// command with the handles of the server
+func (t *TUI) stageFilesAndExecute(command string) {
+	t.ShowInfo(i18n.GetMessage("commands.files_staging"))
+
+	go func() {
+		lastPercent := -1
+		resolved, err := t.client.StageFileParameters(context.Background(), command, func(path string, sent, total int64) {
+			percent := 100
+			if total > 0 {
+				percent = int(sent * 100 / total)
+			}
+			if percent == lastPercent {
+				return
+			}
+			lastPercent = percent
+			t.app.QueueUpdateDraw(func() {
+				t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.file_staging_progress"), filepath.Base(path), percent))
+			})
+		})
+		t.app.QueueUpdateDraw(func() {
+			if err != nil {
+				t.ShowError(fmt.Sprintf(i18n.GetMessage("error.file_parameter"), err))
+				return
+			}
+			t.executeCommand(resolved)
+		})
+	}()
+}
@@ -0,0 +1,159 @@
+// verbose.go
+/**
+ * Nexuflex Client - Client Options and Verbose Mode
+ *
+ * This file contains the "set" client command, which switches options of
+ * the running session such as the verbose mode or the rendering of
+ * Markdown, and the debug blocks of the verbose mode: for each RPC a
+ * one-line summary with the method, status code, duration and message
+ * sizes is written to the output area. The summary is a
+ * placeholder like a folded result; Enter (or a click) expands it to the
+ * request metadata, the response headers and the message counts.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+	"google.golang.org/grpc/codes"
+)
+
+// setUsage is the syntax of the "set" client command
+const setUsage = "set [<option> on|off]"
+
+// sessionOption is an option of the session switched with "set"
+type sessionOption struct {
+	name    string
+	get     func(t *TUI) bool
+	set     func(t *TUI, on bool)
+	message string // Key of the messages, "_on" or "_off" is appended
+}
+
+// sessionOptions lists the options of the "set" command
+var sessionOptions = []sessionOption{
+	{
+		name:    "verbose",
+		get:     func(t *TUI) bool { return t.client.IsVerbose() },
+		set:     func(t *TUI, on bool) { t.client.SetVerbose(on) },
+		message: "commands.verbose",
+	},
+	{
+		name:    "markdown",
+		get:     func(t *TUI) bool { return t.client.GetConfig().UI.RenderMarkdown },
+		set:     func(t *TUI, on bool) { t.client.GetConfig().UI.RenderMarkdown = on },
+		message: "commands.markdown",
+	},
+	{
+		name: "lowbandwidth",
+		get:  func(t *TUI) bool { return t.client.IsLowBandwidth() },
+		set: func(t *TUI, on bool) {
+			t.client.SetLowBandwidth(on)
+			t.renderStatus()
+		},
+		message: "commands.lowbandwidth",
+	},
+}
+
+// handleSetCommand processes the "set [<option> on|off]" client command
+func (t *TUI) handleSetCommand(args string) {
+	fields := strings.Fields(strings.ToLower(args))
+	if len(fields) == 0 {
+		var sb strings.Builder
+		sb.WriteString(i18n.GetMessage("commands.set_title") + "\n")
+		for _, option := range sessionOptions {
+			sb.WriteString(fmt.Sprintf("  %-12s %s\n", option.name, onOff(option.get(t))))
+		}
+		t.output.Write([]byte(sb.String()))
+		return
+	}
+	if len(fields) != 2 || (fields[1] != "on" && fields[1] != "off") {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), setUsage))
+		return
+	}
+
+	for _, option := range sessionOptions {
+		if option.name == fields[0] {
+			option.set(t, fields[1] == "on")
+			t.ShowInfo(i18n.GetMessage(option.message + "_" + onOff(option.get(t))))
+			return
+		}
+	}
+	t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.set_unknown"), fields[0]))
+}
+
+// onOff returns "on" or "off" for a switch
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
+
+// handleRPCTrace is called by the client with the trace of an RPC in verbose mode
+func (t *TUI) handleRPCTrace(trace client.RPCTrace) {
+	go t.app.QueueUpdateDraw(func() {
+		t.writeDebugBlock(trace)
+	})
+}
+
+// writeDebugBlock writes the collapsed debug block of an RPC to the output area
+func (t *TUI) writeDebugBlock(trace client.RPCTrace) {
+	kind := "RPC"
+	if trace.Stream {
+		kind = "stream"
+	}
+	summary := fmt.Sprintf("» %s %s %s %dms ↑%s ↓%s", kind, trace.Method, trace.Code,
+		trace.Duration.Milliseconds(), formatBytes(int64(trace.RequestBytes)), formatBytes(int64(trace.ResponseBytes)))
+
+	lines := []string{
+		summary,
+		"  " + fmt.Sprintf(i18n.GetMessage("ui.debug_started"), trace.Started.Format("15:04:05.000")),
+		"  " + fmt.Sprintf(i18n.GetMessage("ui.debug_request"), trace.Requests, formatBytes(int64(trace.RequestBytes))),
+	}
+	lines = append(lines, debugMetadata(trace.RequestMetadata)...)
+	lines = append(lines, "  "+fmt.Sprintf(i18n.GetMessage("ui.debug_response"), trace.Responses, formatBytes(int64(trace.ResponseBytes))))
+	lines = append(lines, debugMetadata(trace.ResponseMetadata)...)
+	status := "  " + fmt.Sprintf(i18n.GetMessage("ui.debug_status"), trace.Code, int(trace.Code))
+	if trace.Message != "" {
+		status += ": " + trace.Message
+	}
+	lines = append(lines, status)
+	for i := range lines {
+		lines[i] = tview.Escape(lines[i])
+	}
+
+	fold := &foldedOutput{lines: lines}
+	t.addFold(fold, tview.Escape(summary))
+
+	// Failed calls are marked, as the placeholder has its own colors
+	marker := ""
+	if trace.Code != codes.OK {
+		marker = "[red]✗[white] "
+	}
+	t.output.Write([]byte(marker + fold.placeholder + "\n"))
+}
+
+// debugMetadata returns the lines of the metadata of an RPC in a debug block
+func debugMetadata(md map[string][]string) []string {
+	keys := make([]string, 0, len(md))
+	for key := range md {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("    %s: %s", key, strings.Join(md[key], ", ")))
+	}
+	return lines
+}
@@ -0,0 +1,63 @@
+// version.go
+/**
+ * Nexuflex Client - Version Command
+ *
+ * This file contains the client command that shows the client and server
+ * versions together with the result of the compatibility check.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+)
+
+// handleVersionCommand processes the "version" client command
+func (t *TUI) handleVersionCommand() {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.version_client"), client.BuildInfo()) + "\n")
+
+	serverInfo := t.client.GetServerInfo()
+	if serverInfo == nil {
+		sb.WriteString(i18n.GetMessage("commands.version_no_server") + "\n")
+		t.output.Write([]byte(sb.String()))
+		return
+	}
+
+	sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.version_server"),
+		serverInfo.ShortName, serverInfo.Version) + "\n")
+	if api := t.client.GetAPIVersion(); api != "" {
+		sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.version_api"), api) + "\n")
+	}
+
+	report := t.client.CheckCompatibility()
+	if len(report.ServerFeatures) > 0 {
+		sb.WriteString(fmt.Sprintf(i18n.GetMessage("commands.version_features"),
+			strings.Join(report.ServerFeatures, ", ")) + "\n")
+	}
+
+	switch report.Verdict {
+	case client.Compatible:
+		sb.WriteString("[green]" + i18n.GetMessage("commands.compat_ok") + "[white]\n")
+	case client.CompatibleWithLimitations:
+		sb.WriteString("[yellow]" + fmt.Sprintf(i18n.GetMessage("commands.compat_limited"),
+			strings.Join(report.MissingFeatures, ", ")) + "[white]\n")
+	case client.Incompatible:
+		sb.WriteString("[red]" + fmt.Sprintf(i18n.GetMessage("commands.compat_incompatible"),
+			report.Reason) + "[white]\n")
+	default:
+		sb.WriteString("[gray]" + fmt.Sprintf(i18n.GetMessage("commands.compat_unknown"),
+			report.Reason) + "[white]\n")
+	}
+
+	t.output.Write([]byte(sb.String()))
+}
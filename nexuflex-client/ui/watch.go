@@ -0,0 +1,249 @@
+// watch.go
+/**
+ * Nexuflex Client - Watch Patterns
+ *
+ * This file contains the alerts for the watch patterns: every incoming
+ * line of command or log output matching a pattern is highlighted, rings
+ * the terminal bell (watch_bell) and is announced in the status bar. With
+ * watch_bookmarks the hits are remembered, and "watch-pattern jump" scrolls
+ * to them one after the other, starting with the newest. Patterns added or
+ * removed with the "watch-pattern" client command are saved to the [watch]
+ * section of the configuration file.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/config"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// watchTag starts the highlighting of a line matching a watch pattern
+const watchTag = "[black:yellow:b]"
+
+// watchUsage is the syntax of the watch-pattern command
+const watchUsage = `watch-pattern [add "<regex>"|remove <name>|jump]`
+
+// maxWatchHits limits the remembered watch hits
+const maxWatchHits = 100
+
+// watchHit is a remembered line matching a watch pattern
+type watchHit struct {
+	view    *tview.TextView // Output area or log pane
+	line    int             // Index of the unwrapped line in the view
+	pattern string
+	text    string
+}
+
+// initWatches compiles the configured watch patterns
+func (t *TUI) initWatches() {
+	if err := t.compileWatches(); err != nil {
+		t.output.Write([]byte(fmt.Sprintf("[red]%s[white]\n",
+			fmt.Sprintf(i18n.GetMessage("error.watch_patterns"), err))))
+	}
+}
+
+// compileWatches replaces the active watch patterns with the ones of the configuration
+func (t *TUI) compileWatches() error {
+	patterns, err := client.ParseWatchPatterns(t.client.GetConfig().Watches)
+	t.watchPatterns = patterns
+	return err
+}
+
+// noticeWatchHits alerts about the lines of incoming output matching a watch pattern;
+// view is the pane the output is written to next, folded tells that only a part of it is shown
+func (t *TUI) noticeWatchHits(view *tview.TextView, output string, folded bool) {
+	if len(t.watchPatterns) == 0 {
+		return
+	}
+
+	var hits []watchHit
+	for i, line := range strings.Split(output, "\n") {
+		if pattern := client.MatchWatch(t.watchPatterns, line); pattern != nil {
+			hits = append(hits, watchHit{view: view, line: i, pattern: pattern.Name, text: line})
+		}
+	}
+	if len(hits) == 0 {
+		return
+	}
+
+	cfg := t.client.GetConfig()
+	if cfg.UI.WatchBookmarks {
+		// The output starts at the empty line after the text written so far
+		first := view.GetOriginalLineCount() - 1
+		if first < 0 {
+			first = 0
+		}
+		for _, hit := range hits {
+			if folded {
+				hit.line = 0 // Hidden lines may be hit; bookmark the start of the result
+			}
+			hit.line += first
+			t.watchHits = append(t.watchHits, hit)
+		}
+		if len(t.watchHits) > maxWatchHits {
+			t.watchHits = t.watchHits[len(t.watchHits)-maxWatchHits:]
+		}
+		t.watchJump = len(t.watchHits)
+		t.watchUnseen += len(hits)
+		t.renderStatus()
+	}
+
+	if cfg.UI.WatchBell && t.screen != nil {
+		_ = t.screen.Beep()
+	}
+
+	for _, hit := range hits {
+		t.client.Events().Publish(client.Event{
+			Type:    client.EventNotification,
+			Text:    fmt.Sprintf("watch %s: %s", hit.pattern, strings.TrimSpace(hit.text)),
+			User:    t.client.GetUsername(),
+			Context: t.client.GetLastServiceUsed(),
+		})
+	}
+
+	last := hits[len(hits)-1]
+	message := fmt.Sprintf(i18n.GetMessage("status.watch_hit"), last.pattern, strings.TrimSpace(last.text))
+	t.showStatusMessage(watchTag+tview.Escape(message)+highlightEndTag, message, 5*time.Second)
+}
+
+// jumpToWatchHit scrolls to the next remembered watch hit, from the newest to the oldest
+func (t *TUI) jumpToWatchHit() {
+	if len(t.watchHits) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.watch_no_hits"))
+		return
+	}
+
+	if t.watchJump <= 0 || t.watchJump > len(t.watchHits) {
+		t.watchJump = len(t.watchHits)
+	}
+	t.watchJump--
+	hit := t.watchHits[t.watchJump]
+
+	scrollToLine(hit.view, hit.line)
+	t.watchUnseen = 0
+	t.renderStatus()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("commands.watch_jumped"),
+		t.watchJump+1, len(t.watchHits), hit.pattern, strings.TrimSpace(hit.text)))
+}
+
+// forgetWatchHits drops the remembered hits of a view (e.g. when it is cleared)
+func (t *TUI) forgetWatchHits(view *tview.TextView) {
+	kept := t.watchHits[:0]
+	for _, hit := range t.watchHits {
+		if hit.view != view {
+			kept = append(kept, hit)
+		}
+	}
+	t.watchHits = kept
+	t.watchJump = len(kept)
+	t.watchUnseen = 0
+	t.renderStatus()
+}
+
+// scrollToLine scrolls a text view to the first row of an unwrapped line
+func scrollToLine(view *tview.TextView, line int) {
+	row := line
+	if _, _, width, _ := view.GetInnerRect(); width > 0 {
+		var layout wrapLayout
+		layout.update(view, width)
+		row = layout.rowOf(line)
+	}
+	view.ScrollTo(row, 0)
+}
+
+// watchBadge returns the status bar segment counting the watch hits not jumped to yet
+func (t *TUI) watchBadge() string {
+	if t.watchUnseen == 0 {
+		return ""
+	}
+	return "[yellow]⚑ " + fmt.Sprintf(i18n.GetMessage("status.watch_hits"), t.watchUnseen) + "[white]"
+}
+
+// handleWatchCommand processes the "watch-pattern [add|remove|jump]" client command
+func (t *TUI) handleWatchCommand(args string) {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	switch strings.ToLower(sub) {
+	case "", "list":
+		t.showWatchPatterns()
+	case "add":
+		t.addWatchPattern(rest)
+	case "remove":
+		t.removeWatchPattern(strings.TrimSpace(rest))
+	case "jump":
+		t.jumpToWatchHit()
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), watchUsage))
+	}
+}
+
+// addWatchPattern adds a pattern given as `"<regex>"` and saves it
+func (t *TUI) addWatchPattern(args string) {
+	pattern, _ := splitQuotedArgument(args)
+	if pattern == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), watchUsage))
+		return
+	}
+
+	cfg := t.client.GetConfig()
+	if cfg.Watches == nil {
+		cfg.Watches = make(map[string]string)
+	}
+	name := client.NextWatchName(cfg.Watches)
+	if _, err := client.NewWatchPattern(name, pattern); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.watch_invalid"), err))
+		return
+	}
+
+	cfg.Watches[name] = pattern
+	t.saveWatches()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.watch_added"), name))
+}
+
+// removeWatchPattern deletes a pattern by name and saves the remaining ones
+func (t *TUI) removeWatchPattern(name string) {
+	cfg := t.client.GetConfig()
+	if _, ok := cfg.Watches[name]; !ok {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.watch_not_found"), name))
+		return
+	}
+
+	delete(cfg.Watches, name)
+	t.saveWatches()
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.watch_removed"), name))
+}
+
+// saveWatches activates the changed patterns and writes them to the configuration file
+func (t *TUI) saveWatches() {
+	// Errors of patterns from the configuration file were reported at startup
+	_ = t.compileWatches()
+
+	if err := config.SaveConfig(*t.client.GetConfig(), ""); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.config_save"), err))
+	}
+}
+
+// showWatchPatterns lists the active watch patterns and the number of remembered hits
+func (t *TUI) showWatchPatterns() {
+	if len(t.watchPatterns) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.watch_none"))
+		return
+	}
+
+	for _, pattern := range t.watchPatterns {
+		t.output.Write([]byte(fmt.Sprintf("  [yellow]%-10s[white] %s\n",
+			pattern.Name, tview.Escape(pattern.Pattern.String()))))
+	}
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.watch_hit_count"), len(t.watchHits)))))
+}
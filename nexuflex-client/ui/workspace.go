@@ -0,0 +1,181 @@
+// workspace.go
+/**
+ * Nexuflex Client - Workspace Commands
+ *
+ * This file contains the "workspace" client command, which saves the
+ * working setup under a name and restores it: the output area and the log
+ * pane, the split layout and the watch patterns right away, the server
+ * connection in the background and the jobs once the session has started.
+ * With `restore_workspace = true` the last workspace is loaded at startup.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msto63/nexuflex/nexuflex-client/client"
+	"github.com/msto63/nexuflex/nexuflex-client/i18n"
+	"github.com/rivo/tview"
+)
+
+// workspaceUsage is the syntax of the workspace command
+const workspaceUsage = "workspace [save|load|delete <name>]"
+
+// handleWorkspaceCommand processes the "workspace" client command
+func (t *TUI) handleWorkspaceCommand(args string) {
+	action, name, _ := strings.Cut(strings.TrimSpace(args), " ")
+	name = strings.TrimSpace(name)
+	if action == "" {
+		t.showWorkspaces()
+		return
+	}
+	if name == "" {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), workspaceUsage))
+		return
+	}
+
+	switch strings.ToLower(action) {
+	case "save":
+		t.saveWorkspace(name)
+	case "load":
+		t.loadWorkspace(name)
+	case "delete":
+		if err := client.DeleteWorkspace(name); err != nil {
+			t.ShowError(fmt.Sprintf(i18n.GetMessage("error.workspace"), err))
+			return
+		}
+		t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.workspace_deleted"), name))
+	default:
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("commands.syntax"), workspaceUsage))
+	}
+}
+
+// showWorkspaces lists the saved workspaces, marking the last one
+func (t *TUI) showWorkspaces() {
+	names, err := client.ListWorkspaces()
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.workspace"), err))
+		return
+	}
+	if len(names) == 0 {
+		t.ShowInfo(i18n.GetMessage("commands.workspace_none"))
+		return
+	}
+
+	last, _ := client.LastWorkspace()
+	t.output.Write([]byte(i18n.GetMessage("commands.workspace_title") + "\n"))
+	for _, name := range names {
+		marker := " "
+		if name == last {
+			marker = "*"
+		}
+		t.output.Write([]byte(fmt.Sprintf("  %s %s\n", marker, tview.Escape(name))))
+	}
+}
+
+// saveWorkspace saves the current setup under a name
+func (t *TUI) saveWorkspace(name string) {
+	workspace := t.client.CurrentWorkspace(name)
+	workspace.Output = t.output.GetText(false)
+	workspace.LogOutput = t.logView.GetText(false)
+	workspace.SplitActive = t.splitActive
+	workspace.SplitRatio = t.splitRatio
+	workspace.Watches = t.client.GetConfig().Watches
+
+	// Jobs rendering into the log pane are started there again
+	for i, job := range workspace.Jobs {
+		for _, running := range t.client.GetJobs() {
+			if running.Command == job.Command && t.attachedJobs[running.ID] {
+				workspace.Jobs[i].LogPane = true
+			}
+		}
+	}
+
+	if err := client.SaveWorkspace(workspace); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.workspace"), err))
+		return
+	}
+	t.ShowInfo(fmt.Sprintf(i18n.GetMessage("success.workspace_saved"), name))
+}
+
+// loadWorkspace restores a saved setup
+func (t *TUI) loadWorkspace(name string) {
+	workspace, err := client.LoadWorkspace(name)
+	if err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.workspace"), err))
+		return
+	}
+
+	// Output and layout
+	t.output.SetText(workspace.Output)
+	t.output.ScrollToEnd()
+	t.logView.SetText(workspace.LogOutput)
+	t.logView.ScrollToEnd()
+	if workspace.SplitRatio > 0 {
+		t.setSplitRatio(workspace.SplitRatio)
+	}
+	t.showLogPane(workspace.SplitActive)
+
+	// Watch patterns apply to this session only
+	cfg := t.client.GetConfig()
+	cfg.Watches = make(map[string]string, len(workspace.Watches))
+	for key, pattern := range workspace.Watches {
+		cfg.Watches[key] = pattern
+	}
+	if err := t.compileWatches(); err != nil {
+		t.ShowError(fmt.Sprintf(i18n.GetMessage("error.watch_patterns"), err))
+	}
+
+	t.output.Write([]byte(fmt.Sprintf("[gray]%s[white]\n",
+		fmt.Sprintf(i18n.GetMessage("commands.workspace_loaded"), tview.Escape(name), workspace.Saved.Format("2006-01-02 15:04")))))
+
+	// The jobs are started once the session of the workspace is running
+	t.workspaceJobs = workspace.Jobs
+	server := workspace.Server
+	if server == nil {
+		t.workspaceJobs = nil
+		return
+	}
+	if info := t.client.GetServerInfo(); t.client.IsConnected() && info != nil &&
+		info.Address == server.Address && int(info.Port) == server.Port {
+		if t.client.IsLoggedIn() {
+			t.startWorkspaceJobs()
+		}
+		return
+	}
+	t.connectRecent(*server)
+}
+
+// startWorkspaceJobs starts the jobs of the loaded workspace that are not running yet
+func (t *TUI) startWorkspaceJobs() {
+	jobs := t.workspaceJobs
+	t.workspaceJobs = nil
+
+	running := make(map[string]bool)
+	for _, job := range t.client.GetJobs() {
+		if job.State == client.JobRunning {
+			running[job.Command] = true
+		}
+	}
+	for _, job := range jobs {
+		if !running[job.Command] {
+			t.startJob(job.Command, job.LogPane)
+		}
+	}
+}
+
+// restoreLastWorkspace loads the last workspace at startup if configured
+func (t *TUI) restoreLastWorkspace() {
+	if cfg := t.client.GetConfig(); cfg == nil || !cfg.UI.RestoreWorkspace {
+		return
+	}
+	if name, err := client.LastWorkspace(); err == nil && name != "" {
+		t.loadWorkspace(name)
+	}
+}
@@ -0,0 +1,130 @@
+// wrap.go
+/**
+ * Nexuflex Client - Soft Wrap Indicator
+ *
+ * This file contains the continuation markers of the output and log pane.
+ * Lines longer than the pane are wrapped by the text view; a marker in the
+ * right border shows that a row continues on the next one, so wrapped
+ * lines can be told apart from real line breaks. The layout of the rows is
+ * recomputed from the unwrapped text whenever the text or the width changes;
+ * the text views report changes with invalidate, so that drawing a frame
+ * does not have to compare the whole scrollback.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package ui
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rivo/uniseg"
+)
+
+// wrapMarker is drawn into the right border next to a row that continues on the next row
+const wrapMarker = '↵'
+
+// wrapLayout records which rows of a text view are continued on the next row
+type wrapLayout struct {
+	stale     atomic.Bool // The text has changed since the layout was computed
+	width     int
+	continued []bool // Per visual row
+}
+
+// invalidate notes that the text of the view has changed; it can be called
+// from any goroutine, e.g. from the changed function of the view
+func (l *wrapLayout) invalidate() {
+	l.stale.Store(true)
+}
+
+// update recomputes the layout if the text or the width has changed; the
+// wrapping follows the character wrapping of the text view
+func (l *wrapLayout) update(view *tview.TextView, width int) {
+	if !l.stale.Swap(false) && width == l.width {
+		return
+	}
+	l.width = width
+	l.continued = l.continued[:0]
+
+	for _, line := range strings.Split(view.GetText(true), "\n") {
+		lineWidth := 0
+		graphemes := uniseg.NewGraphemes(line)
+		for graphemes.Next() {
+			w := graphemes.Width()
+			if graphemes.Str() == "\t" {
+				w = tview.TabSize - lineWidth%tview.TabSize
+			}
+			if lineWidth+w > width {
+				l.continued = append(l.continued, true)
+				lineWidth = 0
+			}
+			lineWidth += w
+		}
+		l.continued = append(l.continued, false)
+	}
+}
+
+// rowOf returns the first visual row of a line of the unwrapped text
+func (l *wrapLayout) rowOf(line int) int {
+	row := 0
+	for ; row < len(l.continued) && line > 0; row++ {
+		if !l.continued[row] {
+			line--
+		}
+	}
+	return row
+}
+
+// isContinued checks whether a visual row continues on the next row
+func (l *wrapLayout) isContinued(row int) bool {
+	return row >= 0 && row < len(l.continued) && l.continued[row]
+}
+
+// isWrapIndicatorEnabled checks whether wrapped rows are marked
+func (t *TUI) isWrapIndicatorEnabled() bool {
+	cfg := t.client.GetConfig()
+	return cfg == nil || cfg.UI.WrapIndicator
+}
+
+// afterDraw marks the wrapped rows of the visible panes and records the frame
+func (t *TUI) afterDraw(screen tcell.Screen) {
+	defer t.stopFrameTimer(screen)
+	defer t.recordFrame(screen)
+
+	if !t.isWrapIndicatorEnabled() || !t.wrapOutput {
+		return
+	}
+
+	// Dialogs may cover the panes
+	if name, _ := t.pages.GetFrontPage(); name != "main" {
+		return
+	}
+
+	t.drawWrapMarkers(screen, t.output, &t.outputWrap)
+	if t.splitActive && !t.isCompactLayout() {
+		t.drawWrapMarkers(screen, t.logView, &t.logWrap)
+	}
+}
+
+// drawWrapMarkers draws the markers of the visible wrapped rows of a text view into its right border
+func (t *TUI) drawWrapMarkers(screen tcell.Screen, view *tview.TextView, layout *wrapLayout) {
+	x, _, width, _ := view.GetRect()
+	innerX, innerY, innerWidth, innerHeight := view.GetInnerRect()
+	if innerWidth <= 0 || innerHeight <= 0 || innerX+innerWidth >= x+width {
+		return // No border to draw into
+	}
+
+	layout.update(view, innerWidth)
+	offset, _ := view.GetScrollOffset()
+	style := tcell.StyleDefault.Foreground(tcell.ColorGray).Background(view.GetBackgroundColor())
+	for row := 0; row < innerHeight; row++ {
+		if layout.isContinued(offset + row) {
+			screen.SetContent(x+width-1, innerY+row, wrapMarker, nil, style)
+		}
+	}
+}
@@ -1,10 +1,10 @@
-module github.com/nexuflex/nexuflex-core-services
+module github.com/msto63/nexuflex/nexuflex-core-services
 
 go 1.20
 
 require (
-	github.com/nexuflex/nexuflex-server v0.0.0-00010101000000-000000000000
-	github.com/nexuflex/shared v0.0.0-00010101000000-000000000000
+	github.com/msto63/nexuflex/nexuflex-server v0.0.0-00010101000000-000000000000
+	github.com/msto63/nexuflex/shared v0.0.0-00010101000000-000000000000
 )
 
 require (
@@ -19,6 +19,6 @@ require (
 	google.golang.org/protobuf v1.31.0 // indirect
 )
 
-replace github.com/nexuflex/shared => ../shared
-replace github.com/nexuflex/nexuflex-server => ../nexuflex-server
+replace github.com/msto63/nexuflex/shared => ../shared
+replace github.com/msto63/nexuflex/nexuflex-server => ../nexuflex-server
  
@@ -1,11 +1,11 @@
-module github.com/nexuflex/nexuflex-server
+module github.com/msto63/nexuflex/nexuflex-server
 
 go 1.20
 
 require (
 	github.com/golang/protobuf v1.5.3
 	github.com/google/uuid v1.3.1
-	github.com/nexuflex/shared v0.0.0-00010101000000-000000000000
+	github.com/msto63/nexuflex/shared v0.0.0-00010101000000-000000000000
 	google.golang.org/grpc v1.58.2
 	google.golang.org/protobuf v1.31.0
 )
@@ -19,4 +19,4 @@ require (
 	gopkg.in/ini.v1 v1.67.0 // indirect
 )
 
-replace github.com/nexuflex/shared => ../shared 
+replace github.com/msto63/nexuflex/shared => ../shared 
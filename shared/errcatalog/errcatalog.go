@@ -0,0 +1,129 @@
+// errcatalog.go
+/**
+ * Nexuflex Shared - Error Catalog
+ *
+ * This file contains the catalog of error codes shared by server and
+ * client. Servers report an error with its code and parameters, either in
+ * the ErrorInfo detail of a gRPC status (domain "nexuflex") or in the
+ * error_code and error_params fields of a command response. Clients look
+ * up the message of the code in their language ("servererror.<code>" in
+ * the language files), so servers need not know the languages of their
+ * clients; the English messages of the catalog are the fallback.
+ * "{name}" in a message is replaced by the parameter of that name.
+ *
+ * @author msto63
+ * @version 1.0.0
+ * @date 2026-10-16
+ */
+
+package errcatalog
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain identifies nexuflex errors in the ErrorInfo detail of a gRPC status
+const Domain = "nexuflex"
+
+// Code identifies an error message of the catalog
+type Code string
+
+// Error codes with the parameters their messages use
+const (
+	CommandUnknown     Code = "command_unknown"   // command
+	ServiceUnknown     Code = "service_unknown"   // service
+	ParameterMissing   Code = "parameter_missing" // command, parameter
+	ParameterInvalid   Code = "parameter_invalid" // parameter, value
+	PermissionDenied   Code = "permission_denied" // command
+	NotLoggedIn        Code = "not_logged_in"
+	SessionExpired     Code = "session_expired"
+	InvalidCredentials Code = "invalid_credentials"
+	AccountLocked      Code = "account_locked"      // user
+	ServiceUnavailable Code = "service_unavailable" // service
+	ServerMaintenance  Code = "server_maintenance"  // until
+	RateLimited        Code = "rate_limited"        // seconds
+	ApprovalRejected   Code = "approval_rejected"   // approver
+	RecordNotFound     Code = "record_not_found"    // entity, id
+	RecordConflict     Code = "record_conflict"     // entity, id
+	InternalError      Code = "internal_error"      // reference
+)
+
+// Catalog maps the error codes to their English messages
+var Catalog = map[Code]string{
+	CommandUnknown:     "Unknown command: {command}",
+	ServiceUnknown:     "Unknown service: {service}",
+	ParameterMissing:   "Parameter {parameter} of {command} is required",
+	ParameterInvalid:   "Invalid value for {parameter}: {value}",
+	PermissionDenied:   "Not permitted to run {command}",
+	NotLoggedIn:        "Not logged in",
+	SessionExpired:     "Session has expired",
+	InvalidCredentials: "Invalid user name or password",
+	AccountLocked:      "Account {user} is locked",
+	ServiceUnavailable: "Service {service} is not available",
+	ServerMaintenance:  "Server is in maintenance until {until}",
+	RateLimited:        "Too many requests, try again in {seconds} seconds",
+	ApprovalRejected:   "Rejected by {approver}",
+	RecordNotFound:     "{entity} {id} not found",
+	RecordConflict:     "{entity} {id} was changed by another user",
+	InternalError:      "Internal server error (reference {reference})",
+}
+
+// Message returns the English message of a code with its parameters; unknown
+// codes return the code itself
+func (c Code) Message(params map[string]string) string {
+	template, ok := Catalog[c]
+	if !ok {
+		return string(c)
+	}
+	return Format(template, params)
+}
+
+// Format replaces "{name}" in a message by the parameter of that name
+func Format(template string, params map[string]string) string {
+	if len(params) == 0 {
+		return template
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+// Error creates a gRPC error with the English message of a code and the code
+// and its parameters in the ErrorInfo detail
+func Error(grpcCode codes.Code, code Code, params map[string]string) error {
+	st := status.New(grpcCode, code.Message(params))
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(code),
+		Domain:   Domain,
+		Metadata: params,
+	}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// FromStatus returns the code and parameters of a gRPC status created with Error
+func FromStatus(st *status.Status) (Code, map[string]string, bool) {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == Domain && info.GetReason() != "" {
+			return Code(info.GetReason()), info.GetMetadata(), true
+		}
+	}
+	return "", nil, false
+}
+
+// FromError returns the code and parameters of an error created with Error
+func FromError(err error) (Code, map[string]string, bool) {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &grpcErr) {
+		return "", nil, false
+	}
+	return FromStatus(grpcErr.GRPCStatus())
+}
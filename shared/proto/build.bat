@@ -1 +1 @@
-protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative nexuflex.proto
\ No newline at end of file
+buf lint && buf generate
\ No newline at end of file
@@ -0,0 +1,1247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.0
+// source: nexuflex/v1/nexuflex.proto
+
+// Version 1 of the nexuflex protocol. Changes must stay wire compatible
+// (checked with "buf breaking"); incompatible changes go into nexuflex.v2.
+
+package nexuflexv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NexuflexService_Discover_FullMethodName                = "/nexuflex.v1.NexuflexService/Discover"
+	NexuflexService_Connect_FullMethodName                 = "/nexuflex.v1.NexuflexService/Connect"
+	NexuflexService_Login_FullMethodName                   = "/nexuflex.v1.NexuflexService/Login"
+	NexuflexService_LoginWithApiKey_FullMethodName         = "/nexuflex.v1.NexuflexService/LoginWithApiKey"
+	NexuflexService_Logout_FullMethodName                  = "/nexuflex.v1.NexuflexService/Logout"
+	NexuflexService_KeepAlive_FullMethodName               = "/nexuflex.v1.NexuflexService/KeepAlive"
+	NexuflexService_DetachSession_FullMethodName           = "/nexuflex.v1.NexuflexService/DetachSession"
+	NexuflexService_AttachSession_FullMethodName           = "/nexuflex.v1.NexuflexService/AttachSession"
+	NexuflexService_ExecuteCommand_FullMethodName          = "/nexuflex.v1.NexuflexService/ExecuteCommand"
+	NexuflexService_QueryCommandStatus_FullMethodName      = "/nexuflex.v1.NexuflexService/QueryCommandStatus"
+	NexuflexService_ExecuteStreamingCommand_FullMethodName = "/nexuflex.v1.NexuflexService/ExecuteStreamingCommand"
+	NexuflexService_UploadCommandData_FullMethodName       = "/nexuflex.v1.NexuflexService/UploadCommandData"
+	NexuflexService_StageFile_FullMethodName               = "/nexuflex.v1.NexuflexService/StageFile"
+	NexuflexService_GetAvailableServices_FullMethodName    = "/nexuflex.v1.NexuflexService/GetAvailableServices"
+	NexuflexService_GetServiceCommands_FullMethodName      = "/nexuflex.v1.NexuflexService/GetServiceCommands"
+	NexuflexService_GetCommandHelp_FullMethodName          = "/nexuflex.v1.NexuflexService/GetCommandHelp"
+	NexuflexService_AutoComplete_FullMethodName            = "/nexuflex.v1.NexuflexService/AutoComplete"
+	NexuflexService_GetAliases_FullMethodName              = "/nexuflex.v1.NexuflexService/GetAliases"
+	NexuflexService_CreateAlias_FullMethodName             = "/nexuflex.v1.NexuflexService/CreateAlias"
+	NexuflexService_DeleteAlias_FullMethodName             = "/nexuflex.v1.NexuflexService/DeleteAlias"
+	NexuflexService_ReportClientInfo_FullMethodName        = "/nexuflex.v1.NexuflexService/ReportClientInfo"
+	NexuflexService_ListApprovals_FullMethodName           = "/nexuflex.v1.NexuflexService/ListApprovals"
+	NexuflexService_GetApprovalStatus_FullMethodName       = "/nexuflex.v1.NexuflexService/GetApprovalStatus"
+	NexuflexService_Approve_FullMethodName                 = "/nexuflex.v1.NexuflexService/Approve"
+	NexuflexService_GetQuickActions_FullMethodName         = "/nexuflex.v1.NexuflexService/GetQuickActions"
+	NexuflexService_Subscribe_FullMethodName               = "/nexuflex.v1.NexuflexService/Subscribe"
+	NexuflexService_AckNotifications_FullMethodName        = "/nexuflex.v1.NexuflexService/AckNotifications"
+	NexuflexService_SubmitFeedback_FullMethodName          = "/nexuflex.v1.NexuflexService/SubmitFeedback"
+	NexuflexService_GetSyncedSettings_FullMethodName       = "/nexuflex.v1.NexuflexService/GetSyncedSettings"
+	NexuflexService_SyncSettings_FullMethodName            = "/nexuflex.v1.NexuflexService/SyncSettings"
+)
+
+// NexuflexServiceClient is the client API for NexuflexService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NexuflexService defines the generic interface between client and application server
+type NexuflexServiceClient interface {
+	// System functions
+	Discover(ctx context.Context, in *DiscoverRequest, opts ...grpc.CallOption) (*DiscoverResponse, error)
+	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	LoginWithApiKey(ctx context.Context, in *ApiKeyLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	KeepAlive(ctx context.Context, in *KeepAliveRequest, opts ...grpc.CallOption) (*KeepAliveResponse, error)
+	// Session handoff between devices
+	DetachSession(ctx context.Context, in *DetachSessionRequest, opts ...grpc.CallOption) (*DetachSessionResponse, error)
+	AttachSession(ctx context.Context, in *AttachSessionRequest, opts ...grpc.CallOption) (*AttachSessionResponse, error)
+	// Main function for all commands
+	ExecuteCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	QueryCommandStatus(ctx context.Context, in *CommandStatusRequest, opts ...grpc.CallOption) (*CommandStatusResponse, error)
+	// Streaming functions for long-running operations
+	ExecuteStreamingCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandOutput], error)
+	UploadCommandData(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunk, CommandResponse], error)
+	StageFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FileChunk, StagedFile], error)
+	// Helper functions
+	GetAvailableServices(ctx context.Context, in *ServicesRequest, opts ...grpc.CallOption) (*ServicesResponse, error)
+	GetServiceCommands(ctx context.Context, in *ServiceCommandsRequest, opts ...grpc.CallOption) (*ServiceCommandsResponse, error)
+	GetCommandHelp(ctx context.Context, in *CommandHelpRequest, opts ...grpc.CallOption) (*CommandHelpResponse, error)
+	AutoComplete(ctx context.Context, in *AutoCompleteRequest, opts ...grpc.CallOption) (*AutoCompleteResponse, error)
+	// Alias management
+	GetAliases(ctx context.Context, in *GetAliasesRequest, opts ...grpc.CallOption) (*GetAliasesResponse, error)
+	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*CreateAliasResponse, error)
+	DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*DeleteAliasResponse, error)
+	// Client telemetry (strictly opt-in)
+	ReportClientInfo(ctx context.Context, in *ClientInfoRequest, opts ...grpc.CallOption) (*ClientInfoResponse, error)
+	// Approval workflow (four-eyes principle)
+	ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error)
+	GetApprovalStatus(ctx context.Context, in *ApprovalStatusRequest, opts ...grpc.CallOption) (*ApprovalStatusResponse, error)
+	Approve(ctx context.Context, in *ApproveRequest, opts ...grpc.CallOption) (*ApproveResponse, error)
+	// Quick actions for the command palette of the client
+	GetQuickActions(ctx context.Context, in *QuickActionsRequest, opts ...grpc.CallOption) (*QuickActionsResponse, error)
+	// Notifications of the server to the user, with read receipts
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
+	AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error)
+	// Feedback of users for the administrators of the server
+	SubmitFeedback(ctx context.Context, in *FeedbackRequest, opts ...grpc.CallOption) (*FeedbackResponse, error)
+	// User settings encrypted by the client, roaming between workstations
+	GetSyncedSettings(ctx context.Context, in *GetSyncedSettingsRequest, opts ...grpc.CallOption) (*GetSyncedSettingsResponse, error)
+	SyncSettings(ctx context.Context, in *SyncSettingsRequest, opts ...grpc.CallOption) (*SyncSettingsResponse, error)
+}
+
+type nexuflexServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNexuflexServiceClient(cc grpc.ClientConnInterface) NexuflexServiceClient {
+	return &nexuflexServiceClient{cc}
+}
+
+func (c *nexuflexServiceClient) Discover(ctx context.Context, in *DiscoverRequest, opts ...grpc.CallOption) (*DiscoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_Discover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_Connect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) LoginWithApiKey(ctx context.Context, in *ApiKeyLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_LoginWithApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) KeepAlive(ctx context.Context, in *KeepAliveRequest, opts ...grpc.CallOption) (*KeepAliveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeepAliveResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_KeepAlive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) DetachSession(ctx context.Context, in *DetachSessionRequest, opts ...grpc.CallOption) (*DetachSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachSessionResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_DetachSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) AttachSession(ctx context.Context, in *AttachSessionRequest, opts ...grpc.CallOption) (*AttachSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachSessionResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_AttachSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) ExecuteCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_ExecuteCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) QueryCommandStatus(ctx context.Context, in *CommandStatusRequest, opts ...grpc.CallOption) (*CommandStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandStatusResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_QueryCommandStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) ExecuteStreamingCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexuflexService_ServiceDesc.Streams[0], NexuflexService_ExecuteStreamingCommand_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CommandRequest, CommandOutput]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_ExecuteStreamingCommandClient = grpc.ServerStreamingClient[CommandOutput]
+
+func (c *nexuflexServiceClient) UploadCommandData(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunk, CommandResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexuflexService_ServiceDesc.Streams[1], NexuflexService_UploadCommandData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChunk, CommandResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_UploadCommandDataClient = grpc.ClientStreamingClient[UploadChunk, CommandResponse]
+
+func (c *nexuflexServiceClient) StageFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[FileChunk, StagedFile], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexuflexService_ServiceDesc.Streams[2], NexuflexService_StageFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FileChunk, StagedFile]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_StageFileClient = grpc.ClientStreamingClient[FileChunk, StagedFile]
+
+func (c *nexuflexServiceClient) GetAvailableServices(ctx context.Context, in *ServicesRequest, opts ...grpc.CallOption) (*ServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServicesResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetAvailableServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) GetServiceCommands(ctx context.Context, in *ServiceCommandsRequest, opts ...grpc.CallOption) (*ServiceCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceCommandsResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetServiceCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) GetCommandHelp(ctx context.Context, in *CommandHelpRequest, opts ...grpc.CallOption) (*CommandHelpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandHelpResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetCommandHelp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) AutoComplete(ctx context.Context, in *AutoCompleteRequest, opts ...grpc.CallOption) (*AutoCompleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoCompleteResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_AutoComplete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) GetAliases(ctx context.Context, in *GetAliasesRequest, opts ...grpc.CallOption) (*GetAliasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAliasesResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*CreateAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAliasResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_CreateAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*DeleteAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAliasResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_DeleteAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) ReportClientInfo(ctx context.Context, in *ClientInfoRequest, opts ...grpc.CallOption) (*ClientInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientInfoResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_ReportClientInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalsResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_ListApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) GetApprovalStatus(ctx context.Context, in *ApprovalStatusRequest, opts ...grpc.CallOption) (*ApprovalStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovalStatusResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetApprovalStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) Approve(ctx context.Context, in *ApproveRequest, opts ...grpc.CallOption) (*ApproveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) GetQuickActions(ctx context.Context, in *QuickActionsRequest, opts ...grpc.CallOption) (*QuickActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuickActionsResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetQuickActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexuflexService_ServiceDesc.Streams[3], NexuflexService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Notification]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_SubscribeClient = grpc.ServerStreamingClient[Notification]
+
+func (c *nexuflexServiceClient) AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckNotificationsResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_AckNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) SubmitFeedback(ctx context.Context, in *FeedbackRequest, opts ...grpc.CallOption) (*FeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedbackResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_SubmitFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) GetSyncedSettings(ctx context.Context, in *GetSyncedSettingsRequest, opts ...grpc.CallOption) (*GetSyncedSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSyncedSettingsResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_GetSyncedSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexuflexServiceClient) SyncSettings(ctx context.Context, in *SyncSettingsRequest, opts ...grpc.CallOption) (*SyncSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncSettingsResponse)
+	err := c.cc.Invoke(ctx, NexuflexService_SyncSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NexuflexServiceServer is the server API for NexuflexService service.
+// All implementations must embed UnimplementedNexuflexServiceServer
+// for forward compatibility.
+//
+// NexuflexService defines the generic interface between client and application server
+type NexuflexServiceServer interface {
+	// System functions
+	Discover(context.Context, *DiscoverRequest) (*DiscoverResponse, error)
+	Connect(context.Context, *ConnectRequest) (*ConnectResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	LoginWithApiKey(context.Context, *ApiKeyLoginRequest) (*LoginResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	KeepAlive(context.Context, *KeepAliveRequest) (*KeepAliveResponse, error)
+	// Session handoff between devices
+	DetachSession(context.Context, *DetachSessionRequest) (*DetachSessionResponse, error)
+	AttachSession(context.Context, *AttachSessionRequest) (*AttachSessionResponse, error)
+	// Main function for all commands
+	ExecuteCommand(context.Context, *CommandRequest) (*CommandResponse, error)
+	QueryCommandStatus(context.Context, *CommandStatusRequest) (*CommandStatusResponse, error)
+	// Streaming functions for long-running operations
+	ExecuteStreamingCommand(*CommandRequest, grpc.ServerStreamingServer[CommandOutput]) error
+	UploadCommandData(grpc.ClientStreamingServer[UploadChunk, CommandResponse]) error
+	StageFile(grpc.ClientStreamingServer[FileChunk, StagedFile]) error
+	// Helper functions
+	GetAvailableServices(context.Context, *ServicesRequest) (*ServicesResponse, error)
+	GetServiceCommands(context.Context, *ServiceCommandsRequest) (*ServiceCommandsResponse, error)
+	GetCommandHelp(context.Context, *CommandHelpRequest) (*CommandHelpResponse, error)
+	AutoComplete(context.Context, *AutoCompleteRequest) (*AutoCompleteResponse, error)
+	// Alias management
+	GetAliases(context.Context, *GetAliasesRequest) (*GetAliasesResponse, error)
+	CreateAlias(context.Context, *CreateAliasRequest) (*CreateAliasResponse, error)
+	DeleteAlias(context.Context, *DeleteAliasRequest) (*DeleteAliasResponse, error)
+	// Client telemetry (strictly opt-in)
+	ReportClientInfo(context.Context, *ClientInfoRequest) (*ClientInfoResponse, error)
+	// Approval workflow (four-eyes principle)
+	ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error)
+	GetApprovalStatus(context.Context, *ApprovalStatusRequest) (*ApprovalStatusResponse, error)
+	Approve(context.Context, *ApproveRequest) (*ApproveResponse, error)
+	// Quick actions for the command palette of the client
+	GetQuickActions(context.Context, *QuickActionsRequest) (*QuickActionsResponse, error)
+	// Notifications of the server to the user, with read receipts
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Notification]) error
+	AckNotifications(context.Context, *AckNotificationsRequest) (*AckNotificationsResponse, error)
+	// Feedback of users for the administrators of the server
+	SubmitFeedback(context.Context, *FeedbackRequest) (*FeedbackResponse, error)
+	// User settings encrypted by the client, roaming between workstations
+	GetSyncedSettings(context.Context, *GetSyncedSettingsRequest) (*GetSyncedSettingsResponse, error)
+	SyncSettings(context.Context, *SyncSettingsRequest) (*SyncSettingsResponse, error)
+	mustEmbedUnimplementedNexuflexServiceServer()
+}
+
+// UnimplementedNexuflexServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNexuflexServiceServer struct{}
+
+func (UnimplementedNexuflexServiceServer) Discover(context.Context, *DiscoverRequest) (*DiscoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Discover not implemented")
+}
+func (UnimplementedNexuflexServiceServer) Connect(context.Context, *ConnectRequest) (*ConnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedNexuflexServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedNexuflexServiceServer) LoginWithApiKey(context.Context, *ApiKeyLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithApiKey not implemented")
+}
+func (UnimplementedNexuflexServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedNexuflexServiceServer) KeepAlive(context.Context, *KeepAliveRequest) (*KeepAliveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeepAlive not implemented")
+}
+func (UnimplementedNexuflexServiceServer) DetachSession(context.Context, *DetachSessionRequest) (*DetachSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachSession not implemented")
+}
+func (UnimplementedNexuflexServiceServer) AttachSession(context.Context, *AttachSessionRequest) (*AttachSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachSession not implemented")
+}
+func (UnimplementedNexuflexServiceServer) ExecuteCommand(context.Context, *CommandRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteCommand not implemented")
+}
+func (UnimplementedNexuflexServiceServer) QueryCommandStatus(context.Context, *CommandStatusRequest) (*CommandStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCommandStatus not implemented")
+}
+func (UnimplementedNexuflexServiceServer) ExecuteStreamingCommand(*CommandRequest, grpc.ServerStreamingServer[CommandOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method ExecuteStreamingCommand not implemented")
+}
+func (UnimplementedNexuflexServiceServer) UploadCommandData(grpc.ClientStreamingServer[UploadChunk, CommandResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadCommandData not implemented")
+}
+func (UnimplementedNexuflexServiceServer) StageFile(grpc.ClientStreamingServer[FileChunk, StagedFile]) error {
+	return status.Errorf(codes.Unimplemented, "method StageFile not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetAvailableServices(context.Context, *ServicesRequest) (*ServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableServices not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetServiceCommands(context.Context, *ServiceCommandsRequest) (*ServiceCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceCommands not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetCommandHelp(context.Context, *CommandHelpRequest) (*CommandHelpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandHelp not implemented")
+}
+func (UnimplementedNexuflexServiceServer) AutoComplete(context.Context, *AutoCompleteRequest) (*AutoCompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoComplete not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetAliases(context.Context, *GetAliasesRequest) (*GetAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAliases not implemented")
+}
+func (UnimplementedNexuflexServiceServer) CreateAlias(context.Context, *CreateAliasRequest) (*CreateAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlias not implemented")
+}
+func (UnimplementedNexuflexServiceServer) DeleteAlias(context.Context, *DeleteAliasRequest) (*DeleteAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlias not implemented")
+}
+func (UnimplementedNexuflexServiceServer) ReportClientInfo(context.Context, *ClientInfoRequest) (*ClientInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportClientInfo not implemented")
+}
+func (UnimplementedNexuflexServiceServer) ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovals not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetApprovalStatus(context.Context, *ApprovalStatusRequest) (*ApprovalStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalStatus not implemented")
+}
+func (UnimplementedNexuflexServiceServer) Approve(context.Context, *ApproveRequest) (*ApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetQuickActions(context.Context, *QuickActionsRequest) (*QuickActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuickActions not implemented")
+}
+func (UnimplementedNexuflexServiceServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Notification]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedNexuflexServiceServer) AckNotifications(context.Context, *AckNotificationsRequest) (*AckNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckNotifications not implemented")
+}
+func (UnimplementedNexuflexServiceServer) SubmitFeedback(context.Context, *FeedbackRequest) (*FeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
+func (UnimplementedNexuflexServiceServer) GetSyncedSettings(context.Context, *GetSyncedSettingsRequest) (*GetSyncedSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncedSettings not implemented")
+}
+func (UnimplementedNexuflexServiceServer) SyncSettings(context.Context, *SyncSettingsRequest) (*SyncSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSettings not implemented")
+}
+func (UnimplementedNexuflexServiceServer) mustEmbedUnimplementedNexuflexServiceServer() {}
+func (UnimplementedNexuflexServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeNexuflexServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NexuflexServiceServer will
+// result in compilation errors.
+type UnsafeNexuflexServiceServer interface {
+	mustEmbedUnimplementedNexuflexServiceServer()
+}
+
+func RegisterNexuflexServiceServer(s grpc.ServiceRegistrar, srv NexuflexServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNexuflexServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NexuflexService_ServiceDesc, srv)
+}
+
+func _NexuflexService_Discover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).Discover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_Discover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).Discover(ctx, req.(*DiscoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_Connect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).Connect(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_LoginWithApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).LoginWithApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_LoginWithApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).LoginWithApiKey(ctx, req.(*ApiKeyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_KeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeepAliveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).KeepAlive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_KeepAlive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).KeepAlive(ctx, req.(*KeepAliveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_DetachSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).DetachSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_DetachSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).DetachSession(ctx, req.(*DetachSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_AttachSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).AttachSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_AttachSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).AttachSession(ctx, req.(*AttachSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_ExecuteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).ExecuteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_ExecuteCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).ExecuteCommand(ctx, req.(*CommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_QueryCommandStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).QueryCommandStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_QueryCommandStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).QueryCommandStatus(ctx, req.(*CommandStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_ExecuteStreamingCommand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CommandRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NexuflexServiceServer).ExecuteStreamingCommand(m, &grpc.GenericServerStream[CommandRequest, CommandOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_ExecuteStreamingCommandServer = grpc.ServerStreamingServer[CommandOutput]
+
+func _NexuflexService_UploadCommandData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NexuflexServiceServer).UploadCommandData(&grpc.GenericServerStream[UploadChunk, CommandResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_UploadCommandDataServer = grpc.ClientStreamingServer[UploadChunk, CommandResponse]
+
+func _NexuflexService_StageFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NexuflexServiceServer).StageFile(&grpc.GenericServerStream[FileChunk, StagedFile]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_StageFileServer = grpc.ClientStreamingServer[FileChunk, StagedFile]
+
+func _NexuflexService_GetAvailableServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetAvailableServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetAvailableServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetAvailableServices(ctx, req.(*ServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_GetServiceCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetServiceCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetServiceCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetServiceCommands(ctx, req.(*ServiceCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_GetCommandHelp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandHelpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetCommandHelp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetCommandHelp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetCommandHelp(ctx, req.(*CommandHelpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_AutoComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoCompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).AutoComplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_AutoComplete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).AutoComplete(ctx, req.(*AutoCompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_GetAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetAliases(ctx, req.(*GetAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_CreateAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_DeleteAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).DeleteAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_DeleteAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).DeleteAlias(ctx, req.(*DeleteAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_ReportClientInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).ReportClientInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_ReportClientInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).ReportClientInfo(ctx, req.(*ClientInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_ListApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).ListApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_ListApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).ListApprovals(ctx, req.(*ListApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_GetApprovalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetApprovalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetApprovalStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetApprovalStatus(ctx, req.(*ApprovalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).Approve(ctx, req.(*ApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_GetQuickActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetQuickActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetQuickActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetQuickActions(ctx, req.(*QuickActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NexuflexServiceServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, Notification]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexuflexService_SubscribeServer = grpc.ServerStreamingServer[Notification]
+
+func _NexuflexService_AckNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).AckNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_AckNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).AckNotifications(ctx, req.(*AckNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_SubmitFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).SubmitFeedback(ctx, req.(*FeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_GetSyncedSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncedSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).GetSyncedSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_GetSyncedSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).GetSyncedSettings(ctx, req.(*GetSyncedSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexuflexService_SyncSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexuflexServiceServer).SyncSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexuflexService_SyncSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexuflexServiceServer).SyncSettings(ctx, req.(*SyncSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NexuflexService_ServiceDesc is the grpc.ServiceDesc for NexuflexService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NexuflexService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nexuflex.v1.NexuflexService",
+	HandlerType: (*NexuflexServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Discover",
+			Handler:    _NexuflexService_Discover_Handler,
+		},
+		{
+			MethodName: "Connect",
+			Handler:    _NexuflexService_Connect_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _NexuflexService_Login_Handler,
+		},
+		{
+			MethodName: "LoginWithApiKey",
+			Handler:    _NexuflexService_LoginWithApiKey_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _NexuflexService_Logout_Handler,
+		},
+		{
+			MethodName: "KeepAlive",
+			Handler:    _NexuflexService_KeepAlive_Handler,
+		},
+		{
+			MethodName: "DetachSession",
+			Handler:    _NexuflexService_DetachSession_Handler,
+		},
+		{
+			MethodName: "AttachSession",
+			Handler:    _NexuflexService_AttachSession_Handler,
+		},
+		{
+			MethodName: "ExecuteCommand",
+			Handler:    _NexuflexService_ExecuteCommand_Handler,
+		},
+		{
+			MethodName: "QueryCommandStatus",
+			Handler:    _NexuflexService_QueryCommandStatus_Handler,
+		},
+		{
+			MethodName: "GetAvailableServices",
+			Handler:    _NexuflexService_GetAvailableServices_Handler,
+		},
+		{
+			MethodName: "GetServiceCommands",
+			Handler:    _NexuflexService_GetServiceCommands_Handler,
+		},
+		{
+			MethodName: "GetCommandHelp",
+			Handler:    _NexuflexService_GetCommandHelp_Handler,
+		},
+		{
+			MethodName: "AutoComplete",
+			Handler:    _NexuflexService_AutoComplete_Handler,
+		},
+		{
+			MethodName: "GetAliases",
+			Handler:    _NexuflexService_GetAliases_Handler,
+		},
+		{
+			MethodName: "CreateAlias",
+			Handler:    _NexuflexService_CreateAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAlias",
+			Handler:    _NexuflexService_DeleteAlias_Handler,
+		},
+		{
+			MethodName: "ReportClientInfo",
+			Handler:    _NexuflexService_ReportClientInfo_Handler,
+		},
+		{
+			MethodName: "ListApprovals",
+			Handler:    _NexuflexService_ListApprovals_Handler,
+		},
+		{
+			MethodName: "GetApprovalStatus",
+			Handler:    _NexuflexService_GetApprovalStatus_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _NexuflexService_Approve_Handler,
+		},
+		{
+			MethodName: "GetQuickActions",
+			Handler:    _NexuflexService_GetQuickActions_Handler,
+		},
+		{
+			MethodName: "AckNotifications",
+			Handler:    _NexuflexService_AckNotifications_Handler,
+		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _NexuflexService_SubmitFeedback_Handler,
+		},
+		{
+			MethodName: "GetSyncedSettings",
+			Handler:    _NexuflexService_GetSyncedSettings_Handler,
+		},
+		{
+			MethodName: "SyncSettings",
+			Handler:    _NexuflexService_SyncSettings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExecuteStreamingCommand",
+			Handler:       _NexuflexService_ExecuteStreamingCommand_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadCommandData",
+			Handler:       _NexuflexService_UploadCommandData_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StageFile",
+			Handler:       _NexuflexService_StageFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _NexuflexService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nexuflex/v1/nexuflex.proto",
+}